@@ -71,7 +71,7 @@ minder control plane for an specific provider/project or profile id, entity type
 				Provider: provider,
 			},
 			Name: profileName,
-			Entity: &minderv1.GetProfileStatusByNameRequest_EntityTypedId{
+			Entity: &minderv1.EntityTypedId{
 				Id:   entityId,
 				Type: minderv1.EntityFromString(entityType),
 			},
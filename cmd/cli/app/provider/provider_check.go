@@ -0,0 +1,143 @@
+//
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/stacklok/minder/cmd/cli/app"
+	"github.com/stacklok/minder/internal/util"
+	"github.com/stacklok/minder/internal/util/cli"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var checkProviderCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Check the permissions of the credentials of a provider",
+	Long: `The minder provider check command checks the permissions granted to the
+credentials of a provider against the permissions Minder needs to register
+repositories, and the ones needed by the rule types, remediations and alerts
+turned on in the profiles of the provider. Missing permissions are listed along
+with the token scopes granting them.
+
+Some credentials, such as GitHub fine-grained personal access tokens and GitHub
+App installation tokens, don't report their permissions and can't be checked.
+The permissions Minder needs are still listed for them, so that they can be
+verified by hand.`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			fmt.Fprintf(os.Stderr, "Error binding flags: %s\n", err)
+		}
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		provider := util.GetConfigValue(viper.GetViper(), "provider", "provider", cmd, "").(string)
+		project := viper.GetString("project-id")
+		format := viper.GetString("output")
+
+		switch format {
+		case app.JSON, app.YAML, "table", "":
+		default:
+			return fmt.Errorf("invalid output format: %s", format)
+		}
+
+		conn, err := util.GrpcForCommand(cmd, viper.GetViper())
+		util.ExitNicelyOnError(err, "Error getting grpc connection")
+		defer conn.Close()
+
+		client := pb.NewOAuthServiceClient(conn)
+		ctx, cancel := util.GetAppContext()
+		defer cancel()
+
+		resp, err := client.CheckProviderPermissions(ctx, &pb.CheckProviderPermissionsRequest{
+			Provider:  provider,
+			ProjectId: project,
+		})
+		util.ExitNicelyOnError(err, "Error checking provider permissions")
+
+		switch format {
+		case "", "table":
+			columns := []table.Column{
+				{Title: "Permission", Width: 30},
+				{Title: "Granted", Width: 10},
+				{Title: "Scopes", Width: 40},
+				{Title: "Required by", Width: 50},
+			}
+
+			var rows []table.Row
+			missing := 0
+			for _, p := range resp.GetPermissions() {
+				granted := "yes"
+				switch {
+				case !resp.GetIntrospected():
+					granted = "unknown"
+				case !p.GetGranted():
+					granted = "no"
+					missing++
+				}
+				rows = append(rows, table.Row{
+					p.GetName(),
+					granted,
+					strings.Join(p.GetScopes(), " or "),
+					strings.Join(p.GetRequiredBy(), ", "),
+				})
+			}
+
+			t := table.New(
+				table.WithColumns(columns),
+				table.WithRows(rows),
+				table.WithFocused(false),
+				table.WithHeight(len(rows)),
+				table.WithStyles(cli.TableHiddenSelectStyles),
+			)
+
+			cli.PrintCmd(cmd, cli.TableRender(t))
+			if !resp.GetIntrospected() {
+				cli.PrintCmd(cmd, cli.WarningBanner.Render(fmt.Sprintf(
+					"The credentials of provider %s don't report their permissions, e.g. because they are a "+
+						"GitHub fine-grained token, so none of the permissions above were checked. "+
+						"Make sure the credentials grant them.", provider)))
+			} else if missing > 0 {
+				cli.PrintCmd(cmd, cli.WarningBanner.Render(
+					fmt.Sprintf("%d permission(s) missing, enroll the provider again with a token granting them", missing)))
+			}
+		case app.JSON:
+			out, err := util.GetJsonFromProto(resp)
+			util.ExitNicelyOnError(err, "Error getting json from proto")
+			fmt.Println(out)
+		case app.YAML:
+			out, err := util.GetYamlFromProto(resp)
+			util.ExitNicelyOnError(err, "Error getting yaml from proto")
+			fmt.Println(out)
+		}
+		return nil
+	},
+}
+
+func init() {
+	ProviderCmd.AddCommand(checkProviderCmd)
+	checkProviderCmd.Flags().StringP("provider", "p", "", "Name of the provider")
+	checkProviderCmd.Flags().StringP("project-id", "g", "", "ID of the project the provider belongs to")
+	checkProviderCmd.Flags().StringP("output", "f", "", "Output format (json, yaml or table)")
+	if err := checkProviderCmd.MarkFlagRequired("provider"); err != nil {
+		fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
+	}
+}
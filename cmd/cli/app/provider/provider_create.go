@@ -0,0 +1,97 @@
+//
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/stacklok/minder/cmd/cli/app"
+	"github.com/stacklok/minder/internal/util"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var createProviderCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a provider in a project",
+	Long: `The minder provider create command creates a provider from a definition given
+as JSON and the interfaces it implements, for example for an OCI registry:
+
+  minder provider create --provider registry --implements oci \
+    --definition '{"oci": {"registry": "registry.example.com", "username": "bot"}}'
+
+Credentials for the provider are stored afterwards with minder provider enroll --token.`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			fmt.Fprintf(os.Stderr, "Error binding flags: %s\n", err)
+		}
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		provider := util.GetConfigValue(viper.GetViper(), "provider", "provider", cmd, "").(string)
+		project := viper.GetString("project-id")
+		format := viper.GetString("output")
+		definition := viper.GetString("definition")
+		implements := viper.GetStringSlice("implements")
+
+		if format != app.JSON && format != app.YAML {
+			return fmt.Errorf("invalid output format: %s", format)
+		}
+
+		prov := &pb.Provider{
+			Name:       provider,
+			Implements: implements,
+			Def:        &pb.Provider_Definition{},
+		}
+		if err := protojson.Unmarshal([]byte(definition), prov.Def); err != nil {
+			return fmt.Errorf("invalid definition: %w", err)
+		}
+
+		conn, err := util.GrpcForCommand(cmd, viper.GetViper())
+		util.ExitNicelyOnError(err, "Error getting grpc connection")
+		defer conn.Close()
+
+		client := pb.NewProvidersServiceClient(conn)
+		ctx, cancel := util.GetAppContext()
+		defer cancel()
+
+		resp, err := client.CreateProvider(ctx, &pb.CreateProviderRequest{
+			Provider:  prov,
+			ProjectId: project,
+		})
+		util.ExitNicelyOnError(err, "Error creating provider")
+
+		printProvider(format, resp.GetProvider())
+		return nil
+	},
+}
+
+func init() {
+	ProviderCmd.AddCommand(createProviderCmd)
+	createProviderCmd.Flags().StringP("provider", "p", "", "Name of the provider")
+	createProviderCmd.Flags().StringP("project-id", "g", "", "ID of the project the provider belongs to")
+	createProviderCmd.Flags().StringP("definition", "d", "", "Definition of the provider, as JSON")
+	createProviderCmd.Flags().StringSliceP("implements", "i", nil, "Interfaces the provider implements")
+	createProviderCmd.Flags().StringP("output", "f", app.JSON, "Output format (json or yaml)")
+	for _, flag := range []string{"provider", "definition", "implements"} {
+		if err := createProviderCmd.MarkFlagRequired(flag); err != nil {
+			fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+//
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/stacklok/minder/internal/util"
+	"github.com/stacklok/minder/internal/util/cli"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var deleteProviderCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a provider of a project",
+	Long: `The minder provider delete command deletes a provider. The webhooks created for
+the repositories registered with the provider are removed, and so are the repositories,
+profiles and rule types of the provider.`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			fmt.Fprintf(os.Stderr, "Error binding flags: %s\n", err)
+		}
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		provider := util.GetConfigValue(viper.GetViper(), "provider", "provider", cmd, "").(string)
+		project := viper.GetString("project-id")
+
+		yes := cli.PrintYesNoPrompt(cmd,
+			fmt.Sprintf("You are about to delete the %s provider, along with its repositories, profiles and rule types.",
+				provider),
+			"Do you confirm?",
+			"Delete operation cancelled.")
+		if !yes {
+			return nil
+		}
+
+		conn, err := util.GrpcForCommand(cmd, viper.GetViper())
+		util.ExitNicelyOnError(err, "Error getting grpc connection")
+		defer conn.Close()
+
+		client := pb.NewProvidersServiceClient(conn)
+		ctx, cancel := util.GetAppContext()
+		defer cancel()
+
+		_, err = client.DeleteProvider(ctx, &pb.DeleteProviderRequest{
+			Name:      provider,
+			ProjectId: project,
+		})
+		util.ExitNicelyOnError(err, "Error deleting provider")
+
+		cli.PrintCmd(cmd, "Provider %s deleted", provider)
+		return nil
+	},
+}
+
+func init() {
+	ProviderCmd.AddCommand(deleteProviderCmd)
+	deleteProviderCmd.Flags().StringP("provider", "p", "", "Name of the provider")
+	deleteProviderCmd.Flags().StringP("project-id", "g", "", "ID of the project the provider belongs to")
+	if err := deleteProviderCmd.MarkFlagRequired("provider"); err != nil {
+		fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
+	}
+}
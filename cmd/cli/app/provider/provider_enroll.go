@@ -26,12 +26,14 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/pkg/browser"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"golang.org/x/exp/slices"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	ghclient "github.com/stacklok/minder/internal/providers/github"
@@ -98,6 +100,7 @@ func callBackServer(ctx context.Context, provider string, project string, port s
 			res, err := client.VerifyProviderTokenFrom(clientCtx,
 				&pb.VerifyProviderTokenFromRequest{Provider: provider, ProjectId: project, Timestamp: timestamppb.New(t)})
 			if err == nil && res.Status == "OK" {
+				warnMissingPermissions(res.GetMissingPermissions())
 				return
 			}
 			if err != nil || res.Status == "OK" || calls >= MAX_CALLS {
@@ -108,12 +111,36 @@ func callBackServer(ctx context.Context, provider string, project string, port s
 
 }
 
+// warnMissingPermissions lists the permissions needed by the rule types in
+// use which the enrolled token doesn't grant
+func warnMissingPermissions(perms []*pb.ProviderPermission) {
+	if len(perms) == 0 {
+		return
+	}
+
+	fmt.Fprintln(os.Stderr, "Warning: the token lacks permissions needed by the rule types in use:")
+	for _, p := range perms {
+		fmt.Fprintf(os.Stderr, "  %s (scopes: %s), required by %s\n",
+			p.GetName(), strings.Join(p.GetScopes(), " or "), strings.Join(p.GetRequiredBy(), ", "))
+	}
+	fmt.Fprintln(os.Stderr, "Enroll the provider again with a token granting them, "+
+		"and run minder provider check to verify.")
+}
+
 var enrollProviderCmd = &cobra.Command{
 	Use:   "enroll",
 	Short: "Enroll a provider within the minder control plane",
 	Long: `The minder provider enroll command allows a user to enroll a provider
 such as GitHub into the minder control plane. Once enrolled, users can perform
-actions such as adding repositories.`,
+actions such as adding repositories.
+
+GitHub providers, including the ones pointing to a GitHub Enterprise Server,
+are enrolled through OAuth. Other providers, such as OCI registries, are
+enrolled by passing their credentials with --token.
+
+Tokens lacking the permissions needed to register repositories are rejected.
+Permissions only needed by the rule types in use are reported as warnings,
+see minder provider check.`,
 	PreRun: func(cmd *cobra.Command, args []string) {
 		if err := viper.BindPFlags(cmd.Flags()); err != nil {
 			fmt.Fprintf(os.Stderr, "Error binding flags: %s\n", err)
@@ -121,31 +148,49 @@ actions such as adding repositories.`,
 	},
 	Run: func(cmd *cobra.Command, args []string) {
 		provider := util.GetConfigValue(viper.GetViper(), "provider", "provider", cmd, "").(string)
-		if provider != ghclient.Github {
-			fmt.Fprintf(os.Stderr, "Only %s is supported at this time\n", ghclient.Github)
-			os.Exit(1)
-		}
 		project := viper.GetString("project-id")
 		pat := util.GetConfigValue(viper.GetViper(), "token", "token", cmd, "").(string)
 		owner := util.GetConfigValue(viper.GetViper(), "owner", "owner", cmd, "").(string)
 
-		// Ask for confirmation if an owner is set on purpose
-		ownerPromptStr := "your personal account"
-		if owner != "" {
-			ownerPromptStr = fmt.Sprintf("the %s organisation", owner)
-		}
-		yes := cli.PrintYesNoPrompt(cmd,
-			fmt.Sprintf("You are about to enroll repositories from %s.", ownerPromptStr),
-			"Do you confirm?",
-			"Enroll operation cancelled.")
-		if !yes {
-			return
-		}
-
 		conn, err := util.GrpcForCommand(cmd, viper.GetViper())
 		util.ExitNicelyOnError(err, "Error getting grpc connection")
 		defer conn.Close()
 
+		// GitHub providers, including the ones of a GitHub Enterprise Server,
+		// are enrolled through OAuth unless a token is passed
+		isGitHub := provider == ghclient.Github
+		if !isGitHub {
+			getCtx, getCancel := util.GetAppContext()
+			defer getCancel()
+			resp, err := pb.NewProvidersServiceClient(conn).GetProvider(getCtx, &pb.GetProviderRequest{
+				Name:      provider,
+				ProjectId: project,
+			})
+			util.ExitNicelyOnError(err, "Error getting provider")
+			isGitHub = slices.Contains(resp.GetProvider().GetImplements(), ghclient.Github)
+		}
+
+		if !isGitHub && pat == "" {
+			fmt.Fprintf(os.Stderr, "Only %s providers can be enrolled through OAuth, use --token for other providers\n",
+				ghclient.Github)
+			os.Exit(1)
+		}
+
+		if isGitHub {
+			// Ask for confirmation if an owner is set on purpose
+			ownerPromptStr := "your personal account"
+			if owner != "" {
+				ownerPromptStr = fmt.Sprintf("the %s organisation", owner)
+			}
+			yes := cli.PrintYesNoPrompt(cmd,
+				fmt.Sprintf("You are about to enroll repositories from %s.", ownerPromptStr),
+				"Do you confirm?",
+				"Enroll operation cancelled.")
+			if !yes {
+				return
+			}
+		}
+
 		client := pb.NewOAuthServiceClient(conn)
 		ctx, cancel := util.GetAppContext()
 		defer cancel()
@@ -154,9 +199,10 @@ actions such as adding repositories.`,
 
 		if pat != "" {
 			// use pat for enrollment
-			_, err := client.StoreProviderToken(context.Background(),
+			res, err := client.StoreProviderToken(context.Background(),
 				&pb.StoreProviderTokenRequest{Provider: provider, ProjectId: project, AccessToken: pat, Owner: &owner})
 			util.ExitNicelyOnError(err, "Error storing token")
+			warnMissingPermissions(res.GetMissingPermissions())
 
 			cli.PrintCmd(cmd, "Provider enrolled successfully")
 			return
@@ -200,7 +246,7 @@ func init() {
 	ProviderCmd.AddCommand(enrollProviderCmd)
 	enrollProviderCmd.Flags().StringP("provider", "p", "", "Name for the provider to enroll")
 	enrollProviderCmd.Flags().StringP("project-id", "g", "", "ID of the project for enrolling the provider")
-	enrollProviderCmd.Flags().StringP("token", "t", "", "Personal Access Token (PAT) to use for enrollment")
+	enrollProviderCmd.Flags().StringP("token", "t", "", "Personal Access Token (PAT), or registry password, to use for enrollment")
 	enrollProviderCmd.Flags().StringP("owner", "o", "", "Owner to filter on for provider resources")
 	if err := enrollProviderCmd.MarkFlagRequired("provider"); err != nil {
 		fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
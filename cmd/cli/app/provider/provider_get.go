@@ -0,0 +1,88 @@
+//
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/stacklok/minder/cmd/cli/app"
+	"github.com/stacklok/minder/internal/util"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var getProviderCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Get a provider of a project",
+	Long:  `The minder provider get command shows a provider, including its definition and health.`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			fmt.Fprintf(os.Stderr, "Error binding flags: %s\n", err)
+		}
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		provider := util.GetConfigValue(viper.GetViper(), "provider", "provider", cmd, "").(string)
+		project := viper.GetString("project-id")
+		format := viper.GetString("output")
+
+		if format != app.JSON && format != app.YAML {
+			return fmt.Errorf("invalid output format: %s", format)
+		}
+
+		conn, err := util.GrpcForCommand(cmd, viper.GetViper())
+		util.ExitNicelyOnError(err, "Error getting grpc connection")
+		defer conn.Close()
+
+		client := pb.NewProvidersServiceClient(conn)
+		ctx, cancel := util.GetAppContext()
+		defer cancel()
+
+		resp, err := client.GetProvider(ctx, &pb.GetProviderRequest{
+			Name:      provider,
+			ProjectId: project,
+		})
+		util.ExitNicelyOnError(err, "Error getting provider")
+
+		printProvider(format, resp.GetProvider())
+		return nil
+	},
+}
+
+// printProvider prints a provider in the given format
+func printProvider(format string, provider *pb.Provider) {
+	var out string
+	var err error
+	if format == app.YAML {
+		out, err = util.GetYamlFromProto(provider)
+	} else {
+		out, err = util.GetJsonFromProto(provider)
+	}
+	util.ExitNicelyOnError(err, "Error formatting provider")
+	fmt.Println(out)
+}
+
+func init() {
+	ProviderCmd.AddCommand(getProviderCmd)
+	getProviderCmd.Flags().StringP("provider", "p", "", "Name of the provider")
+	getProviderCmd.Flags().StringP("project-id", "g", "", "ID of the project the provider belongs to")
+	getProviderCmd.Flags().StringP("output", "f", app.JSON, "Output format (json or yaml)")
+	if err := getProviderCmd.MarkFlagRequired("provider"); err != nil {
+		fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
+	}
+}
@@ -0,0 +1,84 @@
+//
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/stacklok/minder/cmd/cli/app"
+	"github.com/stacklok/minder/internal/util"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var healthProviderCmd = &cobra.Command{
+	Use:   "health",
+	Short: "Show the health of a provider",
+	Long: `The minder provider health command shows whether the credentials of a provider
+are usable. A provider becomes unhealthy when its access token expired and couldn't
+be refreshed, in which case it needs to be enrolled again.`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			fmt.Fprintf(os.Stderr, "Error binding flags: %s\n", err)
+		}
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		provider := util.GetConfigValue(viper.GetViper(), "provider", "provider", cmd, "").(string)
+		project := viper.GetString("project-id")
+		format := viper.GetString("output")
+
+		if format != app.JSON && format != app.YAML {
+			return fmt.Errorf("invalid output format: %s", format)
+		}
+
+		conn, err := util.GrpcForCommand(cmd, viper.GetViper())
+		util.ExitNicelyOnError(err, "Error getting grpc connection")
+		defer conn.Close()
+
+		client := pb.NewOAuthServiceClient(conn)
+		ctx, cancel := util.GetAppContext()
+		defer cancel()
+
+		resp, err := client.GetProviderHealth(ctx, &pb.GetProviderHealthRequest{
+			Provider:  provider,
+			ProjectId: project,
+		})
+		util.ExitNicelyOnError(err, "Error getting provider health")
+
+		var out string
+		if format == app.JSON {
+			out, err = util.GetJsonFromProto(resp.Health)
+		} else {
+			out, err = util.GetYamlFromProto(resp.Health)
+		}
+		util.ExitNicelyOnError(err, "Error formatting provider health")
+		fmt.Println(out)
+		return nil
+	},
+}
+
+func init() {
+	ProviderCmd.AddCommand(healthProviderCmd)
+	healthProviderCmd.Flags().StringP("provider", "p", "", "Name of the provider")
+	healthProviderCmd.Flags().StringP("project-id", "g", "", "ID of the project the provider belongs to")
+	healthProviderCmd.Flags().StringP("output", "f", app.JSON, "Output format (json or yaml)")
+	if err := healthProviderCmd.MarkFlagRequired("provider"); err != nil {
+		fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
+	}
+}
@@ -0,0 +1,108 @@
+//
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/stacklok/minder/cmd/cli/app"
+	"github.com/stacklok/minder/internal/util"
+	"github.com/stacklok/minder/internal/util/cli"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var listProviderCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the providers of a project",
+	Long:  `The minder provider list command lists the providers of a project.`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			fmt.Fprintf(os.Stderr, "Error binding flags: %s\n", err)
+		}
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		project := viper.GetString("project-id")
+		format := viper.GetString("output")
+
+		switch format {
+		case app.JSON, app.YAML, "table", "":
+		default:
+			return fmt.Errorf("invalid output format: %s", format)
+		}
+
+		conn, err := util.GrpcForCommand(cmd, viper.GetViper())
+		util.ExitNicelyOnError(err, "Error getting grpc connection")
+		defer conn.Close()
+
+		client := pb.NewProvidersServiceClient(conn)
+		ctx, cancel := util.GetAppContext()
+		defer cancel()
+
+		resp, err := client.ListProviders(ctx, &pb.ListProvidersRequest{ProjectId: project})
+		util.ExitNicelyOnError(err, "Error listing providers")
+
+		switch format {
+		case "", "table":
+			columns := []table.Column{
+				{Title: "Name", Width: 20},
+				{Title: "Project", Width: 40},
+				{Title: "Implements", Width: 40},
+				{Title: "Health", Width: 10},
+			}
+
+			var rows []table.Row
+			for _, p := range resp.Providers {
+				rows = append(rows, table.Row{
+					p.GetName(),
+					p.GetContext().GetProject(),
+					strings.Join(p.GetImplements(), ", "),
+					p.GetHealth().GetStatus(),
+				})
+			}
+
+			t := table.New(
+				table.WithColumns(columns),
+				table.WithRows(rows),
+				table.WithFocused(false),
+				table.WithHeight(len(rows)),
+				table.WithStyles(cli.TableHiddenSelectStyles),
+			)
+
+			cli.PrintCmd(cmd, cli.TableRender(t))
+		case app.JSON:
+			out, err := util.GetJsonFromProto(resp)
+			util.ExitNicelyOnError(err, "Error getting json from proto")
+			fmt.Println(out)
+		case app.YAML:
+			out, err := util.GetYamlFromProto(resp)
+			util.ExitNicelyOnError(err, "Error getting yaml from proto")
+			fmt.Println(out)
+		}
+		return nil
+	},
+}
+
+func init() {
+	ProviderCmd.AddCommand(listProviderCmd)
+	listProviderCmd.Flags().StringP("project-id", "g", "", "ID of the project to list the providers of")
+	listProviderCmd.Flags().StringP("output", "f", "", "Output format (json, yaml or table)")
+}
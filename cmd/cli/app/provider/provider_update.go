@@ -0,0 +1,100 @@
+//
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/stacklok/minder/cmd/cli/app"
+	"github.com/stacklok/minder/internal/util"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var updateProviderCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update a provider of a project",
+	Long: `The minder provider update command replaces the definition of a provider, or
+the interfaces it implements. The definition is given as JSON, for example to point a
+GitHub provider to a GitHub Enterprise Server:
+
+  minder provider update --provider github \
+    --definition '{"github": {"endpoint": "https://github.example.com/api/v3/"}}'`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			fmt.Fprintf(os.Stderr, "Error binding flags: %s\n", err)
+		}
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		provider := util.GetConfigValue(viper.GetViper(), "provider", "provider", cmd, "").(string)
+		project := viper.GetString("project-id")
+		format := viper.GetString("output")
+		definition := viper.GetString("definition")
+		implements := viper.GetStringSlice("implements")
+
+		if format != app.JSON && format != app.YAML {
+			return fmt.Errorf("invalid output format: %s", format)
+		}
+
+		if definition == "" && len(implements) == 0 {
+			return fmt.Errorf("either definition or implements needs to be set")
+		}
+
+		update := &pb.Provider{
+			Name:       provider,
+			Implements: implements,
+		}
+		if definition != "" {
+			update.Def = &pb.Provider_Definition{}
+			if err := protojson.Unmarshal([]byte(definition), update.Def); err != nil {
+				return fmt.Errorf("invalid definition: %w", err)
+			}
+		}
+
+		conn, err := util.GrpcForCommand(cmd, viper.GetViper())
+		util.ExitNicelyOnError(err, "Error getting grpc connection")
+		defer conn.Close()
+
+		client := pb.NewProvidersServiceClient(conn)
+		ctx, cancel := util.GetAppContext()
+		defer cancel()
+
+		resp, err := client.UpdateProvider(ctx, &pb.UpdateProviderRequest{
+			Provider:  update,
+			ProjectId: project,
+		})
+		util.ExitNicelyOnError(err, "Error updating provider")
+
+		printProvider(format, resp.GetProvider())
+		return nil
+	},
+}
+
+func init() {
+	ProviderCmd.AddCommand(updateProviderCmd)
+	updateProviderCmd.Flags().StringP("provider", "p", "", "Name of the provider")
+	updateProviderCmd.Flags().StringP("project-id", "g", "", "ID of the project the provider belongs to")
+	updateProviderCmd.Flags().StringP("definition", "d", "", "Definition of the provider, as JSON")
+	updateProviderCmd.Flags().StringSliceP("implements", "i", nil, "Interfaces the provider implements")
+	updateProviderCmd.Flags().StringP("output", "f", app.JSON, "Output format (json or yaml)")
+	if err := updateProviderCmd.MarkFlagRequired("provider"); err != nil {
+		fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
+	}
+}
@@ -0,0 +1,39 @@
+//
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package remediation provides the CLI subcommand for reviewing the remediations
+// waiting for approval
+package remediation
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/stacklok/minder/cmd/cli/app"
+)
+
+// RemediationCmd is the root command for the remediation subcommands
+var RemediationCmd = &cobra.Command{
+	Use:   "remediation",
+	Short: "Review remediations within a minder control plane",
+	Long: `The minder remediation subcommands allow reviewing the remediations
+waiting for approval within a minder control plane.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Usage()
+	},
+}
+
+func init() {
+	app.RootCmd.AddCommand(RemediationCmd)
+}
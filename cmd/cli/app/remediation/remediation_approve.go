@@ -0,0 +1,68 @@
+//
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/stacklok/minder/internal/util"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var remediation_approveCmd = &cobra.Command{
+	Use:   "approve",
+	Short: "Approve a remediation waiting for approval",
+	Long: `The minder remediation approve subcommand lets you approve a remediation
+waiting for approval. The remediation is performed once the rule is evaluated
+again, as long as it still fails.`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			fmt.Fprintf(os.Stderr, "Error binding flags: %s\n", err)
+		}
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		id := viper.GetString("id")
+
+		conn, err := util.GrpcForCommand(cmd, viper.GetViper())
+		util.ExitNicelyOnError(err, "Error getting grpc connection")
+		defer conn.Close()
+
+		client := pb.NewProfileServiceClient(conn)
+		ctx, cancel := util.GetAppContext()
+		defer cancel()
+
+		_, err = client.ApproveRemediation(ctx, &pb.ApproveRemediationRequest{
+			Context: &pb.Context{
+				Provider: viper.GetString("provider"),
+			},
+			Id: id,
+		})
+		util.ExitNicelyOnError(err, "Error reviewing remediation")
+		cmd.Println("Successfully approved remediation with id:", id)
+	},
+}
+
+func init() {
+	RemediationCmd.AddCommand(remediation_approveCmd)
+	remediation_approveCmd.Flags().StringP("id", "i", "", "ID of the remediation to approve")
+	remediation_approveCmd.Flags().StringP("provider", "p", "github", "Provider for the remediation")
+	err := remediation_approveCmd.MarkFlagRequired("id")
+	util.ExitNicelyOnError(err, "Error marking flag as required")
+}
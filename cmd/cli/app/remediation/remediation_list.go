@@ -0,0 +1,127 @@
+//
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/stacklok/minder/cmd/cli/app"
+	"github.com/stacklok/minder/internal/util"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var remediation_listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the remediations waiting for approval",
+	Long: `The minder remediation list subcommand lets you list the remediations
+waiting for approval within a minder control plane, along with the change
+each one would make.`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			fmt.Fprintf(os.Stderr, "Error binding flags: %s\n", err)
+		}
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format := viper.GetString("output")
+
+		switch format {
+		case app.JSON:
+		case app.YAML:
+		case app.Table:
+		default:
+			return fmt.Errorf("invalid format: %s", format)
+		}
+
+		conn, err := util.GrpcForCommand(cmd, viper.GetViper())
+		if err != nil {
+			return fmt.Errorf("error getting grpc connection: %w", err)
+		}
+		defer conn.Close()
+
+		client := pb.NewProfileServiceClient(conn)
+		ctx, cancel := util.GetAppContext()
+		defer cancel()
+
+		resp, err := client.ListPendingRemediations(ctx, &pb.ListPendingRemediationsRequest{
+			Context: &pb.Context{
+				Provider: viper.GetString("provider"),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error getting pending remediations: %w", err)
+		}
+
+		switch format {
+		case app.JSON:
+			out, err := util.GetJsonFromProto(resp)
+			util.ExitNicelyOnError(err, "Error getting json from proto")
+			fmt.Println(out)
+		case app.YAML:
+			out, err := util.GetYamlFromProto(resp)
+			util.ExitNicelyOnError(err, "Error getting yaml from proto")
+			fmt.Println(out)
+		case app.Table:
+			handleListTableOutput(cmd, resp)
+		}
+
+		return nil
+	},
+}
+
+func handleListTableOutput(cmd *cobra.Command, resp *pb.ListPendingRemediationsResponse) {
+	table := tablewriter.NewWriter(cmd.OutOrStdout())
+	table.SetHeader([]string{"Id", "Profile", "Rule", "Remediation", "Entity", "Change", "Created"})
+	table.SetRowLine(true)
+	table.SetRowSeparator("-")
+	// This is needed for the rendered change
+	table.SetAutoWrapText(false)
+
+	for _, rem := range resp.Remediations {
+		table.Append([]string{
+			rem.Id,
+			rem.Profile,
+			rem.RuleType,
+			rem.ActionName,
+			entityName(rem.GetEntityInfo()),
+			rem.Preview,
+			rem.CreatedAt.AsTime().Format(time.RFC3339),
+		})
+	}
+	table.Render()
+}
+
+// entityName returns a human readable name of the entity of a remediation
+func entityName(info map[string]string) string {
+	name := fmt.Sprintf("%s/%s", info["repo_owner"], info["repo_name"])
+	if pr, ok := info["pr_number"]; ok {
+		name = fmt.Sprintf("%s#%s", name, pr)
+	} else if artifact, ok := info["artifact_id"]; ok {
+		name = fmt.Sprintf("%s (artifact %s)", name, artifact)
+	}
+	return name
+}
+
+func init() {
+	RemediationCmd.AddCommand(remediation_listCmd)
+	remediation_listCmd.Flags().StringP("provider", "p", "github", "Provider to list remediations for")
+	remediation_listCmd.Flags().StringP("output", "o", app.Table, "Output format (json, yaml or table)")
+}
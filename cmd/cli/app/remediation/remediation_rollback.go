@@ -0,0 +1,145 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/stacklok/minder/cmd/cli/app"
+	"github.com/stacklok/minder/internal/entities"
+	"github.com/stacklok/minder/internal/util"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var remediation_rollbackCmd = &cobra.Command{
+	Use:   "rollback",
+	Short: "Roll back the remediations of a rule type",
+	Long: `The minder remediation rollback subcommand lets you restore the state the
+entities had before they were remediated by a rule type, e.g. after a bad
+remediation template. Every entity remediated by the rule type is rolled back,
+unless a revision of the rule type or an entity is given.
+
+Only the branch protection and REST remediations can be rolled back. Turn the
+remediation off, or fix the rule type, before rolling back, otherwise the next
+evaluation of the rule remediates the entity again.`,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			fmt.Fprintf(os.Stderr, "Error binding flags: %s\n", err)
+		}
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format := viper.GetString("output")
+		revision := viper.GetInt32("revision")
+		entityId := viper.GetString("entity")
+		entityType := viper.GetString("entity-type")
+
+		switch format {
+		case app.JSON:
+		case app.YAML:
+		case app.Table:
+		default:
+			return fmt.Errorf("invalid format: %s", format)
+		}
+
+		req := &pb.RollbackRemediationRequest{
+			Context: &pb.Context{
+				Provider: viper.GetString("provider"),
+			},
+			RuleType: viper.GetString("rule-type"),
+		}
+		if revision > 0 {
+			req.RuleTypeRevision = &revision
+		}
+		if entityId != "" {
+			req.Entity = &pb.EntityTypedId{
+				Id:   entityId,
+				Type: pb.EntityFromString(entityType),
+			}
+		}
+
+		conn, err := util.GrpcForCommand(cmd, viper.GetViper())
+		if err != nil {
+			return fmt.Errorf("error getting grpc connection: %w", err)
+		}
+		defer conn.Close()
+
+		client := pb.NewProfileServiceClient(conn)
+		ctx, cancel := util.GetAppContext()
+		defer cancel()
+
+		resp, err := client.RollbackRemediation(ctx, req)
+		if err != nil {
+			return fmt.Errorf("error rolling back remediations: %w", err)
+		}
+
+		switch format {
+		case app.JSON:
+			out, err := util.GetJsonFromProto(resp)
+			util.ExitNicelyOnError(err, "Error getting json from proto")
+			fmt.Println(out)
+		case app.YAML:
+			out, err := util.GetYamlFromProto(resp)
+			util.ExitNicelyOnError(err, "Error getting yaml from proto")
+			fmt.Println(out)
+		case app.Table:
+			handleRollbackTableOutput(cmd, resp)
+		}
+
+		return nil
+	},
+}
+
+func handleRollbackTableOutput(cmd *cobra.Command, resp *pb.RollbackRemediationResponse) {
+	if len(resp.Results) == 0 {
+		cmd.Println("No remediations to roll back")
+		return
+	}
+
+	table := tablewriter.NewWriter(cmd.OutOrStdout())
+	table.SetHeader([]string{"Profile", "Remediation", "Revision", "Entity", "Status", "Details"})
+	table.SetRowLine(true)
+	table.SetRowSeparator("-")
+
+	for _, res := range resp.Results {
+		table.Append([]string{
+			res.Profile,
+			res.ActionName,
+			fmt.Sprintf("%d", res.RuleTypeRevision),
+			entityName(res.GetEntityInfo()),
+			res.Status,
+			res.Details,
+		})
+	}
+	table.Render()
+}
+
+func init() {
+	RemediationCmd.AddCommand(remediation_rollbackCmd)
+	remediation_rollbackCmd.Flags().StringP("rule-type", "r", "", "Name of the rule type whose remediations are rolled back")
+	remediation_rollbackCmd.Flags().Int32P("revision", "", 0,
+		"Only roll back the remediations performed by this revision of the rule type")
+	remediation_rollbackCmd.Flags().StringP("entity-type", "t", "repository",
+		fmt.Sprintf("the entity type to roll back (one of %s)", entities.KnownTypesCSV()))
+	remediation_rollbackCmd.Flags().StringP("entity", "e", "", "Only roll back the remediations of this entity ID")
+	remediation_rollbackCmd.Flags().StringP("provider", "p", "github", "Provider for the remediations")
+	remediation_rollbackCmd.Flags().StringP("output", "o", app.Table, "Output format (json, yaml or table)")
+	err := remediation_rollbackCmd.MarkFlagRequired("rule-type")
+	util.ExitNicelyOnError(err, "Error marking flag as required")
+}
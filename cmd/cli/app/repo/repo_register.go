@@ -252,14 +252,15 @@ func getSelectedRepositories(repoList []*pb.UpstreamRepositoryRef, flagRepos str
 
 	// Convert the selected repos into a slice of Repositories protobufs
 	for i, repo := range allSelectedRepos {
-		splitRepo := strings.Split(repo, "/")
-		if len(splitRepo) != 2 {
+		// GitLab namespaces may be nested, so only the last segment is the name
+		sep := strings.LastIndex(repo, "/")
+		if sep <= 0 || sep == len(repo)-1 {
 			_, _ = fmt.Fprintf(os.Stderr, "Unexpected repository name format: %s, skipping registration\n", repo)
 			continue
 		}
 		protoRepos[i] = &pb.UpstreamRepositoryRef{
-			Owner:  splitRepo[0],
-			Name:   splitRepo[1],
+			Owner:  repo[:sep],
+			Name:   repo[sep+1:],
 			RepoId: repoIDs[repo],
 		}
 	}
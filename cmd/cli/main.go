@@ -24,6 +24,7 @@ import (
 	_ "github.com/stacklok/minder/cmd/cli/app/profile"
 	_ "github.com/stacklok/minder/cmd/cli/app/profile_status"
 	_ "github.com/stacklok/minder/cmd/cli/app/provider"
+	_ "github.com/stacklok/minder/cmd/cli/app/remediation"
 	_ "github.com/stacklok/minder/cmd/cli/app/repo"
 	_ "github.com/stacklok/minder/cmd/cli/app/rule_type"
 	_ "github.com/stacklok/minder/cmd/cli/app/version"
@@ -130,7 +130,8 @@ func testCmdRun(cmd *cobra.Command, _ []string) error {
 		},
 		db.ProviderAccessToken{},
 		token,
-	))
+		// alert digests need a database, which isn't available when testing rule types
+	), nil)
 	inf := &engine.EntityInfoWrapper{
 		Entity: ent,
 	}
@@ -174,8 +175,8 @@ func runEvaluationForRules(
 		// Perform the actions, if any
 		evalStatus.SetActionsErr(context.Background(), eng.Actions(context.Background(), inf, evalStatus))
 
-		if errors.IsActionFatalError(evalStatus.GetActionsErr().RemediateErr) {
-			fmt.Printf("Remediation failed with fatal error: %s", evalStatus.GetActionsErr().RemediateErr)
+		if errors.IsActionFatalError(evalStatus.GetActionsErr().RemediateErr()) {
+			fmt.Printf("Remediation failed with fatal error: %s", evalStatus.GetActionsErr().RemediateErr())
 		}
 
 		if evalStatus.GetEvalErr() != nil {
@@ -30,10 +30,14 @@ import (
 	"github.com/stacklok/minder/internal/auth"
 	"github.com/stacklok/minder/internal/config"
 	"github.com/stacklok/minder/internal/controlplane"
+	"github.com/stacklok/minder/internal/crypto"
 	"github.com/stacklok/minder/internal/db"
 	"github.com/stacklok/minder/internal/engine"
+	"github.com/stacklok/minder/internal/engine/actions/alert/digest"
 	"github.com/stacklok/minder/internal/events"
 	"github.com/stacklok/minder/internal/logger"
+	"github.com/stacklok/minder/internal/providers"
+	ghprov "github.com/stacklok/minder/internal/providers/github"
 	provtelemetry "github.com/stacklok/minder/internal/providers/telemetry"
 	"github.com/stacklok/minder/internal/reconcilers"
 )
@@ -104,19 +108,43 @@ var serveCmd = &cobra.Command{
 		serverMetrics := controlplane.NewMetrics()
 		providerMetrics := provtelemetry.NewProviderMetrics()
 
-		s, err := controlplane.NewServer(store, evt, serverMetrics, cfg, vldtr, controlplane.WithProviderMetrics(providerMetrics))
+		// GitHub App authentication is optional, without it the OAuth tokens are used
+		var ghApp *ghprov.App
+		if cfg.GitHubApp.Enabled() {
+			key, err := cfg.GitHubApp.GetPrivateKey()
+			if err != nil {
+				return fmt.Errorf("unable to read GitHub App private key: %w", err)
+			}
+			ghApp, err = ghprov.NewApp(cfg.GitHubApp.AppID, key)
+			if err != nil {
+				return fmt.Errorf("unable to create GitHub App: %w", err)
+			}
+		}
+
+		s, err := controlplane.NewServer(store, evt, serverMetrics, cfg, vldtr,
+			controlplane.WithProviderMetrics(providerMetrics), controlplane.WithGitHubApp(ghApp))
 		if err != nil {
 			return fmt.Errorf("unable to create server: %w", err)
 		}
 
-		exec, err := engine.NewExecutor(store, &cfg.Auth, engine.WithProviderMetrics(providerMetrics))
+		exec, err := engine.NewExecutor(store, &cfg.Auth,
+			engine.WithProviderMetrics(providerMetrics), engine.WithGitHubApp(ghApp))
 		if err != nil {
 			return fmt.Errorf("unable to create executor: %w", err)
 		}
 
 		s.ConsumeEvents(exec)
 
-		rec, err := reconcilers.NewReconciler(store, evt, &cfg.Auth, reconcilers.WithProviderMetrics(providerMetrics))
+		digests := digest.NewFlusher(store, exec.NewDigestNotifier)
+
+		cryptoEngine, err := crypto.EngineFromAuthConfig(&cfg.Auth)
+		if err != nil {
+			return fmt.Errorf("unable to create crypto engine: %w", err)
+		}
+		tokens := providers.NewTokenRefresher(store, cryptoEngine)
+
+		rec, err := reconcilers.NewReconciler(store, evt, &cfg.Auth,
+			reconcilers.WithProviderMetrics(providerMetrics), reconcilers.WithGitHubApp(ghApp))
 		if err != nil {
 			return fmt.Errorf("unable to create reconciler: %w", err)
 		}
@@ -134,6 +162,14 @@ var serveCmd = &cobra.Command{
 
 		errg.Go(s.HandleEvents(ctx))
 
+		errg.Go(func() error {
+			return digests.Run(ctx)
+		})
+
+		errg.Go(func() error {
+			return tokens.Run(ctx)
+		})
+
 		return errg.Wait()
 	},
 }
@@ -0,0 +1,51 @@
+-- Copyright 2023 Stacklok, Inc
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+CREATE TYPE digest_finding_status AS ENUM ('failing', 'resolved');
+
+-- alert_digests tracks the digests of a project. A digest is identified by a
+-- channel, the hash of the alert configuration it is delivered through, so rule
+-- types sharing the same alert configuration share the same digest.
+CREATE TABLE IF NOT EXISTS alert_digests (
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    channel TEXT NOT NULL,
+    provider TEXT NOT NULL,
+    schedule TEXT NOT NULL,
+    alert_config JSONB NOT NULL,
+    last_sent_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (project_id, channel)
+);
+
+-- alert_digest_findings are the alerts waiting to be sent in the next digest.
+-- notified_at is set once a finding has been part of a digest.
+CREATE TABLE IF NOT EXISTS alert_digest_findings (
+    id UUID NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    project_id UUID NOT NULL,
+    channel TEXT NOT NULL,
+    profile_name TEXT NOT NULL,
+    rule_type TEXT NOT NULL,
+    entity_type entities NOT NULL,
+    entity_name TEXT NOT NULL,
+    status digest_finding_status NOT NULL,
+    details TEXT NOT NULL DEFAULT '',
+    guidance TEXT NOT NULL DEFAULT '',
+    notified_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (project_id, channel) REFERENCES alert_digests(project_id, channel) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS alert_digest_findings_entity_idx
+    ON alert_digest_findings(project_id, channel, profile_name, rule_type, entity_type, entity_name);
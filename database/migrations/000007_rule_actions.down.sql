@@ -0,0 +1,34 @@
+-- Copyright 2023 Stacklok, Inc
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- keep a single remediation and alert per rule evaluation
+DELETE FROM rule_details_remediate rdr
+USING rule_details_remediate other
+WHERE rdr.rule_eval_id = other.rule_eval_id AND rdr.action_name > other.action_name;
+
+DELETE FROM rule_details_alert rda
+USING rule_details_alert other
+WHERE rda.rule_eval_id = other.rule_eval_id AND rda.action_name > other.action_name;
+
+DROP INDEX IF EXISTS idx_rule_detail_remediate_ids;
+DROP INDEX IF EXISTS idx_rule_detail_alert_ids;
+CREATE UNIQUE INDEX idx_rule_detail_remediate_ids ON rule_details_remediate(rule_eval_id);
+CREATE UNIQUE INDEX idx_rule_detail_alert_ids ON rule_details_alert(rule_eval_id);
+
+ALTER TABLE rule_details_alert DROP COLUMN action_name;
+ALTER TABLE rule_details_remediate DROP COLUMN metadata;
+ALTER TABLE rule_details_remediate DROP COLUMN action_name;
+
+ALTER TABLE profiles DROP COLUMN alert_actions;
+ALTER TABLE profiles DROP COLUMN remediate_actions;
@@ -0,0 +1,42 @@
+-- Copyright 2023 Stacklok, Inc
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- per action settings of a profile, keyed by the name of the action
+ALTER TABLE profiles ADD COLUMN remediate_actions JSONB NOT NULL DEFAULT '{}';
+ALTER TABLE profiles ADD COLUMN alert_actions JSONB NOT NULL DEFAULT '{}';
+
+-- rule types may have several remediations and alerts, each one is tracked
+-- independently by its name
+ALTER TABLE rule_details_remediate ADD COLUMN action_name TEXT NOT NULL DEFAULT '';
+ALTER TABLE rule_details_remediate ADD COLUMN metadata JSONB NOT NULL DEFAULT '{}';
+ALTER TABLE rule_details_alert ADD COLUMN action_name TEXT NOT NULL DEFAULT '';
+
+-- the existing details belong to the single remediation and alert of the
+-- rule type, which is named after its type unless it has a name
+UPDATE rule_details_remediate rdr
+SET action_name = COALESCE(rt.definition->'remediate'->>'name', rt.definition->'remediate'->>'type', '')
+FROM rule_evaluations re
+JOIN rule_type rt ON rt.id = re.rule_type_id
+WHERE re.id = rdr.rule_eval_id;
+
+UPDATE rule_details_alert rda
+SET action_name = COALESCE(rt.definition->'alert'->>'name', rt.definition->'alert'->>'type', '')
+FROM rule_evaluations re
+JOIN rule_type rt ON rt.id = re.rule_type_id
+WHERE re.id = rda.rule_eval_id;
+
+DROP INDEX IF EXISTS idx_rule_detail_remediate_ids;
+DROP INDEX IF EXISTS idx_rule_detail_alert_ids;
+CREATE UNIQUE INDEX idx_rule_detail_remediate_ids ON rule_details_remediate(rule_eval_id, action_name);
+CREATE UNIQUE INDEX idx_rule_detail_alert_ids ON rule_details_alert(rule_eval_id, action_name);
@@ -0,0 +1,30 @@
+-- Copyright 2023 Stacklok, Inc
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+DROP TABLE IF EXISTS remediation_approvals;
+DROP TYPE IF EXISTS remediation_approval_status;
+
+-- enum values can't be dropped, recreate the types without them
+UPDATE profiles SET remediate = 'off' WHERE remediate = 'approval';
+ALTER TYPE action_type RENAME TO action_type_old;
+CREATE TYPE action_type AS ENUM ('on', 'off', 'dry_run');
+ALTER TABLE profiles ALTER COLUMN remediate TYPE action_type USING remediate::text::action_type;
+ALTER TABLE profiles ALTER COLUMN alert TYPE action_type USING alert::text::action_type;
+DROP TYPE action_type_old;
+
+UPDATE rule_details_remediate SET status = 'skipped' WHERE status = 'pending_approval';
+ALTER TYPE remediation_status_types RENAME TO remediation_status_types_old;
+CREATE TYPE remediation_status_types AS ENUM ('success', 'failure', 'error', 'skipped', 'not_available');
+ALTER TABLE rule_details_remediate ALTER COLUMN status TYPE remediation_status_types USING status::text::remediation_status_types;
+DROP TYPE remediation_status_types_old;
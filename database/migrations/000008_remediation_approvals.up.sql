@@ -0,0 +1,41 @@
+-- Copyright 2023 Stacklok, Inc
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- remediations may wait for the approval of a user before running
+ALTER TYPE action_type ADD VALUE IF NOT EXISTS 'approval';
+ALTER TYPE remediation_status_types ADD VALUE IF NOT EXISTS 'pending_approval';
+
+CREATE TYPE remediation_approval_status AS ENUM ('pending', 'approved', 'rejected', 'executed', 'failed', 'expired');
+
+-- remediation_approvals tracks the remediations waiting for, or having gone
+-- through, the review of a user. preview is the rendered change the
+-- remediation would make, which is what gets approved.
+CREATE TABLE IF NOT EXISTS remediation_approvals (
+    id UUID NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    rule_eval_id UUID NOT NULL REFERENCES rule_evaluations(id) ON DELETE CASCADE,
+    action_name TEXT NOT NULL,
+    remediation_type TEXT NOT NULL,
+    preview TEXT NOT NULL,
+    status remediation_approval_status NOT NULL DEFAULT 'pending',
+    reviewed_by TEXT NOT NULL DEFAULT '',
+    details TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- there is at most one approval in progress per remediation of a rule evaluation
+CREATE UNIQUE INDEX IF NOT EXISTS remediation_approvals_active_idx
+    ON remediation_approvals(rule_eval_id, action_name) WHERE status IN ('pending', 'approved');
+CREATE INDEX IF NOT EXISTS remediation_approvals_project_idx ON remediation_approvals(project_id, status);
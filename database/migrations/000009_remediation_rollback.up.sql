@@ -0,0 +1,21 @@
+-- Copyright 2023 Stacklok, Inc
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- rule types keep a revision, incremented on every update, so that the
+-- remediations performed by a given revision of a rule type can be rolled back
+ALTER TABLE rule_type ADD COLUMN revision INTEGER NOT NULL DEFAULT 1;
+
+-- the revision of the rule type that performed the last successful remediation,
+-- whose metadata holds the state of the entity before the remediation
+ALTER TABLE rule_details_remediate ADD COLUMN rule_type_revision INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1,30 @@
+-- Copyright 2023 Stacklok, Inc
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- GitHub App installations enrolled in a project. When a project's github
+-- provider has an installation, installation tokens are minted for it
+-- instead of using the OAuth token of the user who enrolled.
+CREATE TABLE provider_github_app_installations (
+    app_installation_id BIGINT NOT NULL,
+    provider TEXT NOT NULL,
+    project_id UUID NOT NULL,
+    account_login TEXT NOT NULL,
+    is_org BOOLEAN NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (project_id, provider) REFERENCES providers(project_id, name) ON DELETE CASCADE,
+    UNIQUE (project_id, provider)
+);
+
+CREATE INDEX provider_github_app_installations_id_idx ON provider_github_app_installations(app_installation_id);
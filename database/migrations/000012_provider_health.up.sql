@@ -0,0 +1,20 @@
+-- Copyright 2023 Stacklok, Inc
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- Health of a provider, set to unhealthy when its credentials can no
+-- longer be used, e.g. once an expired OAuth token fails to refresh.
+CREATE TYPE provider_health AS ENUM ('healthy', 'unhealthy');
+
+ALTER TABLE providers ADD COLUMN health provider_health NOT NULL DEFAULT 'healthy';
+ALTER TABLE providers ADD COLUMN health_detail TEXT NOT NULL DEFAULT '';
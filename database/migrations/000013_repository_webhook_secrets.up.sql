@@ -0,0 +1,18 @@
+-- Copyright 2023 Stacklok, Inc
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- Secret of the webhook of a repository, encrypted with the token key. It is
+-- set for the providers that don't sign their webhooks with the server-wide
+-- secret. Repositories registered before it was introduced keep an empty one.
+ALTER TABLE repositories ADD COLUMN webhook_secret TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,18 @@
+-- Copyright 2023 Stacklok, Inc
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+DROP INDEX repositories_repo_id_idx;
+DROP INDEX repositories_provider_repo_id_idx;
+CREATE UNIQUE INDEX repositories_repo_id_idx ON repositories(repo_id);
+ALTER TABLE repositories ADD CONSTRAINT unique_repo_id UNIQUE (repo_id);
@@ -0,0 +1,20 @@
+-- Copyright 2023 Stacklok, Inc
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- Repository IDs are assigned by each provider, so the same ID can belong to
+-- different repositories on GitHub, a GitLab instance or a Gitea instance.
+ALTER TABLE repositories DROP CONSTRAINT unique_repo_id;
+DROP INDEX repositories_repo_id_idx;
+CREATE UNIQUE INDEX repositories_provider_repo_id_idx ON repositories(provider, repo_id);
+CREATE INDEX repositories_repo_id_idx ON repositories(repo_id);
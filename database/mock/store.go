@@ -9,6 +9,7 @@ import (
 	sql "database/sql"
 	json "encoding/json"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -97,6 +98,21 @@ func (mr *MockStoreMockRecorder) CheckHealth() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckHealth", reflect.TypeOf((*MockStore)(nil).CheckHealth))
 }
 
+// ClaimAlertDigest mocks base method.
+func (m *MockStore) ClaimAlertDigest(arg0 context.Context, arg1 db.ClaimAlertDigestParams) (db.AlertDigest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimAlertDigest", arg0, arg1)
+	ret0, _ := ret[0].(db.AlertDigest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimAlertDigest indicates an expected call of ClaimAlertDigest.
+func (mr *MockStoreMockRecorder) ClaimAlertDigest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimAlertDigest", reflect.TypeOf((*MockStore)(nil).ClaimAlertDigest), arg0, arg1)
+}
+
 // Commit mocks base method.
 func (m *MockStore) Commit(arg0 *sql.Tx) error {
 	m.ctrl.T.Helper()
@@ -422,6 +438,20 @@ func (mr *MockStoreMockRecorder) DeleteExpiredSessionStates(arg0 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredSessionStates", reflect.TypeOf((*MockStore)(nil).DeleteExpiredSessionStates), arg0)
 }
 
+// DeleteGitHubAppInstallationByID mocks base method.
+func (m *MockStore) DeleteGitHubAppInstallationByID(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGitHubAppInstallationByID", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGitHubAppInstallationByID indicates an expected call of DeleteGitHubAppInstallationByID.
+func (mr *MockStoreMockRecorder) DeleteGitHubAppInstallationByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGitHubAppInstallationByID", reflect.TypeOf((*MockStore)(nil).DeleteGitHubAppInstallationByID), arg0, arg1)
+}
+
 // DeleteOldArtifactVersions mocks base method.
 func (m *MockStore) DeleteOldArtifactVersions(arg0 context.Context, arg1 db.DeleteOldArtifactVersionsParams) error {
 	m.ctrl.T.Helper()
@@ -521,6 +551,20 @@ func (mr *MockStoreMockRecorder) DeleteRepository(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRepository", reflect.TypeOf((*MockStore)(nil).DeleteRepository), arg0, arg1)
 }
 
+// DeleteResolvedAlertDigestFindings mocks base method.
+func (m *MockStore) DeleteResolvedAlertDigestFindings(arg0 context.Context, arg1 db.DeleteResolvedAlertDigestFindingsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteResolvedAlertDigestFindings", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteResolvedAlertDigestFindings indicates an expected call of DeleteResolvedAlertDigestFindings.
+func (mr *MockStoreMockRecorder) DeleteResolvedAlertDigestFindings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResolvedAlertDigestFindings", reflect.TypeOf((*MockStore)(nil).DeleteResolvedAlertDigestFindings), arg0, arg1)
+}
+
 // DeleteRole mocks base method.
 func (m *MockStore) DeleteRole(arg0 context.Context, arg1 int32) error {
 	m.ctrl.T.Helper()
@@ -605,6 +649,20 @@ func (mr *MockStoreMockRecorder) DeleteUser(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockStore)(nil).DeleteUser), arg0, arg1)
 }
 
+// ExpireRemediationApprovals mocks base method.
+func (m *MockStore) ExpireRemediationApprovals(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireRemediationApprovals", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireRemediationApprovals indicates an expected call of ExpireRemediationApprovals.
+func (mr *MockStoreMockRecorder) ExpireRemediationApprovals(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireRemediationApprovals", reflect.TypeOf((*MockStore)(nil).ExpireRemediationApprovals), arg0, arg1)
+}
+
 // GetAccessTokenByProjectID mocks base method.
 func (m *MockStore) GetAccessTokenByProjectID(arg0 context.Context, arg1 db.GetAccessTokenByProjectIDParams) (db.ProviderAccessToken, error) {
 	m.ctrl.T.Helper()
@@ -635,6 +693,21 @@ func (mr *MockStoreMockRecorder) GetAccessTokenByProvider(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessTokenByProvider", reflect.TypeOf((*MockStore)(nil).GetAccessTokenByProvider), arg0, arg1)
 }
 
+// GetAccessTokenForUpdate mocks base method.
+func (m *MockStore) GetAccessTokenForUpdate(arg0 context.Context, arg1 db.GetAccessTokenForUpdateParams) (db.ProviderAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccessTokenForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.ProviderAccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccessTokenForUpdate indicates an expected call of GetAccessTokenForUpdate.
+func (mr *MockStoreMockRecorder) GetAccessTokenForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessTokenForUpdate", reflect.TypeOf((*MockStore)(nil).GetAccessTokenForUpdate), arg0, arg1)
+}
+
 // GetAccessTokenSinceDate mocks base method.
 func (m *MockStore) GetAccessTokenSinceDate(arg0 context.Context, arg1 db.GetAccessTokenSinceDateParams) (db.ProviderAccessToken, error) {
 	m.ctrl.T.Helper()
@@ -725,6 +798,21 @@ func (mr *MockStoreMockRecorder) GetFeatureInProject(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeatureInProject", reflect.TypeOf((*MockStore)(nil).GetFeatureInProject), arg0, arg1)
 }
 
+// GetGitHubAppInstallationByProjectAndProvider mocks base method.
+func (m *MockStore) GetGitHubAppInstallationByProjectAndProvider(arg0 context.Context, arg1 db.GetGitHubAppInstallationByProjectAndProviderParams) (db.ProviderGithubAppInstallation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGitHubAppInstallationByProjectAndProvider", arg0, arg1)
+	ret0, _ := ret[0].(db.ProviderGithubAppInstallation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGitHubAppInstallationByProjectAndProvider indicates an expected call of GetGitHubAppInstallationByProjectAndProvider.
+func (mr *MockStoreMockRecorder) GetGitHubAppInstallationByProjectAndProvider(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGitHubAppInstallationByProjectAndProvider", reflect.TypeOf((*MockStore)(nil).GetGitHubAppInstallationByProjectAndProvider), arg0, arg1)
+}
+
 // GetOrganization mocks base method.
 func (m *MockStore) GetOrganization(arg0 context.Context, arg1 uuid.UUID) (db.Project, error) {
 	m.ctrl.T.Helper()
@@ -994,6 +1082,21 @@ func (mr *MockStoreMockRecorder) GetQuerierWithTransaction(arg0 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuerierWithTransaction", reflect.TypeOf((*MockStore)(nil).GetQuerierWithTransaction), arg0)
 }
 
+// GetRemediationApprovalByID mocks base method.
+func (m *MockStore) GetRemediationApprovalByID(arg0 context.Context, arg1 db.GetRemediationApprovalByIDParams) (db.GetRemediationApprovalByIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRemediationApprovalByID", arg0, arg1)
+	ret0, _ := ret[0].(db.GetRemediationApprovalByIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRemediationApprovalByID indicates an expected call of GetRemediationApprovalByID.
+func (mr *MockStoreMockRecorder) GetRemediationApprovalByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRemediationApprovalByID", reflect.TypeOf((*MockStore)(nil).GetRemediationApprovalByID), arg0, arg1)
+}
+
 // GetRepositoryByID mocks base method.
 func (m *MockStore) GetRepositoryByID(arg0 context.Context, arg1 uuid.UUID) (db.Repository, error) {
 	m.ctrl.T.Helper()
@@ -1025,7 +1128,7 @@ func (mr *MockStoreMockRecorder) GetRepositoryByIDAndProject(arg0, arg1 interfac
 }
 
 // GetRepositoryByRepoID mocks base method.
-func (m *MockStore) GetRepositoryByRepoID(arg0 context.Context, arg1 int32) (db.Repository, error) {
+func (m *MockStore) GetRepositoryByRepoID(arg0 context.Context, arg1 db.GetRepositoryByRepoIDParams) (db.Repository, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetRepositoryByRepoID", arg0, arg1)
 	ret0, _ := ret[0].(db.Repository)
@@ -1279,6 +1382,36 @@ func (mr *MockStoreMockRecorder) GlobalListProviders(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GlobalListProviders", reflect.TypeOf((*MockStore)(nil).GlobalListProviders), arg0)
 }
 
+// ListAccessTokensExpiringBefore mocks base method.
+func (m *MockStore) ListAccessTokensExpiringBefore(arg0 context.Context, arg1 time.Time) ([]db.ProviderAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccessTokensExpiringBefore", arg0, arg1)
+	ret0, _ := ret[0].([]db.ProviderAccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccessTokensExpiringBefore indicates an expected call of ListAccessTokensExpiringBefore.
+func (mr *MockStoreMockRecorder) ListAccessTokensExpiringBefore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccessTokensExpiringBefore", reflect.TypeOf((*MockStore)(nil).ListAccessTokensExpiringBefore), arg0, arg1)
+}
+
+// ListAlertDigestFindings mocks base method.
+func (m *MockStore) ListAlertDigestFindings(arg0 context.Context, arg1 db.ListAlertDigestFindingsParams) ([]db.AlertDigestFinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAlertDigestFindings", arg0, arg1)
+	ret0, _ := ret[0].([]db.AlertDigestFinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAlertDigestFindings indicates an expected call of ListAlertDigestFindings.
+func (mr *MockStoreMockRecorder) ListAlertDigestFindings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlertDigestFindings", reflect.TypeOf((*MockStore)(nil).ListAlertDigestFindings), arg0, arg1)
+}
+
 // ListAllRepositories mocks base method.
 func (m *MockStore) ListAllRepositories(arg0 context.Context, arg1 string) ([]db.Repository, error) {
 	m.ctrl.T.Helper()
@@ -1339,6 +1472,51 @@ func (mr *MockStoreMockRecorder) ListArtifactsByRepoID(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArtifactsByRepoID", reflect.TypeOf((*MockStore)(nil).ListArtifactsByRepoID), arg0, arg1)
 }
 
+// ListDueAlertDigests mocks base method.
+func (m *MockStore) ListDueAlertDigests(arg0 context.Context) ([]db.AlertDigest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDueAlertDigests", arg0)
+	ret0, _ := ret[0].([]db.AlertDigest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDueAlertDigests indicates an expected call of ListDueAlertDigests.
+func (mr *MockStoreMockRecorder) ListDueAlertDigests(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueAlertDigests", reflect.TypeOf((*MockStore)(nil).ListDueAlertDigests), arg0)
+}
+
+// ListGitHubAppInstallationsByID mocks base method.
+func (m *MockStore) ListGitHubAppInstallationsByID(arg0 context.Context, arg1 int64) ([]db.ProviderGithubAppInstallation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGitHubAppInstallationsByID", arg0, arg1)
+	ret0, _ := ret[0].([]db.ProviderGithubAppInstallation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGitHubAppInstallationsByID indicates an expected call of ListGitHubAppInstallationsByID.
+func (mr *MockStoreMockRecorder) ListGitHubAppInstallationsByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGitHubAppInstallationsByID", reflect.TypeOf((*MockStore)(nil).ListGitHubAppInstallationsByID), arg0, arg1)
+}
+
+// ListLatestRemediationApprovals mocks base method.
+func (m *MockStore) ListLatestRemediationApprovals(arg0 context.Context, arg1 uuid.UUID) ([]db.RemediationApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLatestRemediationApprovals", arg0, arg1)
+	ret0, _ := ret[0].([]db.RemediationApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLatestRemediationApprovals indicates an expected call of ListLatestRemediationApprovals.
+func (mr *MockStoreMockRecorder) ListLatestRemediationApprovals(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLatestRemediationApprovals", reflect.TypeOf((*MockStore)(nil).ListLatestRemediationApprovals), arg0, arg1)
+}
+
 // ListOrganizations mocks base method.
 func (m *MockStore) ListOrganizations(arg0 context.Context, arg1 db.ListOrganizationsParams) ([]db.Project, error) {
 	m.ctrl.T.Helper()
@@ -1414,6 +1592,36 @@ func (mr *MockStoreMockRecorder) ListRegisteredRepositoriesByProjectIDAndProvide
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegisteredRepositoriesByProjectIDAndProvider", reflect.TypeOf((*MockStore)(nil).ListRegisteredRepositoriesByProjectIDAndProvider), arg0, arg1)
 }
 
+// ListRemediationApprovalsByProject mocks base method.
+func (m *MockStore) ListRemediationApprovalsByProject(arg0 context.Context, arg1 db.ListRemediationApprovalsByProjectParams) ([]db.ListRemediationApprovalsByProjectRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRemediationApprovalsByProject", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListRemediationApprovalsByProjectRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRemediationApprovalsByProject indicates an expected call of ListRemediationApprovalsByProject.
+func (mr *MockStoreMockRecorder) ListRemediationApprovalsByProject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRemediationApprovalsByProject", reflect.TypeOf((*MockStore)(nil).ListRemediationApprovalsByProject), arg0, arg1)
+}
+
+// ListRemediationsForRollback mocks base method.
+func (m *MockStore) ListRemediationsForRollback(arg0 context.Context, arg1 db.ListRemediationsForRollbackParams) ([]db.ListRemediationsForRollbackRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRemediationsForRollback", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListRemediationsForRollbackRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRemediationsForRollback indicates an expected call of ListRemediationsForRollback.
+func (mr *MockStoreMockRecorder) ListRemediationsForRollback(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRemediationsForRollback", reflect.TypeOf((*MockStore)(nil).ListRemediationsForRollback), arg0, arg1)
+}
+
 // ListRepositoriesByOwner mocks base method.
 func (m *MockStore) ListRepositoriesByOwner(arg0 context.Context, arg1 db.ListRepositoriesByOwnerParams) ([]db.Repository, error) {
 	m.ctrl.T.Helper()
@@ -1444,6 +1652,21 @@ func (mr *MockStoreMockRecorder) ListRepositoriesByProjectID(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepositoriesByProjectID", reflect.TypeOf((*MockStore)(nil).ListRepositoriesByProjectID), arg0, arg1)
 }
 
+// ListRepositoriesByRepoID mocks base method.
+func (m *MockStore) ListRepositoriesByRepoID(arg0 context.Context, arg1 int32) ([]db.Repository, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRepositoriesByRepoID", arg0, arg1)
+	ret0, _ := ret[0].([]db.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRepositoriesByRepoID indicates an expected call of ListRepositoriesByRepoID.
+func (mr *MockStoreMockRecorder) ListRepositoriesByRepoID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepositoriesByRepoID", reflect.TypeOf((*MockStore)(nil).ListRepositoriesByRepoID), arg0, arg1)
+}
+
 // ListRoles mocks base method.
 func (m *MockStore) ListRoles(arg0 context.Context, arg1 db.ListRolesParams) ([]db.Role, error) {
 	m.ctrl.T.Helper()
@@ -1474,6 +1697,36 @@ func (mr *MockStoreMockRecorder) ListRolesByProjectID(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRolesByProjectID", reflect.TypeOf((*MockStore)(nil).ListRolesByProjectID), arg0, arg1)
 }
 
+// ListRuleDetailsAlert mocks base method.
+func (m *MockStore) ListRuleDetailsAlert(arg0 context.Context, arg1 uuid.UUID) ([]db.RuleDetailsAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRuleDetailsAlert", arg0, arg1)
+	ret0, _ := ret[0].([]db.RuleDetailsAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRuleDetailsAlert indicates an expected call of ListRuleDetailsAlert.
+func (mr *MockStoreMockRecorder) ListRuleDetailsAlert(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuleDetailsAlert", reflect.TypeOf((*MockStore)(nil).ListRuleDetailsAlert), arg0, arg1)
+}
+
+// ListRuleDetailsRemediate mocks base method.
+func (m *MockStore) ListRuleDetailsRemediate(arg0 context.Context, arg1 uuid.UUID) ([]db.RuleDetailsRemediate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRuleDetailsRemediate", arg0, arg1)
+	ret0, _ := ret[0].([]db.RuleDetailsRemediate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRuleDetailsRemediate indicates an expected call of ListRuleDetailsRemediate.
+func (mr *MockStoreMockRecorder) ListRuleDetailsRemediate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuleDetailsRemediate", reflect.TypeOf((*MockStore)(nil).ListRuleDetailsRemediate), arg0, arg1)
+}
+
 // ListRuleEvaluationsByProfileId mocks base method.
 func (m *MockStore) ListRuleEvaluationsByProfileId(arg0 context.Context, arg1 db.ListRuleEvaluationsByProfileIdParams) ([]db.ListRuleEvaluationsByProfileIdRow, error) {
 	m.ctrl.T.Helper()
@@ -1564,6 +1817,63 @@ func (mr *MockStoreMockRecorder) ListUsersByRoleId(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersByRoleId", reflect.TypeOf((*MockStore)(nil).ListUsersByRoleId), arg0, arg1)
 }
 
+// MarkAlertDigestFindingsNotified mocks base method.
+func (m *MockStore) MarkAlertDigestFindingsNotified(arg0 context.Context, arg1 []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAlertDigestFindingsNotified", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAlertDigestFindingsNotified indicates an expected call of MarkAlertDigestFindingsNotified.
+func (mr *MockStoreMockRecorder) MarkAlertDigestFindingsNotified(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAlertDigestFindingsNotified", reflect.TypeOf((*MockStore)(nil).MarkAlertDigestFindingsNotified), arg0, arg1)
+}
+
+// ReleaseAlertDigest mocks base method.
+func (m *MockStore) ReleaseAlertDigest(arg0 context.Context, arg1 db.ReleaseAlertDigestParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseAlertDigest", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseAlertDigest indicates an expected call of ReleaseAlertDigest.
+func (mr *MockStoreMockRecorder) ReleaseAlertDigest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseAlertDigest", reflect.TypeOf((*MockStore)(nil).ReleaseAlertDigest), arg0, arg1)
+}
+
+// ResolveAlertDigestFinding mocks base method.
+func (m *MockStore) ResolveAlertDigestFinding(arg0 context.Context, arg1 db.ResolveAlertDigestFindingParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveAlertDigestFinding", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveAlertDigestFinding indicates an expected call of ResolveAlertDigestFinding.
+func (mr *MockStoreMockRecorder) ResolveAlertDigestFinding(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveAlertDigestFinding", reflect.TypeOf((*MockStore)(nil).ResolveAlertDigestFinding), arg0, arg1)
+}
+
+// ReviewRemediationApproval mocks base method.
+func (m *MockStore) ReviewRemediationApproval(arg0 context.Context, arg1 db.ReviewRemediationApprovalParams) (db.RemediationApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewRemediationApproval", arg0, arg1)
+	ret0, _ := ret[0].(db.RemediationApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewRemediationApproval indicates an expected call of ReviewRemediationApproval.
+func (mr *MockStoreMockRecorder) ReviewRemediationApproval(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewRemediationApproval", reflect.TypeOf((*MockStore)(nil).ReviewRemediationApproval), arg0, arg1)
+}
+
 // Rollback mocks base method.
 func (m *MockStore) Rollback(arg0 *sql.Tx) error {
 	m.ctrl.T.Helper()
@@ -1578,6 +1888,20 @@ func (mr *MockStoreMockRecorder) Rollback(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockStore)(nil).Rollback), arg0)
 }
 
+// RollbackRuleDetailsRemediate mocks base method.
+func (m *MockStore) RollbackRuleDetailsRemediate(arg0 context.Context, arg1 db.RollbackRuleDetailsRemediateParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackRuleDetailsRemediate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackRuleDetailsRemediate indicates an expected call of RollbackRuleDetailsRemediate.
+func (mr *MockStoreMockRecorder) RollbackRuleDetailsRemediate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackRuleDetailsRemediate", reflect.TypeOf((*MockStore)(nil).RollbackRuleDetailsRemediate), arg0, arg1)
+}
+
 // UpdateAccessToken mocks base method.
 func (m *MockStore) UpdateAccessToken(arg0 context.Context, arg1 db.UpdateAccessTokenParams) (db.ProviderAccessToken, error) {
 	m.ctrl.T.Helper()
@@ -1608,6 +1932,49 @@ func (mr *MockStoreMockRecorder) UpdateOrganization(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrganization", reflect.TypeOf((*MockStore)(nil).UpdateOrganization), arg0, arg1)
 }
 
+// UpdateProvider mocks base method.
+func (m *MockStore) UpdateProvider(arg0 context.Context, arg1 db.UpdateProviderParams) (db.Provider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProvider", arg0, arg1)
+	ret0, _ := ret[0].(db.Provider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProvider indicates an expected call of UpdateProvider.
+func (mr *MockStoreMockRecorder) UpdateProvider(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProvider", reflect.TypeOf((*MockStore)(nil).UpdateProvider), arg0, arg1)
+}
+
+// UpdateProviderHealth mocks base method.
+func (m *MockStore) UpdateProviderHealth(arg0 context.Context, arg1 db.UpdateProviderHealthParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProviderHealth", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProviderHealth indicates an expected call of UpdateProviderHealth.
+func (mr *MockStoreMockRecorder) UpdateProviderHealth(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProviderHealth", reflect.TypeOf((*MockStore)(nil).UpdateProviderHealth), arg0, arg1)
+}
+
+// UpdateRemediationApprovalStatus mocks base method.
+func (m *MockStore) UpdateRemediationApprovalStatus(arg0 context.Context, arg1 db.UpdateRemediationApprovalStatusParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRemediationApprovalStatus", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRemediationApprovalStatus indicates an expected call of UpdateRemediationApprovalStatus.
+func (mr *MockStoreMockRecorder) UpdateRemediationApprovalStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRemediationApprovalStatus", reflect.TypeOf((*MockStore)(nil).UpdateRemediationApprovalStatus), arg0, arg1)
+}
+
 // UpdateRepository mocks base method.
 func (m *MockStore) UpdateRepository(arg0 context.Context, arg1 db.UpdateRepositoryParams) (db.Repository, error) {
 	m.ctrl.T.Helper()
@@ -1667,6 +2034,35 @@ func (mr *MockStoreMockRecorder) UpdateRuleType(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRuleType", reflect.TypeOf((*MockStore)(nil).UpdateRuleType), arg0, arg1)
 }
 
+// UpsertAlertDigest mocks base method.
+func (m *MockStore) UpsertAlertDigest(arg0 context.Context, arg1 db.UpsertAlertDigestParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertAlertDigest", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertAlertDigest indicates an expected call of UpsertAlertDigest.
+func (mr *MockStoreMockRecorder) UpsertAlertDigest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAlertDigest", reflect.TypeOf((*MockStore)(nil).UpsertAlertDigest), arg0, arg1)
+}
+
+// UpsertAlertDigestFinding mocks base method.
+func (m *MockStore) UpsertAlertDigestFinding(arg0 context.Context, arg1 db.UpsertAlertDigestFindingParams) (db.AlertDigestFinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertAlertDigestFinding", arg0, arg1)
+	ret0, _ := ret[0].(db.AlertDigestFinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertAlertDigestFinding indicates an expected call of UpsertAlertDigestFinding.
+func (mr *MockStoreMockRecorder) UpsertAlertDigestFinding(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAlertDigestFinding", reflect.TypeOf((*MockStore)(nil).UpsertAlertDigestFinding), arg0, arg1)
+}
+
 // UpsertArtifact mocks base method.
 func (m *MockStore) UpsertArtifact(arg0 context.Context, arg1 db.UpsertArtifactParams) (db.Artifact, error) {
 	m.ctrl.T.Helper()
@@ -1697,6 +2093,21 @@ func (mr *MockStoreMockRecorder) UpsertArtifactVersion(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertArtifactVersion", reflect.TypeOf((*MockStore)(nil).UpsertArtifactVersion), arg0, arg1)
 }
 
+// UpsertGitHubAppInstallation mocks base method.
+func (m *MockStore) UpsertGitHubAppInstallation(arg0 context.Context, arg1 db.UpsertGitHubAppInstallationParams) (db.ProviderGithubAppInstallation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertGitHubAppInstallation", arg0, arg1)
+	ret0, _ := ret[0].(db.ProviderGithubAppInstallation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertGitHubAppInstallation indicates an expected call of UpsertGitHubAppInstallation.
+func (mr *MockStoreMockRecorder) UpsertGitHubAppInstallation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertGitHubAppInstallation", reflect.TypeOf((*MockStore)(nil).UpsertGitHubAppInstallation), arg0, arg1)
+}
+
 // UpsertPullRequest mocks base method.
 func (m *MockStore) UpsertPullRequest(arg0 context.Context, arg1 db.UpsertPullRequestParams) (db.PullRequest, error) {
 	m.ctrl.T.Helper()
@@ -1712,6 +2123,20 @@ func (mr *MockStoreMockRecorder) UpsertPullRequest(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertPullRequest", reflect.TypeOf((*MockStore)(nil).UpsertPullRequest), arg0, arg1)
 }
 
+// UpsertRemediationApproval mocks base method.
+func (m *MockStore) UpsertRemediationApproval(arg0 context.Context, arg1 db.UpsertRemediationApprovalParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertRemediationApproval", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertRemediationApproval indicates an expected call of UpsertRemediationApproval.
+func (mr *MockStoreMockRecorder) UpsertRemediationApproval(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertRemediationApproval", reflect.TypeOf((*MockStore)(nil).UpsertRemediationApproval), arg0, arg1)
+}
+
 // UpsertRuleDetailsAlert mocks base method.
 func (m *MockStore) UpsertRuleDetailsAlert(arg0 context.Context, arg1 db.UpsertRuleDetailsAlertParams) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,60 @@
+-- name: UpsertAlertDigest :exec
+INSERT INTO alert_digests (project_id, channel, provider, schedule, alert_config)
+VALUES ($1, $2, $3, $4, sqlc.arg(alert_config)::jsonb)
+ON CONFLICT (project_id, channel) DO UPDATE SET
+    provider = $3,
+    schedule = $4,
+    alert_config = sqlc.arg(alert_config)::jsonb;
+
+-- name: UpsertAlertDigestFinding :one
+INSERT INTO alert_digest_findings (project_id, channel, profile_name, rule_type, entity_type, entity_name, status, details, guidance)
+VALUES ($1, $2, $3, $4, $5, $6, 'failing', $7, $8)
+ON CONFLICT (project_id, channel, profile_name, rule_type, entity_type, entity_name) DO UPDATE SET
+    status = 'failing',
+    details = $7,
+    guidance = $8,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: ResolveAlertDigestFinding :exec
+UPDATE alert_digest_findings SET status = 'resolved', updated_at = NOW()
+WHERE project_id = $1 AND channel = $2 AND profile_name = $3 AND rule_type = $4 AND entity_type = $5 AND entity_name = $6;
+
+-- ListDueAlertDigests returns the digests whose schedule has elapsed since they were last sent.
+
+-- name: ListDueAlertDigests :many
+SELECT * FROM alert_digests
+WHERE last_sent_at + (CASE schedule WHEN 'daily' THEN INTERVAL '1 day' ELSE INTERVAL '1 hour' END) <= NOW();
+
+-- name: ListAlertDigestFindings :many
+SELECT * FROM alert_digest_findings WHERE project_id = $1 AND channel = $2
+ORDER BY rule_type, entity_name, profile_name;
+
+-- name: MarkAlertDigestFindingsNotified :exec
+UPDATE alert_digest_findings SET notified_at = NOW()
+WHERE id = ANY(sqlc.arg(ids)::UUID[]) AND status = 'failing';
+
+-- DeleteResolvedAlertDigestFindings deletes the findings which were resolved
+-- when listed for a digest. A finding updated since, by an evaluation running
+-- concurrently with the delivery, is kept for the next digest.
+
+-- name: DeleteResolvedAlertDigestFindings :exec
+DELETE FROM alert_digest_findings
+WHERE id = ANY(sqlc.arg(ids)::UUID[]) AND status = 'resolved'
+  AND updated_at <= sqlc.arg(listed_until)::TIMESTAMP;
+
+-- ClaimAlertDigest marks a due digest as sent before delivering it, so that
+-- concurrent flushes don't deliver it twice. It returns no rows if the digest
+-- is not due anymore, e.g. because another flush claimed it.
+
+-- name: ClaimAlertDigest :one
+UPDATE alert_digests SET last_sent_at = NOW()
+WHERE project_id = $1 AND channel = $2
+  AND last_sent_at + (CASE schedule WHEN 'daily' THEN INTERVAL '1 day' ELSE INTERVAL '1 hour' END) <= NOW()
+RETURNING *;
+
+-- ReleaseAlertDigest restores the time a claimed digest was last sent at when
+-- delivering it failed, so that it is retried on the next flush.
+
+-- name: ReleaseAlertDigest :exec
+UPDATE alert_digests SET last_sent_at = $3 WHERE project_id = $1 AND channel = $2;
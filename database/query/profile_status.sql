@@ -26,37 +26,49 @@ RETURNING id;
 -- name: UpsertRuleDetailsRemediate :one
 INSERT INTO rule_details_remediate (
     rule_eval_id,
+    action_name,
     status,
     details,
+    metadata,
+    rule_type_revision,
     last_updated
 )
-VALUES ($1, $2, $3, NOW())
-ON CONFLICT(rule_eval_id)
+VALUES ($1, $2, $3, $4, sqlc.arg(metadata)::jsonb, sqlc.arg(rule_type_revision), NOW())
+ON CONFLICT(rule_eval_id, action_name)
     DO UPDATE SET
-                  status = $2,
-                  details = $3,
+                  status = $3,
+                  details = $4,
+                  metadata = CASE WHEN $3 != 'skipped' THEN sqlc.arg(metadata)::jsonb ELSE rule_details_remediate.metadata END,
+                  rule_type_revision = CASE WHEN $3 = 'success' THEN sqlc.arg(rule_type_revision) ELSE rule_details_remediate.rule_type_revision END,
                   last_updated = NOW()
-    WHERE rule_details_remediate.rule_eval_id = $1
+    WHERE rule_details_remediate.rule_eval_id = $1 AND rule_details_remediate.action_name = $2
 RETURNING id;
 
 -- name: UpsertRuleDetailsAlert :one
 INSERT INTO rule_details_alert (
     rule_eval_id,
+    action_name,
     status,
     details,
     metadata,
     last_updated
 )
-VALUES ($1, $2, $3, sqlc.arg(metadata)::jsonb, NOW())
-ON CONFLICT(rule_eval_id)
+VALUES ($1, $2, $3, $4, sqlc.arg(metadata)::jsonb, NOW())
+ON CONFLICT(rule_eval_id, action_name)
     DO UPDATE SET
-                  status = CASE WHEN $2 != 'skipped' THEN $2 ELSE rule_details_alert.status END,
-                  details = CASE WHEN $2 != 'skipped' THEN $3 ELSE rule_details_alert.details END,
-                  metadata = CASE WHEN $2 != 'skipped' THEN sqlc.arg(metadata)::jsonb ELSE rule_details_alert.metadata END,
+                  status = CASE WHEN $3 != 'skipped' THEN $3 ELSE rule_details_alert.status END,
+                  details = CASE WHEN $3 != 'skipped' THEN $4 ELSE rule_details_alert.details END,
+                  metadata = CASE WHEN $3 != 'skipped' THEN sqlc.arg(metadata)::jsonb ELSE rule_details_alert.metadata END,
                   last_updated = NOW()
-    WHERE rule_details_alert.rule_eval_id = $1
+    WHERE rule_details_alert.rule_eval_id = $1 AND rule_details_alert.action_name = $2
 RETURNING id;
 
+-- name: ListRuleDetailsRemediate :many
+SELECT * FROM rule_details_remediate WHERE rule_eval_id = $1 ORDER BY action_name;
+
+-- name: ListRuleDetailsAlert :many
+SELECT * FROM rule_details_alert WHERE rule_eval_id = $1 ORDER BY action_name;
+
 -- name: GetProfileStatusByIdAndProject :one
 SELECT p.id, p.name, ps.profile_status, ps.last_updated FROM profile_status ps
 INNER JOIN profiles p ON p.id = ps.profile_id
@@ -82,25 +94,34 @@ WITH
        last_updated AS eval_last_updated
    FROM rule_details_eval
    ),
+   -- a rule type may have several remediations and alerts, report the
+   -- most relevant status of each
    remediation_details AS (
-       SELECT
+       SELECT DISTINCT ON (rule_eval_id)
            rule_eval_id,
            status AS rem_status,
            details AS rem_details,
            last_updated AS rem_last_updated
        FROM rule_details_remediate
+       ORDER BY rule_eval_id,
+                array_position(ARRAY['failure', 'error', 'pending_approval', 'success', 'skipped', 'not_available']::remediation_status_types[], status),
+                action_name
    ),
    alert_details AS (
-       SELECT
+       SELECT DISTINCT ON (rule_eval_id)
            rule_eval_id,
            status AS alert_status,
            details AS alert_details,
            metadata AS alert_metadata,
            last_updated AS alert_last_updated
        FROM rule_details_alert
+       ORDER BY rule_eval_id,
+                array_position(ARRAY['error', 'on', 'off', 'skipped', 'not_available']::alert_status_types[], status),
+                action_name
    )
 
 SELECT
+    res.id AS rule_evaluation_id,
     ed.eval_status,
     ed.eval_last_updated,
     ed.eval_details,
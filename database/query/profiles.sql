@@ -4,7 +4,9 @@ INSERT INTO profiles (
     project_id,
     remediate,
     alert,
-    name) VALUES ($1, $2, $3, $4, $5) RETURNING *;
+    name,
+    remediate_actions,
+    alert_actions) VALUES ($1, $2, $3, $4, $5, sqlc.arg(remediate_actions)::jsonb, sqlc.arg(alert_actions)::jsonb) RETURNING *;
 
 -- name: CreateProfileForEntity :one
 INSERT INTO entity_profiles (
@@ -4,6 +4,11 @@ INSERT INTO provider_access_tokens (project_id, provider, encrypted_token, expir
 -- name: GetAccessTokenByProjectID :one
 SELECT * FROM provider_access_tokens WHERE provider = $1 AND project_id = $2;
 
+-- GetAccessTokenForUpdate locks the token of a provider until the end of the
+-- transaction, so that concurrent refreshes of the token are serialized.
+-- name: GetAccessTokenForUpdate :one
+SELECT * FROM provider_access_tokens WHERE provider = $1 AND project_id = $2 FOR UPDATE;
+
 -- name: UpdateAccessToken :one
 UPDATE provider_access_tokens SET encrypted_token = $3, expiration_time = $4, owner_filter = $5, updated_at = NOW() WHERE provider = $1 AND project_id = $2 RETURNING *;
 
@@ -15,3 +20,9 @@ SELECT * FROM provider_access_tokens WHERE provider = $1;
 
 -- name: GetAccessTokenSinceDate :one
 SELECT * FROM provider_access_tokens WHERE provider = $1 AND project_id = $2 AND created_at >= $3;
+
+-- ListAccessTokensExpiringBefore lists the tokens that expire before the
+-- given time. Tokens that don't expire are stored with a zero expiration
+-- time, so they are left out.
+-- name: ListAccessTokensExpiringBefore :many
+SELECT * FROM provider_access_tokens WHERE expiration_time > 'epoch'::timestamp AND expiration_time < $1;
@@ -0,0 +1,23 @@
+-- name: UpsertGitHubAppInstallation :one
+INSERT INTO provider_github_app_installations (
+    app_installation_id,
+    provider,
+    project_id,
+    account_login,
+    is_org) VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (project_id, provider)
+DO UPDATE SET
+    app_installation_id = $1,
+    account_login = $4,
+    is_org = $5,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: GetGitHubAppInstallationByProjectAndProvider :one
+SELECT * FROM provider_github_app_installations WHERE provider = $1 AND project_id = $2;
+
+-- name: ListGitHubAppInstallationsByID :many
+SELECT * FROM provider_github_app_installations WHERE app_installation_id = $1;
+
+-- name: DeleteGitHubAppInstallationByID :exec
+DELETE FROM provider_github_app_installations WHERE app_installation_id = $1;
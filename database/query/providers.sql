@@ -17,5 +17,12 @@ SELECT * FROM providers WHERE project_id = $1;
 -- name: GlobalListProviders :many
 SELECT * FROM providers;
 
+-- name: UpdateProvider :one
+UPDATE providers
+    SET implements = $3, definition = sqlc.arg(definition)::jsonb, updated_at = NOW()
+    WHERE name = $1 AND project_id = $2 RETURNING *;
+
 -- name: DeleteProvider :exec
-DELETE FROM providers WHERE id = $1 AND project_id = $2;
\ No newline at end of file
+DELETE FROM providers WHERE id = $1 AND project_id = $2;
+-- name: UpdateProviderHealth :exec
+UPDATE providers SET health = $3, health_detail = $4, updated_at = NOW() WHERE name = $1 AND project_id = $2;
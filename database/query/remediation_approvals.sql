@@ -0,0 +1,64 @@
+-- UpsertRemediationApproval records a remediation waiting for approval, or
+-- refreshes the preview of the one already waiting.
+
+-- name: UpsertRemediationApproval :exec
+INSERT INTO remediation_approvals (project_id, rule_eval_id, action_name, remediation_type, preview)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (rule_eval_id, action_name) WHERE status IN ('pending', 'approved') DO UPDATE SET
+    remediation_type = $4,
+    preview = $5,
+    updated_at = NOW()
+WHERE remediation_approvals.status = 'pending';
+
+-- ListLatestRemediationApprovals returns the most recent approval of each remediation of a rule evaluation.
+
+-- name: ListLatestRemediationApprovals :many
+SELECT DISTINCT ON (action_name) * FROM remediation_approvals
+WHERE rule_eval_id = $1
+ORDER BY action_name, created_at DESC;
+
+-- name: ListRemediationApprovalsByProject :many
+SELECT ra.id, ra.action_name, ra.remediation_type, ra.preview, ra.status, ra.created_at, ra.updated_at,
+       re.entity, re.repository_id, re.artifact_id, re.pull_request_id,
+       repo.provider, repo.repo_owner, repo.repo_name, pr.pr_number,
+       p.name AS profile_name, rt.name AS rule_type_name
+FROM remediation_approvals ra
+JOIN rule_evaluations re ON re.id = ra.rule_eval_id
+JOIN profiles p ON p.id = re.profile_id
+JOIN rule_type rt ON rt.id = re.rule_type_id
+LEFT JOIN repositories repo ON repo.id = re.repository_id
+LEFT JOIN pull_requests pr ON pr.id = re.pull_request_id
+WHERE ra.project_id = $1 AND ra.status = $2
+ORDER BY ra.created_at;
+
+-- GetRemediationApprovalByID returns the same columns as ListRemediationApprovalsByProject.
+
+-- name: GetRemediationApprovalByID :one
+SELECT ra.id, ra.action_name, ra.remediation_type, ra.preview, ra.status, ra.created_at, ra.updated_at,
+       re.entity, re.repository_id, re.artifact_id, re.pull_request_id,
+       repo.provider, repo.repo_owner, repo.repo_name, pr.pr_number,
+       p.name AS profile_name, rt.name AS rule_type_name
+FROM remediation_approvals ra
+JOIN rule_evaluations re ON re.id = ra.rule_eval_id
+JOIN profiles p ON p.id = re.profile_id
+JOIN rule_type rt ON rt.id = re.rule_type_id
+LEFT JOIN repositories repo ON repo.id = re.repository_id
+LEFT JOIN pull_requests pr ON pr.id = re.pull_request_id
+WHERE ra.id = $1 AND ra.project_id = $2;
+
+-- ReviewRemediationApproval approves or rejects a pending remediation.
+
+-- name: ReviewRemediationApproval :one
+UPDATE remediation_approvals SET status = $3, reviewed_by = $4, updated_at = NOW()
+WHERE id = $1 AND project_id = $2 AND status = 'pending'
+RETURNING *;
+
+-- name: UpdateRemediationApprovalStatus :exec
+UPDATE remediation_approvals SET status = $2, details = $3, updated_at = NOW() WHERE id = $1;
+
+-- ExpireRemediationApprovals expires the approvals in progress of a rule
+-- evaluation, e.g. because the rule passes again.
+
+-- name: ExpireRemediationApprovals :exec
+UPDATE remediation_approvals SET status = 'expired', updated_at = NOW()
+WHERE rule_eval_id = $1 AND status IN ('pending', 'approved');
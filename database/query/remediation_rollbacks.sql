@@ -0,0 +1,31 @@
+-- ListRemediationsForRollback returns the remediations of a rule type that
+-- recorded the state of the entity before the remediation, optionally filtered
+-- by the revision of the rule type and by entity. The status is not checked:
+-- the evaluations following a remediation overwrite it, e.g. with 'skipped'
+-- once the rule passes, but keep the recorded state.
+
+-- name: ListRemediationsForRollback :many
+SELECT rdr.id, rdr.action_name, rdr.metadata, rdr.rule_type_revision,
+       re.entity, re.repository_id, re.artifact_id, re.pull_request_id,
+       repo.provider, repo.repo_owner, repo.repo_name, pr.pr_number,
+       p.name AS profile_name, rt.definition AS rule_type_definition
+FROM rule_details_remediate rdr
+JOIN rule_evaluations re ON re.id = rdr.rule_eval_id
+JOIN profiles p ON p.id = re.profile_id
+JOIN rule_type rt ON rt.id = re.rule_type_id
+LEFT JOIN repositories repo ON repo.id = re.repository_id
+LEFT JOIN pull_requests pr ON pr.id = re.pull_request_id
+WHERE p.project_id = $1 AND rt.provider = $2 AND rt.name = $3
+  AND rdr.metadata != '{}'::jsonb
+  AND (sqlc.narg(rule_type_revision)::integer IS NULL OR rdr.rule_type_revision = sqlc.narg(rule_type_revision))
+  AND (sqlc.narg(repository_id)::uuid IS NULL OR re.repository_id = sqlc.narg(repository_id))
+  AND (sqlc.narg(artifact_id)::uuid IS NULL OR re.artifact_id = sqlc.narg(artifact_id))
+  AND (sqlc.narg(pull_request_id)::uuid IS NULL OR re.pull_request_id = sqlc.narg(pull_request_id))
+ORDER BY re.entity, rdr.action_name;
+
+-- RollbackRuleDetailsRemediate forgets the state recorded by a remediation
+-- once it has been restored, so that it is not rolled back twice.
+
+-- name: RollbackRuleDetailsRemediate :exec
+UPDATE rule_details_remediate SET metadata = '{}'::jsonb, details = $2, last_updated = NOW()
+WHERE id = $1;
@@ -10,13 +10,24 @@ INSERT INTO repositories (
     webhook_id,
     webhook_url,
     deploy_url,
-    clone_url) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING *;
+    clone_url,
+    webhook_secret) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) RETURNING *;
 
 -- name: GetRepositoryByID :one
 SELECT * FROM repositories WHERE id = $1;
 
+-- GetRepositoryByRepoID looks up a repository by the ID assigned to it by a
+-- kind of provider, e.g. GitHub.
 -- name: GetRepositoryByRepoID :one
-SELECT * FROM repositories WHERE repo_id = $1;
+SELECT r.* FROM repositories r
+JOIN providers p ON p.project_id = r.project_id AND p.name = r.provider
+WHERE r.repo_id = sqlc.arg(repo_id) AND sqlc.arg(provider_type)::provider_type = ANY(p.implements);
+
+-- ListRepositoriesByRepoID lists the repositories registered with an ID through
+-- any provider. Providers of the same kind may be enrolled with different
+-- instances, which assign the same IDs to different repositories.
+-- name: ListRepositoriesByRepoID :many
+SELECT * FROM repositories WHERE repo_id = $1 ORDER BY created_at;
 
 -- name: GetRepositoryByRepoName :one
 SELECT * FROM repositories WHERE provider = $1 AND repo_owner = $2 AND repo_name = $3;
@@ -20,4 +20,4 @@ SELECT * FROM rule_type WHERE provider = $1 AND project_id = $2 AND name = $3;
 DELETE FROM rule_type WHERE id = $1;
 
 -- name: UpdateRuleType :exec
-UPDATE rule_type SET description = $2, definition = sqlc.arg(definition)::jsonb WHERE id = $1;
+UPDATE rule_type SET description = $2, definition = sqlc.arg(definition)::jsonb, revision = revision + 1 WHERE id = $1;
@@ -0,0 +1,159 @@
+//
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	go_github "github.com/google/go-github/v53/github"
+	"github.com/spf13/viper"
+	"golang.org/x/oauth2"
+)
+
+// GitHubEnterprise is the configuration key of the OAuth2 apps Minder uses on
+// GitHub Enterprise Server instances
+const GitHubEnterprise = "github_enterprise"
+
+// ErrNoGitHubEnterpriseServer is returned when no OAuth2 app is configured
+// for a GitHub Enterprise Server instance
+var ErrNoGitHubEnterpriseServer = errors.New("no OAuth2 app configured for the GitHub Enterprise Server")
+
+// GitHubEnterpriseServer is the OAuth2 app Minder uses on a GitHub Enterprise
+// Server instance
+type GitHubEnterpriseServer struct {
+	// URL is the URL of the instance, e.g. https://github.example.com
+	URL string `mapstructure:"url"`
+	// ClientID is the client ID of the OAuth2 app
+	ClientID string `mapstructure:"client_id"`
+	// ClientIDFile is a file containing the client ID, it takes precedence
+	// over ClientID
+	ClientIDFile string `mapstructure:"client_id_file"`
+	// ClientSecret is the client secret of the OAuth2 app
+	ClientSecret string `mapstructure:"client_secret"`
+	// ClientSecretFile is a file containing the client secret, it takes
+	// precedence over ClientSecret
+	ClientSecretFile string `mapstructure:"client_secret_file"`
+	// RedirectURI is the base URL of the OAuth2 callback of the Minder server,
+	// e.g. http://localhost:8080/api/v1/auth/callback. The name of the
+	// provider is appended to it.
+	RedirectURI string `mapstructure:"redirect_uri"`
+}
+
+// GetGitHubEnterpriseServer returns the OAuth2 app configured for the GitHub
+// Enterprise Server instance at the given URL
+func GetGitHubEnterpriseServer(serverURL string) (*GitHubEnterpriseServer, error) {
+	return getGitHubEnterpriseServer(viper.GetViper(), serverURL)
+}
+
+func getGitHubEnterpriseServer(v *viper.Viper, serverURL string) (*GitHubEnterpriseServer, error) {
+	var servers []GitHubEnterpriseServer
+	if err := v.UnmarshalKey(GitHubEnterprise, &servers); err != nil {
+		return nil, fmt.Errorf("error reading %s config: %w", GitHubEnterprise, err)
+	}
+
+	want := strings.TrimSuffix(serverURL, "/")
+	for i := range servers {
+		if strings.TrimSuffix(servers[i].URL, "/") == want {
+			return &servers[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrNoGitHubEnterpriseServer, serverURL)
+}
+
+// OAuthConfig creates a new OAuth2 config for the given provider, and whether
+// the client is a CLI or web client
+func (s *GitHubEnterpriseServer) OAuthConfig(provider string, cli bool) (*oauth2.Config, error) {
+	if s.RedirectURI == "" {
+		return nil, fmt.Errorf("no redirect_uri configured for %s", s.URL)
+	}
+
+	clientID, err := readFileOrValue(s.ClientIDFile, s.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client_id of %s: %w", s.URL, err)
+	}
+	clientSecret, err := readFileOrValue(s.ClientSecretFile, s.ClientSecret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client_secret of %s: %w", s.URL, err)
+	}
+
+	redirectURL := fmt.Sprintf("%s/%s/web", strings.TrimSuffix(s.RedirectURI, "/"), provider)
+	if cli {
+		redirectURL = fmt.Sprintf("%s/%s/cli", strings.TrimSuffix(s.RedirectURI, "/"), provider)
+	}
+
+	serverURL := strings.TrimSuffix(s.URL, "/")
+	return &oauth2.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		RedirectURL:  redirectURL,
+		// the same scopes as on github.com
+		Scopes: []string{"user:email", "repo", "read:packages", "write:packages", "workflow", "read:org"},
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  serverURL + "/login/oauth/authorize",
+			TokenURL: serverURL + "/login/oauth/access_token",
+		},
+	}, nil
+}
+
+// RevokeToken revokes an OAuth2 token issued by the instance
+// See https://docs.github.com/en/enterprise-server@latest/rest/apps/oauth-applications#delete-an-app-token
+func (s *GitHubEnterpriseServer) RevokeToken(ctx context.Context, token string) error {
+	clientID, err := readFileOrValue(s.ClientIDFile, s.ClientID)
+	if err != nil {
+		return fmt.Errorf("failed to read client_id of %s: %w", s.URL, err)
+	}
+	clientSecret, err := readFileOrValue(s.ClientSecretFile, s.ClientSecret)
+	if err != nil {
+		return fmt.Errorf("failed to read client_secret of %s: %w", s.URL, err)
+	}
+
+	hClient := &http.Client{
+		Transport: &go_github.BasicAuthTransport{
+			Username: clientID,
+			Password: clientSecret,
+		},
+	}
+	// the API of an instance is served under /api/v3/, which
+	// NewEnterpriseClient appends
+	serverURL := strings.TrimSuffix(s.URL, "/") + "/"
+	client, err := go_github.NewEnterpriseClient(serverURL, serverURL, hClient)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Authorizations.Revoke(ctx, clientID, token)
+	return err
+}
+
+// readFileOrValue prefers reading from a file (for Kubernetes distribution of
+// secrets), but falls back to the given value if there's no file.
+func readFileOrValue(filename, value string) (string, error) {
+	if filename == "" {
+		return value, nil
+	}
+	// filepath.Clean avoids a gosec warning on reading a file by name
+	data, err := os.ReadFile(filepath.Clean(filename))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
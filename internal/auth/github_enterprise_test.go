@@ -0,0 +1,140 @@
+//
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newGitHubEnterpriseViper(serverURL string) *viper.Viper {
+	v := viper.New()
+	v.Set(GitHubEnterprise, []map[string]any{
+		{
+			"url":           "https://other.example.com",
+			"client_id":     "other",
+			"client_secret": "other",
+			"redirect_uri":  "http://localhost:8080/api/v1/auth/callback",
+		},
+		{
+			"url":           serverURL,
+			"client_id":     "client-id",
+			"client_secret": "client-secret",
+			"redirect_uri":  "http://localhost:8080/api/v1/auth/callback/",
+		},
+	})
+	return v
+}
+
+func TestGetGitHubEnterpriseServer(t *testing.T) {
+	t.Parallel()
+
+	v := newGitHubEnterpriseViper("https://github.example.com/")
+
+	srv, err := getGitHubEnterpriseServer(v, "https://github.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "client-id", srv.ClientID)
+
+	_, err = getGitHubEnterpriseServer(v, "https://unknown.example.com")
+	assert.ErrorIs(t, err, ErrNoGitHubEnterpriseServer)
+
+	_, err = getGitHubEnterpriseServer(viper.New(), "https://github.example.com")
+	assert.ErrorIs(t, err, ErrNoGitHubEnterpriseServer)
+}
+
+func TestGitHubEnterpriseOAuthConfig(t *testing.T) {
+	t.Parallel()
+
+	srv := &GitHubEnterpriseServer{
+		URL:          "https://github.example.com/",
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		RedirectURI:  "http://localhost:8080/api/v1/auth/callback/",
+	}
+
+	cfg, err := srv.OAuthConfig("ghes", true)
+	require.NoError(t, err)
+	assert.Equal(t, "client-id", cfg.ClientID)
+	assert.Equal(t, "client-secret", cfg.ClientSecret)
+	assert.Equal(t, "http://localhost:8080/api/v1/auth/callback/ghes/cli", cfg.RedirectURL)
+	assert.Equal(t, "https://github.example.com/login/oauth/authorize", cfg.Endpoint.AuthURL)
+	assert.Equal(t, "https://github.example.com/login/oauth/access_token", cfg.Endpoint.TokenURL)
+
+	cfg, err = srv.OAuthConfig("ghes", false)
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080/api/v1/auth/callback/ghes/web", cfg.RedirectURL)
+
+	srv.RedirectURI = ""
+	_, err = srv.OAuthConfig("ghes", true)
+	assert.Error(t, err)
+}
+
+func TestGitHubEnterpriseExchange(t *testing.T) {
+	t.Parallel()
+
+	// a stand-in for the OAuth2 endpoints of a GitHub Enterprise Server
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/login/oauth/access_token" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err := r.ParseForm(); err != nil || r.Form.Get("code") != "code" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token": "token", "token_type": "bearer"}`))
+	}))
+	defer ts.Close()
+
+	srv, err := getGitHubEnterpriseServer(newGitHubEnterpriseViper(ts.URL), ts.URL)
+	require.NoError(t, err)
+	cfg, err := srv.OAuthConfig("ghes", true)
+	require.NoError(t, err)
+
+	token, err := cfg.Exchange(context.Background(), "code")
+	require.NoError(t, err)
+	assert.Equal(t, "token", token.AccessToken)
+}
+
+func TestGitHubEnterpriseRevokeToken(t *testing.T) {
+	t.Parallel()
+
+	var revoked bool
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		if r.Method != http.MethodDelete || r.URL.Path != "/api/v3/applications/client-id/token" ||
+			!ok || user != "client-id" || pass != "client-secret" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		revoked = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	srv, err := getGitHubEnterpriseServer(newGitHubEnterpriseViper(ts.URL), ts.URL)
+	require.NoError(t, err)
+
+	require.NoError(t, srv.RevokeToken(context.Background(), "token"))
+	assert.True(t, revoked)
+}
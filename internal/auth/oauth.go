@@ -25,8 +25,10 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 
 	go_github "github.com/google/go-github/v53/github"
 	"github.com/spf13/pflag"
@@ -44,10 +46,32 @@ const (
 
 	// Github OAuth2 provider
 	Github = "github"
+
+	// Gitlab OAuth2 provider
+	Gitlab = "gitlab"
+
+	// DefaultGitlabURL is the URL of the GitLab instance used when
+	// gitlab.url is not configured
+	DefaultGitlabURL = "https://gitlab.com"
 )
 
 // TODO:
-var knownProviders = []string{Google, Github}
+var knownProviders = []string{Google, Github, Gitlab}
+
+// GitlabURL returns the URL of the configured GitLab instance, without a
+// trailing slash.
+func GitlabURL() string {
+	u := viper.GetString(fmt.Sprintf("%s.url", Gitlab))
+	if u == "" {
+		return DefaultGitlabURL
+	}
+	return strings.TrimSuffix(u, "/")
+}
+
+// GitlabAPIEndpoint returns the v4 API endpoint of the configured GitLab instance
+func GitlabAPIEndpoint() string {
+	return GitlabURL() + "/api/v4/"
+}
 
 // NewOAuthConfig creates a new OAuth2 config for the given provider
 // and whether the client is a CLI or web client
@@ -64,6 +88,9 @@ func NewOAuthConfig(provider string, cli bool) (*oauth2.Config, error) {
 		if provider == Google {
 			return []string{"profile", "email"}
 		}
+		if provider == Gitlab {
+			return []string{"api", "read_user", "read_repository"}
+		}
 		return []string{"user:email", "repo", "read:packages", "write:packages", "workflow", "read:org"}
 	}
 
@@ -71,10 +98,16 @@ func NewOAuthConfig(provider string, cli bool) (*oauth2.Config, error) {
 		if provider == Google {
 			return google.Endpoint
 		}
+		if provider == Gitlab {
+			return oauth2.Endpoint{
+				AuthURL:  GitlabURL() + "/oauth/authorize",
+				TokenURL: GitlabURL() + "/oauth/token",
+			}
+		}
 		return github.Endpoint
 	}
 
-	if provider != Google && provider != Github {
+	if provider != Google && provider != Github && provider != Gitlab {
 		return nil, fmt.Errorf("invalid provider: %s", provider)
 	}
 
@@ -96,6 +129,13 @@ func NewOAuthConfig(provider string, cli bool) (*oauth2.Config, error) {
 	}, nil
 }
 
+// IsProviderConfigured returns true if an OAuth2 client is configured for
+// the given provider
+func IsProviderConfigured(provider string) bool {
+	return viper.GetString(fmt.Sprintf("%s.client_id", provider)) != "" ||
+		viper.GetString(fmt.Sprintf("%s.client_id_file", provider)) != ""
+}
+
 // readFileOrConfig prefers reading from configKey_file (for Kubernetes distribution
 // of secrets), but falls back to a viper string value if the file is not present.
 func readFileOrConfig(configKey string) (string, error) {
@@ -128,6 +168,10 @@ func NewProviderHttpClient(provider string) *http.Client {
 
 // DeleteAccessToken deletes the access token for a given provider
 func DeleteAccessToken(ctx context.Context, provider string, token string) error {
+	if provider == Gitlab {
+		return revokeGitlabToken(ctx, token)
+	}
+
 	hClient := NewProviderHttpClient(provider)
 	if hClient == nil {
 		return fmt.Errorf("invalid provider: %s", provider)
@@ -143,8 +187,62 @@ func DeleteAccessToken(ctx context.Context, provider string, token string) error
 	return nil
 }
 
+// revokeGitlabToken revokes an OAuth token issued by the configured GitLab instance
+// See https://docs.gitlab.com/ee/api/oauth2.html#revoke-a-token
+func revokeGitlabToken(ctx context.Context, token string) error {
+	clientId, err := readFileOrConfig(fmt.Sprintf("%s.client_id", Gitlab))
+	if err != nil {
+		return fmt.Errorf("failed to read %s.client_id: %w", Gitlab, err)
+	}
+	clientSecret, err := readFileOrConfig(fmt.Sprintf("%s.client_secret", Gitlab))
+	if err != nil {
+		return fmt.Errorf("failed to read %s.client_secret: %w", Gitlab, err)
+	}
+
+	form := url.Values{
+		"client_id":     {clientId},
+		"client_secret": {clientSecret},
+		"token":         {token},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, GitlabURL()+"/oauth/revoke",
+		strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("error revoking token: unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
 // ValidateProviderToken validates the given token for the given provider
-func ValidateProviderToken(_ context.Context, provider string, token string) error {
+func ValidateProviderToken(ctx context.Context, provider string, token string) error {
+	if provider == Gitlab {
+		oauth2Client := oauth2.NewClient(ctx, oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token}))
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, GitlabAPIEndpoint()+"user", nil)
+		if err != nil {
+			return err
+		}
+		resp, err := oauth2Client.Do(req)
+		if err != nil {
+			return fmt.Errorf("invalid token: %s", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("invalid token: unexpected status %d", resp.StatusCode)
+		}
+		return nil
+	}
+
 	if provider == Github {
 		// Create an OAuth2 token source with the PAT
 		tokenSource := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
@@ -40,6 +40,7 @@ type Config struct {
 	Salt          CryptoConfig       `mapstructure:"salt"`
 	Auth          AuthConfig         `mapstructure:"auth"`
 	WebhookConfig WebhookConfig      `mapstructure:"webhook-config"`
+	GitHubApp     GitHubAppConfig    `mapstructure:"github-app"`
 	Events        EventConfig        `mapstructure:"events"`
 }
 
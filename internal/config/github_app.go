@@ -0,0 +1,38 @@
+//
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+// GitHubAppConfig is the configuration for authenticating to GitHub as a
+// GitHub App. When it is not set, minder only uses the OAuth tokens of the
+// users who enrolled.
+type GitHubAppConfig struct {
+	// AppID is the numeric ID of the GitHub App
+	AppID int64 `mapstructure:"app_id" default:"0"`
+	// PrivateKey is the path to the PEM encoded private key of the GitHub App
+	PrivateKey string `mapstructure:"private_key"`
+	// WebhookSecret is the secret GitHub signs the App's webhook deliveries with
+	WebhookSecret string `mapstructure:"webhook_secret"`
+}
+
+// Enabled returns true if minder is configured to authenticate as a GitHub App
+func (gacfg *GitHubAppConfig) Enabled() bool {
+	return gacfg.AppID != 0 && gacfg.PrivateKey != ""
+}
+
+// GetPrivateKey returns the PEM encoded private key of the GitHub App
+func (gacfg *GitHubAppConfig) GetPrivateKey() ([]byte, error) {
+	return readKey(gacfg.PrivateKey)
+}
@@ -19,6 +19,10 @@ package config
 type WebhookConfig struct {
 	// ExternalWebhookURL is the URL that we will send our webhook to
 	ExternalWebhookURL string `mapstructure:"external_webhook_url"`
+	// ExternalGitLabWebhookURL is the URL GitLab projects will send their webhooks to
+	ExternalGitLabWebhookURL string `mapstructure:"external_gitlab_webhook_url"`
+	// ExternalGiteaWebhookURL is the URL Gitea repositories will send their webhooks to
+	ExternalGiteaWebhookURL string `mapstructure:"external_gitea_webhook_url"`
 	// ExternalPingURL is the URL that we will send our ping to
 	ExternalPingURL string `mapstructure:"external_ping_url"`
 	// WebhookSecret is the secret that we will use to sign our webhook
@@ -77,13 +77,14 @@ var (
 	ErrProtoParse = errors.New("error getting bytes from proto")
 )
 
-// GetArtifactSignatureAndWorkflowInfo returns the signature and workflow information as raw JSON for a given artifact
+// GetArtifactSignatureAndWorkflowInfo returns the signature and workflow information as raw JSON for a given artifact,
+// pulled from the container registry of the GitHub instance of the client
 func GetArtifactSignatureAndWorkflowInfo(
 	ctx context.Context,
-	cli provifv1.Provider,
+	cli provifv1.GitHub,
 	ownerLogin, artifactName, versionName string,
 ) (sigInfo json.RawMessage, workflowInfo json.RawMessage, err error) {
-	imageRef := artifactImageRef("", ownerLogin, artifactName, versionName)
+	imageRef := artifactImageRef(cli.GetRegistry(), ownerLogin, artifactName, versionName)
 	signatureVerification, githubWorkflow, validateErr := ValidateSignature(ctx,
 		cli.GetToken(), ownerLogin, imageRef)
 	if validateErr != nil {
@@ -91,6 +92,24 @@ func GetArtifactSignatureAndWorkflowInfo(
 		return
 	}
 
+	return marshalSignatureAndWorkflowInfo(signatureVerification, githubWorkflow)
+}
+
+// GetOCIArtifactSignatureAndWorkflowInfo returns the signature and workflow information as raw JSON
+// for an image stored in an OCI registry, authenticating with the given credentials
+func GetOCIArtifactSignatureAndWorkflowInfo(
+	ctx context.Context,
+	auth authn.Authenticator,
+	imageRef name.Reference,
+) (sigInfo json.RawMessage, workflowInfo json.RawMessage, err error) {
+	signatureVerification, githubWorkflow := validateSignature(ctx, auth, imageRef)
+	return marshalSignatureAndWorkflowInfo(signatureVerification, githubWorkflow)
+}
+
+func marshalSignatureAndWorkflowInfo(
+	signatureVerification *pb.SignatureVerification,
+	githubWorkflow *pb.GithubWorkflow,
+) (sigInfo json.RawMessage, workflowInfo json.RawMessage, err error) {
 	sig, parseErr := util.GetBytesFromProto(signatureVerification)
 	if parseErr != nil {
 		sigInfo = json.RawMessage("{}")
@@ -113,7 +132,8 @@ func GetArtifactSignatureAndWorkflowInfo(
 
 func extractAndValidateSignature(
 	ctx context.Context,
-	package_url, package_owner, accessToken string,
+	imageRef name.Reference,
+	auth authn.Authenticator,
 	manifest containerregistry.Manifest,
 	signatureVerification *pb.SignatureVerification,
 	githubWorkflow *pb.GithubWorkflow,
@@ -125,7 +145,7 @@ func extractAndValidateSignature(
 		signatureVerification.CertIssuer = &issuer
 
 		// we have issuer and identity, we can verify the image
-		verified, bundleVerified, imageKeys, err := VerifyFromIdentity(ctx, package_url, package_owner, accessToken, identity, issuer)
+		verified, bundleVerified, imageKeys, err := verifyFromIdentity(ctx, imageRef, auth, identity, issuer)
 		if err == nil {
 			// we can add information for the image
 			signatureVerification.IsVerified = verified
@@ -217,6 +237,16 @@ func ValidateSignature(ctx context.Context, accessToken string, package_owner st
 		return nil, nil, fmt.Errorf("error parsing image path: %w", err)
 	}
 
+	signature_verification, github_workflow := validateSignature(ctx,
+		githubAuthenticator{package_owner, accessToken}, baseRef)
+	return signature_verification, github_workflow, nil
+}
+
+func validateSignature(
+	ctx context.Context,
+	auth authn.Authenticator,
+	baseRef name.Reference,
+) (*pb.SignatureVerification, *pb.GithubWorkflow) {
 	// need to retrieve package by name
 	signature_verification := &pb.SignatureVerification{
 		IsVerified:       false,
@@ -226,24 +256,23 @@ func ValidateSignature(ctx context.Context, accessToken string, package_owner st
 	github_workflow := &pb.GithubWorkflow{}
 
 	// get information about signature
-	signature, err := GetSignatureTag(baseRef, package_owner, accessToken)
+	signature, err := getSignatureTag(baseRef, auth)
 
 	// if there is a signature, we can move forward and retrieve details
 	if err == nil && signature != nil {
 		// we need to extract manifest from the signature
-		manifest, err := GetImageManifest(signature, package_owner, accessToken)
+		manifest, err := getImageManifest(signature, auth)
 		if errors.Is(err, errManifestNotFound) {
 			zerolog.Ctx(ctx).Info().
-				Str("packageUrl", package_url).
+				Str("packageUrl", baseRef.String()).
 				Msg("no manifest found")
 		} else if err != nil {
 			log.Printf("error getting manifest: %v", err)
 		} else if manifest.Layers != nil {
 			extractAndValidateSignature(
 				ctx,
-				package_url,
-				package_owner,
-				accessToken,
+				baseRef,
+				auth,
 				manifest,
 				signature_verification,
 				github_workflow)
@@ -251,13 +280,15 @@ func ValidateSignature(ctx context.Context, accessToken string, package_owner st
 	} else {
 		log.Printf("error getting signature tag: %v", err)
 	}
-	return signature_verification, github_workflow, nil
-
+	return signature_verification, github_workflow
 }
 
 // GetSignatureTag returns the signature tag for a given image if exists
 func GetSignatureTag(imageRef name.Reference, username string, token string) (name.Reference, error) {
-	auth := githubAuthenticator{username, token}
+	return getSignatureTag(imageRef, githubAuthenticator{username, token})
+}
+
+func getSignatureTag(imageRef name.Reference, auth authn.Authenticator) (name.Reference, error) {
 	dstRef, err := ociremote.SignatureTag(imageRef, ociremote.WithRemoteOptions(remote.WithAuth(auth)))
 	if err != nil {
 		return nil, fmt.Errorf("error getting signature tag: %w", err)
@@ -267,7 +298,10 @@ func GetSignatureTag(imageRef name.Reference, username string, token string) (na
 
 // GetImageManifest returns the manifest for the given image
 func GetImageManifest(imageRef name.Reference, username string, token string) (containerregistry.Manifest, error) {
-	auth := githubAuthenticator{username, token}
+	return getImageManifest(imageRef, githubAuthenticator{username, token})
+}
+
+func getImageManifest(imageRef name.Reference, auth authn.Authenticator) (containerregistry.Manifest, error) {
 	img, err := remote.Image(imageRef, remote.WithAuth(auth))
 	if err != nil {
 		var transportErr *transport.Error
@@ -399,13 +433,20 @@ func GetKeysFromVerified(verified []oci.Signature) ([]payload.SimpleContainerIma
 // VerifyFromIdentity verifies the image from the identity and extracts the keys
 func VerifyFromIdentity(ctx context.Context, imageRef string, owner string, token string,
 	identity string, issuer string) (bool, bool, map[string]interface{}, error) {
-	imageKeys := make(map[string]interface{})
-
 	options := []name.Option{}
 	ref, err := name.ParseReference(imageRef, options...)
 	if err != nil {
 		return false, false, nil, fmt.Errorf("error parsing reference url: %w", err)
 	}
+
+	// need to authenticate in case artifact is private
+	return verifyFromIdentity(ctx, ref, githubAuthenticator{owner, token}, identity, issuer)
+}
+
+func verifyFromIdentity(ctx context.Context, ref name.Reference, auth authn.Authenticator,
+	identity string, issuer string) (bool, bool, map[string]interface{}, error) {
+	imageKeys := make(map[string]interface{})
+
 	identityObj := []cosign.Identity{{Issuer: issuer, Subject: identity}}
 
 	// get fulcio roots
@@ -419,8 +460,6 @@ func VerifyFromIdentity(ctx context.Context, imageRef string, owner string, toke
 		return false, false, nil, fmt.Errorf("error getting rekor public keys: %w", err)
 	}
 
-	// need to authenticate in case artifact is private
-	auth := githubAuthenticator{owner, token}
 	registryClientOpts := []ociremote.Option{ociremote.WithRemoteOptions(remote.WithAuth(auth))}
 
 	co := &cosign.CheckOpts{
@@ -25,8 +25,10 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/stacklok/minder/internal/auth"
 	"github.com/stacklok/minder/internal/db"
 	github "github.com/stacklok/minder/internal/providers/github"
+	"github.com/stacklok/minder/internal/providers/gitlab"
 	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
 )
 
@@ -111,5 +113,26 @@ func CreateDefaultRecordsForOrg(ctx context.Context, qtx db.Querier,
 	if err != nil {
 		return nil, nil, status.Errorf(codes.Internal, "failed to create provider: %v", err)
 	}
+
+	// Create GitLab provider, only if the server is set up to enroll against GitLab
+	if auth.IsProviderConfigured(gitlab.Gitlab) {
+		def, err := json.Marshal(map[string]any{
+			"gitlab": &pb.GitLabProviderConfig{Endpoint: auth.GitlabAPIEndpoint()},
+		})
+		if err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "failed to marshal gitlab provider definition: %v", err)
+		}
+
+		_, err = qtx.CreateProvider(ctx, db.CreateProviderParams{
+			Name:       gitlab.Gitlab,
+			ProjectID:  project.ID,
+			Implements: gitlab.Implements,
+			Definition: def,
+		})
+		if err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "failed to create provider: %v", err)
+		}
+	}
+
 	return &prj, []int32{role1.ID, role2.ID}, nil
 }
@@ -0,0 +1,401 @@
+//
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	urlparser "net/url"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/stacklok/minder/internal/db"
+	"github.com/stacklok/minder/internal/engine"
+	"github.com/stacklok/minder/internal/events"
+	"github.com/stacklok/minder/internal/providers/gitea"
+	"github.com/stacklok/minder/internal/providers/registry"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// maxGiteaPayloadSize caps the size of the webhook payloads we read
+const maxGiteaPayloadSize = 25 << 20
+
+// giteaEvent is the subset of Gitea's push and pull request webhook
+// payloads minder needs.
+// See https://docs.gitea.com/usage/webhooks
+type giteaEvent struct {
+	Action      string `json:"action"`
+	Number      int64  `json:"number"`
+	PullRequest *struct {
+		HTMLURL string `json:"html_url"`
+		User    struct {
+			ID int64 `json:"id"`
+		} `json:"user"`
+		Head struct {
+			Sha string `json:"sha"`
+		} `json:"head"`
+	} `json:"pull_request"`
+	Repository struct {
+		ID      int64 `json:"id"`
+		Private bool  `json:"private"`
+	} `json:"repository"`
+}
+
+// HandleGiteaWebHook handles incoming Gitea webhooks
+// See https://docs.gitea.com/usage/webhooks for more information.
+func (s *Server) HandleGiteaWebHook() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		wes := webhookEventState{
+			typ:      "unknown",
+			accepted: false,
+			error:    true,
+		}
+		defer func() {
+			s.mt.webhookEventTypeCount(r.Context(), wes)
+		}()
+
+		rawWBPayload, err := io.ReadAll(io.LimitReader(r.Body, maxGiteaPayloadSize))
+		if err != nil {
+			log.Printf("Error reading webhook payload: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		wes.typ = r.Header.Get("X-Gitea-Event")
+
+		m := message.NewMessage(uuid.New().String(), nil)
+		m.Metadata.Set(events.ProviderDeliveryIdKey, r.Header.Get("X-Gitea-Delivery"))
+		m.Metadata.Set(events.ProviderTypeKey, gitea.Gitea)
+		m.Metadata.Set(events.GithubWebhookEventTypeKey, wes.typ)
+
+		// the signature is checked against the secret of the hook once the
+		// repository the event was sent for is known
+		signature := r.Header.Get("X-Gitea-Signature")
+		if err := s.parseGiteaEventForProcessing(r.Context(), wes.typ, rawWBPayload, signature, m); err != nil {
+			if errors.Is(err, errInvalidWebhookSecret) {
+				log.Printf("Error validating gitea webhook signature: %v", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			wes = handleParseError(wes.typ, err)
+			if wes.error {
+				w.WriteHeader(http.StatusInternalServerError)
+			} else {
+				w.WriteHeader(http.StatusOK)
+			}
+			return
+		}
+
+		wes.accepted = true
+
+		if err := s.evt.Publish(engine.InternalEntityEventTopic, m); err != nil {
+			wes.error = true
+			log.Printf("Error publishing message: %v", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		wes.error = false
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// validateGiteaSignature checks the hex encoded HMAC-SHA256 of the payload
+// Gitea signs the webhooks it sends with
+func validateGiteaSignature(signature string, payload []byte, secret string) error {
+	if secret == "" {
+		return errors.New("no webhook secret configured")
+	}
+
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return errors.New("signature mismatch")
+	}
+	return nil
+}
+
+func (s *Server) parseGiteaEventForProcessing(
+	ctx context.Context,
+	eventType string,
+	rawWHPayload []byte,
+	signature string,
+	msg *message.Message,
+) error {
+	var ent pb.Entity
+	switch eventType {
+	case "push":
+		ent = pb.Entity_ENTITY_REPOSITORIES
+	case "pull_request":
+		ent = pb.Entity_ENTITY_PULL_REQUESTS
+	default:
+		return newErrNotHandled("event %s not handled", eventType)
+	}
+
+	var evt giteaEvent
+	if err := json.Unmarshal(rawWHPayload, &evt); err != nil {
+		return fmt.Errorf("error unmarshalling payload: %w", err)
+	}
+
+	dbRepo, err := s.getGiteaRepoFromPayload(ctx, &evt, rawWHPayload, signature)
+	if err != nil {
+		return fmt.Errorf("error getting repo information from payload: %w", err)
+	}
+
+	if ent == pb.Entity_ENTITY_PULL_REQUESTS {
+		return parseGiteaPullRequestEvent(ctx, &evt, msg, dbRepo, s.store)
+	}
+
+	return parseRepoEvent(msg, dbRepo, dbRepo.Provider)
+}
+
+// getGiteaRepoFromPayload looks up the repository the event was sent for.
+// Repository IDs are only unique within a Gitea instance, so the event
+// belongs to the repository registered through a gitea provider whose hook
+// signed the payload.
+func (s *Server) getGiteaRepoFromPayload(
+	ctx context.Context,
+	evt *giteaEvent,
+	rawWHPayload []byte,
+	signature string,
+) (db.Repository, error) {
+	if evt.Repository.ID == 0 {
+		return db.Repository{}, fmt.Errorf("unable to determine repository for event: %w", errRepoNotFound)
+	}
+
+	id := int32(evt.Repository.ID)
+	dbrepos, err := s.store.ListRepositoriesByRepoID(ctx, id)
+	if err != nil {
+		return db.Repository{}, fmt.Errorf("error getting repositories: %w", err)
+	}
+
+	var sigErr error
+	for _, dbrepo := range dbrepos {
+		prov, err := s.store.GetProviderByName(ctx, db.GetProviderByNameParams{
+			Name:      dbrepo.Provider,
+			ProjectID: dbrepo.ProjectID,
+		})
+		if err != nil {
+			return db.Repository{}, fmt.Errorf("error getting provider: %w", err)
+		}
+		if impl, err := registry.Get(prov.Definition); err != nil || impl.Name != gitea.Gitea {
+			continue
+		}
+
+		secret, err := s.repositoryWebhookSecret(&dbrepo)
+		if err != nil {
+			return db.Repository{}, err
+		}
+		if err := validateGiteaSignature(signature, rawWHPayload, secret); err != nil {
+			sigErr = fmt.Errorf("%w: %w", errInvalidWebhookSecret, err)
+			continue
+		}
+
+		// ignore processing webhooks for private repositories
+		if evt.Repository.Private && !projectAllowsPrivateRepos(ctx, s.store, dbrepo.ProjectID) {
+			return db.Repository{}, errRepoIsPrivate
+		}
+
+		log.Printf("handling gitea event for repository %d", id)
+
+		return dbrepo, nil
+	}
+
+	if sigErr != nil {
+		return db.Repository{}, sigErr
+	}
+	return db.Repository{}, fmt.Errorf("repository %d not found for a gitea provider: %w", id, errRepoNotFound)
+}
+
+// parseGiteaPullRequestEvent maps a Gitea pull request event onto the pull
+// request entity. Gitea's actions are translated to their GitHub counterparts
+// so PRs are tracked the same way regardless of the provider.
+func parseGiteaPullRequestEvent(
+	ctx context.Context,
+	evt *giteaEvent,
+	msg *message.Message,
+	dbrepo db.Repository,
+	store db.Store,
+) error {
+	if evt.PullRequest == nil {
+		return newErrNotHandled("pull request event without a pull request")
+	}
+
+	var action string
+	switch evt.Action {
+	case "opened", "reopened":
+		action = "opened"
+	case "synchronized":
+		action = "synchronize"
+	default:
+		action = evt.Action
+	}
+
+	prEvalInfo := &pb.PullRequest{
+		Url:       evt.PullRequest.HTMLURL,
+		Number:    int32(evt.Number),
+		AuthorId:  evt.PullRequest.User.ID,
+		Action:    action,
+		CommitSha: evt.PullRequest.Head.Sha,
+		RepoOwner: dbrepo.RepoOwner,
+		RepoName:  dbrepo.RepoName,
+	}
+
+	dbPr, err := reconcilePrWithDb(ctx, store, dbrepo, prEvalInfo)
+	if errors.Is(err, errNotHandled) {
+		return err
+	} else if err != nil {
+		return fmt.Errorf("error reconciling PR with DB: %w", err)
+	}
+
+	eiw := engine.NewEntityInfoWrapper().
+		WithPullRequest(prEvalInfo).
+		WithPullRequestID(dbPr.ID).
+		WithProvider(dbrepo.Provider).
+		WithProjectID(dbrepo.ProjectID).
+		WithRepositoryID(dbrepo.ID)
+
+	return eiw.ToMessage(msg)
+}
+
+// registerGiteaWebhookForRepository registers a webhook on a Gitea repository,
+// signing its deliveries with the given secret, and returns the registration
+// result.
+// https://docs.gitea.com/api/1.20/#tag/repository/operation/repoCreateHook
+func (s *Server) registerGiteaWebhookForRepository(
+	ctx context.Context,
+	client *gitea.Client,
+	projectID uuid.UUID,
+	repo *pb.UpstreamRepositoryRef,
+	secret string,
+) (*pb.RegisterRepoResult, error) {
+	url := s.cfg.WebhookConfig.ExternalGiteaWebhookURL
+	if url == "" {
+		return nil, fmt.Errorf("no external webhook URL configured for gitea")
+	}
+
+	regResult := &pb.RegisterRepoResult{
+		Repository: &pb.Repository{
+			Name:  repo.Name,
+			Owner: repo.Owner,
+		},
+		Status: &pb.RegisterRepoResult_Status{
+			Success: false,
+		},
+	}
+
+	logger := zerolog.Ctx(ctx).With().
+		Str("repoName", repo.Name).
+		Str("repoOwner", repo.Owner).Logger()
+
+	// let's verify that the repository actually exists.
+	repoGet, err := client.GetRepository(ctx, repo.Owner, repo.Name)
+	if err != nil {
+		errorStr := err.Error()
+		regResult.Status.Error = &errorStr
+		return regResult, nil
+	}
+	regResult.Repository.RepoId = int32(repoGet.ID)
+
+	// skip if we try to register a private repository
+	if repoGet.Private && !projectAllowsPrivateRepos(ctx, s.store, projectID) {
+		errorStr := "repository is private"
+		regResult.Status.Error = &errorStr
+		return regResult, nil
+	}
+
+	urlUUID := uuid.New().String()
+
+	webhookUrl := fmt.Sprintf("%s/%s", url, urlUUID)
+	parsedOriginalURL, err := urlparser.Parse(webhookUrl)
+	if err != nil {
+		errStr := err.Error()
+		regResult.Status.Error = &errStr
+		return regResult, nil
+	}
+
+	// if we have an existing hook for same repository, delete it
+	hooks, err := client.ListHooks(ctx, repo.Owner, repo.Name)
+	if err != nil && !errors.Is(err, gitea.ErrNotFound) {
+		errorStr := err.Error()
+		regResult.Status.Error = &errorStr
+		logger.Error().Msg("error listing hooks")
+		return regResult, nil
+	}
+	for _, h := range hooks {
+		parsedURL, err := urlparser.Parse(h.URL())
+		if err != nil {
+			errorStr := err.Error()
+			regResult.Status.Error = &errorStr
+			return regResult, nil
+		}
+		if parsedURL.Host == parsedOriginalURL.Host {
+			// it is our hook, we can remove it
+			if err := client.DeleteHook(ctx, repo.Owner, repo.Name, h.ID); err != nil {
+				errorStr := err.Error()
+				regResult.Status.Error = &errorStr
+				logger.Error().Msg("error deleting hook")
+				return regResult, nil
+			}
+		}
+	}
+
+	// Attempt to register webhook
+	mhook, err := client.CreateHook(ctx, repo.Owner, repo.Name, &gitea.Hook{
+		Type: gitea.HookTypeGitea,
+		Config: map[string]string{
+			"url":          webhookUrl,
+			"content_type": "json",
+			"secret":       secret,
+		},
+		Events: []string{"push", "pull_request"},
+		Active: true,
+	})
+	if err != nil {
+		errorStr := err.Error()
+		regResult.Status.Error = &errorStr
+		logger.Error().Msg("error creating hook")
+		return regResult, nil
+	}
+
+	regResult.Status.Success = true
+	regResult.Repository.HookId = mhook.ID
+	regResult.Repository.HookUrl = mhook.URL()
+	regResult.Repository.DeployUrl = webhookUrl
+	regResult.Repository.CloneUrl = repoGet.CloneURL
+	regResult.Repository.HookType = "Repository"
+	regResult.Repository.HookName = gitea.Gitea
+	regResult.Repository.HookUuid = urlUUID
+	regResult.Repository.IsPrivate = repoGet.Private
+	regResult.Repository.IsFork = repoGet.Fork
+
+	return regResult, nil
+}
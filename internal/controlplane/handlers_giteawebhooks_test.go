@@ -0,0 +1,319 @@
+//
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	mockdb "github.com/stacklok/minder/database/mock"
+	"github.com/stacklok/minder/internal/crypto"
+	"github.com/stacklok/minder/internal/db"
+	"github.com/stacklok/minder/internal/engine"
+	"github.com/stacklok/minder/internal/providers/gitea"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	giteaDefinition  = `{"gitea": {"endpoint": "https://gitea.example.com/api/v1/"}}`
+	giteaPushPayload = `{
+  "ref": "refs/heads/main",
+  "after": "da1560886d4f094c3e6c9ef40349f7d38b5d27d7",
+  "repository": {"id": 15, "full_name": "acme/api", "private": false}
+}`
+	giteaPrSyncPayload = `{
+  "action": "synchronized",
+  "number": 7,
+  "pull_request": {
+    "html_url": "https://gitea.example.com/acme/api/pulls/7",
+    "user": {"id": 51},
+    "head": {"sha": "da1560886d4f094c3e6c9ef40349f7d38b5d27d7"}
+  },
+  "repository": {"id": 15, "private": false}
+}`
+	giteaIssuePayload = `{"action": "opened", "repository": {"id": 15}}`
+)
+
+func signGiteaPayload(payload, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestHandleGiteaWebHook(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	repositoryID := uuid.New()
+	prID := uuid.New()
+
+	hookSecret, err := crypto.NewEngine("test").EncryptString("hook-secret")
+	require.NoError(t, err)
+
+	dbRepo := db.Repository{
+		ID:            repositoryID,
+		ProjectID:     projectID,
+		Provider:      gitea.Gitea,
+		RepoOwner:     "acme",
+		RepoName:      "api",
+		RepoID:        15,
+		WebhookSecret: hookSecret,
+	}
+	// repositories registered before hooks got their own secret
+	legacyRepo := dbRepo
+	legacyRepo.WebhookSecret = ""
+
+	// a repository with the same ID on another Gitea instance
+	otherSecret, err := crypto.NewEngine("test").EncryptString("other-secret")
+	require.NoError(t, err)
+	otherRepo := db.Repository{
+		ID:            uuid.New(),
+		ProjectID:     uuid.New(),
+		Provider:      "gitea-internal",
+		RepoOwner:     "internal",
+		RepoName:      "tools",
+		RepoID:        15,
+		WebhookSecret: otherSecret,
+	}
+
+	expectRepoWithSecret := func(store *mockdb.MockStore, repo db.Repository, definition string) {
+		store.EXPECT().ListRepositoriesByRepoID(gomock.Any(), int32(15)).Return([]db.Repository{repo}, nil)
+		store.EXPECT().GetProviderByName(gomock.Any(), db.GetProviderByNameParams{
+			Name:      gitea.Gitea,
+			ProjectID: projectID,
+		}).Return(db.Provider{
+			Name:       gitea.Gitea,
+			ProjectID:  projectID,
+			Implements: gitea.Implements,
+			Definition: []byte(definition),
+		}, nil)
+	}
+	expectRepo := func(store *mockdb.MockStore, definition string) {
+		expectRepoWithSecret(store, dbRepo, definition)
+	}
+
+	tests := []struct {
+		name       string
+		secret     string
+		event      string
+		payload    string
+		setup      func(store *mockdb.MockStore)
+		wantStatus int
+		check      func(t *testing.T, msg *message.Message)
+	}{
+		{
+			name:    "invalid signature",
+			secret:  "wrong",
+			event:   "push",
+			payload: giteaPushPayload,
+			setup: func(store *mockdb.MockStore) {
+				expectRepo(store, giteaDefinition)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "server-wide secret for a hook with its own secret",
+			secret:  "secret",
+			event:   "push",
+			payload: giteaPushPayload,
+			setup: func(store *mockdb.MockStore) {
+				expectRepo(store, giteaDefinition)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "server-wide secret for a legacy hook",
+			secret:  "secret",
+			event:   "push",
+			payload: giteaPushPayload,
+			setup: func(store *mockdb.MockStore) {
+				expectRepoWithSecret(store, legacyRepo, giteaDefinition)
+			},
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, msg *message.Message) {
+				t.Helper()
+				assert.Equal(t, repositoryID.String(), msg.Metadata[engine.RepositoryIDEventKey])
+			},
+		},
+		{
+			name:    "repository with the same id on another gitea instance",
+			secret:  "hook-secret",
+			event:   "push",
+			payload: giteaPushPayload,
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().ListRepositoriesByRepoID(gomock.Any(), int32(15)).
+					Return([]db.Repository{otherRepo, dbRepo}, nil)
+				store.EXPECT().GetProviderByName(gomock.Any(), db.GetProviderByNameParams{
+					Name:      otherRepo.Provider,
+					ProjectID: otherRepo.ProjectID,
+				}).Return(db.Provider{
+					Name:       otherRepo.Provider,
+					Implements: gitea.Implements,
+					Definition: []byte(`{"gitea": {"endpoint": "https://gitea.internal/api/v1/"}}`),
+				}, nil)
+				store.EXPECT().GetProviderByName(gomock.Any(), db.GetProviderByNameParams{
+					Name:      gitea.Gitea,
+					ProjectID: projectID,
+				}).Return(db.Provider{
+					Name:       gitea.Gitea,
+					ProjectID:  projectID,
+					Implements: gitea.Implements,
+					Definition: []byte(giteaDefinition),
+				}, nil)
+			},
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, msg *message.Message) {
+				t.Helper()
+				assert.Equal(t, projectID.String(), msg.Metadata[engine.ProjectIDEventKey])
+				assert.Equal(t, repositoryID.String(), msg.Metadata[engine.RepositoryIDEventKey])
+			},
+		},
+		{
+			name:    "push to registered repository",
+			secret:  "hook-secret",
+			event:   "push",
+			payload: giteaPushPayload,
+			setup: func(store *mockdb.MockStore) {
+				expectRepo(store, giteaDefinition)
+			},
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, msg *message.Message) {
+				t.Helper()
+				assert.Equal(t, "delivery", msg.Metadata["id"])
+				assert.Equal(t, "push", msg.Metadata["type"])
+				assert.Equal(t, "gitea", msg.Metadata["provider"])
+				assert.Equal(t, projectID.String(), msg.Metadata[engine.ProjectIDEventKey])
+				assert.Equal(t, repositoryID.String(), msg.Metadata[engine.RepositoryIDEventKey])
+
+				assert.Equal(t, "repository", msg.Metadata[engine.EntityTypeEventKey])
+				repo := &pb.Repository{}
+				require.NoError(t, protojson.Unmarshal(msg.Payload, repo))
+				assert.Equal(t, "acme", repo.Owner)
+				assert.Equal(t, "api", repo.Name)
+			},
+		},
+		{
+			name:    "repository registered through another provider",
+			secret:  "hook-secret",
+			event:   "push",
+			payload: giteaPushPayload,
+			setup: func(store *mockdb.MockStore) {
+				expectRepo(store, `{"github": {}}`)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:    "pull request synchronized",
+			secret:  "hook-secret",
+			event:   "pull_request",
+			payload: giteaPrSyncPayload,
+			setup: func(store *mockdb.MockStore) {
+				expectRepo(store, giteaDefinition)
+				store.EXPECT().UpsertPullRequest(gomock.Any(), db.UpsertPullRequestParams{
+					RepositoryID: repositoryID,
+					PrNumber:     7,
+				}).Return(db.PullRequest{ID: prID}, nil)
+			},
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, msg *message.Message) {
+				t.Helper()
+				assert.Equal(t, prID.String(), msg.Metadata[engine.PullRequestIDEventKey])
+
+				assert.Equal(t, "pull_request", msg.Metadata[engine.EntityTypeEventKey])
+				pr := &pb.PullRequest{}
+				require.NoError(t, protojson.Unmarshal(msg.Payload, pr))
+				assert.Equal(t, int32(7), pr.Number)
+				assert.Equal(t, int64(51), pr.AuthorId)
+				assert.Equal(t, "synchronize", pr.Action)
+				assert.Equal(t, "da1560886d4f094c3e6c9ef40349f7d38b5d27d7", pr.CommitSha)
+				assert.Equal(t, "acme", pr.RepoOwner)
+				assert.Equal(t, "api", pr.RepoName)
+			},
+		},
+		{
+			name:       "unhandled event",
+			secret:     "hook-secret",
+			event:      "issues",
+			payload:    giteaIssuePayload,
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			if tt.setup != nil {
+				tt.setup(mockStore)
+			}
+
+			srv := newDefaultServer(t, mockStore)
+			srv.cfg.WebhookConfig.WebhookSecret = "secret"
+			defer srv.evt.Close()
+
+			pq := newPassthroughQueue()
+			queued := pq.getQueue()
+			srv.evt.Register(engine.InternalEntityEventTopic, pq.pass)
+
+			go func() {
+				err := srv.evt.Run(context.Background())
+				require.NoError(t, err, "failed to run eventer")
+			}()
+			<-srv.evt.Running()
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/gitea/"+uuid.NewString(),
+				strings.NewReader(tt.payload))
+			req.Header.Set("X-Gitea-Signature", signGiteaPayload(tt.payload, tt.secret))
+			req.Header.Set("X-Gitea-Event", tt.event)
+			req.Header.Set("X-Gitea-Delivery", "delivery")
+			rec := httptest.NewRecorder()
+
+			srv.HandleGiteaWebHook()(rec, req)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			if tt.check == nil {
+				assert.Len(t, queued, 0)
+				return
+			}
+
+			select {
+			case msg := <-queued:
+				tt.check(t, msg)
+			case <-time.After(5 * time.Second):
+				t.Fatal("timed out waiting for the event to be published")
+			}
+		})
+	}
+}
@@ -0,0 +1,437 @@
+//
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v53/github"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/stacklok/minder/internal/db"
+	"github.com/stacklok/minder/internal/providers"
+	ghprov "github.com/stacklok/minder/internal/providers/github"
+	"github.com/stacklok/minder/internal/reconcilers"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+// HandleGitHubAppWebHook handles the webhook of the GitHub App. Besides the
+// events of the repositories the app is installed on, which are handled
+// like the repository webhooks, it receives the installation events used
+// to enroll installations in projects.
+// See https://docs.github.com/en/apps/creating-github-apps/registering-a-github-app/using-webhooks-with-github-apps
+func (s *Server) HandleGitHubAppWebHook() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		wes := webhookEventState{
+			typ:      "unknown",
+			accepted: false,
+			error:    true,
+		}
+		defer func() {
+			s.mt.webhookEventTypeCount(r.Context(), wes)
+		}()
+
+		rawWBPayload, err := github.ValidatePayload(r, []byte(s.cfg.GitHubApp.WebhookSecret))
+		if err != nil {
+			log.Printf("Error validating GitHub App webhook payload: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		typ := github.WebHookType(r)
+		if typ != "installation" && typ != "installation_repositories" {
+			s.processGitHubWebHook(w, r, rawWBPayload, &wes)
+			return
+		}
+
+		wes.typ = typ
+		event, err := github.ParseWebHook(typ, rawWBPayload)
+		if err != nil {
+			log.Printf("Error parsing %s event: %v", typ, err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if err := s.handleInstallationEvent(r.Context(), event); err != nil {
+			wes = handleParseError(typ, err)
+			if wes.error {
+				w.WriteHeader(http.StatusInternalServerError)
+			} else {
+				w.WriteHeader(http.StatusOK)
+			}
+			return
+		}
+
+		wes.accepted = true
+		wes.error = false
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func (s *Server) handleInstallationEvent(ctx context.Context, event any) error {
+	switch evt := event.(type) {
+	case *github.InstallationEvent:
+		inst := evt.GetInstallation()
+		switch evt.GetAction() {
+		case "created":
+			projects, err := s.enrollInstallation(ctx, inst)
+			if err != nil {
+				return err
+			}
+			return s.registerInstallationRepositories(ctx, projects, evt.Repositories)
+		case "unsuspend", "new_permissions_accepted":
+			_, err := s.enrollInstallation(ctx, inst)
+			return err
+		case "deleted", "suspend":
+			// without an installation, the providers fall back to the OAuth tokens
+			if s.ghApp != nil {
+				s.ghApp.ForgetInstallation(inst.GetID())
+			}
+			return s.store.DeleteGitHubAppInstallationByID(ctx, inst.GetID())
+		}
+		return newErrNotHandled("installation action %s not handled", evt.GetAction())
+	case *github.InstallationRepositoriesEvent:
+		installs, err := s.store.ListGitHubAppInstallationsByID(ctx, evt.GetInstallation().GetID())
+		if err != nil {
+			return fmt.Errorf("error listing installations: %w", err)
+		}
+		if len(installs) == 0 {
+			return newErrNotHandled("installation %d is not enrolled", evt.GetInstallation().GetID())
+		}
+
+		switch evt.GetAction() {
+		case "added":
+			return s.registerInstallationRepositories(ctx, installs, evt.RepositoriesAdded)
+		case "removed":
+			return s.removeInstallationRepositories(ctx, installs, evt.RepositoriesRemoved)
+		}
+		return newErrNotHandled("installation_repositories action %s not handled", evt.GetAction())
+	}
+
+	return newErrNotHandled("event %T not handled", event)
+}
+
+// enrollInstallation records the installation in the projects whose GitHub
+// provider was enrolled by an administrator of the account it was installed
+// on, so their GitHub provider authenticates as the installation from now on.
+// Who administers the account is checked with the token of each enrolling
+// user, as the login of the account doesn't prove who owns it.
+func (s *Server) enrollInstallation(
+	ctx context.Context,
+	inst *github.Installation,
+) ([]db.ProviderGithubAppInstallation, error) {
+	tokens, err := s.store.GetAccessTokenByProvider(ctx, ghprov.Github)
+	if err != nil {
+		return nil, fmt.Errorf("error listing access tokens: %w", err)
+	}
+
+	account := inst.GetAccount()
+	var installs []db.ProviderGithubAppInstallation
+	for _, tok := range tokens {
+		ok, err := s.administersGitHubAccount(ctx, tok, account)
+		if err != nil {
+			// keep on checking the other projects
+			log.Printf("error checking whether project %s administers %s: %v", tok.ProjectID, account.GetLogin(), err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		install, err := s.store.UpsertGitHubAppInstallation(ctx, db.UpsertGitHubAppInstallationParams{
+			AppInstallationID: inst.GetID(),
+			Provider:          ghprov.Github,
+			ProjectID:         tok.ProjectID,
+			AccountLogin:      account.GetLogin(),
+			IsOrg:             account.GetType() == "Organization",
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error enrolling installation in project %s: %w", tok.ProjectID, err)
+		}
+		installs = append(installs, install)
+	}
+
+	if len(installs) == 0 {
+		return nil, newErrNotHandled("no project enrolled by an administrator of %s", account.GetLogin())
+	}
+	return installs, nil
+}
+
+// administersGitHubAccount checks, with the access token of the user who
+// enrolled the GitHub provider of a project, whether that user administers
+// the given account.
+func (s *Server) administersGitHubAccount(
+	ctx context.Context,
+	tok db.ProviderAccessToken,
+	account *github.User,
+) (bool, error) {
+	prov, err := s.store.GetProviderByName(ctx, db.GetProviderByNameParams{
+		Name:      tok.Provider,
+		ProjectID: tok.ProjectID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("error getting provider: %w", err)
+	}
+
+	decryptedToken, err := s.cryptoEngine.DecryptOAuthToken(tok.EncryptedToken)
+	if err != nil {
+		return false, fmt.Errorf("error decrypting access token: %w", err)
+	}
+
+	cli, err := s.githubUserClient(ctx, &prov, decryptedToken.AccessToken)
+	if err != nil {
+		return false, err
+	}
+	return cli.AdministersAccount(ctx, account)
+}
+
+// githubUserClient returns a client of a GitHub provider authenticating as
+// the user the token was issued to, rather than as an installation of the app.
+func (s *Server) githubUserClient(
+	ctx context.Context,
+	prov *db.Provider,
+	token string,
+) (*ghprov.RestClient, error) {
+	cfg, err := ghprov.ParseV1Config(prov.Definition)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing github config: %w", err)
+	}
+
+	cli, err := ghprov.NewRestClient(ctx, cfg, s.provMt, token, "")
+	if err != nil {
+		return nil, fmt.Errorf("error creating github client: %w", err)
+	}
+	return cli, nil
+}
+
+// registerInstallationRepositories registers the repositories the app was
+// granted access to. Repositories can only belong to one project, so they
+// are only registered when the installation maps to a single project.
+func (s *Server) registerInstallationRepositories(
+	ctx context.Context,
+	installs []db.ProviderGithubAppInstallation,
+	repos []*github.Repository,
+) error {
+	if len(repos) == 0 {
+		return nil
+	}
+	if len(installs) != 1 {
+		zerolog.Ctx(ctx).Info().Int("projects", len(installs)).
+			Msg("installation enrolled in several projects, not registering repositories")
+		return nil
+	}
+
+	install := installs[0]
+	cli, err := s.installationClient(ctx, install)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range repos {
+		if err := s.registerInstallationRepository(ctx, cli, install, r); err != nil {
+			// keep on registering the other repositories
+			log.Printf("error registering repository %s: %v", r.GetFullName(), err)
+		}
+	}
+	return nil
+}
+
+func (s *Server) installationClient(
+	ctx context.Context,
+	install db.ProviderGithubAppInstallation,
+) (provifv1.GitHub, error) {
+	prov, err := s.store.GetProviderByName(ctx, db.GetProviderByNameParams{
+		Name:      install.Provider,
+		ProjectID: install.ProjectID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting provider: %w", err)
+	}
+
+	pbOpts := []providers.ProviderBuilderOption{
+		providers.WithProviderMetrics(s.provMt),
+		providers.WithGitHubApp(s.ghApp),
+	}
+	pbuild, err := providers.GetProviderBuilder(ctx, prov, install.ProjectID, s.store, s.cryptoEngine, pbOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error building client: %w", err)
+	}
+
+	return pbuild.GetGitHub(ctx)
+}
+
+func (s *Server) registerInstallationRepository(
+	ctx context.Context,
+	cli provifv1.GitHub,
+	install db.ProviderGithubAppInstallation,
+	r *github.Repository,
+) error {
+	_, err := s.store.GetRepositoryByRepoID(ctx, db.GetRepositoryByRepoIDParams{
+		RepoID:       int32(r.GetID()),
+		ProviderType: db.ProviderTypeGithub,
+	})
+	if err == nil {
+		// already registered, possibly in another project
+		return nil
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("error getting repository: %w", err)
+	}
+
+	// the installation events only carry a summary of the repository
+	owner, name := install.AccountLogin, r.GetName()
+	repo, err := cli.GetRepository(ctx, owner, name)
+	if err != nil {
+		return fmt.Errorf("error getting repository: %w", err)
+	}
+
+	if repo.GetPrivate() && !projectAllowsPrivateRepos(ctx, s.store, install.ProjectID) {
+		return nil
+	}
+
+	_, err = s.store.CreateRepository(ctx, db.CreateRepositoryParams{
+		Provider:  install.Provider,
+		ProjectID: install.ProjectID,
+		RepoOwner: owner,
+		RepoName:  name,
+		RepoID:    int32(repo.GetID()),
+		IsPrivate: repo.GetPrivate(),
+		IsFork:    repo.GetFork(),
+		// there's no webhook of our own, the app's webhook delivers the
+		// events of the repository
+		WebhookID: sql.NullInt32{Int32: 0, Valid: true},
+		CloneUrl:  repo.GetCloneURL(),
+	})
+	if err != nil {
+		return fmt.Errorf("error creating repository: %w", err)
+	}
+
+	log.Printf("publishing register event for repository: %s/%s", owner, name)
+
+	msg, err := reconcilers.NewRepoReconcilerMessage(install.Provider, int32(repo.GetID()), install.ProjectID)
+	if err != nil {
+		return fmt.Errorf("error creating reconciler event: %w", err)
+	}
+
+	if err := s.evt.Publish(reconcilers.InternalReconcilerEventTopic, msg); err != nil {
+		log.Printf("error publishing reconciler event: %v", err)
+	}
+	return nil
+}
+
+// removeInstallationRepositories removes the repositories the app lost access
+// to from the projects the installation is enrolled in.
+func (s *Server) removeInstallationRepositories(
+	ctx context.Context,
+	installs []db.ProviderGithubAppInstallation,
+	repos []*github.Repository,
+) error {
+	projects := make(map[uuid.UUID]bool, len(installs))
+	for _, install := range installs {
+		projects[install.ProjectID] = true
+	}
+
+	for _, r := range repos {
+		dbRepo, err := s.store.GetRepositoryByRepoID(ctx, db.GetRepositoryByRepoIDParams{
+			RepoID:       int32(r.GetID()),
+			ProviderType: db.ProviderTypeGithub,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("error getting repository: %w", err)
+		}
+
+		if dbRepo.Provider != ghprov.Github || !projects[dbRepo.ProjectID] {
+			continue
+		}
+
+		if err := s.store.DeleteRepository(ctx, dbRepo.ID); err != nil {
+			return fmt.Errorf("error deleting repository %s: %w", r.GetFullName(), err)
+		}
+	}
+
+	return nil
+}
+
+// discoverGitHubAppInstallation enrolls the installation of the GitHub App
+// on the account a user just enrolled, if there is one and the user
+// administers that account. Installation events only reach projects that
+// were enrolled beforehand, so this covers apps that were installed first.
+func (s *Server) discoverGitHubAppInstallation(
+	ctx context.Context,
+	prov *db.Provider,
+	projectID uuid.UUID,
+	owner sql.NullString,
+	token string,
+) {
+	if s.ghApp == nil || prov.Name != ghprov.Github {
+		return
+	}
+
+	logger := zerolog.Ctx(ctx).With().Str("project_id", projectID.String()).Logger()
+
+	cli, err := s.githubUserClient(ctx, prov, token)
+	if err != nil {
+		logger.Error().Err(err).Msg("error creating github client")
+		return
+	}
+
+	login := owner.String
+	if !owner.Valid {
+		user, err := cli.GetAuthenticatedUser(ctx)
+		if err != nil {
+			logger.Error().Err(err).Msg("error getting authenticated user")
+			return
+		}
+		login = user.GetLogin()
+	}
+
+	inst, err := s.ghApp.FindInstallation(ctx, login, owner.Valid)
+	if errors.Is(err, ghprov.ErrNotFound) {
+		return
+	} else if err != nil {
+		logger.Error().Err(err).Msg("error finding GitHub App installation")
+		return
+	}
+
+	account := inst.GetAccount()
+	ok, err := cli.AdministersAccount(ctx, account)
+	if err != nil {
+		logger.Error().Err(err).Msg("error checking access to the GitHub App installation")
+		return
+	} else if !ok {
+		logger.Info().Str("account", account.GetLogin()).
+			Msg("user doesn't administer the account of the GitHub App installation, not enrolling it")
+		return
+	}
+
+	_, err = s.store.UpsertGitHubAppInstallation(ctx, db.UpsertGitHubAppInstallationParams{
+		AppInstallationID: inst.GetID(),
+		Provider:          prov.Name,
+		ProjectID:         projectID,
+		AccountLogin:      account.GetLogin(),
+		IsOrg:             account.GetType() == "Organization",
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("error enrolling GitHub App installation")
+	}
+}
@@ -0,0 +1,334 @@
+//
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	mockdb "github.com/stacklok/minder/database/mock"
+	"github.com/stacklok/minder/internal/crypto"
+	"github.com/stacklok/minder/internal/db"
+	ghprov "github.com/stacklok/minder/internal/providers/github"
+	"github.com/stacklok/minder/internal/reconcilers"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+const (
+	installationCreatedPayload = `{
+  "action": "created",
+  "installation": {"id": 7, "account": {"id": 10, "login": "acme", "type": "Organization"}},
+  "repositories": [{"id": 1234, "name": "api", "full_name": "acme/api", "private": false}]
+}`
+	installationDeletedPayload = `{
+  "action": "deleted",
+  "installation": {"id": 7, "account": {"id": 10, "login": "acme", "type": "Organization"}}
+}`
+	installationReposRemovedPayload = `{
+  "action": "removed",
+  "installation": {"id": 7, "account": {"id": 10, "login": "acme", "type": "Organization"}},
+  "repositories_removed": [{"id": 1234, "name": "api", "full_name": "acme/api"}]
+}`
+)
+
+// newFakeGitHubAppAPI serves the bits of the GitHub API the installation
+// handling talks to
+func newFakeGitHubAppAPI(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/app/installations/7/access_tokens", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		assert.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+			"token":      "ghs_installation",
+			"expires_at": time.Now().Add(time.Hour).Format(time.RFC3339),
+		}))
+	})
+	mux.HandleFunc("/user/memberships/orgs/acme", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer gho_admin" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"state": "active", "role": "admin", "organization": {"id": 10, "login": "acme"}}`))
+	})
+	mux.HandleFunc("/repos/acme/api", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer ghs_installation", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{"id": 1234, "name": "api", "private": false, "fork": false,
+			"clone_url": "https://github.com/acme/api.git"}`))
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newTestGitHubApp(t *testing.T, endpoint string) *ghprov.App {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	app, err := ghprov.NewApp(42, keyPEM, ghprov.WithAppEndpoint(endpoint))
+	require.NoError(t, err)
+	return app
+}
+
+func TestHandleGitHubAppWebHook(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	repositoryID := uuid.New()
+
+	// the access tokens of the users who enrolled the GitHub provider
+	accessToken := func(projectID uuid.UUID, token string) db.ProviderAccessToken {
+		t.Helper()
+		data, err := json.Marshal(oauth2.Token{AccessToken: token})
+		require.NoError(t, err)
+		encToken, err := crypto.NewEngine("test").EncryptOAuthToken(data)
+		require.NoError(t, err)
+		return db.ProviderAccessToken{
+			Provider:       ghprov.Github,
+			ProjectID:      projectID,
+			EncryptedToken: base64.StdEncoding.EncodeToString(encToken),
+		}
+	}
+	githubProvider := func(projectID uuid.UUID, endpoint string) db.Provider {
+		return db.Provider{
+			Name:       ghprov.Github,
+			ProjectID:  projectID,
+			Version:    provifv1.V1,
+			Implements: ghprov.Implements,
+			Definition: json.RawMessage(`{"github": {"endpoint": "` + endpoint + `"}}`),
+		}
+	}
+	otherProjectID := uuid.New()
+
+	install := db.ProviderGithubAppInstallation{
+		AppInstallationID: 7,
+		Provider:          ghprov.Github,
+		ProjectID:         projectID,
+		AccountLogin:      "acme",
+		IsOrg:             true,
+	}
+
+	tests := []struct {
+		name       string
+		secret     string
+		event      string
+		payload    string
+		setup      func(store *mockdb.MockStore, endpoint string)
+		wantStatus int
+		check      func(t *testing.T, msg *message.Message)
+	}{
+		{
+			name:       "invalid signature",
+			secret:     "wrong",
+			event:      "installation",
+			payload:    installationDeletedPayload,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "installation created",
+			secret:  "secret",
+			event:   "installation",
+			payload: installationCreatedPayload,
+			setup: func(store *mockdb.MockStore, endpoint string) {
+				// only the user who enrolled projectID administers the organization
+				store.EXPECT().GetAccessTokenByProvider(gomock.Any(), ghprov.Github).
+					Return([]db.ProviderAccessToken{
+						accessToken(otherProjectID, "gho_member"),
+						accessToken(projectID, "gho_admin"),
+					}, nil)
+				store.EXPECT().GetProviderByName(gomock.Any(), db.GetProviderByNameParams{
+					Name:      ghprov.Github,
+					ProjectID: otherProjectID,
+				}).Return(githubProvider(otherProjectID, endpoint), nil)
+				store.EXPECT().UpsertGitHubAppInstallation(gomock.Any(), db.UpsertGitHubAppInstallationParams{
+					AppInstallationID: 7,
+					Provider:          ghprov.Github,
+					ProjectID:         projectID,
+					AccountLogin:      "acme",
+					IsOrg:             true,
+				}).Return(install, nil)
+				store.EXPECT().GetProviderByName(gomock.Any(), db.GetProviderByNameParams{
+					Name:      ghprov.Github,
+					ProjectID: projectID,
+				}).Return(githubProvider(projectID, endpoint), nil).Times(2)
+				store.EXPECT().GetGitHubAppInstallationByProjectAndProvider(gomock.Any(),
+					db.GetGitHubAppInstallationByProjectAndProviderParams{
+						Provider:  ghprov.Github,
+						ProjectID: projectID,
+					}).Return(install, nil)
+				store.EXPECT().GetRepositoryByRepoID(gomock.Any(), db.GetRepositoryByRepoIDParams{
+					RepoID:       1234,
+					ProviderType: db.ProviderTypeGithub,
+				}).Return(db.Repository{}, sql.ErrNoRows)
+				store.EXPECT().CreateRepository(gomock.Any(), db.CreateRepositoryParams{
+					Provider:  ghprov.Github,
+					ProjectID: projectID,
+					RepoOwner: "acme",
+					RepoName:  "api",
+					RepoID:    1234,
+					WebhookID: sql.NullInt32{Int32: 0, Valid: true},
+					CloneUrl:  "https://github.com/acme/api.git",
+				}).Return(db.Repository{ID: repositoryID}, nil)
+			},
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, msg *message.Message) {
+				t.Helper()
+				var evt reconcilers.RepoReconcilerEvent
+				require.NoError(t, json.Unmarshal(msg.Payload, &evt))
+				assert.Equal(t, int32(1234), evt.Repository)
+				assert.Equal(t, projectID, evt.Project)
+			},
+		},
+		{
+			name:    "installation deleted",
+			secret:  "secret",
+			event:   "installation",
+			payload: installationDeletedPayload,
+			setup: func(store *mockdb.MockStore, _ string) {
+				store.EXPECT().DeleteGitHubAppInstallationByID(gomock.Any(), int64(7)).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:    "repositories removed from installation",
+			secret:  "secret",
+			event:   "installation_repositories",
+			payload: installationReposRemovedPayload,
+			setup: func(store *mockdb.MockStore, _ string) {
+				store.EXPECT().ListGitHubAppInstallationsByID(gomock.Any(), int64(7)).
+					Return([]db.ProviderGithubAppInstallation{install}, nil)
+				store.EXPECT().GetRepositoryByRepoID(gomock.Any(), db.GetRepositoryByRepoIDParams{
+					RepoID:       1234,
+					ProviderType: db.ProviderTypeGithub,
+				}).Return(db.Repository{
+					ID:        repositoryID,
+					Provider:  ghprov.Github,
+					ProjectID: projectID,
+				}, nil)
+				store.EXPECT().DeleteRepository(gomock.Any(), repositoryID).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:    "installation of an account no project enrolled",
+			secret:  "secret",
+			event:   "installation",
+			payload: installationCreatedPayload,
+			setup: func(store *mockdb.MockStore, _ string) {
+				store.EXPECT().GetAccessTokenByProvider(gomock.Any(), ghprov.Github).Return(nil, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:    "installation of an account no enrolling user administers",
+			secret:  "secret",
+			event:   "installation",
+			payload: installationCreatedPayload,
+			setup: func(store *mockdb.MockStore, endpoint string) {
+				store.EXPECT().GetAccessTokenByProvider(gomock.Any(), ghprov.Github).
+					Return([]db.ProviderAccessToken{accessToken(otherProjectID, "gho_member")}, nil)
+				store.EXPECT().GetProviderByName(gomock.Any(), db.GetProviderByNameParams{
+					Name:      ghprov.Github,
+					ProjectID: otherProjectID,
+				}).Return(githubProvider(otherProjectID, endpoint), nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fakeAPI := newFakeGitHubAppAPI(t)
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			if tt.setup != nil {
+				tt.setup(mockStore, fakeAPI.URL+"/")
+			}
+
+			srv := newDefaultServer(t, mockStore)
+			srv.cfg.GitHubApp.WebhookSecret = "secret"
+			srv.ghApp = newTestGitHubApp(t, fakeAPI.URL+"/")
+			defer srv.evt.Close()
+
+			pq := newPassthroughQueue()
+			queued := pq.getQueue()
+			srv.evt.Register(reconcilers.InternalReconcilerEventTopic, pq.pass)
+
+			go func() {
+				err := srv.evt.Run(context.Background())
+				require.NoError(t, err, "failed to run eventer")
+			}()
+			<-srv.evt.Running()
+
+			mac := hmac.New(sha256.New, []byte(tt.secret))
+			mac.Write([]byte(tt.payload))
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/github-app",
+				strings.NewReader(tt.payload))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("X-GitHub-Event", tt.event)
+			req.Header.Set("X-GitHub-Delivery", "delivery")
+			req.Header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+			rec := httptest.NewRecorder()
+
+			srv.HandleGitHubAppWebHook()(rec, req)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			if tt.check == nil {
+				assert.Len(t, queued, 0)
+				return
+			}
+
+			select {
+			case msg := <-queued:
+				tt.check(t, msg)
+			case <-time.After(5 * time.Second):
+				t.Fatal("timed out waiting for the event to be published")
+			}
+		})
+	}
+}
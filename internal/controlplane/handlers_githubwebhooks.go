@@ -24,7 +24,9 @@ package controlplane
 
 import (
 	"context"
+	"crypto/rand"
 	"database/sql"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -34,7 +36,6 @@ import (
 	"sort"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/google/go-github/v53/github"
@@ -50,7 +51,9 @@ import (
 	"github.com/stacklok/minder/internal/engine"
 	"github.com/stacklok/minder/internal/events"
 	"github.com/stacklok/minder/internal/providers"
+	"github.com/stacklok/minder/internal/providers/gitea"
 	githubprovider "github.com/stacklok/minder/internal/providers/github"
+	"github.com/stacklok/minder/internal/providers/gitlab"
 	"github.com/stacklok/minder/internal/util"
 	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
 	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
@@ -59,6 +62,10 @@ import (
 // CONTAINER_TYPE is the type for container artifacts
 var CONTAINER_TYPE = "container"
 
+// githubEnterpriseHostHeader is the header GitHub Enterprise Server sends its
+// host in along with webhooks
+const githubEnterpriseHostHeader = "X-GitHub-Enterprise-Host"
+
 type tagIsASignatureError struct {
 	message      string
 	signatureTag string
@@ -81,6 +88,10 @@ var errArtifactNotFound = errors.New("artifact not found")
 // errRepoIsPrivate is returned when a repository is private
 var errRepoIsPrivate = errors.New("repository is private")
 
+// errInvalidWebhookSecret is returned when a webhook delivery doesn't carry
+// the secret of the hook registered for the repository
+var errInvalidWebhookSecret = errors.New("invalid webhook secret")
+
 // errNotHandled is returned when a webhook event is not handled
 var errNotHandled = errors.New("webhook event not handled")
 
@@ -160,46 +171,83 @@ func (s *Server) HandleGitHubWebHook() http.HandlerFunc {
 			return
 		}
 
-		wes.typ = github.WebHookType(r)
-		if wes.typ == "ping" {
-			log.Printf("ping received")
-			wes.error = false
-			return
-		}
+		s.processGitHubWebHook(w, r, rawWBPayload, &wes)
+	}
+}
 
-		// TODO: extract sender and event time from payload portably
-		m := message.NewMessage(uuid.New().String(), nil)
-		m.Metadata.Set(events.ProviderDeliveryIdKey, github.DeliveryID(r))
-		m.Metadata.Set(events.ProviderTypeKey, string(db.ProviderTypeGithub))
-		m.Metadata.Set(events.ProviderSourceKey, "https://api.github.com/") // TODO: handle other sources
-		m.Metadata.Set(events.GithubWebhookEventTypeKey, wes.typ)
-		// m.Metadata.Set("subject", ghEvent.GetRepo().GetFullName())
-		// m.Metadata.Set("time", ghEvent.GetCreatedAt().String())
-
-		log.Printf("publishing of type: %s", m.Metadata["type"])
-
-		if err := s.parseGithubEventForProcessing(rawWBPayload, m); err != nil {
-			wes = handleParseError(wes.typ, err)
-			if wes.error {
-				w.WriteHeader(http.StatusInternalServerError)
-			} else {
-				w.WriteHeader(http.StatusOK)
-			}
-			return
-		}
+// processGitHubWebHook publishes the entity event of a validated GitHub
+// webhook payload. It is shared by the repository webhooks and the GitHub
+// App webhook.
+func (s *Server) processGitHubWebHook(
+	w http.ResponseWriter,
+	r *http.Request,
+	rawWBPayload []byte,
+	wes *webhookEventState,
+) {
+	wes.typ = github.WebHookType(r)
+	if wes.typ == "ping" {
+		log.Printf("ping received")
+		wes.error = false
+		return
+	}
+
+	// TODO: extract sender and event time from payload portably
+	m := message.NewMessage(uuid.New().String(), nil)
+	m.Metadata.Set(events.ProviderDeliveryIdKey, github.DeliveryID(r))
+	m.Metadata.Set(events.ProviderTypeKey, string(db.ProviderTypeGithub))
+	m.Metadata.Set(events.ProviderSourceKey, githubWebhookSource(r))
+	m.Metadata.Set(events.GithubWebhookEventTypeKey, wes.typ)
+	// m.Metadata.Set("subject", ghEvent.GetRepo().GetFullName())
+	// m.Metadata.Set("time", ghEvent.GetCreatedAt().String())
 
-		wes.accepted = true
+	log.Printf("publishing of type: %s", m.Metadata["type"])
 
-		if err := s.evt.Publish(engine.InternalEntityEventTopic, m); err != nil {
-			wes.error = true
-			log.Printf("Error publishing message: %v", err)
+	if err := s.parseGithubEventForProcessing(rawWBPayload, m); err != nil {
+		*wes = handleParseError(wes.typ, err)
+		if wes.error {
 			w.WriteHeader(http.StatusInternalServerError)
-			return
+		} else {
+			w.WriteHeader(http.StatusOK)
 		}
+		return
+	}
 
-		wes.error = false
-		w.WriteHeader(http.StatusOK)
+	wes.accepted = true
+
+	if err := s.evt.Publish(engine.InternalEntityEventTopic, m); err != nil {
+		wes.error = true
+		log.Printf("Error publishing message: %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	wes.error = false
+	w.WriteHeader(http.StatusOK)
+}
+
+// githubWebhookSource returns the API endpoint of the GitHub instance which
+// sent a webhook. GitHub Enterprise Server sends its host along.
+func githubWebhookSource(r *http.Request) string {
+	if host := r.Header.Get(githubEnterpriseHostHeader); host != "" {
+		return fmt.Sprintf("https://%s/api/v3/", host)
+	}
+	return "https://api.github.com/"
+}
+
+// isFromProviderInstance returns true if the webhook with the given source
+// was sent by the GitHub instance the provider points to
+func isFromProviderInstance(prov *db.Provider, source string) bool {
+	src, err := urlparser.Parse(source)
+	if err != nil {
+		return false
+	}
+
+	cfg, err := githubprovider.ParseV1Config(prov.Definition)
+	if err != nil {
+		// providers without a GitHub configuration talk to github.com
+		return src.Host == githubprovider.APIHost(nil)
 	}
+	return src.Host == githubprovider.APIHost(cfg)
 }
 
 func handleParseError(typ string, parseErr error) webhookEventState {
@@ -227,26 +275,85 @@ func handleParseError(typ string, parseErr error) webhookEventState {
 }
 
 // registerWebhookForRepository registers a set repository and sets up the webhook for each of them
-// and returns the registration result for each repository.
+// and returns the registration result for each repository. It also returns the encrypted secret of
+// the webhook, for the providers whose webhooks have their own secret.
 // If an error occurs, the registration is aborted and the error is returned.
-// https://docs.github.com/en/rest/reference/repos#create-a-repository-webhook
 func (s *Server) registerWebhookForRepository(
 	ctx context.Context,
 	pbuild *providers.ProviderBuilder,
 	projectID uuid.UUID,
 	repo *pb.UpstreamRepositoryRef,
 	ghEvents []string,
-) (*pb.RegisterRepoResult, error) {
+) (*pb.RegisterRepoResult, string, error) {
+
+	cli, err := pbuild.GetClient(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("error creating provider client: %w", err)
+	}
+
+	switch cli := cli.(type) {
+	case *gitlab.Client:
+		secret, encSecret, err := s.newWebhookSecret()
+		if err != nil {
+			return nil, "", err
+		}
+		res, err := s.registerGitLabWebhookForRepository(ctx, cli, projectID, repo, secret)
+		return res, encSecret, err
+	case *gitea.Client:
+		secret, encSecret, err := s.newWebhookSecret()
+		if err != nil {
+			return nil, "", err
+		}
+		res, err := s.registerGiteaWebhookForRepository(ctx, cli, projectID, repo, secret)
+		return res, encSecret, err
+	case *githubprovider.RestClient:
+		res, err := s.registerGitHubWebhookForRepository(ctx, pbuild, cli, projectID, repo, ghEvents)
+		return res, "", err
+	}
+	return nil, "", fmt.Errorf("provider %s is not supported for github webhook", pbuild.GetName())
+}
+
+// newWebhookSecret generates the secret of a webhook, it returns the secret
+// and its encrypted form, to be stored with the repository
+func (s *Server) newWebhookSecret() (string, string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", fmt.Errorf("error generating webhook secret: %w", err)
+	}
+	secret := hex.EncodeToString(raw)
 
-	if !pbuild.Implements(db.ProviderTypeGithub) {
-		return nil, fmt.Errorf("provider %s is not supported for github webhook", pbuild.GetName())
+	encSecret, err := s.cryptoEngine.EncryptString(secret)
+	if err != nil {
+		return "", "", fmt.Errorf("error encrypting webhook secret: %w", err)
 	}
+	return secret, encSecret, nil
+}
 
-	client, err := pbuild.GetGitHub(ctx)
+// repositoryWebhookSecret returns the secret of the webhook registered for a
+// repository. Repositories registered before hooks got their own secret fall
+// back to the server-wide secret.
+func (s *Server) repositoryWebhookSecret(repo *db.Repository) (string, error) {
+	if repo.WebhookSecret == "" {
+		return s.cfg.WebhookConfig.WebhookSecret, nil
+	}
+	secret, err := s.cryptoEngine.DecryptString(repo.WebhookSecret)
 	if err != nil {
-		return nil, fmt.Errorf("error creating github provider: %w", err)
+		return "", fmt.Errorf("error decrypting webhook secret: %w", err)
 	}
+	return secret, nil
+}
 
+// registerGitHubWebhookForRepository registers a webhook on a GitHub repository, signed with
+// the server-wide secret, and returns the registration result.
+// https://docs.github.com/en/rest/reference/repos#create-a-repository-webhook
+func (s *Server) registerGitHubWebhookForRepository(
+	ctx context.Context,
+	pbuild *providers.ProviderBuilder,
+	client *githubprovider.RestClient,
+	projectID uuid.UUID,
+	repo *pb.UpstreamRepositoryRef,
+	ghEvents []string,
+) (*pb.RegisterRepoResult, error) {
 	url := s.cfg.WebhookConfig.ExternalWebhookURL
 	ping := s.cfg.WebhookConfig.ExternalPingURL
 	secret := s.cfg.WebhookConfig.WebhookSecret
@@ -281,6 +388,16 @@ func (s *Server) registerWebhookForRepository(
 		return regResult, nil
 	}
 
+	// the GitHub App's webhook already delivers the events of the
+	// repositories of the installation
+	if pbuild.IsGitHubAppInstallation() {
+		regResult.Status.Success = true
+		regResult.Repository.CloneUrl = repoGet.GetCloneURL()
+		regResult.Repository.IsPrivate = repoGet.GetPrivate()
+		regResult.Repository.IsFork = repoGet.GetFork()
+		return regResult, nil
+	}
+
 	urlUUID := uuid.New().String()
 
 	webhookUrl := fmt.Sprintf("%s/%s", url, urlUUID)
@@ -387,8 +504,15 @@ func (s *Server) parseGithubEventForProcessing(
 		return fmt.Errorf("error getting provider: %w", err)
 	}
 
+	// repository IDs are only unique within a GitHub instance
+	if !isFromProviderInstance(&prov, msg.Metadata.Get(events.ProviderSourceKey)) {
+		return fmt.Errorf("repository %d not found for the sending GitHub instance: %w",
+			dbRepo.RepoID, errRepoNotFound)
+	}
+
 	pbOpts := []providers.ProviderBuilderOption{
 		providers.WithProviderMetrics(s.provMt),
+		providers.WithGitHubApp(s.ghApp),
 	}
 	provBuilder, err := providers.GetProviderBuilder(ctx, prov, dbRepo.ProjectID, s.store, s.cryptoEngine, pbOpts...)
 	if err != nil {
@@ -473,7 +597,7 @@ func (s *Server) parseArtifactPublishedEvent(
 		return fmt.Errorf("error gathering versioned artifact: %w", err)
 	}
 
-	dbArtifact, _, err := upsertVersionedArtifact(ctx, dbrepo, tempArtifact, s.store)
+	dbArtifact, _, err := util.UpsertVersionedArtifact(ctx, dbrepo, tempArtifact, s.store)
 	if err != nil {
 		return fmt.Errorf("error upserting artifact from payload: %w", err)
 	}
@@ -783,116 +907,6 @@ func updateArtifactVersionFromRegistry(
 	return nil
 }
 
-func upsertVersionedArtifact(
-	ctx context.Context,
-	dbrepo db.Repository,
-	artifact *pb.Artifact,
-	store db.Store,
-) (*db.Artifact, *db.ArtifactVersion, error) {
-	// we expect to have only one version at this point, the one from this webhook update
-	newArtifactVersion := artifact.Versions[0]
-	sigInfo, err := protojson.Marshal(newArtifactVersion.SignatureVerification)
-	if err != nil {
-		return nil, nil, fmt.Errorf("error marshalling signature verification: %w", err)
-	}
-
-	workflowInfo, err := protojson.Marshal(newArtifactVersion.GithubWorkflow)
-	if err != nil {
-		return nil, nil, fmt.Errorf("error marshalling workflow info: %w", err)
-	}
-
-	tx, err := store.BeginTransaction()
-	if err != nil {
-		return nil, nil, fmt.Errorf("error starting transaction: %w", err)
-	}
-	defer tx.Rollback()
-
-	qtx := store.GetQuerierWithTransaction(tx)
-
-	dbArtifact, err := qtx.UpsertArtifact(ctx, db.UpsertArtifactParams{
-		RepositoryID:       dbrepo.ID,
-		ArtifactName:       artifact.GetName(),
-		ArtifactType:       artifact.GetType(),
-		ArtifactVisibility: artifact.Visibility,
-	})
-	if err != nil {
-		return nil, nil, fmt.Errorf("error upserting artifact: %w", err)
-	}
-
-	thirtyDaysAgo := time.Now().AddDate(0, 0, -30)
-	err = qtx.DeleteOldArtifactVersions(ctx,
-		db.DeleteOldArtifactVersionsParams{ArtifactID: dbArtifact.ID, CreatedAt: thirtyDaysAgo})
-	if err != nil {
-		// just log error, we will not remove older for now
-		log.Printf("error removing older artifact versions: %v", err)
-	}
-
-	// To avoid conflicts, we search for all existing entries that have the incoming tag in their Tags field.
-	// If found, the existing artifact is updated by removing the incoming tag from its tags column.
-	// Loop through all incoming tags
-	for _, incomingTag := range newArtifactVersion.Tags {
-		// Search artifact versions having the incoming tag (there should be at most 1 or no matches at all)
-		existingArtifactVersions, err := qtx.ListArtifactVersionsByArtifactIDAndTag(ctx,
-			db.ListArtifactVersionsByArtifactIDAndTagParams{ArtifactID: dbArtifact.ID,
-				Tags:  sql.NullString{Valid: true, String: incomingTag},
-				Limit: sql.NullInt32{Valid: false, Int32: 0}})
-		if errors.Is(err, sql.ErrNoRows) {
-			// There are no tagged versions matching the incoming tag, all okay
-			continue
-		} else if err != nil {
-			// Unexpected failure
-			return nil, nil, fmt.Errorf("failed during repository synchronization: %w", err)
-		}
-		// Loop through all artifact versions that matched the incoming tag
-		for _, existing := range existingArtifactVersions {
-			if !existing.Tags.Valid {
-				continue
-			}
-			// Rebuild the list of tags removing anything that would conflict with the incoming tag
-			newTags := slices.DeleteFunc(strings.Split(existing.Tags.String, ","), func(in string) bool { return in == incomingTag })
-			newTagsSQL := sql.NullString{String: strings.Join(newTags, ",")}
-			newTagsSQL.Valid = len(newTagsSQL.String) > 0
-			// Update the versioned artifact row in the store (we shouldn't change anything else except the tags value)
-			_, err := qtx.UpsertArtifactVersion(ctx, db.UpsertArtifactVersionParams{
-				ArtifactID:            existing.ArtifactID,
-				Version:               existing.Version,
-				Tags:                  newTagsSQL,
-				Sha:                   existing.Sha,
-				CreatedAt:             existing.CreatedAt,
-				SignatureVerification: existing.SignatureVerification.RawMessage,
-				GithubWorkflow:        existing.GithubWorkflow.RawMessage,
-			})
-			if err != nil {
-				return nil, nil, fmt.Errorf("error upserting artifact %s with version %d: %w", existing.ArtifactID, existing.Version, err)
-			}
-		}
-	}
-
-	// Proceed storing the new versioned artifact
-	dbVersion, err := qtx.UpsertArtifactVersion(ctx, db.UpsertArtifactVersionParams{
-		ArtifactID: dbArtifact.ID,
-		Version:    newArtifactVersion.VersionId,
-		Tags: sql.NullString{
-			String: strings.Join(newArtifactVersion.Tags, ","),
-			Valid:  true,
-		},
-		Sha:                   newArtifactVersion.Sha,
-		CreatedAt:             newArtifactVersion.CreatedAt.AsTime(),
-		SignatureVerification: sigInfo,
-		GithubWorkflow:        workflowInfo,
-	})
-	if err != nil {
-		return nil, nil, fmt.Errorf("error upserting artifact version: %w", err)
-	}
-
-	err = tx.Commit()
-	if err != nil {
-		return nil, nil, fmt.Errorf("error committing transaction: %w", err)
-	}
-
-	return &dbArtifact, &dbVersion, nil
-}
-
 func getPullRequestInfoFromPayload(
 	ctx context.Context,
 	payload map[string]any,
@@ -1001,7 +1015,10 @@ func getRepoInformationFromPayload(
 	// At this point, we're unsure what the group ID is, so we need to look it up.
 	// It's the same case for the provider. We can gather this information from the
 	// repository ID.
-	dbrepo, err := store.GetRepositoryByRepoID(ctx, id)
+	dbrepo, err := store.GetRepositoryByRepoID(ctx, db.GetRepositoryByRepoIDParams{
+		RepoID:       id,
+		ProviderType: db.ProviderTypeGithub,
+	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			log.Printf("repository %d not found", id)
@@ -387,6 +387,56 @@ func (s *UnitTestSuite) TestHandleWebHookUnexistentRepoPackage() {
 	assert.Len(t, queued, 0)
 }
 
+func TestWebhookFromProviderInstance(t *testing.T) {
+	t.Parallel()
+
+	req, err := http.NewRequest(http.MethodPost, "/api/v1/webhook/github", nil)
+	require.NoError(t, err)
+	dotcom := githubWebhookSource(req)
+	assert.Equal(t, "https://api.github.com/", dotcom)
+
+	req.Header.Set(githubEnterpriseHostHeader, "github.example.com")
+	ghes := githubWebhookSource(req)
+	assert.Equal(t, "https://github.example.com/api/v3/", ghes)
+
+	tests := []struct {
+		name   string
+		def    string
+		source string
+		want   bool
+	}{
+		{name: "github.com provider", def: `{"github": {}}`, source: dotcom, want: true},
+		{name: "github.com provider, enterprise webhook", def: `{"github": {}}`, source: ghes},
+		{name: "provider without definition", source: dotcom, want: true},
+		{
+			name:   "enterprise provider",
+			def:    `{"github": {"endpoint": "https://github.example.com/api/v3/"}}`,
+			source: ghes,
+			want:   true,
+		},
+		{
+			name:   "enterprise provider, github.com webhook",
+			def:    `{"github": {"endpoint": "https://github.example.com/api/v3/"}}`,
+			source: dotcom,
+		},
+		{
+			name:   "other enterprise provider",
+			def:    `{"github": {"endpoint": "https://github.other.com/api/v3/"}}`,
+			source: ghes,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			prov := &db.Provider{Name: "github", Definition: json.RawMessage(tt.def)}
+			assert.Equal(t, tt.want, isFromProviderInstance(prov, tt.source))
+		})
+	}
+}
+
 func TestAll(t *testing.T) {
 	t.Parallel()
 
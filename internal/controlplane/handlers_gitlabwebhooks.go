@@ -0,0 +1,388 @@
+//
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	urlparser "net/url"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"golang.org/x/exp/slices"
+
+	"github.com/stacklok/minder/internal/db"
+	"github.com/stacklok/minder/internal/engine"
+	"github.com/stacklok/minder/internal/events"
+	"github.com/stacklok/minder/internal/providers/gitlab"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	// maxGitLabPayloadSize caps the size of the webhook payloads we read
+	maxGitLabPayloadSize = 25 << 20
+
+	// gitlabVisibilityPublic is the visibility_level GitLab sends for public projects
+	gitlabVisibilityPublic = 20
+)
+
+// gitlabEvent is the subset of GitLab's push and merge request webhook
+// payloads minder needs.
+// See https://docs.gitlab.com/ee/user/project/integrations/webhook_events.html
+type gitlabEvent struct {
+	ObjectKind string `json:"object_kind"`
+	Project    struct {
+		ID              int64 `json:"id"`
+		VisibilityLevel int   `json:"visibility_level"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID        int64  `json:"iid"`
+		AuthorID   int64  `json:"author_id"`
+		URL        string `json:"url"`
+		Action     string `json:"action"`
+		OldRev     string `json:"oldrev"`
+		LastCommit struct {
+			ID string `json:"id"`
+		} `json:"last_commit"`
+	} `json:"object_attributes"`
+}
+
+// HandleGitLabWebHook handles incoming GitLab webhooks
+// See https://docs.gitlab.com/ee/user/project/integrations/webhooks.html
+// for more information.
+func (s *Server) HandleGitLabWebHook() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		wes := webhookEventState{
+			typ:      "unknown",
+			accepted: false,
+			error:    true,
+		}
+		defer func() {
+			s.mt.webhookEventTypeCount(r.Context(), wes)
+		}()
+
+		rawWBPayload, err := io.ReadAll(io.LimitReader(r.Body, maxGitLabPayloadSize))
+		if err != nil {
+			log.Printf("Error reading webhook payload: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		wes.typ = r.Header.Get("X-Gitlab-Event")
+
+		m := message.NewMessage(uuid.New().String(), nil)
+		m.Metadata.Set(events.ProviderDeliveryIdKey, r.Header.Get("X-Gitlab-Event-UUID"))
+		m.Metadata.Set(events.ProviderTypeKey, string(db.ProviderTypeGitlab))
+		m.Metadata.Set(events.ProviderSourceKey, r.Header.Get("X-Gitlab-Instance"))
+		m.Metadata.Set(events.GithubWebhookEventTypeKey, wes.typ)
+
+		// GitLab doesn't sign payloads, it sends back the secret token the
+		// hook was registered with, which is checked once the repository
+		// the event was sent for is known
+		token := r.Header.Get("X-Gitlab-Token")
+		if err := s.parseGitLabEventForProcessing(r.Context(), rawWBPayload, token, m); err != nil {
+			if errors.Is(err, errInvalidWebhookSecret) {
+				log.Printf("Error validating gitlab webhook token")
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			wes = handleParseError(wes.typ, err)
+			if wes.error {
+				w.WriteHeader(http.StatusInternalServerError)
+			} else {
+				w.WriteHeader(http.StatusOK)
+			}
+			return
+		}
+
+		wes.accepted = true
+
+		if err := s.evt.Publish(engine.InternalEntityEventTopic, m); err != nil {
+			wes.error = true
+			log.Printf("Error publishing message: %v", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		wes.error = false
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func (s *Server) parseGitLabEventForProcessing(
+	ctx context.Context,
+	rawWHPayload []byte,
+	token string,
+	msg *message.Message,
+) error {
+	var evt gitlabEvent
+	if err := json.Unmarshal(rawWHPayload, &evt); err != nil {
+		return fmt.Errorf("error unmarshalling payload: %w", err)
+	}
+
+	var ent pb.Entity
+	switch evt.ObjectKind {
+	case "push", "tag_push":
+		ent = pb.Entity_ENTITY_REPOSITORIES
+	case "merge_request":
+		ent = pb.Entity_ENTITY_PULL_REQUESTS
+	default:
+		return newErrNotHandled("event %s not handled", evt.ObjectKind)
+	}
+
+	dbRepo, err := s.getGitLabRepoFromPayload(ctx, &evt, token)
+	if err != nil {
+		return fmt.Errorf("error getting repo information from payload: %w", err)
+	}
+
+	if ent == pb.Entity_ENTITY_PULL_REQUESTS {
+		return parseMergeRequestEvent(ctx, &evt, msg, dbRepo, s.store)
+	}
+
+	return parseRepoEvent(msg, dbRepo, dbRepo.Provider)
+}
+
+// getGitLabRepoFromPayload looks up the repository the event was sent for.
+// Project IDs are only unique within a GitLab instance, so the event belongs
+// to the repository registered through a gitlab provider whose hook was
+// created with the token the event carries.
+func (s *Server) getGitLabRepoFromPayload(
+	ctx context.Context,
+	evt *gitlabEvent,
+	token string,
+) (db.Repository, error) {
+	if evt.Project.ID == 0 {
+		return db.Repository{}, fmt.Errorf("unable to determine repository for event: %w", errRepoNotFound)
+	}
+
+	id := int32(evt.Project.ID)
+	dbrepos, err := s.store.ListRepositoriesByRepoID(ctx, id)
+	if err != nil {
+		return db.Repository{}, fmt.Errorf("error getting repositories: %w", err)
+	}
+
+	found := false
+	for _, dbrepo := range dbrepos {
+		prov, err := s.store.GetProviderByName(ctx, db.GetProviderByNameParams{
+			Name:      dbrepo.Provider,
+			ProjectID: dbrepo.ProjectID,
+		})
+		if err != nil {
+			return db.Repository{}, fmt.Errorf("error getting provider: %w", err)
+		}
+		if !slices.Contains(prov.Implements, db.ProviderTypeGitlab) {
+			continue
+		}
+		found = true
+
+		secret, err := s.repositoryWebhookSecret(&dbrepo)
+		if err != nil {
+			return db.Repository{}, err
+		}
+		if secret == "" || subtle.ConstantTimeCompare([]byte(token), []byte(secret)) != 1 {
+			continue
+		}
+
+		// ignore processing webhooks for private repositories
+		if evt.Project.VisibilityLevel != gitlabVisibilityPublic &&
+			!projectAllowsPrivateRepos(ctx, s.store, dbrepo.ProjectID) {
+			return db.Repository{}, errRepoIsPrivate
+		}
+
+		log.Printf("handling gitlab event for repository %d", id)
+
+		return dbrepo, nil
+	}
+
+	if !found {
+		return db.Repository{}, fmt.Errorf("repository %d not found for a gitlab provider: %w", id, errRepoNotFound)
+	}
+	return db.Repository{}, errInvalidWebhookSecret
+}
+
+// parseMergeRequestEvent maps a GitLab merge request event onto the pull
+// request entity. GitLab's actions are translated to their GitHub counterparts
+// so PRs are tracked the same way regardless of the provider.
+func parseMergeRequestEvent(
+	ctx context.Context,
+	evt *gitlabEvent,
+	msg *message.Message,
+	dbrepo db.Repository,
+	store db.Store,
+) error {
+	attrs := evt.ObjectAttributes
+
+	var action string
+	switch attrs.Action {
+	case "open", "reopen":
+		action = "opened"
+	case "update":
+		// updates without a previous revision only touch the metadata
+		if attrs.OldRev == "" {
+			return newErrNotHandled("merge request update without new commits")
+		}
+		action = "synchronize"
+	case "close", "merge":
+		action = "closed"
+	default:
+		action = attrs.Action
+	}
+
+	prEvalInfo := &pb.PullRequest{
+		Url:       attrs.URL,
+		Number:    int32(attrs.IID),
+		AuthorId:  attrs.AuthorID,
+		Action:    action,
+		CommitSha: attrs.LastCommit.ID,
+		RepoOwner: dbrepo.RepoOwner,
+		RepoName:  dbrepo.RepoName,
+	}
+
+	dbPr, err := reconcilePrWithDb(ctx, store, dbrepo, prEvalInfo)
+	if errors.Is(err, errNotHandled) {
+		return err
+	} else if err != nil {
+		return fmt.Errorf("error reconciling PR with DB: %w", err)
+	}
+
+	eiw := engine.NewEntityInfoWrapper().
+		WithPullRequest(prEvalInfo).
+		WithPullRequestID(dbPr.ID).
+		WithProvider(dbrepo.Provider).
+		WithProjectID(dbrepo.ProjectID).
+		WithRepositoryID(dbrepo.ID)
+
+	return eiw.ToMessage(msg)
+}
+
+// registerGitLabWebhookForRepository registers a webhook on a GitLab project,
+// sending back the given secret with every delivery, and returns the
+// registration result.
+// https://docs.gitlab.com/ee/api/projects.html#add-project-hook
+func (s *Server) registerGitLabWebhookForRepository(
+	ctx context.Context,
+	client *gitlab.Client,
+	projectID uuid.UUID,
+	repo *pb.UpstreamRepositoryRef,
+	secret string,
+) (*pb.RegisterRepoResult, error) {
+	url := s.cfg.WebhookConfig.ExternalGitLabWebhookURL
+	if url == "" {
+		return nil, fmt.Errorf("no external webhook URL configured for gitlab")
+	}
+
+	regResult := &pb.RegisterRepoResult{
+		Repository: &pb.Repository{
+			Name:  repo.Name,
+			Owner: repo.Owner,
+		},
+		Status: &pb.RegisterRepoResult_Status{
+			Success: false,
+		},
+	}
+
+	logger := zerolog.Ctx(ctx).With().
+		Str("repoName", repo.Name).
+		Str("repoOwner", repo.Owner).Logger()
+
+	// let's verify that the project actually exists.
+	project, err := client.GetProject(ctx, repo.Owner, repo.Name)
+	if err != nil {
+		errorStr := err.Error()
+		regResult.Status.Error = &errorStr
+		return regResult, nil
+	}
+	regResult.Repository.RepoId = int32(project.ID)
+
+	// skip if we try to register a private repository
+	if project.IsPrivate() && !projectAllowsPrivateRepos(ctx, s.store, projectID) {
+		errorStr := "repository is private"
+		regResult.Status.Error = &errorStr
+		return regResult, nil
+	}
+
+	urlUUID := uuid.New().String()
+
+	webhookUrl := fmt.Sprintf("%s/%s", url, urlUUID)
+	parsedOriginalURL, err := urlparser.Parse(webhookUrl)
+	if err != nil {
+		errStr := err.Error()
+		regResult.Status.Error = &errStr
+		return regResult, nil
+	}
+
+	// if we have an existing hook for same project, delete it
+	hooks, err := client.ListHooks(ctx, project.ID)
+	if err != nil && !errors.Is(err, gitlab.ErrNotFound) {
+		errorStr := err.Error()
+		regResult.Status.Error = &errorStr
+		logger.Error().Msg("error listing hooks")
+		return regResult, nil
+	}
+	for _, h := range hooks {
+		parsedURL, err := urlparser.Parse(h.URL)
+		if err != nil {
+			errorStr := err.Error()
+			regResult.Status.Error = &errorStr
+			return regResult, nil
+		}
+		if parsedURL.Host == parsedOriginalURL.Host {
+			// it is our hook, we can remove it
+			if err := client.DeleteHook(ctx, project.ID, h.ID); err != nil {
+				errorStr := err.Error()
+				regResult.Status.Error = &errorStr
+				logger.Error().Msg("error deleting hook")
+				return regResult, nil
+			}
+		}
+	}
+
+	// Attempt to register webhook
+	mhook, err := client.CreateHook(ctx, project.ID, &gitlab.Hook{
+		URL:                   webhookUrl,
+		Token:                 secret,
+		PushEvents:            true,
+		TagPushEvents:         true,
+		MergeRequestsEvents:   true,
+		EnableSSLVerification: true,
+	})
+	if err != nil {
+		errorStr := err.Error()
+		regResult.Status.Error = &errorStr
+		logger.Error().Msg("error creating hook")
+		return regResult, nil
+	}
+
+	regResult.Status.Success = true
+	regResult.Repository.HookId = mhook.ID
+	regResult.Repository.HookUrl = mhook.URL
+	regResult.Repository.DeployUrl = webhookUrl
+	regResult.Repository.CloneUrl = project.HTTPURLToRepo
+	regResult.Repository.HookType = "ProjectHook"
+	regResult.Repository.HookName = gitlab.Gitlab
+	regResult.Repository.HookUuid = urlUUID
+	regResult.Repository.IsPrivate = project.IsPrivate()
+	regResult.Repository.IsFork = project.IsFork()
+
+	return regResult, nil
+}
@@ -0,0 +1,314 @@
+//
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	mockdb "github.com/stacklok/minder/database/mock"
+	"github.com/stacklok/minder/internal/crypto"
+	"github.com/stacklok/minder/internal/db"
+	"github.com/stacklok/minder/internal/engine"
+	"github.com/stacklok/minder/internal/providers/gitlab"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	gitlabPushPayload = `{
+  "object_kind": "push",
+  "ref": "refs/heads/main",
+  "checkout_sha": "da1560886d4f094c3e6c9ef40349f7d38b5d27d7",
+  "project": {"id": 15, "path_with_namespace": "acme/api", "visibility_level": 20}
+}`
+	gitlabMrOpenPayload = `{
+  "object_kind": "merge_request",
+  "project": {"id": 15, "visibility_level": 20},
+  "object_attributes": {
+    "iid": 7,
+    "author_id": 51,
+    "url": "https://gitlab.example.com/acme/api/-/merge_requests/7",
+    "action": "open",
+    "last_commit": {"id": "da1560886d4f094c3e6c9ef40349f7d38b5d27d7"}
+  }
+}`
+	gitlabMrRetitledPayload = `{
+  "object_kind": "merge_request",
+  "project": {"id": 15, "visibility_level": 20},
+  "object_attributes": {"iid": 7, "action": "update"}
+}`
+	gitlabIssuePayload = `{"object_kind": "issue", "project": {"id": 15, "visibility_level": 20}}`
+)
+
+func TestHandleGitLabWebHook(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	repositoryID := uuid.New()
+	prID := uuid.New()
+
+	hookSecret, err := crypto.NewEngine("test").EncryptString("hook-secret")
+	require.NoError(t, err)
+
+	dbRepo := db.Repository{
+		ID:            repositoryID,
+		ProjectID:     projectID,
+		Provider:      gitlab.Gitlab,
+		RepoOwner:     "acme",
+		RepoName:      "api",
+		RepoID:        15,
+		WebhookSecret: hookSecret,
+	}
+	// repositories registered before hooks got their own secret
+	legacyRepo := dbRepo
+	legacyRepo.WebhookSecret = ""
+
+	// a project with the same ID on another GitLab instance
+	otherSecret, err := crypto.NewEngine("test").EncryptString("other-secret")
+	require.NoError(t, err)
+	otherRepo := db.Repository{
+		ID:            uuid.New(),
+		ProjectID:     uuid.New(),
+		Provider:      "gitlab-internal",
+		RepoOwner:     "internal",
+		RepoName:      "tools",
+		RepoID:        15,
+		WebhookSecret: otherSecret,
+	}
+
+	expectRepoWithSecret := func(store *mockdb.MockStore, repo db.Repository, implements []db.ProviderType) {
+		store.EXPECT().ListRepositoriesByRepoID(gomock.Any(), int32(15)).Return([]db.Repository{repo}, nil)
+		store.EXPECT().GetProviderByName(gomock.Any(), db.GetProviderByNameParams{
+			Name:      gitlab.Gitlab,
+			ProjectID: projectID,
+		}).Return(db.Provider{Name: gitlab.Gitlab, ProjectID: projectID, Implements: implements}, nil)
+	}
+	expectRepo := func(store *mockdb.MockStore, implements []db.ProviderType) {
+		expectRepoWithSecret(store, dbRepo, implements)
+	}
+
+	tests := []struct {
+		name       string
+		token      string
+		event      string
+		payload    string
+		setup      func(store *mockdb.MockStore)
+		wantStatus int
+		check      func(t *testing.T, msg *message.Message)
+	}{
+		{
+			name:    "invalid token",
+			token:   "wrong",
+			event:   "Push Hook",
+			payload: gitlabPushPayload,
+			setup: func(store *mockdb.MockStore) {
+				expectRepo(store, gitlab.Implements)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "server-wide secret for a hook with its own secret",
+			token:   "secret",
+			event:   "Push Hook",
+			payload: gitlabPushPayload,
+			setup: func(store *mockdb.MockStore) {
+				expectRepo(store, gitlab.Implements)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "server-wide secret for a legacy hook",
+			token:   "secret",
+			event:   "Push Hook",
+			payload: gitlabPushPayload,
+			setup: func(store *mockdb.MockStore) {
+				expectRepoWithSecret(store, legacyRepo, gitlab.Implements)
+			},
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, msg *message.Message) {
+				t.Helper()
+				assert.Equal(t, repositoryID.String(), msg.Metadata[engine.RepositoryIDEventKey])
+			},
+		},
+		{
+			name:    "project with the same id on another gitlab instance",
+			token:   "hook-secret",
+			event:   "Push Hook",
+			payload: gitlabPushPayload,
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().ListRepositoriesByRepoID(gomock.Any(), int32(15)).
+					Return([]db.Repository{otherRepo, dbRepo}, nil)
+				store.EXPECT().GetProviderByName(gomock.Any(), db.GetProviderByNameParams{
+					Name:      otherRepo.Provider,
+					ProjectID: otherRepo.ProjectID,
+				}).Return(db.Provider{Name: otherRepo.Provider, Implements: gitlab.Implements}, nil)
+				store.EXPECT().GetProviderByName(gomock.Any(), db.GetProviderByNameParams{
+					Name:      gitlab.Gitlab,
+					ProjectID: projectID,
+				}).Return(db.Provider{Name: gitlab.Gitlab, ProjectID: projectID, Implements: gitlab.Implements}, nil)
+			},
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, msg *message.Message) {
+				t.Helper()
+				assert.Equal(t, projectID.String(), msg.Metadata[engine.ProjectIDEventKey])
+				assert.Equal(t, repositoryID.String(), msg.Metadata[engine.RepositoryIDEventKey])
+			},
+		},
+		{
+			name:    "push to registered project",
+			token:   "hook-secret",
+			event:   "Push Hook",
+			payload: gitlabPushPayload,
+			setup: func(store *mockdb.MockStore) {
+				expectRepo(store, gitlab.Implements)
+			},
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, msg *message.Message) {
+				t.Helper()
+				assert.Equal(t, "delivery", msg.Metadata["id"])
+				assert.Equal(t, "Push Hook", msg.Metadata["type"])
+				assert.Equal(t, "gitlab", msg.Metadata["provider"])
+				assert.Equal(t, "https://gitlab.example.com", msg.Metadata["source"])
+				assert.Equal(t, projectID.String(), msg.Metadata[engine.ProjectIDEventKey])
+				assert.Equal(t, repositoryID.String(), msg.Metadata[engine.RepositoryIDEventKey])
+
+				assert.Equal(t, "repository", msg.Metadata[engine.EntityTypeEventKey])
+				repo := &pb.Repository{}
+				require.NoError(t, protojson.Unmarshal(msg.Payload, repo))
+				assert.Equal(t, "acme", repo.Owner)
+				assert.Equal(t, "api", repo.Name)
+			},
+		},
+		{
+			name:    "project registered through another provider",
+			token:   "hook-secret",
+			event:   "Push Hook",
+			payload: gitlabPushPayload,
+			setup: func(store *mockdb.MockStore) {
+				expectRepo(store, []db.ProviderType{db.ProviderTypeGithub})
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:    "merge request opened",
+			token:   "hook-secret",
+			event:   "Merge Request Hook",
+			payload: gitlabMrOpenPayload,
+			setup: func(store *mockdb.MockStore) {
+				expectRepo(store, gitlab.Implements)
+				store.EXPECT().UpsertPullRequest(gomock.Any(), db.UpsertPullRequestParams{
+					RepositoryID: repositoryID,
+					PrNumber:     7,
+				}).Return(db.PullRequest{ID: prID}, nil)
+			},
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, msg *message.Message) {
+				t.Helper()
+				assert.Equal(t, prID.String(), msg.Metadata[engine.PullRequestIDEventKey])
+
+				assert.Equal(t, "pull_request", msg.Metadata[engine.EntityTypeEventKey])
+				pr := &pb.PullRequest{}
+				require.NoError(t, protojson.Unmarshal(msg.Payload, pr))
+				assert.Equal(t, int32(7), pr.Number)
+				assert.Equal(t, int64(51), pr.AuthorId)
+				assert.Equal(t, "opened", pr.Action)
+				assert.Equal(t, "da1560886d4f094c3e6c9ef40349f7d38b5d27d7", pr.CommitSha)
+				assert.Equal(t, "acme", pr.RepoOwner)
+				assert.Equal(t, "api", pr.RepoName)
+			},
+		},
+		{
+			name:    "merge request updated without new commits",
+			token:   "hook-secret",
+			event:   "Merge Request Hook",
+			payload: gitlabMrRetitledPayload,
+			setup: func(store *mockdb.MockStore) {
+				expectRepo(store, gitlab.Implements)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "unhandled event",
+			token:      "hook-secret",
+			event:      "Issue Hook",
+			payload:    gitlabIssuePayload,
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			if tt.setup != nil {
+				tt.setup(mockStore)
+			}
+
+			srv := newDefaultServer(t, mockStore)
+			srv.cfg.WebhookConfig.WebhookSecret = "secret"
+			defer srv.evt.Close()
+
+			pq := newPassthroughQueue()
+			queued := pq.getQueue()
+			srv.evt.Register(engine.InternalEntityEventTopic, pq.pass)
+
+			go func() {
+				err := srv.evt.Run(context.Background())
+				require.NoError(t, err, "failed to run eventer")
+			}()
+			<-srv.evt.Running()
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/gitlab/"+uuid.NewString(),
+				strings.NewReader(tt.payload))
+			req.Header.Set("X-Gitlab-Token", tt.token)
+			req.Header.Set("X-Gitlab-Event", tt.event)
+			req.Header.Set("X-Gitlab-Event-UUID", "delivery")
+			req.Header.Set("X-Gitlab-Instance", "https://gitlab.example.com")
+			rec := httptest.NewRecorder()
+
+			srv.HandleGitLabWebHook()(rec, req)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			if tt.check == nil {
+				assert.Len(t, queued, 0)
+				return
+			}
+
+			select {
+			case msg := <-queued:
+				tt.check(t, msg)
+			case <-time.After(5 * time.Second):
+				t.Fatal("timed out waiting for the event to be published")
+			}
+		})
+	}
+}
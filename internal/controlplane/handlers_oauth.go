@@ -21,22 +21,30 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 	"golang.org/x/oauth2"
 	"google.golang.org/genproto/googleapis/api/httpbody"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/stacklok/minder/internal/auth"
 	mcrypto "github.com/stacklok/minder/internal/crypto"
 	"github.com/stacklok/minder/internal/db"
+	"github.com/stacklok/minder/internal/engine"
+	"github.com/stacklok/minder/internal/providers"
+	"github.com/stacklok/minder/internal/providers/registry"
 	"github.com/stacklok/minder/internal/util"
 	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provinfv1 "github.com/stacklok/minder/pkg/providers/v1"
 )
 
 // GetAuthorizationURL returns the URL to redirect the user to for authorization
@@ -70,7 +78,7 @@ func (s *Server) GetAuthorizationURL(ctx context.Context,
 	}
 
 	// Create a new OAuth2 config for the given provider
-	oauthConfig, err := auth.NewOAuthConfig(provider.Name, req.Cli)
+	oauthConfig, err := providers.OAuthConfig(&provider, req.Cli)
 	if err != nil {
 		return nil, err
 	}
@@ -164,7 +172,7 @@ func (s *Server) ExchangeCodeForTokenCLI(ctx context.Context,
 	}
 
 	// generate a new OAuth2 config for the given provider
-	oauthConfig, err := auth.NewOAuthConfig(in.Provider, true)
+	oauthConfig, err := providers.OAuthConfig(&provider, true)
 	if err != nil {
 		return nil, err
 	}
@@ -178,10 +186,12 @@ func (s *Server) ExchangeCodeForTokenCLI(ctx context.Context,
 		return nil, err
 	}
 
+	// keep the refresh token and the expiry, so expiring tokens can be refreshed
 	ftoken := &oauth2.Token{
 		AccessToken:  token.AccessToken,
 		TokenType:    token.TokenType,
-		RefreshToken: "",
+		RefreshToken: token.RefreshToken,
+		Expiry:       token.Expiry,
 	}
 
 	// Convert token to JSON
@@ -215,12 +225,25 @@ func (s *Server) ExchangeCodeForTokenCLI(ctx context.Context,
 		ProjectID:      stateData.ProjectID,
 		Provider:       provider.Name,
 		EncryptedToken: encodedToken,
+		ExpirationTime: token.Expiry,
 		OwnerFilter:    owner,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Unknown, "error inserting access token: %s", err)
 	}
 
+	// a fresh token makes a provider whose token failed to refresh usable again
+	err = s.store.UpdateProviderHealth(ctx, db.UpdateProviderHealthParams{
+		Name:      provider.Name,
+		ProjectID: stateData.ProjectID,
+		Health:    db.ProviderHealthHealthy,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "error updating provider health: %s", err)
+	}
+
+	s.discoverGitHubAppInstallation(ctx, &provider, stateData.ProjectID, owner, token.AccessToken)
+
 	return &httpbody.HttpBody{
 		ContentType: "text/html",
 		Data:        auth.OAuthSuccessHtml,
@@ -286,15 +309,15 @@ func (s *Server) getProviderAccessToken(ctx context.Context, provider string,
 // RevokeOauthTokens revokes the all oauth tokens for a provider
 // This is in case of a security breach, where we need to revoke all tokens
 func (s *Server) RevokeOauthTokens(ctx context.Context, _ *pb.RevokeOauthTokensRequest) (*pb.RevokeOauthTokensResponse, error) {
-	providers, err := s.store.GlobalListProviders(ctx)
+	provs, err := s.store.GlobalListProviders(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "unable to list providers: %v", err)
 	}
 
 	revoked_tokens := 0
 
-	for idx := range providers {
-		provider := providers[idx]
+	for idx := range provs {
+		provider := provs[idx]
 		// need to read all tokens from the provider and revoke them
 		tokens, err := s.store.GetAccessTokenByProvider(ctx, provider.Name)
 		if errors.Is(err, sql.ErrNoRows) {
@@ -314,7 +337,7 @@ func (s *Server) RevokeOauthTokens(ctx context.Context, _ *pb.RevokeOauthTokensR
 				_ = s.store.DeleteAccessToken(ctx, db.DeleteAccessTokenParams{Provider: provider.Name, ProjectID: token.ProjectID})
 
 				// remove from provider
-				err := auth.DeleteAccessToken(ctx, provider.Name, objToken.AccessToken)
+				err := providers.RevokeToken(ctx, &provider, objToken.AccessToken)
 
 				if err != nil {
 					log.Error().Msgf("Error deleting access token: %v", err)
@@ -360,7 +383,7 @@ func (s *Server) RevokeOauthProjectToken(ctx context.Context,
 	_ = s.store.DeleteAccessToken(ctx, db.DeleteAccessTokenParams{Provider: provider.Name, ProjectID: token.ProjectID})
 
 	// remove from provider
-	err = auth.DeleteAccessToken(ctx, provider.Name, objToken.AccessToken)
+	err = providers.RevokeToken(ctx, &provider, objToken.AccessToken)
 
 	if err != nil {
 		log.Error().Msgf("Error deleting access token: %v", err)
@@ -388,11 +411,27 @@ func (s *Server) StoreProviderToken(ctx context.Context,
 	}
 
 	// validate token
-	err = auth.ValidateProviderToken(ctx, in.Provider, in.AccessToken)
-	if err != nil {
+	if err := s.validateProviderToken(ctx, &provider, in.AccessToken); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid token provided")
 	}
 
+	// reject tokens Minder can't register repositories with, and warn about
+	// the permissions the rule types in use need
+	missing, err := s.missingProviderPermissions(ctx, &provider, projectID, in.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	var lacking []string
+	for _, m := range missing {
+		if m.IsRequiredForEnrollment() {
+			lacking = append(lacking, describePermission(m))
+		}
+	}
+	if len(lacking) > 0 {
+		return nil, util.UserVisibleError(codes.FailedPrecondition,
+			"the token lacks the permissions needed to enroll the provider: %s", strings.Join(lacking, ", "))
+	}
+
 	ftoken := &oauth2.Token{
 		AccessToken:  in.AccessToken,
 		RefreshToken: "",
@@ -427,7 +466,29 @@ func (s *Server) StoreProviderToken(ctx context.Context,
 	} else if err != nil {
 		return nil, status.Errorf(codes.Internal, "error storing access token: %v", err)
 	}
-	return &pb.StoreProviderTokenResponse{}, nil
+	return &pb.StoreProviderTokenResponse{MissingPermissions: permissionsToPB(missing)}, nil
+}
+
+// validateProviderToken checks the token against the service the provider
+// talks to. Providers whose clients can validate their credentials, e.g. OCI
+// registries, are checked with the client the provider will use, the other
+// providers through their OAuth service.
+func (s *Server) validateProviderToken(ctx context.Context, provider *db.Provider, token string) error {
+	if _, err := registry.Get(provider.Definition); err != nil {
+		return auth.ValidateProviderToken(ctx, provider.Name, token)
+	}
+
+	pbuild := providers.NewProviderBuilder(provider, db.ProviderAccessToken{}, token,
+		providers.WithProviderMetrics(s.provMt))
+	cli, err := pbuild.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	if v, ok := cli.(providers.Validator); ok {
+		return v.Validate(ctx)
+	}
+	return auth.ValidateProviderToken(ctx, provider.Name, token)
 }
 
 // VerifyProviderTokenFrom verifies the provider token since a timestamp
@@ -458,5 +519,223 @@ func (s *Server) VerifyProviderTokenFrom(ctx context.Context,
 		}
 		return nil, status.Errorf(codes.Internal, "error getting access token: %v", err)
 	}
-	return &pb.VerifyProviderTokenFromResponse{Status: "OK"}, nil
+
+	// the token is stored already, so missing permissions are only reported
+	token, _, err := s.getProviderAccessToken(ctx, provider.Name, projectID, false)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting access token: %v", err)
+	}
+	missing, err := s.missingProviderPermissions(ctx, &provider, projectID, token.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.VerifyProviderTokenFromResponse{Status: "OK", MissingPermissions: permissionsToPB(missing)}, nil
+}
+
+// GetProviderHealth returns the health of the credentials of a provider
+func (s *Server) GetProviderHealth(ctx context.Context,
+	in *pb.GetProviderHealthRequest) (*pb.GetProviderHealthResponse, error) {
+	projectID, err := getProjectFromRequestOrDefault(ctx, in)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, err.Error())
+	}
+
+	// check if user is authorized
+	if err := AuthorizedOnProject(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	provider, err := s.store.GetProviderByName(ctx, db.GetProviderByNameParams{
+		Name: in.Provider, ProjectID: projectID})
+	if err != nil {
+		return nil, providerError(fmt.Errorf("provider error: %w", err))
+	}
+
+	health := &pb.ProviderHealth{
+		Status: string(provider.Health),
+		Detail: provider.HealthDetail,
+	}
+
+	token, err := s.store.GetAccessTokenByProjectID(ctx,
+		db.GetAccessTokenByProjectIDParams{Provider: provider.Name, ProjectID: projectID})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.Internal, "error getting access token: %v", err)
+	} else if err == nil && !token.ExpirationTime.IsZero() {
+		health.TokenExpiresAt = timestamppb.New(token.ExpirationTime)
+	}
+
+	return &pb.GetProviderHealthResponse{Health: health}, nil
+}
+
+// CheckProviderPermissions checks the permissions granted to the credentials
+// of a provider against the permissions needed to enroll it and by the rule
+// types in use
+func (s *Server) CheckProviderPermissions(ctx context.Context,
+	in *pb.CheckProviderPermissionsRequest) (*pb.CheckProviderPermissionsResponse, error) {
+	projectID, err := getProjectFromRequestOrDefault(ctx, in)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, err.Error())
+	}
+
+	// check if user is authorized
+	if err := AuthorizedOnProject(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	provider, err := s.store.GetProviderByName(ctx, db.GetProviderByNameParams{
+		Name: in.Provider, ProjectID: projectID})
+	if err != nil {
+		return nil, providerError(fmt.Errorf("provider error: %w", err))
+	}
+
+	token, _, err := s.getProviderAccessToken(ctx, provider.Name, projectID, false)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, util.UserVisibleError(codes.NotFound, "provider %s is not enrolled", provider.Name)
+	} else if err != nil {
+		return nil, err
+	}
+
+	checks, introspected, err := s.checkProviderPermissions(ctx, &provider, projectID, token.AccessToken)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error checking provider permissions: %v", err)
+	}
+
+	return &pb.CheckProviderPermissionsResponse{
+		Introspected: introspected,
+		Permissions:  permissionsToPB(checks),
+	}, nil
+}
+
+// checkProviderPermissions checks the permissions granted to the token
+// against the permissions needed by the provider. When the provider can't
+// tell the permissions of its tokens, e.g. for GitHub fine-grained tokens,
+// introspected is false and the checks only list the needed permissions.
+func (s *Server) checkProviderPermissions(ctx context.Context, provider *db.Provider,
+	projectID uuid.UUID, token string) (checks []providers.PermissionCheck, introspected bool, err error) {
+	introspector, granted, introspected, err := s.grantedProviderPermissions(ctx, provider, token)
+	if err != nil {
+		return nil, false, err
+	}
+
+	required, err := s.requiredProviderPermissions(ctx, provider, projectID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return providers.CheckPermissions(introspector, granted, required), introspected, nil
+}
+
+// grantedProviderPermissions returns the permissions granted to the token,
+// along with the introspector of the provider's client, which is nil when
+// the client can't map permissions to scopes. introspected is false when the
+// provider can't tell the permissions of its tokens.
+func (s *Server) grantedProviderPermissions(ctx context.Context, provider *db.Provider, token string) (
+	introspector provinfv1.PermissionIntrospector, granted []provinfv1.Permission, introspected bool, err error) {
+	if _, err := registry.Get(provider.Definition); err != nil {
+		return nil, nil, false, nil
+	}
+
+	pbuild := providers.NewProviderBuilder(provider, db.ProviderAccessToken{}, token,
+		providers.WithProviderMetrics(s.provMt))
+	cli, err := pbuild.GetClient(ctx)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	introspector, ok := cli.(provinfv1.PermissionIntrospector)
+	if !ok {
+		return nil, nil, false, nil
+	}
+
+	granted, err = introspector.GrantedPermissions(ctx)
+	if errors.Is(err, provinfv1.ErrPermissionsUnknown) {
+		return introspector, nil, false, nil
+	} else if err != nil {
+		return nil, nil, false, err
+	}
+	return introspector, granted, true, nil
+}
+
+// missingProviderPermissions returns the permissions needed by the provider
+// which the token doesn't grant. Failing to introspect the token isn't an
+// error, since the token has been validated already.
+func (s *Server) missingProviderPermissions(ctx context.Context, provider *db.Provider,
+	projectID uuid.UUID, token string) ([]providers.PermissionCheck, error) {
+	logger := zerolog.Ctx(ctx).With().Str("provider", provider.Name).Logger()
+
+	introspector, granted, introspected, err := s.grantedProviderPermissions(ctx, provider, token)
+	if err != nil {
+		logger.Warn().Err(err).Msg("unable to check the permissions of the token")
+		return nil, nil
+	}
+	if !introspected {
+		return nil, nil
+	}
+
+	required, err := s.requiredProviderPermissions(ctx, provider, projectID)
+	if err != nil {
+		logger.Warn().Err(err).Msg("unable to check the permissions of the token")
+		return nil, nil
+	}
+	return providers.MissingPermissions(providers.CheckPermissions(introspector, granted, required)), nil
+}
+
+// requiredProviderPermissions returns the permissions needed by the rule
+// types the profiles of the provider instantiate
+func (s *Server) requiredProviderPermissions(ctx context.Context, provider *db.Provider,
+	projectID uuid.UUID) (map[provinfv1.Permission][]string, error) {
+	ectx := &engine.EntityContext{
+		Project:  engine.Project{ID: projectID},
+		Provider: engine.Provider{ID: provider.ID, Name: provider.Name},
+	}
+
+	rows, err := s.store.ListProfilesByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing profiles: %w", err)
+	}
+	// profiles are unique per provider and project, keep the ones of the provider
+	rows = slices.DeleteFunc(rows, func(r db.ListProfilesByProjectIDRow) bool {
+		return r.Provider != provider.Name
+	})
+	profiles := maps.Values(engine.MergeDatabaseListIntoProfiles(rows, ectx))
+
+	dbRuleTypes, err := s.store.ListRuleTypesByProviderAndProject(ctx, db.ListRuleTypesByProviderAndProjectParams{
+		Provider:  provider.Name,
+		ProjectID: projectID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing rule types: %w", err)
+	}
+	ruleTypes := make([]*pb.RuleType, 0, len(dbRuleTypes))
+	for idx := range dbRuleTypes {
+		rt, err := engine.RuleTypePBFromDB(&dbRuleTypes[idx], ectx)
+		if err != nil {
+			return nil, err
+		}
+		ruleTypes = append(ruleTypes, rt)
+	}
+
+	return engine.RequiredPermissions(profiles, ruleTypes)
+}
+
+// permissionsToPB converts permission checks to their protobuf representation
+func permissionsToPB(checks []providers.PermissionCheck) []*pb.ProviderPermission {
+	perms := make([]*pb.ProviderPermission, 0, len(checks))
+	for _, c := range checks {
+		perms = append(perms, &pb.ProviderPermission{
+			Name:       string(c.Permission),
+			Scopes:     c.Scopes,
+			RequiredBy: c.RequiredBy,
+			Granted:    c.Granted,
+		})
+	}
+	return perms
+}
+
+// describePermission names the permission along with the scopes granting it
+func describePermission(c providers.PermissionCheck) string {
+	if len(c.Scopes) == 0 {
+		return string(c.Permission)
+	}
+	return fmt.Sprintf("%s (%s)", c.Permission, strings.Join(c.Scopes, " or "))
 }
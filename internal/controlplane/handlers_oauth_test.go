@@ -17,20 +17,29 @@ package controlplane
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/go-containerregistry/pkg/registry"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/oauth2/github"
 	"golang.org/x/oauth2/google"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	mockdb "github.com/stacklok/minder/database/mock"
 	"github.com/stacklok/minder/internal/auth"
 	"github.com/stacklok/minder/internal/db"
 	ghclient "github.com/stacklok/minder/internal/providers/github"
 	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provinfv1 "github.com/stacklok/minder/pkg/providers/v1"
 )
 
 func TestNewOAuthConfig(t *testing.T) {
@@ -251,3 +260,213 @@ func RevokeOauthProjectToken_gRPC(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 }
+
+func TestStoreProviderTokenOCI(t *testing.T) {
+	t.Parallel()
+
+	// a registry which only accepts the given credentials
+	reg := registry.New()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		if !ok || user != "bot" || pass != "s3cr3t" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		reg.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	projectID := uuid.New()
+	host := strings.TrimPrefix(srv.URL, "http://")
+	prov := db.Provider{
+		Name:       "registry",
+		ProjectID:  projectID,
+		Version:    provinfv1.V1,
+		Implements: []db.ProviderType{db.ProviderTypeOci},
+		Definition: json.RawMessage(fmt.Sprintf(
+			`{"oci": {"registry": %q, "username": "bot", "insecure": true}}`, host)),
+	}
+
+	testCases := []struct {
+		name       string
+		token      string
+		buildStubs func(store *mockdb.MockStore)
+		wantCode   codes.Code
+	}{
+		{
+			name:  "valid credentials are stored",
+			token: "s3cr3t",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateAccessToken(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.CreateAccessTokenParams) (db.ProviderAccessToken, error) {
+						assert.Equal(t, "registry", arg.Provider)
+						assert.Equal(t, projectID, arg.ProjectID)
+						return db.ProviderAccessToken{}, nil
+					})
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:       "invalid credentials are rejected",
+			token:      "wrong",
+			buildStubs: func(_ *mockdb.MockStore) {},
+			wantCode:   codes.InvalidArgument,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			mockStore.EXPECT().GetProviderByName(gomock.Any(), db.GetProviderByNameParams{
+				Name:      "registry",
+				ProjectID: projectID,
+			}).Return(prov, nil)
+			tc.buildStubs(mockStore)
+			s := newDefaultServer(t, mockStore)
+
+			_, err := s.StoreProviderToken(providersTestContext(projectID), &pb.StoreProviderTokenRequest{
+				Provider:    "registry",
+				ProjectId:   projectID.String(),
+				AccessToken: tc.token,
+			})
+			assert.Equal(t, tc.wantCode, status.Code(err), "unexpected error: %v", err)
+		})
+	}
+}
+
+func TestStoreProviderTokenPermissions(t *testing.T) {
+	t.Parallel()
+
+	// a GitHub Enterprise Server granting each token the scopes it's named
+	// after, "fine-grained" tokens don't report their scopes
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3/user" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if token != "fine-grained" {
+			w.Header().Set("X-OAuth-Scopes", token)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"login": "octocat"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	projectID := uuid.New()
+	prov := db.Provider{
+		Name:       "ghes",
+		ProjectID:  projectID,
+		Version:    provinfv1.V1,
+		Implements: []db.ProviderType{db.ProviderTypeGithub, db.ProviderTypeGit, db.ProviderTypeRest},
+		Definition: json.RawMessage(fmt.Sprintf(`{"github": {"endpoint": %q}}`, srv.URL+"/api/v3/")),
+	}
+
+	// a profile remediating with a pull request patching a workflow
+	profiles := []db.ListProfilesByProjectIDRow{{
+		ID:              uuid.New(),
+		Name:            "pin-actions",
+		Provider:        "ghes",
+		ProjectID:       projectID,
+		Remediate:       db.NullActionType{ActionType: db.ActionTypeOn, Valid: true},
+		Entity:          db.EntitiesRepository,
+		ContextualRules: json.RawMessage(`[{"type": "actions_pinned", "def": {}}]`),
+	}}
+	ruleTypes := []db.RuleType{{
+		ID:        uuid.New(),
+		Name:      "actions_pinned",
+		Provider:  "ghes",
+		ProjectID: projectID,
+		Definition: json.RawMessage(`{"in_entity": "repository", "rule_schema": {},
+			"ingest": {"type": "git"}, "eval": {"type": "rego"},
+			"remediate": {"type": "pull_request", "pull_request": {"title": "Pin actions", "body": "",
+				"contents": [{"path": ".github/workflows/ci.yml", "content": ""}]}}}`),
+	}}
+
+	testCases := []struct {
+		name        string
+		token       string
+		checked     bool
+		stored      bool
+		wantCode    codes.Code
+		wantMissing []string
+	}{
+		{
+			name:     "token granting all permissions",
+			token:    "repo, workflow",
+			checked:  true,
+			stored:   true,
+			wantCode: codes.OK,
+		},
+		{
+			name:        "token missing a permission of a rule type",
+			token:       "repo",
+			checked:     true,
+			stored:      true,
+			wantCode:    codes.OK,
+			wantMissing: []string{"write_workflows"},
+		},
+		{
+			name:     "token missing enrollment permissions",
+			token:    "read:user",
+			checked:  true,
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "token not reporting its permissions",
+			token:    "fine-grained",
+			stored:   true,
+			wantCode: codes.OK,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			mockStore.EXPECT().GetProviderByName(gomock.Any(), db.GetProviderByNameParams{
+				Name:      "ghes",
+				ProjectID: projectID,
+			}).Return(prov, nil)
+			if tc.checked {
+				mockStore.EXPECT().ListProfilesByProjectID(gomock.Any(), projectID).Return(profiles, nil)
+				mockStore.EXPECT().ListRuleTypesByProviderAndProject(gomock.Any(),
+					db.ListRuleTypesByProviderAndProjectParams{Provider: "ghes", ProjectID: projectID}).
+					Return(ruleTypes, nil)
+			}
+			if tc.stored {
+				mockStore.EXPECT().CreateAccessToken(gomock.Any(), gomock.Any()).Return(db.ProviderAccessToken{}, nil)
+			}
+			s := newDefaultServer(t, mockStore)
+
+			res, err := s.StoreProviderToken(providersTestContext(projectID), &pb.StoreProviderTokenRequest{
+				Provider:    "ghes",
+				ProjectId:   projectID.String(),
+				AccessToken: tc.token,
+			})
+			require.Equal(t, tc.wantCode, status.Code(err), "unexpected error: %v", err)
+			if err != nil {
+				return
+			}
+
+			var missing []string
+			for _, p := range res.GetMissingPermissions() {
+				missing = append(missing, p.GetName())
+				assert.Equal(t, []string{"actions_pinned"}, p.GetRequiredBy())
+			}
+			assert.Equal(t, tc.wantMissing, missing)
+		})
+	}
+}
@@ -103,11 +103,22 @@ func validateActionType(r string) db.NullActionType {
 		return db.NullActionType{ActionType: db.ActionTypeOff, Valid: true}
 	case "dry_run":
 		return db.NullActionType{ActionType: db.ActionTypeDryRun, Valid: true}
+	case "approval":
+		return db.NullActionType{ActionType: db.ActionTypeApproval, Valid: true}
 	}
 
 	return db.NullActionType{Valid: false}
 }
 
+// actionSettingsToDB marshals the per action settings of a profile, storing an
+// empty object if there are none
+func actionSettingsToDB(settings map[string]string) (json.RawMessage, error) {
+	if settings == nil {
+		settings = map[string]string{}
+	}
+	return json.Marshal(settings)
+}
+
 // CreateProfile creates a profile for a group
 // nolint: gocyclo
 func (s *Server) CreateProfile(ctx context.Context,
@@ -201,12 +212,25 @@ func (s *Server) CreateProfile(ctx context.Context,
 
 	qtx := s.store.GetQuerierWithTransaction(tx)
 
+	remediateActions, err := actionSettingsToDB(in.GetRemediateActions())
+	if err != nil {
+		log.Printf("error marshalling remediate actions: %v", err)
+		return nil, status.Errorf(codes.Internal, "error creating profile")
+	}
+	alertActions, err := actionSettingsToDB(in.GetAlertActions())
+	if err != nil {
+		log.Printf("error marshalling alert actions: %v", err)
+		return nil, status.Errorf(codes.Internal, "error creating profile")
+	}
+
 	params := db.CreateProfileParams{
-		Provider:  provider.Name,
-		ProjectID: entityCtx.GetProject().GetID(),
-		Name:      in.GetName(),
-		Remediate: validateActionType(in.GetRemediate()),
-		Alert:     validateActionType(in.GetAlert()),
+		Provider:         provider.Name,
+		ProjectID:        entityCtx.GetProject().GetID(),
+		Name:             in.GetName(),
+		Remediate:        validateActionType(in.GetRemediate()),
+		Alert:            validateActionType(in.GetAlert()),
+		RemediateActions: remediateActions,
+		AlertActions:     alertActions,
 	}
 
 	// Create profile
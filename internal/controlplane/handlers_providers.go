@@ -0,0 +1,389 @@
+//
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/stacklok/minder/internal/db"
+	"github.com/stacklok/minder/internal/providers"
+	"github.com/stacklok/minder/internal/util"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+// CreateProvider creates a provider in a project
+func (s *Server) CreateProvider(ctx context.Context,
+	in *pb.CreateProviderRequest) (*pb.CreateProviderResponse, error) {
+	projectID, err := getProjectFromRequestOrDefault(ctx, in)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, err.Error())
+	}
+
+	// check if user is authorized
+	if err := AuthorizedOnProject(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	prov := in.GetProvider()
+	if prov.GetName() == "" {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "provider name is required")
+	}
+	if v := prov.GetVersion(); v != "" && v != provifv1.V1 {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "provider version %s is not supported", v)
+	}
+
+	implements, def, err := providerDefinitionFromPB(prov, nil, nil)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid provider: %s", err)
+	}
+
+	created, err := s.store.CreateProvider(ctx, db.CreateProviderParams{
+		Name:       prov.GetName(),
+		ProjectID:  projectID,
+		Implements: implements,
+		Definition: def,
+	})
+	if db.ErrIsUniqueViolation(err) {
+		return nil, util.UserVisibleError(codes.AlreadyExists, "provider %s already exists", prov.GetName())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "error creating provider: %v", err)
+	}
+
+	out, err := providerPBFromDB(&created)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error converting provider: %v", err)
+	}
+
+	return &pb.CreateProviderResponse{Provider: out}, nil
+}
+
+// ListProviders lists the providers of a project
+func (s *Server) ListProviders(ctx context.Context,
+	in *pb.ListProvidersRequest) (*pb.ListProvidersResponse, error) {
+	projectID, err := getProjectFromRequestOrDefault(ctx, in)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, err.Error())
+	}
+
+	// check if user is authorized
+	if err := AuthorizedOnProject(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	provs, err := s.store.ListProvidersByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error listing providers: %v", err)
+	}
+
+	resp := &pb.ListProvidersResponse{}
+	for i := range provs {
+		out, err := providerPBFromDB(&provs[i])
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error converting provider %s: %v", provs[i].Name, err)
+		}
+		resp.Providers = append(resp.Providers, out)
+	}
+
+	return resp, nil
+}
+
+// GetProvider gets a provider of a project by name
+func (s *Server) GetProvider(ctx context.Context,
+	in *pb.GetProviderRequest) (*pb.GetProviderResponse, error) {
+	projectID, err := getProjectFromRequestOrDefault(ctx, in)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, err.Error())
+	}
+
+	// check if user is authorized
+	if err := AuthorizedOnProject(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	prov, err := s.store.GetProviderByName(ctx, db.GetProviderByNameParams{
+		Name:      in.GetName(),
+		ProjectID: projectID,
+	})
+	if err != nil {
+		return nil, providerError(err)
+	}
+
+	out, err := providerPBFromDB(&prov)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error converting provider: %v", err)
+	}
+
+	return &pb.GetProviderResponse{Provider: out}, nil
+}
+
+// UpdateProvider replaces the interfaces a provider implements and its
+// definition. Whatever isn't set in the request is left untouched. The
+// endpoints of an enrolled provider can't be changed, since its credentials
+// were only meant for the endpoints it was enrolled with.
+func (s *Server) UpdateProvider(ctx context.Context,
+	in *pb.UpdateProviderRequest) (*pb.UpdateProviderResponse, error) {
+	projectID, err := getProjectFromRequestOrDefault(ctx, in)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, err.Error())
+	}
+
+	// check if user is authorized
+	if err := AuthorizedOnProject(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.store.GetProviderByName(ctx, db.GetProviderByNameParams{
+		Name:      in.GetProvider().GetName(),
+		ProjectID: projectID,
+	})
+	if err != nil {
+		return nil, providerError(err)
+	}
+
+	implements, def, err := providerDefinitionFromPB(in.GetProvider(), existing.Implements, existing.Definition)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid provider: %s", err)
+	}
+
+	changed, err := providers.EndpointsChanged(existing.Definition, def)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid provider: %s", err)
+	}
+	if changed {
+		enrolled, err := s.providerEnrolled(ctx, &existing)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error checking provider enrollment: %v", err)
+		}
+		if enrolled {
+			return nil, util.UserVisibleError(codes.FailedPrecondition,
+				"the endpoints of an enrolled provider can't be changed, delete the provider and enroll it again")
+		}
+	}
+
+	updated, err := s.store.UpdateProvider(ctx, db.UpdateProviderParams{
+		Name:       existing.Name,
+		ProjectID:  projectID,
+		Implements: implements,
+		Definition: def,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error updating provider: %v", err)
+	}
+
+	out, err := providerPBFromDB(&updated)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error converting provider: %v", err)
+	}
+
+	return &pb.UpdateProviderResponse{Provider: out}, nil
+}
+
+// providerEnrolled returns true if credentials were stored for the provider,
+// either a token or a GitHub App installation
+func (s *Server) providerEnrolled(ctx context.Context, prov *db.Provider) (bool, error) {
+	_, err := s.store.GetAccessTokenByProjectID(ctx, db.GetAccessTokenByProjectIDParams{
+		Provider:  prov.Name,
+		ProjectID: prov.ProjectID,
+	})
+	if err == nil {
+		return true, nil
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return false, err
+	}
+
+	_, err = s.store.GetGitHubAppInstallationByProjectAndProvider(ctx,
+		db.GetGitHubAppInstallationByProjectAndProviderParams{
+			Provider:  prov.Name,
+			ProjectID: prov.ProjectID,
+		})
+	if err == nil {
+		return true, nil
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return false, err
+	}
+	return false, nil
+}
+
+// DeleteProvider deletes a provider. The webhooks created for the
+// repositories registered with the provider are removed, and the database
+// cascades the deletion to the repositories, tokens, profiles and rule types
+// of the provider.
+func (s *Server) DeleteProvider(ctx context.Context,
+	in *pb.DeleteProviderRequest) (*pb.DeleteProviderResponse, error) {
+	projectID, err := getProjectFromRequestOrDefault(ctx, in)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, err.Error())
+	}
+
+	// check if user is authorized
+	if err := AuthorizedOnProject(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	prov, err := s.store.GetProviderByName(ctx, db.GetProviderByNameParams{
+		Name:      in.GetName(),
+		ProjectID: projectID,
+	})
+	if err != nil {
+		return nil, providerError(err)
+	}
+
+	s.deleteProviderWebhooks(ctx, &prov)
+
+	if err := s.store.DeleteProvider(ctx, db.DeleteProviderParams{
+		ID:        prov.ID,
+		ProjectID: projectID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "error deleting provider: %v", err)
+	}
+
+	return &pb.DeleteProviderResponse{Name: prov.Name}, nil
+}
+
+// deleteProviderWebhooks removes the webhooks created for the repositories
+// registered with a provider. This is best effort: once the provider's
+// credentials are gone the webhooks can't be removed, and they are left
+// behind rather than blocking the deletion.
+func (s *Server) deleteProviderWebhooks(ctx context.Context, prov *db.Provider) {
+	logger := zerolog.Ctx(ctx).With().
+		Str("provider", prov.Name).
+		Str("project_id", prov.ProjectID.String()).
+		Logger()
+
+	repos, err := s.store.ListRegisteredRepositoriesByProjectIDAndProvider(ctx,
+		db.ListRegisteredRepositoriesByProjectIDAndProviderParams{
+			Provider:  prov.Name,
+			ProjectID: prov.ProjectID,
+		})
+	if err != nil {
+		logger.Error().Err(err).Msg("error listing repositories")
+		return
+	}
+	if len(repos) == 0 {
+		return
+	}
+
+	pbOpts := []providers.ProviderBuilderOption{
+		providers.WithProviderMetrics(s.provMt),
+		providers.WithGitHubApp(s.ghApp),
+	}
+	pbuild, err := providers.GetProviderBuilder(ctx, *prov, prov.ProjectID, s.store, s.cryptoEngine, pbOpts...)
+	if err != nil {
+		logger.Error().Err(err).Msg("error building client, not removing webhooks")
+		return
+	}
+
+	// the repositories of a GitHub App installation have no webhook of their own
+	if pbuild.IsGitHubAppInstallation() {
+		return
+	}
+
+	for i := range repos {
+		repo := &repos[i]
+		if !repo.WebhookID.Valid || repo.WebhookID.Int32 == 0 {
+			continue
+		}
+		if err := deleteRepositoryWebhook(ctx, pbuild, repo); err != nil {
+			logger.Error().Err(err).
+				Str("repository", fmt.Sprintf("%s/%s", repo.RepoOwner, repo.RepoName)).
+				Msg("error removing webhook")
+		}
+	}
+}
+
+func deleteRepositoryWebhook(ctx context.Context, pbuild *providers.ProviderBuilder, repo *db.Repository) error {
+	cli, err := pbuild.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	// providers registering no webhooks have nothing to remove
+	deleter, ok := cli.(provifv1.WebhookDeleter)
+	if !ok {
+		return nil
+	}
+	return deleter.DeleteRepositoryWebhook(ctx, repo.RepoOwner, repo.RepoName,
+		int64(repo.RepoID), int64(repo.WebhookID.Int32))
+}
+
+// providerDefinitionFromPB returns the interfaces and the definition of a
+// provider as stored in the database, falling back to the given ones for
+// whatever isn't set. The result is validated.
+func providerDefinitionFromPB(
+	prov *pb.Provider,
+	implements []db.ProviderType,
+	def json.RawMessage,
+) ([]db.ProviderType, json.RawMessage, error) {
+	if len(prov.GetImplements()) > 0 {
+		implements = make([]db.ProviderType, 0, len(prov.GetImplements()))
+		for _, impl := range prov.GetImplements() {
+			implements = append(implements, db.ProviderType(impl))
+		}
+	}
+
+	if prov.GetDef() != nil {
+		var err error
+		def, err = protojson.MarshalOptions{UseProtoNames: true}.Marshal(prov.GetDef())
+		if err != nil {
+			return nil, nil, fmt.Errorf("error marshalling definition: %w", err)
+		}
+	}
+	if def == nil {
+		def = json.RawMessage(`{}`)
+	}
+
+	if err := providers.ValidateDefinition(implements, def); err != nil {
+		return nil, nil, err
+	}
+
+	return implements, def, nil
+}
+
+func providerPBFromDB(prov *db.Provider) (*pb.Provider, error) {
+	def := &pb.Provider_Definition{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(prov.Definition, def); err != nil {
+		return nil, fmt.Errorf("error parsing definition: %w", err)
+	}
+
+	implements := make([]string, 0, len(prov.Implements))
+	for _, impl := range prov.Implements {
+		implements = append(implements, string(impl))
+	}
+
+	return &pb.Provider{
+		Name: prov.Name,
+		Context: &pb.Provider_Context{
+			Project: prov.ProjectID.String(),
+		},
+		Version:    prov.Version,
+		Implements: implements,
+		Def:        def,
+		Health: &pb.ProviderHealth{
+			Status: string(prov.Health),
+			Detail: prov.HealthDetail,
+		},
+	}, nil
+}
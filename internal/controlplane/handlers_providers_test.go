@@ -0,0 +1,365 @@
+//
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mockdb "github.com/stacklok/minder/database/mock"
+	"github.com/stacklok/minder/internal/auth"
+	"github.com/stacklok/minder/internal/db"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+func providersTestContext(projectID uuid.UUID) context.Context {
+	orgID := uuid.New()
+	ctx := auth.WithPermissionsContext(context.Background(), auth.UserPermissions{
+		UserId:         1,
+		OrganizationId: orgID,
+		ProjectIds:     []uuid.UUID{projectID},
+		Roles: []auth.RoleInfo{
+			{RoleID: 1, IsAdmin: true, ProjectID: &projectID, OrganizationID: orgID}},
+	})
+	return withRpcOptions(ctx, &pb.RpcOptions{
+		AuthScope: pb.ObjectOwner_OBJECT_OWNER_PROJECT,
+		OwnerOnly: true,
+	})
+}
+
+func TestCreateProvider(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+
+	testCases := []struct {
+		name       string
+		provider   *pb.Provider
+		buildStubs func(store *mockdb.MockStore)
+		wantCode   codes.Code
+	}{
+		{
+			name: "valid provider is created",
+			provider: &pb.Provider{
+				Name:       "ghes",
+				Implements: []string{"github", "git", "rest"},
+				Def: &pb.Provider_Definition{
+					Github: &pb.GitHubProviderConfig{Endpoint: "https://github.example.com/api/v3/"},
+				},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateProvider(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.CreateProviderParams) (db.Provider, error) {
+						assert.Equal(t, "ghes", arg.Name)
+						assert.Equal(t, projectID, arg.ProjectID)
+						assert.JSONEq(t, `{"github": {"endpoint": "https://github.example.com/api/v3/"}}`,
+							string(arg.Definition))
+						return db.Provider{
+							Name:       arg.Name,
+							ProjectID:  arg.ProjectID,
+							Version:    provifv1.V1,
+							Implements: arg.Implements,
+							Definition: arg.Definition,
+							Health:     db.ProviderHealthHealthy,
+						}, nil
+					})
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "missing github configuration is rejected",
+			provider: &pb.Provider{
+				Name:       "ghes",
+				Implements: []string{"github"},
+			},
+			buildStubs: func(_ *mockdb.MockStore) {},
+			wantCode:   codes.InvalidArgument,
+		},
+		{
+			name: "unknown interface is rejected",
+			provider: &pb.Provider{
+				Name:       "ghes",
+				Implements: []string{"svn"},
+			},
+			buildStubs: func(_ *mockdb.MockStore) {},
+			wantCode:   codes.InvalidArgument,
+		},
+		{
+			name: "oci provider without a registry is rejected",
+			provider: &pb.Provider{
+				Name:       "registry",
+				Implements: []string{"oci"},
+				Def:        &pb.Provider_Definition{Oci: &pb.OCIProviderConfig{Username: "bot"}},
+			},
+			buildStubs: func(_ *mockdb.MockStore) {},
+			wantCode:   codes.InvalidArgument,
+		},
+		{
+			name: "duplicate provider is rejected",
+			provider: &pb.Provider{
+				Name:       "github",
+				Implements: []string{"github"},
+				Def:        &pb.Provider_Definition{Github: &pb.GitHubProviderConfig{}},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateProvider(gomock.Any(), gomock.Any()).
+					Return(db.Provider{}, &pq.Error{Code: "23505"})
+			},
+			wantCode: codes.AlreadyExists,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(mockStore)
+			s := newDefaultServer(t, mockStore)
+
+			resp, err := s.CreateProvider(providersTestContext(projectID), &pb.CreateProviderRequest{
+				Provider:  tc.provider,
+				ProjectId: projectID.String(),
+			})
+			assert.Equal(t, tc.wantCode, status.Code(err), "unexpected error: %v", err)
+			if tc.wantCode != codes.OK {
+				return
+			}
+			assert.Equal(t, tc.provider.Name, resp.GetProvider().GetName())
+			assert.Equal(t, projectID.String(), resp.GetProvider().GetContext().GetProject())
+			assert.Equal(t, "https://github.example.com/api/v3/", resp.GetProvider().GetDef().GetGithub().GetEndpoint())
+			assert.Equal(t, string(db.ProviderHealthHealthy), resp.GetProvider().GetHealth().GetStatus())
+		})
+	}
+}
+
+func TestListAndGetProviders(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	ctx := providersTestContext(projectID)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mockdb.NewMockStore(ctrl)
+	s := newDefaultServer(t, mockStore)
+
+	github := db.Provider{
+		Name:       "github",
+		ProjectID:  projectID,
+		Version:    provifv1.V1,
+		Implements: []db.ProviderType{db.ProviderTypeGithub, db.ProviderTypeGit},
+		Definition: json.RawMessage(`{"github": {}}`),
+		Health:     db.ProviderHealthUnhealthy,
+	}
+	mockStore.EXPECT().ListProvidersByProjectID(gomock.Any(), projectID).Return([]db.Provider{github}, nil)
+	mockStore.EXPECT().GetProviderByName(gomock.Any(), db.GetProviderByNameParams{
+		Name:      "gitlab",
+		ProjectID: projectID,
+	}).Return(db.Provider{}, sql.ErrNoRows)
+
+	list, err := s.ListProviders(ctx, &pb.ListProvidersRequest{ProjectId: projectID.String()})
+	require.NoError(t, err)
+	require.Len(t, list.GetProviders(), 1)
+	assert.Equal(t, "github", list.GetProviders()[0].GetName())
+	assert.Equal(t, []string{"github", "git"}, list.GetProviders()[0].GetImplements())
+	assert.Equal(t, "unhealthy", list.GetProviders()[0].GetHealth().GetStatus())
+
+	_, err = s.GetProvider(ctx, &pb.GetProviderRequest{Name: "gitlab", ProjectId: projectID.String()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// other projects are off limits
+	_, err = s.ListProviders(ctx, &pb.ListProvidersRequest{ProjectId: uuid.NewString()})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestUpdateProvider(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mockdb.NewMockStore(ctrl)
+	s := newDefaultServer(t, mockStore)
+
+	existing := db.Provider{
+		Name:       "github",
+		ProjectID:  projectID,
+		Version:    provifv1.V1,
+		Implements: []db.ProviderType{db.ProviderTypeGithub, db.ProviderTypeGit},
+		Definition: json.RawMessage(`{"github": {}}`),
+	}
+	mockStore.EXPECT().GetProviderByName(gomock.Any(), db.GetProviderByNameParams{
+		Name:      "github",
+		ProjectID: projectID,
+	}).Return(existing, nil)
+	// the endpoint of a provider that wasn't enrolled yet can be changed
+	mockStore.EXPECT().GetAccessTokenByProjectID(gomock.Any(), db.GetAccessTokenByProjectIDParams{
+		Provider:  "github",
+		ProjectID: projectID,
+	}).Return(db.ProviderAccessToken{}, sql.ErrNoRows)
+	mockStore.EXPECT().GetGitHubAppInstallationByProjectAndProvider(gomock.Any(), gomock.Any()).
+		Return(db.ProviderGithubAppInstallation{}, sql.ErrNoRows)
+	mockStore.EXPECT().UpdateProvider(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.UpdateProviderParams) (db.Provider, error) {
+			// the interfaces weren't part of the update
+			assert.Equal(t, existing.Implements, arg.Implements)
+			assert.JSONEq(t, `{"github": {"endpoint": "https://github.example.com/api/v3/"}}`, string(arg.Definition))
+			updated := existing
+			updated.Definition = arg.Definition
+			return updated, nil
+		})
+
+	resp, err := s.UpdateProvider(providersTestContext(projectID), &pb.UpdateProviderRequest{
+		Provider: &pb.Provider{
+			Name: "github",
+			Def: &pb.Provider_Definition{
+				Github: &pb.GitHubProviderConfig{Endpoint: "https://github.example.com/api/v3/"},
+			},
+		},
+		ProjectId: projectID.String(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://github.example.com/api/v3/", resp.GetProvider().GetDef().GetGithub().GetEndpoint())
+}
+
+func TestUpdateProviderEnrolledEndpoint(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mockdb.NewMockStore(ctrl)
+	s := newDefaultServer(t, mockStore)
+
+	mockStore.EXPECT().GetProviderByName(gomock.Any(), gomock.Any()).Return(db.Provider{
+		Name:       "github",
+		ProjectID:  projectID,
+		Version:    provifv1.V1,
+		Implements: []db.ProviderType{db.ProviderTypeGithub, db.ProviderTypeGit},
+		Definition: json.RawMessage(`{"github": {}}`),
+	}, nil)
+	mockStore.EXPECT().GetAccessTokenByProjectID(gomock.Any(), gomock.Any()).
+		Return(db.ProviderAccessToken{Provider: "github", ProjectID: projectID}, nil)
+	// the token must not be sent to the new endpoint
+	mockStore.EXPECT().UpdateProvider(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := s.UpdateProvider(providersTestContext(projectID), &pb.UpdateProviderRequest{
+		Provider: &pb.Provider{
+			Name: "github",
+			Def: &pb.Provider_Definition{
+				Github: &pb.GitHubProviderConfig{Endpoint: "https://attacker.example.com/"},
+			},
+		},
+		ProjectId: projectID.String(),
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestDeleteProvider(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	providerID := uuid.New()
+
+	var hooksDeleted atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete && r.URL.Path == "/repos/acme/api/hooks/42" {
+			assert.Equal(t, "Bearer gho_token", r.Header.Get("Authorization"))
+			hooksDeleted.Add(1)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mockdb.NewMockStore(ctrl)
+	s := newDefaultServer(t, mockStore)
+
+	tokJSON, err := json.Marshal(&oauth2.Token{AccessToken: "gho_token"})
+	require.NoError(t, err)
+	encToken, err := s.cryptoEngine.EncryptOAuthToken(tokJSON)
+	require.NoError(t, err)
+
+	prov := db.Provider{
+		ID:         providerID,
+		Name:       "github",
+		ProjectID:  projectID,
+		Version:    provifv1.V1,
+		Implements: []db.ProviderType{db.ProviderTypeGithub, db.ProviderTypeGit},
+		Definition: json.RawMessage(fmt.Sprintf(`{"github": {"endpoint": %q}}`, srv.URL+"/")),
+	}
+	mockStore.EXPECT().GetProviderByName(gomock.Any(), db.GetProviderByNameParams{
+		Name:      "github",
+		ProjectID: projectID,
+	}).Return(prov, nil)
+	mockStore.EXPECT().ListRegisteredRepositoriesByProjectIDAndProvider(gomock.Any(),
+		db.ListRegisteredRepositoriesByProjectIDAndProviderParams{
+			Provider:  "github",
+			ProjectID: projectID,
+		}).Return([]db.Repository{
+		{RepoOwner: "acme", RepoName: "api", WebhookID: sql.NullInt32{Int32: 42, Valid: true}},
+		{RepoOwner: "acme", RepoName: "nohook"},
+	}, nil)
+	mockStore.EXPECT().GetAccessTokenByProjectID(gomock.Any(), gomock.Any()).Return(db.ProviderAccessToken{
+		Provider:       "github",
+		ProjectID:      projectID,
+		EncryptedToken: base64.StdEncoding.EncodeToString(encToken),
+	}, nil)
+	mockStore.EXPECT().DeleteProvider(gomock.Any(), db.DeleteProviderParams{
+		ID:        providerID,
+		ProjectID: projectID,
+	}).Return(nil)
+
+	resp, err := s.DeleteProvider(providersTestContext(projectID), &pb.DeleteProviderRequest{
+		Name:      "github",
+		ProjectId: projectID.String(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "github", resp.GetName())
+	assert.Equal(t, int32(1), hooksDeleted.Load())
+}
@@ -0,0 +1,397 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stacklok/minder/internal/auth"
+	"github.com/stacklok/minder/internal/db"
+	"github.com/stacklok/minder/internal/engine"
+	"github.com/stacklok/minder/internal/engine/actions/remediate"
+	engif "github.com/stacklok/minder/internal/engine/interfaces"
+	"github.com/stacklok/minder/internal/entities"
+	"github.com/stacklok/minder/internal/providers"
+	"github.com/stacklok/minder/internal/util"
+	minderv1 "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	rollbackStatusSuccess      = "success"
+	rollbackStatusFailure      = "failure"
+	rollbackStatusNotSupported = "not_supported"
+)
+
+// ListPendingRemediations lists the remediations of a project waiting for approval
+func (s *Server) ListPendingRemediations(ctx context.Context,
+	in *minderv1.ListPendingRemediationsRequest) (*minderv1.ListPendingRemediationsResponse, error) {
+	ctx, err := s.authAndContextValidation(ctx, in.GetContext())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error ensuring default group: %v", err)
+	}
+
+	entityCtx := engine.EntityFromContext(ctx)
+
+	approvals, err := s.store.ListRemediationApprovalsByProject(ctx, db.ListRemediationApprovalsByProjectParams{
+		ProjectID: entityCtx.GetProject().GetID(),
+		Status:    db.RemediationApprovalStatusPending,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list pending remediations: %s", err)
+	}
+
+	resp := &minderv1.ListPendingRemediationsResponse{
+		Remediations: make([]*minderv1.RemediationApproval, 0, len(approvals)),
+	}
+	for _, approval := range approvals {
+		resp.Remediations = append(resp.Remediations, remediationApprovalToPb(approval))
+	}
+
+	return resp, nil
+}
+
+// ApproveRemediation approves a pending remediation and triggers the evaluation
+// of its entity, which performs the remediation if the rule still fails
+func (s *Server) ApproveRemediation(ctx context.Context,
+	in *minderv1.ApproveRemediationRequest) (*minderv1.ApproveRemediationResponse, error) {
+	ctx, err := s.authAndContextValidation(ctx, in.GetContext())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error ensuring default group: %v", err)
+	}
+
+	approval, err := s.reviewRemediation(ctx, in.GetId(), db.RemediationApprovalStatusApproved)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.publishApprovedRemediation(ctx, approval); err != nil {
+		// The remediation is performed the next time the entity is evaluated
+		zerolog.Ctx(ctx).Error().Err(err).Str("id", in.GetId()).Msg("error triggering the evaluation of the entity")
+	}
+
+	return &minderv1.ApproveRemediationResponse{
+		Remediation: remediationApprovalToPb(*approval),
+	}, nil
+}
+
+// RejectRemediation rejects a pending remediation
+func (s *Server) RejectRemediation(ctx context.Context,
+	in *minderv1.RejectRemediationRequest) (*minderv1.RejectRemediationResponse, error) {
+	ctx, err := s.authAndContextValidation(ctx, in.GetContext())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error ensuring default group: %v", err)
+	}
+
+	approval, err := s.reviewRemediation(ctx, in.GetId(), db.RemediationApprovalStatusRejected)
+	if err != nil {
+		return nil, err
+	}
+
+	return &minderv1.RejectRemediationResponse{
+		Remediation: remediationApprovalToPb(*approval),
+	}, nil
+}
+
+// reviewRemediation sets the status of a pending remediation on behalf of the user
+func (s *Server) reviewRemediation(
+	ctx context.Context,
+	id string,
+	reviewStatus db.RemediationApprovalStatus,
+) (*db.ListRemediationApprovalsByProjectRow, error) {
+	entityCtx := engine.EntityFromContext(ctx)
+
+	parsedID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid remediation ID")
+	}
+
+	row, err := s.store.GetRemediationApprovalByID(ctx, db.GetRemediationApprovalByIDParams{
+		ID:        parsedID,
+		ProjectID: entityCtx.GetProject().GetID(),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "remediation not found")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get remediation: %s", err)
+	}
+	approval := db.ListRemediationApprovalsByProjectRow(row)
+
+	if approval.Status != db.RemediationApprovalStatusPending {
+		return nil, util.UserVisibleError(codes.FailedPrecondition,
+			"remediation is not pending approval, its status is %s", approval.Status)
+	}
+
+	reviewed, err := s.store.ReviewRemediationApproval(ctx, db.ReviewRemediationApprovalParams{
+		ID:         parsedID,
+		ProjectID:  entityCtx.GetProject().GetID(),
+		Status:     reviewStatus,
+		ReviewedBy: strconv.Itoa(int(auth.GetPermissionsFromContext(ctx).UserId)),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		// reviewed concurrently, or expired in the meantime
+		return nil, util.UserVisibleError(codes.FailedPrecondition, "remediation is not pending approval anymore")
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to review remediation: %s", err)
+	}
+
+	approval.Status = reviewed.Status
+	approval.UpdatedAt = reviewed.UpdatedAt
+	return &approval, nil
+}
+
+// publishApprovedRemediation publishes an event to evaluate the entity of an
+// approved remediation. Pull requests are evaluated on their next update.
+func (s *Server) publishApprovedRemediation(
+	ctx context.Context,
+	approval *db.ListRemediationApprovalsByProjectRow,
+) error {
+	entityCtx := engine.EntityFromContext(ctx)
+	if !approval.RepositoryID.Valid {
+		return fmt.Errorf("remediation is not bound to a repository")
+	}
+
+	dbrepo, err := s.store.GetRepositoryByID(ctx, approval.RepositoryID.UUID)
+	if err != nil {
+		return fmt.Errorf("error getting repository: %w", err)
+	}
+
+	eiw := engine.NewEntityInfoWrapper().
+		WithProvider(dbrepo.Provider).
+		WithProjectID(entityCtx.GetProject().GetID()).
+		WithRepositoryID(dbrepo.ID)
+
+	switch approval.Entity {
+	case db.EntitiesRepository:
+		eiw.WithRepository(&minderv1.Repository{
+			Owner:     dbrepo.RepoOwner,
+			Name:      dbrepo.RepoName,
+			RepoId:    dbrepo.RepoID,
+			HookUrl:   dbrepo.WebhookUrl,
+			DeployUrl: dbrepo.DeployUrl,
+			CloneUrl:  dbrepo.CloneUrl,
+			CreatedAt: timestamppb.New(dbrepo.CreatedAt),
+			UpdatedAt: timestamppb.New(dbrepo.UpdatedAt),
+		})
+	case db.EntitiesArtifact:
+		artifact, err := util.GetArtifactWithVersions(ctx, s.store, dbrepo.ID, approval.ArtifactID.UUID)
+		if err != nil {
+			return fmt.Errorf("error getting artifact versions: %w", err)
+		}
+		eiw.WithArtifact(artifact).WithArtifactID(approval.ArtifactID.UUID)
+	case db.EntitiesPullRequest, db.EntitiesBuildEnvironment:
+		return nil
+	}
+
+	return eiw.Publish(s.evt)
+}
+
+// RollbackRemediation restores the state the entities had before they were
+// remediated by a rule type, using the state recorded by the remediations
+func (s *Server) RollbackRemediation(ctx context.Context,
+	in *minderv1.RollbackRemediationRequest) (*minderv1.RollbackRemediationResponse, error) {
+	ctx, err := s.authAndContextValidation(ctx, in.GetContext())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error ensuring default group: %v", err)
+	}
+
+	entityCtx := engine.EntityFromContext(ctx)
+	projectID := entityCtx.GetProject().GetID()
+
+	if in.GetRuleType() == "" {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "rule type is required")
+	}
+
+	params := db.ListRemediationsForRollbackParams{
+		ProjectID: projectID,
+		Provider:  entityCtx.GetProvider().Name,
+		Name:      in.GetRuleType(),
+	}
+	if in.RuleTypeRevision != nil {
+		params.RuleTypeRevision = sql.NullInt32{Int32: in.GetRuleTypeRevision(), Valid: true}
+	}
+	if e := in.GetEntity(); e != nil {
+		entityID := uuid.NullUUID{}
+		if err := entityID.Scan(e.GetId()); err != nil || !entityID.Valid {
+			return nil, util.UserVisibleError(codes.InvalidArgument, "invalid entity ID")
+		}
+		switch e.GetType() {
+		case minderv1.Entity_ENTITY_REPOSITORIES:
+			params.RepositoryID = entityID
+		case minderv1.Entity_ENTITY_ARTIFACTS:
+			params.ArtifactID = entityID
+		case minderv1.Entity_ENTITY_PULL_REQUESTS:
+			params.PullRequestID = entityID
+		default:
+			return nil, util.UserVisibleError(codes.InvalidArgument,
+				"invalid entity type %s, please use one of %s", e.GetType(), entities.KnownTypesCSV())
+		}
+	}
+
+	rows, err := s.store.ListRemediationsForRollback(ctx, params)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list remediations: %s", err)
+	}
+
+	resp := &minderv1.RollbackRemediationResponse{
+		Results: make([]*minderv1.RollbackRemediationResult, 0, len(rows)),
+	}
+	if len(rows) == 0 {
+		return resp, nil
+	}
+
+	provider, err := s.store.GetProviderByName(ctx, db.GetProviderByNameParams{
+		Name:      entityCtx.GetProvider().Name,
+		ProjectID: projectID,
+	})
+	if err != nil {
+		return nil, providerError(fmt.Errorf("provider error: %w", err))
+	}
+
+	pbOpts := []providers.ProviderBuilderOption{
+		providers.WithProviderMetrics(s.provMt),
+		providers.WithGitHubApp(s.ghApp),
+	}
+	pbuild, err := providers.GetProviderBuilder(ctx, provider, projectID, s.store, s.cryptoEngine, pbOpts...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot get provider builder: %v", err)
+	}
+
+	for _, row := range rows {
+		resp.Results = append(resp.Results, s.rollbackRemediation(ctx, pbuild, row))
+	}
+
+	return resp, nil
+}
+
+// rollbackRemediation restores the state recorded by a single remediation
+func (s *Server) rollbackRemediation(
+	ctx context.Context,
+	pbuild *providers.ProviderBuilder,
+	row db.ListRemediationsForRollbackRow,
+) *minderv1.RollbackRemediationResult {
+	res := &minderv1.RollbackRemediationResult{
+		Profile:          row.ProfileName,
+		ActionName:       row.ActionName,
+		RuleTypeRevision: row.RuleTypeRevision,
+		Entity:           string(row.Entity),
+		EntityInfo: remediationEntityInfo(row.Provider, row.RepositoryID, row.RepoOwner, row.RepoName,
+			row.ArtifactID, row.PrNumber),
+	}
+
+	rollbacker, err := remediationRollbacker(row, pbuild)
+	if err != nil {
+		res.Status = rollbackStatusNotSupported
+		res.Details = err.Error()
+		return res
+	}
+
+	if err := rollbacker.Rollback(ctx, row.Metadata); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Str("action_name", row.ActionName).Msg("error rolling back remediation")
+		res.Status = rollbackStatusFailure
+		res.Details = err.Error()
+		return res
+	}
+
+	if err := s.store.RollbackRuleDetailsRemediate(ctx, db.RollbackRuleDetailsRemediateParams{
+		ID:      row.ID,
+		Details: "remediation rolled back",
+	}); err != nil {
+		// the state was restored nonetheless, a second rollback restores it again
+		zerolog.Ctx(ctx).Error().Err(err).Str("action_name", row.ActionName).Msg("error recording the rollback")
+	}
+
+	res.Status = rollbackStatusSuccess
+	return res
+}
+
+// remediationRollbacker creates the remediation of the rule type able to roll
+// back the given remediation
+func remediationRollbacker(
+	row db.ListRemediationsForRollbackRow,
+	pbuild *providers.ProviderBuilder,
+) (engif.ActionRollbacker, error) {
+	def, err := engine.RuleDefFromDB(&db.RuleType{Definition: row.RuleTypeDefinition})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, remCfg := range def.GetRemediateActions() {
+		if remCfg.GetActionName() != row.ActionName {
+			continue
+		}
+		action, err := remediate.NewRuleRemediator(remCfg, pbuild)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create remediation: %w", err)
+		}
+		rollbacker, ok := action.(engif.ActionRollbacker)
+		if !ok {
+			return nil, fmt.Errorf("%s remediations can't be rolled back", remCfg.GetType())
+		}
+		return rollbacker, nil
+	}
+	return nil, fmt.Errorf("remediation %s is no longer defined by the rule type", row.ActionName)
+}
+
+func remediationEntityInfo(
+	provider sql.NullString,
+	repositoryID uuid.NullUUID,
+	repoOwner, repoName sql.NullString,
+	artifactID uuid.NullUUID,
+	prNumber sql.NullInt64,
+) map[string]string {
+	entityInfo := map[string]string{}
+	if provider.Valid {
+		entityInfo["provider"] = provider.String
+	}
+	if repositoryID.Valid {
+		entityInfo["repo_owner"] = repoOwner.String
+		entityInfo["repo_name"] = repoName.String
+		entityInfo["repository_id"] = repositoryID.UUID.String()
+	}
+	if artifactID.Valid {
+		entityInfo["artifact_id"] = artifactID.UUID.String()
+	}
+	if prNumber.Valid {
+		entityInfo["pr_number"] = strconv.FormatInt(prNumber.Int64, 10)
+	}
+	return entityInfo
+}
+
+func remediationApprovalToPb(approval db.ListRemediationApprovalsByProjectRow) *minderv1.RemediationApproval {
+	return &minderv1.RemediationApproval{
+		Id:              approval.ID.String(),
+		Profile:         approval.ProfileName,
+		RuleType:        approval.RuleTypeName,
+		ActionName:      approval.ActionName,
+		RemediationType: approval.RemediationType,
+		Entity:          string(approval.Entity),
+		EntityInfo: remediationEntityInfo(approval.Provider, approval.RepositoryID, approval.RepoOwner,
+			approval.RepoName, approval.ArtifactID, approval.PrNumber),
+		Preview:   approval.Preview,
+		Status:    string(approval.Status),
+		CreatedAt: timestamppb.New(approval.CreatedAt),
+		UpdatedAt: timestamppb.New(approval.UpdatedAt),
+	}
+}
@@ -0,0 +1,360 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mockdb "github.com/stacklok/minder/database/mock"
+	"github.com/stacklok/minder/internal/auth"
+	"github.com/stacklok/minder/internal/db"
+	"github.com/stacklok/minder/internal/providers"
+	"github.com/stacklok/minder/internal/util"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+func TestReviewRemediation(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	projectID := uuid.New()
+	approvalID := uuid.New()
+	repoID := uuid.New()
+
+	pendingApproval := db.GetRemediationApprovalByIDRow{
+		ID:              approvalID,
+		ActionName:      "branch_protection",
+		RemediationType: "gh_branch_protection",
+		Preview:         "curl -X PUT https://api.github.com/repos/foo/bar/branches/main/protection",
+		Status:          db.RemediationApprovalStatusPending,
+		Entity:          db.EntitiesRepository,
+		RepositoryID:    uuid.NullUUID{UUID: repoID, Valid: true},
+		Provider:        sql.NullString{String: "github", Valid: true},
+		RepoOwner:       sql.NullString{String: "foo", Valid: true},
+		RepoName:        sql.NullString{String: "bar", Valid: true},
+		ProfileName:     "profile",
+		RuleTypeName:    "branch_protection",
+	}
+
+	tests := []struct {
+		name       string
+		approve    bool
+		buildStubs func(store *mockdb.MockStore)
+		wantStatus string
+		wantCode   codes.Code
+	}{
+		{
+			name:    "approve pending remediation",
+			approve: true,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetRemediationApprovalByID(gomock.Any(), db.GetRemediationApprovalByIDParams{
+						ID:        approvalID,
+						ProjectID: projectID,
+					}).
+					Return(pendingApproval, nil)
+				store.EXPECT().
+					ReviewRemediationApproval(gomock.Any(), db.ReviewRemediationApprovalParams{
+						ID:         approvalID,
+						ProjectID:  projectID,
+						Status:     db.RemediationApprovalStatusApproved,
+						ReviewedBy: "1",
+					}).
+					Return(db.RemediationApproval{ID: approvalID, Status: db.RemediationApprovalStatusApproved}, nil)
+				store.EXPECT().
+					GetRepositoryByID(gomock.Any(), repoID).
+					Return(db.Repository{ID: repoID, Provider: "github", RepoOwner: "foo", RepoName: "bar"}, nil)
+			},
+			wantStatus: "approved",
+			wantCode:   codes.OK,
+		},
+		{
+			name: "reject pending remediation",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetRemediationApprovalByID(gomock.Any(), gomock.Any()).
+					Return(pendingApproval, nil)
+				store.EXPECT().
+					ReviewRemediationApproval(gomock.Any(), gomock.Any()).
+					Return(db.RemediationApproval{ID: approvalID, Status: db.RemediationApprovalStatusRejected}, nil)
+			},
+			wantStatus: "rejected",
+			wantCode:   codes.OK,
+		},
+		{
+			name:    "remediation that is not pending cannot be reviewed",
+			approve: true,
+			buildStubs: func(store *mockdb.MockStore) {
+				executed := pendingApproval
+				executed.Status = db.RemediationApprovalStatusExecuted
+				store.EXPECT().
+					GetRemediationApprovalByID(gomock.Any(), gomock.Any()).
+					Return(executed, nil)
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name: "unknown remediation",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetRemediationApprovalByID(gomock.Any(), gomock.Any()).
+					Return(db.GetRemediationApprovalByIDRow{}, sql.ErrNoRows)
+			},
+			wantCode: codes.NotFound,
+		},
+	}
+
+	ctx := auth.WithPermissionsContext(context.Background(), auth.UserPermissions{
+		UserId:         1,
+		OrganizationId: orgID,
+		ProjectIds:     []uuid.UUID{projectID},
+		Roles: []auth.RoleInfo{
+			{RoleID: 1, IsAdmin: true, ProjectID: &projectID, OrganizationID: orgID}},
+	})
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().
+				GetProjectByID(gomock.Any(), projectID).
+				Return(db.Project{ID: projectID, Name: "project"}, nil)
+			store.EXPECT().
+				GetProjectByName(gomock.Any(), "project").
+				Return(db.Project{ID: projectID, Name: "project"}, nil)
+			store.EXPECT().
+				GetProviderByName(gomock.Any(), gomock.Any()).
+				Return(db.Provider{ID: uuid.New(), Name: "github"}, nil)
+			tt.buildStubs(store)
+
+			server := newDefaultServer(t, store)
+			reqCtx := &pb.Context{Provider: "github"}
+
+			var remediation *pb.RemediationApproval
+			var err error
+			if tt.approve {
+				var resp *pb.ApproveRemediationResponse
+				resp, err = server.ApproveRemediation(ctx, &pb.ApproveRemediationRequest{
+					Context: reqCtx,
+					Id:      approvalID.String(),
+				})
+				remediation = resp.GetRemediation()
+			} else {
+				var resp *pb.RejectRemediationResponse
+				resp, err = server.RejectRemediation(ctx, &pb.RejectRemediationRequest{
+					Context: reqCtx,
+					Id:      approvalID.String(),
+				})
+				remediation = resp.GetRemediation()
+			}
+
+			if tt.wantCode != codes.OK {
+				require.Error(t, err)
+				var nice *util.NiceStatus
+				if errors.As(err, &nice) {
+					assert.Equal(t, tt.wantCode, nice.Code)
+				} else {
+					assert.Equal(t, tt.wantCode, status.Code(err))
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, remediation.Status)
+			assert.Equal(t, "bar", remediation.EntityInfo["repo_name"])
+			assert.Equal(t, pendingApproval.Preview, remediation.Preview)
+		})
+	}
+}
+
+func TestRollbackRemediation(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	projectID := uuid.New()
+	repoID := uuid.New()
+	revision := int32(2)
+
+	tests := []struct {
+		name        string
+		req         *pb.RollbackRemediationRequest
+		buildStubs  func(store *mockdb.MockStore)
+		wantResults int
+		wantCode    codes.Code
+	}{
+		{
+			name:       "rule type is required",
+			req:        &pb.RollbackRemediationRequest{},
+			buildStubs: func(_ *mockdb.MockStore) {},
+			wantCode:   codes.InvalidArgument,
+		},
+		{
+			name: "invalid entity ID",
+			req: &pb.RollbackRemediationRequest{
+				RuleType: "branch_protection",
+				Entity:   &pb.EntityTypedId{Type: pb.Entity_ENTITY_REPOSITORIES, Id: "foo"},
+			},
+			buildStubs: func(_ *mockdb.MockStore) {},
+			wantCode:   codes.InvalidArgument,
+		},
+		{
+			name: "remediations are filtered by revision and entity",
+			req: &pb.RollbackRemediationRequest{
+				RuleType:         "branch_protection",
+				RuleTypeRevision: &revision,
+				Entity:           &pb.EntityTypedId{Type: pb.Entity_ENTITY_REPOSITORIES, Id: repoID.String()},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListRemediationsForRollback(gomock.Any(), db.ListRemediationsForRollbackParams{
+						ProjectID:        projectID,
+						Provider:         "github",
+						Name:             "branch_protection",
+						RuleTypeRevision: sql.NullInt32{Int32: revision, Valid: true},
+						RepositoryID:     uuid.NullUUID{UUID: repoID, Valid: true},
+					}).
+					Return(nil, nil)
+			},
+			wantCode: codes.OK,
+		},
+	}
+
+	ctx := auth.WithPermissionsContext(context.Background(), auth.UserPermissions{
+		UserId:         1,
+		OrganizationId: orgID,
+		ProjectIds:     []uuid.UUID{projectID},
+		Roles: []auth.RoleInfo{
+			{RoleID: 1, IsAdmin: true, ProjectID: &projectID, OrganizationID: orgID}},
+	})
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().
+				GetProjectByID(gomock.Any(), projectID).
+				Return(db.Project{ID: projectID, Name: "project"}, nil)
+			store.EXPECT().
+				GetProjectByName(gomock.Any(), "project").
+				Return(db.Project{ID: projectID, Name: "project"}, nil)
+			store.EXPECT().
+				GetProviderByName(gomock.Any(), gomock.Any()).
+				Return(db.Provider{ID: uuid.New(), Name: "github"}, nil)
+			tt.buildStubs(store)
+
+			server := newDefaultServer(t, store)
+			tt.req.Context = &pb.Context{Provider: "github"}
+
+			resp, err := server.RollbackRemediation(ctx, tt.req)
+			if tt.wantCode != codes.OK {
+				require.Error(t, err)
+				var nice *util.NiceStatus
+				if errors.As(err, &nice) {
+					assert.Equal(t, tt.wantCode, nice.Code)
+				} else {
+					assert.Equal(t, tt.wantCode, status.Code(err))
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, resp.Results, tt.wantResults)
+		})
+	}
+}
+
+func TestRemediationRollbacker(t *testing.T) {
+	t.Parallel()
+
+	pbuild := providers.NewProviderBuilder(
+		&db.Provider{
+			Name:       "github",
+			Version:    provifv1.V1,
+			Implements: []db.ProviderType{db.ProviderTypeGithub, db.ProviderTypeRest},
+			Definition: json.RawMessage(`{"github": {"endpoint": "https://api.github.com/"}}`),
+		},
+		db.ProviderAccessToken{},
+		"token",
+	)
+
+	definition := json.RawMessage(`{
+		"remediate": {"type": "gh_branch_protection", "gh_branch_protection": {"patch": "{}"}},
+		"remediations": [{
+			"type": "pull_request",
+			"pull_request": {"title": "title", "body": "body", "contents": [{"path": "README", "content": "foo"}]}
+		}]
+	}`)
+
+	tests := []struct {
+		name       string
+		actionName string
+		wantErr    string
+	}{
+		{
+			name:       "branch protection can be rolled back",
+			actionName: "gh_branch_protection",
+		},
+		{
+			name:       "pull requests can't be rolled back",
+			actionName: "pull_request",
+			wantErr:    "can't be rolled back",
+		},
+		{
+			name:       "remediation removed from the rule type",
+			actionName: "rest",
+			wantErr:    "no longer defined",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rollbacker, err := remediationRollbacker(db.ListRemediationsForRollbackRow{
+				ActionName:         tt.actionName,
+				RuleTypeDefinition: definition,
+			}, pbuild)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, rollbacker)
+		})
+	}
+}
@@ -61,6 +61,7 @@ func (s *Server) RegisterRepository(ctx context.Context,
 
 	pbOpts := []providers.ProviderBuilderOption{
 		providers.WithProviderMetrics(s.provMt),
+		providers.WithGitHubApp(s.ghApp),
 	}
 	p, err := providers.GetProviderBuilder(ctx, provider, projectID, s.store, s.cryptoEngine, pbOpts...)
 	if err != nil {
@@ -75,7 +76,7 @@ func (s *Server) RegisterRepository(ctx context.Context,
 	repo := in.GetRepository()
 
 	allEvents := []string{"*"}
-	result, err := s.registerWebhookForRepository(
+	result, webhookSecret, err := s.registerWebhookForRepository(
 		ctx, p, projectID, repo, allEvents)
 	if err != nil {
 		return nil, util.UserVisibleError(codes.Internal, "cannot register webhook: %v", err)
@@ -105,9 +106,10 @@ func (s *Server) RegisterRepository(ctx context.Context,
 			Int32: int32(r.HookId),
 			Valid: true,
 		},
-		CloneUrl:   r.CloneUrl,
-		WebhookUrl: r.HookUrl,
-		DeployUrl:  r.DeployUrl,
+		CloneUrl:      r.CloneUrl,
+		WebhookUrl:    r.HookUrl,
+		DeployUrl:     r.DeployUrl,
+		WebhookSecret: webhookSecret,
 	})
 	// even if we set the webhook, if we couldn't create it in the database, we'll return an error
 	if err != nil {
@@ -436,6 +438,7 @@ func (s *Server) ListRemoteRepositoriesFromProvider(
 
 	pbOpts := []providers.ProviderBuilderOption{
 		providers.WithProviderMetrics(s.provMt),
+		providers.WithGitHubApp(s.ghApp),
 	}
 	p, err := providers.GetProviderBuilder(ctx, provider, projectID, s.store, s.cryptoEngine, pbOpts...)
 	if err != nil {
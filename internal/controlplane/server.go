@@ -50,6 +50,7 @@ import (
 	"github.com/stacklok/minder/internal/db"
 	"github.com/stacklok/minder/internal/events"
 	"github.com/stacklok/minder/internal/logger"
+	ghprov "github.com/stacklok/minder/internal/providers/github"
 	provtelemetry "github.com/stacklok/minder/internal/providers/telemetry"
 	"github.com/stacklok/minder/internal/util"
 	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
@@ -68,6 +69,7 @@ type Server struct {
 	evt        *events.Eventer
 	mt         *metrics
 	provMt     provtelemetry.ProviderMetrics
+	ghApp      *ghprov.App
 	grpcServer *grpc.Server
 	vldtr      auth.JwtValidator
 	pb.UnimplementedHealthServiceServer
@@ -77,6 +79,7 @@ type Server struct {
 	pb.UnimplementedProfileServiceServer
 	pb.UnimplementedArtifactServiceServer
 	pb.UnimplementedKeyServiceServer
+	pb.UnimplementedProvidersServiceServer
 	OAuth2       *oauth2.Config
 	ClientID     string
 	ClientSecret string
@@ -93,6 +96,14 @@ func WithProviderMetrics(mt provtelemetry.ProviderMetrics) ServerOption {
 	}
 }
 
+// WithGitHubApp sets the GitHub App used to authenticate GitHub providers
+// that have an installation
+func WithGitHubApp(app *ghprov.App) ServerOption {
+	return func(s *Server) {
+		s.ghApp = app
+	}
+}
+
 // NewServer creates a new server instance
 func NewServer(
 	store db.Store,
@@ -279,6 +290,11 @@ func (s *Server) StartHTTPServer(ctx context.Context) error {
 
 	mux.Handle("/", gwmux)
 	mux.Handle("/api/v1/webhook/", mw(s.HandleGitHubWebHook()))
+	mux.Handle("/api/v1/webhook/gitlab/", mw(s.HandleGitLabWebHook()))
+	mux.Handle("/api/v1/webhook/gitea/", mw(s.HandleGiteaWebHook()))
+	if s.ghApp != nil {
+		mux.Handle("/api/v1/webhook/github-app", mw(s.HandleGitHubAppWebHook()))
+	}
 	mux.Handle("/static/", fs)
 
 	errch := make(chan error)
@@ -192,6 +192,33 @@ func (e *Engine) DecryptOAuthToken(encToken string) (oauth2.Token, error) {
 	return decryptedToken, nil
 }
 
+// EncryptString encrypts a secret to be stored in the database, the result
+// is base64 encoded
+func (e *Engine) EncryptString(data string) (string, error) {
+	encrypted, err := EncryptBytes(e.encryptionKey, []byte(data))
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+// DecryptString decrypts a secret encrypted with EncryptString
+func (e *Engine) DecryptString(encData string) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(encData)
+	if err != nil {
+		return "", err
+	}
+	if len(decoded) < aes.BlockSize {
+		return "", errors.New("encrypted data is too short")
+	}
+
+	decrypted, err := decryptBytes(e.encryptionKey, decoded)
+	if err != nil {
+		return "", err
+	}
+	return string(decrypted), nil
+}
+
 // decryptBytes decrypts a row of data
 func decryptBytes(key string, ciphertext []byte) ([]byte, error) {
 	block, err := aes.NewCipher(deriveKey(key))
@@ -71,6 +71,22 @@ func TestEncryptDecryptBytes(t *testing.T) {
 	assert.Equal(t, "test", string(decrypted))
 }
 
+func TestEncryptDecryptString(t *testing.T) {
+	t.Parallel()
+
+	engine := NewEngine("test")
+	encrypted, err := engine.EncryptString("s3cr3t")
+	assert.Nil(t, err)
+	assert.NotEqual(t, "s3cr3t", encrypted)
+
+	decrypted, err := engine.DecryptString(encrypted)
+	assert.Nil(t, err)
+	assert.Equal(t, "s3cr3t", decrypted)
+
+	_, err = engine.DecryptString("c2hvcnQ=")
+	assert.Error(t, err)
+}
+
 func TestGenerateNonce(t *testing.T) {
 	t.Parallel()
 
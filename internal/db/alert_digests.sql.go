@@ -0,0 +1,285 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.23.0
+// source: alert_digests.sql
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const claimAlertDigest = `-- name: ClaimAlertDigest :one
+
+UPDATE alert_digests SET last_sent_at = NOW()
+WHERE project_id = $1 AND channel = $2
+  AND last_sent_at + (CASE schedule WHEN 'daily' THEN INTERVAL '1 day' ELSE INTERVAL '1 hour' END) <= NOW()
+RETURNING project_id, channel, provider, schedule, alert_config, last_sent_at, created_at
+`
+
+type ClaimAlertDigestParams struct {
+	ProjectID uuid.UUID `json:"project_id"`
+	Channel   string    `json:"channel"`
+}
+
+// ClaimAlertDigest marks a due digest as sent before delivering it, so that
+// concurrent flushes don't deliver it twice. It returns no rows if the digest
+// is not due anymore, e.g. because another flush claimed it.
+func (q *Queries) ClaimAlertDigest(ctx context.Context, arg ClaimAlertDigestParams) (AlertDigest, error) {
+	row := q.db.QueryRowContext(ctx, claimAlertDigest, arg.ProjectID, arg.Channel)
+	var i AlertDigest
+	err := row.Scan(
+		&i.ProjectID,
+		&i.Channel,
+		&i.Provider,
+		&i.Schedule,
+		&i.AlertConfig,
+		&i.LastSentAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteResolvedAlertDigestFindings = `-- name: DeleteResolvedAlertDigestFindings :exec
+
+DELETE FROM alert_digest_findings
+WHERE id = ANY($1::UUID[]) AND status = 'resolved'
+  AND updated_at <= $2::TIMESTAMP
+`
+
+type DeleteResolvedAlertDigestFindingsParams struct {
+	Ids         []uuid.UUID `json:"ids"`
+	ListedUntil time.Time   `json:"listed_until"`
+}
+
+// DeleteResolvedAlertDigestFindings deletes the findings which were resolved
+// when listed for a digest. A finding updated since, by an evaluation running
+// concurrently with the delivery, is kept for the next digest.
+func (q *Queries) DeleteResolvedAlertDigestFindings(ctx context.Context, arg DeleteResolvedAlertDigestFindingsParams) error {
+	_, err := q.db.ExecContext(ctx, deleteResolvedAlertDigestFindings, pq.Array(arg.Ids), arg.ListedUntil)
+	return err
+}
+
+const listAlertDigestFindings = `-- name: ListAlertDigestFindings :many
+SELECT id, project_id, channel, profile_name, rule_type, entity_type, entity_name, status, details, guidance, notified_at, created_at, updated_at FROM alert_digest_findings WHERE project_id = $1 AND channel = $2
+ORDER BY rule_type, entity_name, profile_name
+`
+
+type ListAlertDigestFindingsParams struct {
+	ProjectID uuid.UUID `json:"project_id"`
+	Channel   string    `json:"channel"`
+}
+
+func (q *Queries) ListAlertDigestFindings(ctx context.Context, arg ListAlertDigestFindingsParams) ([]AlertDigestFinding, error) {
+	rows, err := q.db.QueryContext(ctx, listAlertDigestFindings, arg.ProjectID, arg.Channel)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AlertDigestFinding{}
+	for rows.Next() {
+		var i AlertDigestFinding
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProjectID,
+			&i.Channel,
+			&i.ProfileName,
+			&i.RuleType,
+			&i.EntityType,
+			&i.EntityName,
+			&i.Status,
+			&i.Details,
+			&i.Guidance,
+			&i.NotifiedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDueAlertDigests = `-- name: ListDueAlertDigests :many
+
+SELECT project_id, channel, provider, schedule, alert_config, last_sent_at, created_at FROM alert_digests
+WHERE last_sent_at + (CASE schedule WHEN 'daily' THEN INTERVAL '1 day' ELSE INTERVAL '1 hour' END) <= NOW()
+`
+
+// ListDueAlertDigests returns the digests whose schedule has elapsed since they were last sent.
+func (q *Queries) ListDueAlertDigests(ctx context.Context) ([]AlertDigest, error) {
+	rows, err := q.db.QueryContext(ctx, listDueAlertDigests)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AlertDigest{}
+	for rows.Next() {
+		var i AlertDigest
+		if err := rows.Scan(
+			&i.ProjectID,
+			&i.Channel,
+			&i.Provider,
+			&i.Schedule,
+			&i.AlertConfig,
+			&i.LastSentAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markAlertDigestFindingsNotified = `-- name: MarkAlertDigestFindingsNotified :exec
+UPDATE alert_digest_findings SET notified_at = NOW()
+WHERE id = ANY($1::UUID[]) AND status = 'failing'
+`
+
+func (q *Queries) MarkAlertDigestFindingsNotified(ctx context.Context, ids []uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, markAlertDigestFindingsNotified, pq.Array(ids))
+	return err
+}
+
+const releaseAlertDigest = `-- name: ReleaseAlertDigest :exec
+
+UPDATE alert_digests SET last_sent_at = $3 WHERE project_id = $1 AND channel = $2
+`
+
+type ReleaseAlertDigestParams struct {
+	ProjectID  uuid.UUID `json:"project_id"`
+	Channel    string    `json:"channel"`
+	LastSentAt time.Time `json:"last_sent_at"`
+}
+
+// ReleaseAlertDigest restores the time a claimed digest was last sent at when
+// delivering it failed, so that it is retried on the next flush.
+func (q *Queries) ReleaseAlertDigest(ctx context.Context, arg ReleaseAlertDigestParams) error {
+	_, err := q.db.ExecContext(ctx, releaseAlertDigest, arg.ProjectID, arg.Channel, arg.LastSentAt)
+	return err
+}
+
+const resolveAlertDigestFinding = `-- name: ResolveAlertDigestFinding :exec
+UPDATE alert_digest_findings SET status = 'resolved', updated_at = NOW()
+WHERE project_id = $1 AND channel = $2 AND profile_name = $3 AND rule_type = $4 AND entity_type = $5 AND entity_name = $6
+`
+
+type ResolveAlertDigestFindingParams struct {
+	ProjectID   uuid.UUID `json:"project_id"`
+	Channel     string    `json:"channel"`
+	ProfileName string    `json:"profile_name"`
+	RuleType    string    `json:"rule_type"`
+	EntityType  Entities  `json:"entity_type"`
+	EntityName  string    `json:"entity_name"`
+}
+
+func (q *Queries) ResolveAlertDigestFinding(ctx context.Context, arg ResolveAlertDigestFindingParams) error {
+	_, err := q.db.ExecContext(ctx, resolveAlertDigestFinding,
+		arg.ProjectID,
+		arg.Channel,
+		arg.ProfileName,
+		arg.RuleType,
+		arg.EntityType,
+		arg.EntityName,
+	)
+	return err
+}
+
+const upsertAlertDigest = `-- name: UpsertAlertDigest :exec
+INSERT INTO alert_digests (project_id, channel, provider, schedule, alert_config)
+VALUES ($1, $2, $3, $4, $5::jsonb)
+ON CONFLICT (project_id, channel) DO UPDATE SET
+    provider = $3,
+    schedule = $4,
+    alert_config = $5::jsonb
+`
+
+type UpsertAlertDigestParams struct {
+	ProjectID   uuid.UUID       `json:"project_id"`
+	Channel     string          `json:"channel"`
+	Provider    string          `json:"provider"`
+	Schedule    string          `json:"schedule"`
+	AlertConfig json.RawMessage `json:"alert_config"`
+}
+
+func (q *Queries) UpsertAlertDigest(ctx context.Context, arg UpsertAlertDigestParams) error {
+	_, err := q.db.ExecContext(ctx, upsertAlertDigest,
+		arg.ProjectID,
+		arg.Channel,
+		arg.Provider,
+		arg.Schedule,
+		arg.AlertConfig,
+	)
+	return err
+}
+
+const upsertAlertDigestFinding = `-- name: UpsertAlertDigestFinding :one
+INSERT INTO alert_digest_findings (project_id, channel, profile_name, rule_type, entity_type, entity_name, status, details, guidance)
+VALUES ($1, $2, $3, $4, $5, $6, 'failing', $7, $8)
+ON CONFLICT (project_id, channel, profile_name, rule_type, entity_type, entity_name) DO UPDATE SET
+    status = 'failing',
+    details = $7,
+    guidance = $8,
+    updated_at = NOW()
+RETURNING id, project_id, channel, profile_name, rule_type, entity_type, entity_name, status, details, guidance, notified_at, created_at, updated_at
+`
+
+type UpsertAlertDigestFindingParams struct {
+	ProjectID   uuid.UUID `json:"project_id"`
+	Channel     string    `json:"channel"`
+	ProfileName string    `json:"profile_name"`
+	RuleType    string    `json:"rule_type"`
+	EntityType  Entities  `json:"entity_type"`
+	EntityName  string    `json:"entity_name"`
+	Details     string    `json:"details"`
+	Guidance    string    `json:"guidance"`
+}
+
+func (q *Queries) UpsertAlertDigestFinding(ctx context.Context, arg UpsertAlertDigestFindingParams) (AlertDigestFinding, error) {
+	row := q.db.QueryRowContext(ctx, upsertAlertDigestFinding,
+		arg.ProjectID,
+		arg.Channel,
+		arg.ProfileName,
+		arg.RuleType,
+		arg.EntityType,
+		arg.EntityName,
+		arg.Details,
+		arg.Guidance,
+	)
+	var i AlertDigestFinding
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.Channel,
+		&i.ProfileName,
+		&i.RuleType,
+		&i.EntityType,
+		&i.EntityName,
+		&i.Status,
+		&i.Details,
+		&i.Guidance,
+		&i.NotifiedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -18,9 +18,10 @@ import (
 type ActionType string
 
 const (
-	ActionTypeOn     ActionType = "on"
-	ActionTypeOff    ActionType = "off"
-	ActionTypeDryRun ActionType = "dry_run"
+	ActionTypeOn       ActionType = "on"
+	ActionTypeOff      ActionType = "off"
+	ActionTypeDryRun   ActionType = "dry_run"
+	ActionTypeApproval ActionType = "approval"
 )
 
 func (e *ActionType) Scan(src interface{}) error {
@@ -103,6 +104,48 @@ func (ns NullAlertStatusTypes) Value() (driver.Value, error) {
 	return string(ns.AlertStatusTypes), nil
 }
 
+type DigestFindingStatus string
+
+const (
+	DigestFindingStatusFailing  DigestFindingStatus = "failing"
+	DigestFindingStatusResolved DigestFindingStatus = "resolved"
+)
+
+func (e *DigestFindingStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = DigestFindingStatus(s)
+	case string:
+		*e = DigestFindingStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for DigestFindingStatus: %T", src)
+	}
+	return nil
+}
+
+type NullDigestFindingStatus struct {
+	DigestFindingStatus DigestFindingStatus `json:"digest_finding_status"`
+	Valid               bool                `json:"valid"` // Valid is true if DigestFindingStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullDigestFindingStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.DigestFindingStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.DigestFindingStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullDigestFindingStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.DigestFindingStatus), nil
+}
+
 type Entities string
 
 const (
@@ -192,6 +235,48 @@ func (ns NullEvalStatusTypes) Value() (driver.Value, error) {
 	return string(ns.EvalStatusTypes), nil
 }
 
+type ProviderHealth string
+
+const (
+	ProviderHealthHealthy   ProviderHealth = "healthy"
+	ProviderHealthUnhealthy ProviderHealth = "unhealthy"
+)
+
+func (e *ProviderHealth) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ProviderHealth(s)
+	case string:
+		*e = ProviderHealth(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ProviderHealth: %T", src)
+	}
+	return nil
+}
+
+type NullProviderHealth struct {
+	ProviderHealth ProviderHealth `json:"provider_health"`
+	Valid          bool           `json:"valid"` // Valid is true if ProviderHealth is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullProviderHealth) Scan(value interface{}) error {
+	if value == nil {
+		ns.ProviderHealth, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ProviderHealth.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullProviderHealth) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ProviderHealth), nil
+}
+
 type ProviderType string
 
 const (
@@ -200,6 +285,7 @@ const (
 	ProviderTypeGit        ProviderType = "git"
 	ProviderTypeOci        ProviderType = "oci"
 	ProviderTypeRepoLister ProviderType = "repo-lister"
+	ProviderTypeGitlab     ProviderType = "gitlab"
 )
 
 func (e *ProviderType) Scan(src interface{}) error {
@@ -237,14 +323,61 @@ func (ns NullProviderType) Value() (driver.Value, error) {
 	return string(ns.ProviderType), nil
 }
 
+type RemediationApprovalStatus string
+
+const (
+	RemediationApprovalStatusPending  RemediationApprovalStatus = "pending"
+	RemediationApprovalStatusApproved RemediationApprovalStatus = "approved"
+	RemediationApprovalStatusRejected RemediationApprovalStatus = "rejected"
+	RemediationApprovalStatusExecuted RemediationApprovalStatus = "executed"
+	RemediationApprovalStatusFailed   RemediationApprovalStatus = "failed"
+	RemediationApprovalStatusExpired  RemediationApprovalStatus = "expired"
+)
+
+func (e *RemediationApprovalStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RemediationApprovalStatus(s)
+	case string:
+		*e = RemediationApprovalStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RemediationApprovalStatus: %T", src)
+	}
+	return nil
+}
+
+type NullRemediationApprovalStatus struct {
+	RemediationApprovalStatus RemediationApprovalStatus `json:"remediation_approval_status"`
+	Valid                     bool                      `json:"valid"` // Valid is true if RemediationApprovalStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRemediationApprovalStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.RemediationApprovalStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RemediationApprovalStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRemediationApprovalStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RemediationApprovalStatus), nil
+}
+
 type RemediationStatusTypes string
 
 const (
-	RemediationStatusTypesSuccess      RemediationStatusTypes = "success"
-	RemediationStatusTypesFailure      RemediationStatusTypes = "failure"
-	RemediationStatusTypesError        RemediationStatusTypes = "error"
-	RemediationStatusTypesSkipped      RemediationStatusTypes = "skipped"
-	RemediationStatusTypesNotAvailable RemediationStatusTypes = "not_available"
+	RemediationStatusTypesSuccess         RemediationStatusTypes = "success"
+	RemediationStatusTypesFailure         RemediationStatusTypes = "failure"
+	RemediationStatusTypesError           RemediationStatusTypes = "error"
+	RemediationStatusTypesSkipped         RemediationStatusTypes = "skipped"
+	RemediationStatusTypesNotAvailable    RemediationStatusTypes = "not_available"
+	RemediationStatusTypesPendingApproval RemediationStatusTypes = "pending_approval"
 )
 
 func (e *RemediationStatusTypes) Scan(src interface{}) error {
@@ -282,6 +415,32 @@ func (ns NullRemediationStatusTypes) Value() (driver.Value, error) {
 	return string(ns.RemediationStatusTypes), nil
 }
 
+type AlertDigest struct {
+	ProjectID   uuid.UUID       `json:"project_id"`
+	Channel     string          `json:"channel"`
+	Provider    string          `json:"provider"`
+	Schedule    string          `json:"schedule"`
+	AlertConfig json.RawMessage `json:"alert_config"`
+	LastSentAt  time.Time       `json:"last_sent_at"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
+
+type AlertDigestFinding struct {
+	ID          uuid.UUID           `json:"id"`
+	ProjectID   uuid.UUID           `json:"project_id"`
+	Channel     string              `json:"channel"`
+	ProfileName string              `json:"profile_name"`
+	RuleType    string              `json:"rule_type"`
+	EntityType  Entities            `json:"entity_type"`
+	EntityName  string              `json:"entity_name"`
+	Status      DigestFindingStatus `json:"status"`
+	Details     string              `json:"details"`
+	Guidance    string              `json:"guidance"`
+	NotifiedAt  sql.NullTime        `json:"notified_at"`
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+}
+
 type Artifact struct {
 	ID                 uuid.UUID `json:"id"`
 	RepositoryID       uuid.UUID `json:"repository_id"`
@@ -334,14 +493,16 @@ type Feature struct {
 }
 
 type Profile struct {
-	ID        uuid.UUID      `json:"id"`
-	Name      string         `json:"name"`
-	Provider  string         `json:"provider"`
-	ProjectID uuid.UUID      `json:"project_id"`
-	Remediate NullActionType `json:"remediate"`
-	Alert     NullActionType `json:"alert"`
-	CreatedAt time.Time      `json:"created_at"`
-	UpdatedAt time.Time      `json:"updated_at"`
+	ID               uuid.UUID       `json:"id"`
+	Name             string          `json:"name"`
+	Provider         string          `json:"provider"`
+	ProjectID        uuid.UUID       `json:"project_id"`
+	Remediate        NullActionType  `json:"remediate"`
+	Alert            NullActionType  `json:"alert"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+	RemediateActions json.RawMessage `json:"remediate_actions"`
+	AlertActions     json.RawMessage `json:"alert_actions"`
 }
 
 type ProfileStatus struct {
@@ -362,14 +523,16 @@ type Project struct {
 }
 
 type Provider struct {
-	ID         uuid.UUID       `json:"id"`
-	Name       string          `json:"name"`
-	Version    string          `json:"version"`
-	ProjectID  uuid.UUID       `json:"project_id"`
-	Implements []ProviderType  `json:"implements"`
-	Definition json.RawMessage `json:"definition"`
-	CreatedAt  time.Time       `json:"created_at"`
-	UpdatedAt  time.Time       `json:"updated_at"`
+	ID           uuid.UUID       `json:"id"`
+	Name         string          `json:"name"`
+	Version      string          `json:"version"`
+	ProjectID    uuid.UUID       `json:"project_id"`
+	Implements   []ProviderType  `json:"implements"`
+	Definition   json.RawMessage `json:"definition"`
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+	Health       ProviderHealth  `json:"health"`
+	HealthDetail string          `json:"health_detail"`
 }
 
 type ProviderAccessToken struct {
@@ -383,6 +546,16 @@ type ProviderAccessToken struct {
 	UpdatedAt      time.Time      `json:"updated_at"`
 }
 
+type ProviderGithubAppInstallation struct {
+	AppInstallationID int64     `json:"app_installation_id"`
+	Provider          string    `json:"provider"`
+	ProjectID         uuid.UUID `json:"project_id"`
+	AccountLogin      string    `json:"account_login"`
+	IsOrg             bool      `json:"is_org"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
 type PullRequest struct {
 	ID           uuid.UUID `json:"id"`
 	RepositoryID uuid.UUID `json:"repository_id"`
@@ -391,21 +564,36 @@ type PullRequest struct {
 	UpdatedAt    time.Time `json:"updated_at"`
 }
 
+type RemediationApproval struct {
+	ID              uuid.UUID                 `json:"id"`
+	ProjectID       uuid.UUID                 `json:"project_id"`
+	RuleEvalID      uuid.UUID                 `json:"rule_eval_id"`
+	ActionName      string                    `json:"action_name"`
+	RemediationType string                    `json:"remediation_type"`
+	Preview         string                    `json:"preview"`
+	Status          RemediationApprovalStatus `json:"status"`
+	ReviewedBy      string                    `json:"reviewed_by"`
+	Details         string                    `json:"details"`
+	CreatedAt       time.Time                 `json:"created_at"`
+	UpdatedAt       time.Time                 `json:"updated_at"`
+}
+
 type Repository struct {
-	ID         uuid.UUID     `json:"id"`
-	Provider   string        `json:"provider"`
-	ProjectID  uuid.UUID     `json:"project_id"`
-	RepoOwner  string        `json:"repo_owner"`
-	RepoName   string        `json:"repo_name"`
-	RepoID     int32         `json:"repo_id"`
-	IsPrivate  bool          `json:"is_private"`
-	IsFork     bool          `json:"is_fork"`
-	WebhookID  sql.NullInt32 `json:"webhook_id"`
-	WebhookUrl string        `json:"webhook_url"`
-	DeployUrl  string        `json:"deploy_url"`
-	CloneUrl   string        `json:"clone_url"`
-	CreatedAt  time.Time     `json:"created_at"`
-	UpdatedAt  time.Time     `json:"updated_at"`
+	ID            uuid.UUID     `json:"id"`
+	Provider      string        `json:"provider"`
+	ProjectID     uuid.UUID     `json:"project_id"`
+	RepoOwner     string        `json:"repo_owner"`
+	RepoName      string        `json:"repo_name"`
+	RepoID        int32         `json:"repo_id"`
+	IsPrivate     bool          `json:"is_private"`
+	IsFork        bool          `json:"is_fork"`
+	WebhookID     sql.NullInt32 `json:"webhook_id"`
+	WebhookUrl    string        `json:"webhook_url"`
+	DeployUrl     string        `json:"deploy_url"`
+	CloneUrl      string        `json:"clone_url"`
+	CreatedAt     time.Time     `json:"created_at"`
+	UpdatedAt     time.Time     `json:"updated_at"`
+	WebhookSecret string        `json:"webhook_secret"`
 }
 
 type Role struct {
@@ -426,6 +614,7 @@ type RuleDetailsAlert struct {
 	Details     string           `json:"details"`
 	Metadata    json.RawMessage  `json:"metadata"`
 	LastUpdated time.Time        `json:"last_updated"`
+	ActionName  string           `json:"action_name"`
 }
 
 type RuleDetailsEval struct {
@@ -437,11 +626,14 @@ type RuleDetailsEval struct {
 }
 
 type RuleDetailsRemediate struct {
-	ID          uuid.UUID              `json:"id"`
-	RuleEvalID  uuid.UUID              `json:"rule_eval_id"`
-	Status      RemediationStatusTypes `json:"status"`
-	Details     string                 `json:"details"`
-	LastUpdated time.Time              `json:"last_updated"`
+	ID               uuid.UUID              `json:"id"`
+	RuleEvalID       uuid.UUID              `json:"rule_eval_id"`
+	Status           RemediationStatusTypes `json:"status"`
+	Details          string                 `json:"details"`
+	LastUpdated      time.Time              `json:"last_updated"`
+	ActionName       string                 `json:"action_name"`
+	Metadata         json.RawMessage        `json:"metadata"`
+	RuleTypeRevision int32                  `json:"rule_type_revision"`
 }
 
 type RuleEvaluation struct {
@@ -464,6 +656,7 @@ type RuleType struct {
 	Definition  json.RawMessage `json:"definition"`
 	CreatedAt   time.Time       `json:"created_at"`
 	UpdatedAt   time.Time       `json:"updated_at"`
+	Revision    int32           `json:"revision"`
 }
 
 type SessionStore struct {
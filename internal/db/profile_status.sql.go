@@ -116,6 +116,77 @@ func (q *Queries) GetProfileStatusByProject(ctx context.Context, projectID uuid.
 	return items, nil
 }
 
+const listRuleDetailsAlert = `-- name: ListRuleDetailsAlert :many
+SELECT id, rule_eval_id, status, details, metadata, last_updated, action_name FROM rule_details_alert WHERE rule_eval_id = $1 ORDER BY action_name
+`
+
+func (q *Queries) ListRuleDetailsAlert(ctx context.Context, ruleEvalID uuid.UUID) ([]RuleDetailsAlert, error) {
+	rows, err := q.db.QueryContext(ctx, listRuleDetailsAlert, ruleEvalID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []RuleDetailsAlert{}
+	for rows.Next() {
+		var i RuleDetailsAlert
+		if err := rows.Scan(
+			&i.ID,
+			&i.RuleEvalID,
+			&i.Status,
+			&i.Details,
+			&i.Metadata,
+			&i.LastUpdated,
+			&i.ActionName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRuleDetailsRemediate = `-- name: ListRuleDetailsRemediate :many
+SELECT id, rule_eval_id, status, details, last_updated, action_name, metadata, rule_type_revision FROM rule_details_remediate WHERE rule_eval_id = $1 ORDER BY action_name
+`
+
+func (q *Queries) ListRuleDetailsRemediate(ctx context.Context, ruleEvalID uuid.UUID) ([]RuleDetailsRemediate, error) {
+	rows, err := q.db.QueryContext(ctx, listRuleDetailsRemediate, ruleEvalID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []RuleDetailsRemediate{}
+	for rows.Next() {
+		var i RuleDetailsRemediate
+		if err := rows.Scan(
+			&i.ID,
+			&i.RuleEvalID,
+			&i.Status,
+			&i.Details,
+			&i.LastUpdated,
+			&i.ActionName,
+			&i.Metadata,
+			&i.RuleTypeRevision,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listRuleEvaluationsByProfileId = `-- name: ListRuleEvaluationsByProfileId :many
 WITH
    eval_details AS (
@@ -126,25 +197,34 @@ WITH
        last_updated AS eval_last_updated
    FROM rule_details_eval
    ),
+   -- a rule type may have several remediations and alerts, report the
+   -- most relevant status of each
    remediation_details AS (
-       SELECT
+       SELECT DISTINCT ON (rule_eval_id)
            rule_eval_id,
            status AS rem_status,
            details AS rem_details,
            last_updated AS rem_last_updated
        FROM rule_details_remediate
+       ORDER BY rule_eval_id,
+                array_position(ARRAY['failure', 'error', 'pending_approval', 'success', 'skipped', 'not_available']::remediation_status_types[], status),
+                action_name
    ),
    alert_details AS (
-       SELECT
+       SELECT DISTINCT ON (rule_eval_id)
            rule_eval_id,
            status AS alert_status,
            details AS alert_details,
            metadata AS alert_metadata,
            last_updated AS alert_last_updated
        FROM rule_details_alert
+       ORDER BY rule_eval_id,
+                array_position(ARRAY['error', 'on', 'off', 'skipped', 'not_available']::alert_status_types[], status),
+                action_name
    )
 
 SELECT
+    res.id AS rule_evaluation_id,
     ed.eval_status,
     ed.eval_last_updated,
     ed.eval_details,
@@ -189,6 +269,7 @@ type ListRuleEvaluationsByProfileIdParams struct {
 }
 
 type ListRuleEvaluationsByProfileIdRow struct {
+	RuleEvaluationID uuid.UUID                  `json:"rule_evaluation_id"`
 	EvalStatus       NullEvalStatusTypes        `json:"eval_status"`
 	EvalLastUpdated  sql.NullTime               `json:"eval_last_updated"`
 	EvalDetails      sql.NullString             `json:"eval_details"`
@@ -223,6 +304,7 @@ func (q *Queries) ListRuleEvaluationsByProfileId(ctx context.Context, arg ListRu
 	for rows.Next() {
 		var i ListRuleEvaluationsByProfileIdRow
 		if err := rows.Scan(
+			&i.RuleEvaluationID,
 			&i.EvalStatus,
 			&i.EvalLastUpdated,
 			&i.EvalDetails,
@@ -257,24 +339,26 @@ func (q *Queries) ListRuleEvaluationsByProfileId(ctx context.Context, arg ListRu
 const upsertRuleDetailsAlert = `-- name: UpsertRuleDetailsAlert :one
 INSERT INTO rule_details_alert (
     rule_eval_id,
+    action_name,
     status,
     details,
     metadata,
     last_updated
 )
-VALUES ($1, $2, $3, $4::jsonb, NOW())
-ON CONFLICT(rule_eval_id)
+VALUES ($1, $2, $3, $4, $5::jsonb, NOW())
+ON CONFLICT(rule_eval_id, action_name)
     DO UPDATE SET
-                  status = CASE WHEN $2 != 'skipped' THEN $2 ELSE rule_details_alert.status END,
-                  details = CASE WHEN $2 != 'skipped' THEN $3 ELSE rule_details_alert.details END,
-                  metadata = CASE WHEN $2 != 'skipped' THEN $4::jsonb ELSE rule_details_alert.metadata END,
+                  status = CASE WHEN $3 != 'skipped' THEN $3 ELSE rule_details_alert.status END,
+                  details = CASE WHEN $3 != 'skipped' THEN $4 ELSE rule_details_alert.details END,
+                  metadata = CASE WHEN $3 != 'skipped' THEN $5::jsonb ELSE rule_details_alert.metadata END,
                   last_updated = NOW()
-    WHERE rule_details_alert.rule_eval_id = $1
+    WHERE rule_details_alert.rule_eval_id = $1 AND rule_details_alert.action_name = $2
 RETURNING id
 `
 
 type UpsertRuleDetailsAlertParams struct {
 	RuleEvalID uuid.UUID        `json:"rule_eval_id"`
+	ActionName string           `json:"action_name"`
 	Status     AlertStatusTypes `json:"status"`
 	Details    string           `json:"details"`
 	Metadata   json.RawMessage  `json:"metadata"`
@@ -283,6 +367,7 @@ type UpsertRuleDetailsAlertParams struct {
 func (q *Queries) UpsertRuleDetailsAlert(ctx context.Context, arg UpsertRuleDetailsAlertParams) (uuid.UUID, error) {
 	row := q.db.QueryRowContext(ctx, upsertRuleDetailsAlert,
 		arg.RuleEvalID,
+		arg.ActionName,
 		arg.Status,
 		arg.Details,
 		arg.Metadata,
@@ -325,28 +410,43 @@ func (q *Queries) UpsertRuleDetailsEval(ctx context.Context, arg UpsertRuleDetai
 const upsertRuleDetailsRemediate = `-- name: UpsertRuleDetailsRemediate :one
 INSERT INTO rule_details_remediate (
     rule_eval_id,
+    action_name,
     status,
     details,
+    metadata,
+    rule_type_revision,
     last_updated
 )
-VALUES ($1, $2, $3, NOW())
-ON CONFLICT(rule_eval_id)
+VALUES ($1, $2, $3, $4, $5::jsonb, $6, NOW())
+ON CONFLICT(rule_eval_id, action_name)
     DO UPDATE SET
-                  status = $2,
-                  details = $3,
+                  status = $3,
+                  details = $4,
+                  metadata = CASE WHEN $3 != 'skipped' THEN $5::jsonb ELSE rule_details_remediate.metadata END,
+                  rule_type_revision = CASE WHEN $3 = 'success' THEN $6 ELSE rule_details_remediate.rule_type_revision END,
                   last_updated = NOW()
-    WHERE rule_details_remediate.rule_eval_id = $1
+    WHERE rule_details_remediate.rule_eval_id = $1 AND rule_details_remediate.action_name = $2
 RETURNING id
 `
 
 type UpsertRuleDetailsRemediateParams struct {
-	RuleEvalID uuid.UUID              `json:"rule_eval_id"`
-	Status     RemediationStatusTypes `json:"status"`
-	Details    string                 `json:"details"`
+	RuleEvalID       uuid.UUID              `json:"rule_eval_id"`
+	ActionName       string                 `json:"action_name"`
+	Status           RemediationStatusTypes `json:"status"`
+	Details          string                 `json:"details"`
+	Metadata         json.RawMessage        `json:"metadata"`
+	RuleTypeRevision int32                  `json:"rule_type_revision"`
 }
 
 func (q *Queries) UpsertRuleDetailsRemediate(ctx context.Context, arg UpsertRuleDetailsRemediateParams) (uuid.UUID, error) {
-	row := q.db.QueryRowContext(ctx, upsertRuleDetailsRemediate, arg.RuleEvalID, arg.Status, arg.Details)
+	row := q.db.QueryRowContext(ctx, upsertRuleDetailsRemediate,
+		arg.RuleEvalID,
+		arg.ActionName,
+		arg.Status,
+		arg.Details,
+		arg.Metadata,
+		arg.RuleTypeRevision,
+	)
 	var id uuid.UUID
 	err := row.Scan(&id)
 	return id, err
@@ -54,15 +54,19 @@ INSERT INTO profiles (
     project_id,
     remediate,
     alert,
-    name) VALUES ($1, $2, $3, $4, $5) RETURNING id, name, provider, project_id, remediate, alert, created_at, updated_at
+    name,
+    remediate_actions,
+    alert_actions) VALUES ($1, $2, $3, $4, $5, $6::jsonb, $7::jsonb) RETURNING id, name, provider, project_id, remediate, alert, created_at, updated_at, remediate_actions, alert_actions
 `
 
 type CreateProfileParams struct {
-	Provider  string         `json:"provider"`
-	ProjectID uuid.UUID      `json:"project_id"`
-	Remediate NullActionType `json:"remediate"`
-	Alert     NullActionType `json:"alert"`
-	Name      string         `json:"name"`
+	Provider         string          `json:"provider"`
+	ProjectID        uuid.UUID       `json:"project_id"`
+	Remediate        NullActionType  `json:"remediate"`
+	Alert            NullActionType  `json:"alert"`
+	Name             string          `json:"name"`
+	RemediateActions json.RawMessage `json:"remediate_actions"`
+	AlertActions     json.RawMessage `json:"alert_actions"`
 }
 
 func (q *Queries) CreateProfile(ctx context.Context, arg CreateProfileParams) (Profile, error) {
@@ -72,6 +76,8 @@ func (q *Queries) CreateProfile(ctx context.Context, arg CreateProfileParams) (P
 		arg.Remediate,
 		arg.Alert,
 		arg.Name,
+		arg.RemediateActions,
+		arg.AlertActions,
 	)
 	var i Profile
 	err := row.Scan(
@@ -83,6 +89,8 @@ func (q *Queries) CreateProfile(ctx context.Context, arg CreateProfileParams) (P
 		&i.Alert,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.RemediateActions,
+		&i.AlertActions,
 	)
 	return i, err
 }
@@ -125,7 +133,7 @@ func (q *Queries) DeleteProfile(ctx context.Context, id uuid.UUID) error {
 }
 
 const getProfileByID = `-- name: GetProfileByID :one
-SELECT id, name, provider, project_id, remediate, alert, created_at, updated_at FROM profiles WHERE id = $1
+SELECT id, name, provider, project_id, remediate, alert, created_at, updated_at, remediate_actions, alert_actions FROM profiles WHERE id = $1
 `
 
 func (q *Queries) GetProfileByID(ctx context.Context, id uuid.UUID) (Profile, error) {
@@ -140,12 +148,14 @@ func (q *Queries) GetProfileByID(ctx context.Context, id uuid.UUID) (Profile, er
 		&i.Alert,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.RemediateActions,
+		&i.AlertActions,
 	)
 	return i, err
 }
 
 const getProfileByProjectAndID = `-- name: GetProfileByProjectAndID :many
-SELECT profiles.id, name, provider, project_id, remediate, alert, profiles.created_at, profiles.updated_at, entity_profiles.id, entity, profile_id, contextual_rules, entity_profiles.created_at, entity_profiles.updated_at FROM profiles JOIN entity_profiles ON profiles.id = entity_profiles.profile_id
+SELECT profiles.id, name, provider, project_id, remediate, alert, profiles.created_at, profiles.updated_at, remediate_actions, alert_actions, entity_profiles.id, entity, profile_id, contextual_rules, entity_profiles.created_at, entity_profiles.updated_at FROM profiles JOIN entity_profiles ON profiles.id = entity_profiles.profile_id
 WHERE profiles.project_id = $1 AND profiles.id = $2
 `
 
@@ -155,20 +165,22 @@ type GetProfileByProjectAndIDParams struct {
 }
 
 type GetProfileByProjectAndIDRow struct {
-	ID              uuid.UUID       `json:"id"`
-	Name            string          `json:"name"`
-	Provider        string          `json:"provider"`
-	ProjectID       uuid.UUID       `json:"project_id"`
-	Remediate       NullActionType  `json:"remediate"`
-	Alert           NullActionType  `json:"alert"`
-	CreatedAt       time.Time       `json:"created_at"`
-	UpdatedAt       time.Time       `json:"updated_at"`
-	ID_2            uuid.UUID       `json:"id_2"`
-	Entity          Entities        `json:"entity"`
-	ProfileID       uuid.UUID       `json:"profile_id"`
-	ContextualRules json.RawMessage `json:"contextual_rules"`
-	CreatedAt_2     time.Time       `json:"created_at_2"`
-	UpdatedAt_2     time.Time       `json:"updated_at_2"`
+	ID               uuid.UUID       `json:"id"`
+	Name             string          `json:"name"`
+	Provider         string          `json:"provider"`
+	ProjectID        uuid.UUID       `json:"project_id"`
+	Remediate        NullActionType  `json:"remediate"`
+	Alert            NullActionType  `json:"alert"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+	RemediateActions json.RawMessage `json:"remediate_actions"`
+	AlertActions     json.RawMessage `json:"alert_actions"`
+	ID_2             uuid.UUID       `json:"id_2"`
+	Entity           Entities        `json:"entity"`
+	ProfileID        uuid.UUID       `json:"profile_id"`
+	ContextualRules  json.RawMessage `json:"contextual_rules"`
+	CreatedAt_2      time.Time       `json:"created_at_2"`
+	UpdatedAt_2      time.Time       `json:"updated_at_2"`
 }
 
 func (q *Queries) GetProfileByProjectAndID(ctx context.Context, arg GetProfileByProjectAndIDParams) ([]GetProfileByProjectAndIDRow, error) {
@@ -189,6 +201,8 @@ func (q *Queries) GetProfileByProjectAndID(ctx context.Context, arg GetProfileBy
 			&i.Alert,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.RemediateActions,
+			&i.AlertActions,
 			&i.ID_2,
 			&i.Entity,
 			&i.ProfileID,
@@ -210,7 +224,7 @@ func (q *Queries) GetProfileByProjectAndID(ctx context.Context, arg GetProfileBy
 }
 
 const getProfileByProjectAndName = `-- name: GetProfileByProjectAndName :many
-SELECT profiles.id, name, provider, project_id, remediate, alert, profiles.created_at, profiles.updated_at, entity_profiles.id, entity, profile_id, contextual_rules, entity_profiles.created_at, entity_profiles.updated_at FROM profiles JOIN entity_profiles ON profiles.id = entity_profiles.profile_id
+SELECT profiles.id, name, provider, project_id, remediate, alert, profiles.created_at, profiles.updated_at, remediate_actions, alert_actions, entity_profiles.id, entity, profile_id, contextual_rules, entity_profiles.created_at, entity_profiles.updated_at FROM profiles JOIN entity_profiles ON profiles.id = entity_profiles.profile_id
 WHERE profiles.project_id = $1 AND profiles.name = $2
 `
 
@@ -220,20 +234,22 @@ type GetProfileByProjectAndNameParams struct {
 }
 
 type GetProfileByProjectAndNameRow struct {
-	ID              uuid.UUID       `json:"id"`
-	Name            string          `json:"name"`
-	Provider        string          `json:"provider"`
-	ProjectID       uuid.UUID       `json:"project_id"`
-	Remediate       NullActionType  `json:"remediate"`
-	Alert           NullActionType  `json:"alert"`
-	CreatedAt       time.Time       `json:"created_at"`
-	UpdatedAt       time.Time       `json:"updated_at"`
-	ID_2            uuid.UUID       `json:"id_2"`
-	Entity          Entities        `json:"entity"`
-	ProfileID       uuid.UUID       `json:"profile_id"`
-	ContextualRules json.RawMessage `json:"contextual_rules"`
-	CreatedAt_2     time.Time       `json:"created_at_2"`
-	UpdatedAt_2     time.Time       `json:"updated_at_2"`
+	ID               uuid.UUID       `json:"id"`
+	Name             string          `json:"name"`
+	Provider         string          `json:"provider"`
+	ProjectID        uuid.UUID       `json:"project_id"`
+	Remediate        NullActionType  `json:"remediate"`
+	Alert            NullActionType  `json:"alert"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+	RemediateActions json.RawMessage `json:"remediate_actions"`
+	AlertActions     json.RawMessage `json:"alert_actions"`
+	ID_2             uuid.UUID       `json:"id_2"`
+	Entity           Entities        `json:"entity"`
+	ProfileID        uuid.UUID       `json:"profile_id"`
+	ContextualRules  json.RawMessage `json:"contextual_rules"`
+	CreatedAt_2      time.Time       `json:"created_at_2"`
+	UpdatedAt_2      time.Time       `json:"updated_at_2"`
 }
 
 func (q *Queries) GetProfileByProjectAndName(ctx context.Context, arg GetProfileByProjectAndNameParams) ([]GetProfileByProjectAndNameRow, error) {
@@ -254,6 +270,8 @@ func (q *Queries) GetProfileByProjectAndName(ctx context.Context, arg GetProfile
 			&i.Alert,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.RemediateActions,
+			&i.AlertActions,
 			&i.ID_2,
 			&i.Entity,
 			&i.ProfileID,
@@ -275,25 +293,27 @@ func (q *Queries) GetProfileByProjectAndName(ctx context.Context, arg GetProfile
 }
 
 const listProfilesByProjectID = `-- name: ListProfilesByProjectID :many
-SELECT profiles.id, name, provider, project_id, remediate, alert, profiles.created_at, profiles.updated_at, entity_profiles.id, entity, profile_id, contextual_rules, entity_profiles.created_at, entity_profiles.updated_at FROM profiles JOIN entity_profiles ON profiles.id = entity_profiles.profile_id
+SELECT profiles.id, name, provider, project_id, remediate, alert, profiles.created_at, profiles.updated_at, remediate_actions, alert_actions, entity_profiles.id, entity, profile_id, contextual_rules, entity_profiles.created_at, entity_profiles.updated_at FROM profiles JOIN entity_profiles ON profiles.id = entity_profiles.profile_id
 WHERE profiles.project_id = $1
 `
 
 type ListProfilesByProjectIDRow struct {
-	ID              uuid.UUID       `json:"id"`
-	Name            string          `json:"name"`
-	Provider        string          `json:"provider"`
-	ProjectID       uuid.UUID       `json:"project_id"`
-	Remediate       NullActionType  `json:"remediate"`
-	Alert           NullActionType  `json:"alert"`
-	CreatedAt       time.Time       `json:"created_at"`
-	UpdatedAt       time.Time       `json:"updated_at"`
-	ID_2            uuid.UUID       `json:"id_2"`
-	Entity          Entities        `json:"entity"`
-	ProfileID       uuid.UUID       `json:"profile_id"`
-	ContextualRules json.RawMessage `json:"contextual_rules"`
-	CreatedAt_2     time.Time       `json:"created_at_2"`
-	UpdatedAt_2     time.Time       `json:"updated_at_2"`
+	ID               uuid.UUID       `json:"id"`
+	Name             string          `json:"name"`
+	Provider         string          `json:"provider"`
+	ProjectID        uuid.UUID       `json:"project_id"`
+	Remediate        NullActionType  `json:"remediate"`
+	Alert            NullActionType  `json:"alert"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+	RemediateActions json.RawMessage `json:"remediate_actions"`
+	AlertActions     json.RawMessage `json:"alert_actions"`
+	ID_2             uuid.UUID       `json:"id_2"`
+	Entity           Entities        `json:"entity"`
+	ProfileID        uuid.UUID       `json:"profile_id"`
+	ContextualRules  json.RawMessage `json:"contextual_rules"`
+	CreatedAt_2      time.Time       `json:"created_at_2"`
+	UpdatedAt_2      time.Time       `json:"updated_at_2"`
 }
 
 func (q *Queries) ListProfilesByProjectID(ctx context.Context, projectID uuid.UUID) ([]ListProfilesByProjectIDRow, error) {
@@ -314,6 +334,8 @@ func (q *Queries) ListProfilesByProjectID(ctx context.Context, projectID uuid.UU
 			&i.Alert,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.RemediateActions,
+			&i.AlertActions,
 			&i.ID_2,
 			&i.Entity,
 			&i.ProfileID,
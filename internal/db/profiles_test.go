@@ -26,6 +26,8 @@ func createRandomProfile(t *testing.T, provName string, projectID uuid.UUID) Pro
 			ActionType: "on",
 			Valid:      true,
 		},
+		RemediateActions: json.RawMessage(`{}`),
+		AlertActions:     json.RawMessage(`{}`),
 	}
 
 	prof, err := testQueries.CreateProfile(context.Background(), arg)
@@ -117,6 +119,7 @@ func upsertRemediationStatus(
 		RuleEvalID: id,
 		Status:     remStatus,
 		Details:    details,
+		Metadata:   json.RawMessage(`{}`),
 	})
 	require.NoError(t, err)
 }
@@ -122,6 +122,33 @@ func (q *Queries) GetAccessTokenByProvider(ctx context.Context, provider string)
 	return items, nil
 }
 
+const getAccessTokenForUpdate = `-- name: GetAccessTokenForUpdate :one
+SELECT id, provider, project_id, owner_filter, encrypted_token, expiration_time, created_at, updated_at FROM provider_access_tokens WHERE provider = $1 AND project_id = $2 FOR UPDATE
+`
+
+type GetAccessTokenForUpdateParams struct {
+	Provider  string    `json:"provider"`
+	ProjectID uuid.UUID `json:"project_id"`
+}
+
+// GetAccessTokenForUpdate locks the token of a provider until the end of the
+// transaction, so that concurrent refreshes of the token are serialized.
+func (q *Queries) GetAccessTokenForUpdate(ctx context.Context, arg GetAccessTokenForUpdateParams) (ProviderAccessToken, error) {
+	row := q.db.QueryRowContext(ctx, getAccessTokenForUpdate, arg.Provider, arg.ProjectID)
+	var i ProviderAccessToken
+	err := row.Scan(
+		&i.ID,
+		&i.Provider,
+		&i.ProjectID,
+		&i.OwnerFilter,
+		&i.EncryptedToken,
+		&i.ExpirationTime,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getAccessTokenSinceDate = `-- name: GetAccessTokenSinceDate :one
 SELECT id, provider, project_id, owner_filter, encrypted_token, expiration_time, created_at, updated_at FROM provider_access_tokens WHERE provider = $1 AND project_id = $2 AND created_at >= $3
 `
@@ -148,6 +175,45 @@ func (q *Queries) GetAccessTokenSinceDate(ctx context.Context, arg GetAccessToke
 	return i, err
 }
 
+const listAccessTokensExpiringBefore = `-- name: ListAccessTokensExpiringBefore :many
+SELECT id, provider, project_id, owner_filter, encrypted_token, expiration_time, created_at, updated_at FROM provider_access_tokens WHERE expiration_time > 'epoch'::timestamp AND expiration_time < $1
+`
+
+// ListAccessTokensExpiringBefore lists the tokens that expire before the
+// given time. Tokens that don't expire are stored with a zero expiration
+// time, so they are left out.
+func (q *Queries) ListAccessTokensExpiringBefore(ctx context.Context, expirationTime time.Time) ([]ProviderAccessToken, error) {
+	rows, err := q.db.QueryContext(ctx, listAccessTokensExpiringBefore, expirationTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ProviderAccessToken{}
+	for rows.Next() {
+		var i ProviderAccessToken
+		if err := rows.Scan(
+			&i.ID,
+			&i.Provider,
+			&i.ProjectID,
+			&i.OwnerFilter,
+			&i.EncryptedToken,
+			&i.ExpirationTime,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateAccessToken = `-- name: UpdateAccessToken :one
 UPDATE provider_access_tokens SET encrypted_token = $3, expiration_time = $4, owner_filter = $5, updated_at = NOW() WHERE provider = $1 AND project_id = $2 RETURNING id, provider, project_id, owner_filter, encrypted_token, expiration_time, created_at, updated_at
 `
@@ -0,0 +1,125 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.23.0
+// source: provider_github_app_installations.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const deleteGitHubAppInstallationByID = `-- name: DeleteGitHubAppInstallationByID :exec
+DELETE FROM provider_github_app_installations WHERE app_installation_id = $1
+`
+
+func (q *Queries) DeleteGitHubAppInstallationByID(ctx context.Context, appInstallationID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteGitHubAppInstallationByID, appInstallationID)
+	return err
+}
+
+const getGitHubAppInstallationByProjectAndProvider = `-- name: GetGitHubAppInstallationByProjectAndProvider :one
+SELECT app_installation_id, provider, project_id, account_login, is_org, created_at, updated_at FROM provider_github_app_installations WHERE provider = $1 AND project_id = $2
+`
+
+type GetGitHubAppInstallationByProjectAndProviderParams struct {
+	Provider  string    `json:"provider"`
+	ProjectID uuid.UUID `json:"project_id"`
+}
+
+func (q *Queries) GetGitHubAppInstallationByProjectAndProvider(ctx context.Context, arg GetGitHubAppInstallationByProjectAndProviderParams) (ProviderGithubAppInstallation, error) {
+	row := q.db.QueryRowContext(ctx, getGitHubAppInstallationByProjectAndProvider, arg.Provider, arg.ProjectID)
+	var i ProviderGithubAppInstallation
+	err := row.Scan(
+		&i.AppInstallationID,
+		&i.Provider,
+		&i.ProjectID,
+		&i.AccountLogin,
+		&i.IsOrg,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listGitHubAppInstallationsByID = `-- name: ListGitHubAppInstallationsByID :many
+SELECT app_installation_id, provider, project_id, account_login, is_org, created_at, updated_at FROM provider_github_app_installations WHERE app_installation_id = $1
+`
+
+func (q *Queries) ListGitHubAppInstallationsByID(ctx context.Context, appInstallationID int64) ([]ProviderGithubAppInstallation, error) {
+	rows, err := q.db.QueryContext(ctx, listGitHubAppInstallationsByID, appInstallationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ProviderGithubAppInstallation{}
+	for rows.Next() {
+		var i ProviderGithubAppInstallation
+		if err := rows.Scan(
+			&i.AppInstallationID,
+			&i.Provider,
+			&i.ProjectID,
+			&i.AccountLogin,
+			&i.IsOrg,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertGitHubAppInstallation = `-- name: UpsertGitHubAppInstallation :one
+INSERT INTO provider_github_app_installations (
+    app_installation_id,
+    provider,
+    project_id,
+    account_login,
+    is_org) VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (project_id, provider)
+DO UPDATE SET
+    app_installation_id = $1,
+    account_login = $4,
+    is_org = $5,
+    updated_at = NOW()
+RETURNING app_installation_id, provider, project_id, account_login, is_org, created_at, updated_at
+`
+
+type UpsertGitHubAppInstallationParams struct {
+	AppInstallationID int64     `json:"app_installation_id"`
+	Provider          string    `json:"provider"`
+	ProjectID         uuid.UUID `json:"project_id"`
+	AccountLogin      string    `json:"account_login"`
+	IsOrg             bool      `json:"is_org"`
+}
+
+func (q *Queries) UpsertGitHubAppInstallation(ctx context.Context, arg UpsertGitHubAppInstallationParams) (ProviderGithubAppInstallation, error) {
+	row := q.db.QueryRowContext(ctx, upsertGitHubAppInstallation,
+		arg.AppInstallationID,
+		arg.Provider,
+		arg.ProjectID,
+		arg.AccountLogin,
+		arg.IsOrg,
+	)
+	var i ProviderGithubAppInstallation
+	err := row.Scan(
+		&i.AppInstallationID,
+		&i.Provider,
+		&i.ProjectID,
+		&i.AccountLogin,
+		&i.IsOrg,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -18,7 +18,7 @@ INSERT INTO providers (
     name,
     project_id,
     implements,
-    definition) VALUES ($1, $2, $3, $4::jsonb) RETURNING id, name, version, project_id, implements, definition, created_at, updated_at
+    definition) VALUES ($1, $2, $3, $4::jsonb) RETURNING id, name, version, project_id, implements, definition, created_at, updated_at, health, health_detail
 `
 
 type CreateProviderParams struct {
@@ -45,6 +45,8 @@ func (q *Queries) CreateProvider(ctx context.Context, arg CreateProviderParams)
 		&i.Definition,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Health,
+		&i.HealthDetail,
 	)
 	return i, err
 }
@@ -64,7 +66,7 @@ func (q *Queries) DeleteProvider(ctx context.Context, arg DeleteProviderParams)
 }
 
 const getProviderByID = `-- name: GetProviderByID :one
-SELECT id, name, version, project_id, implements, definition, created_at, updated_at FROM providers WHERE id = $1 AND project_id = $2
+SELECT id, name, version, project_id, implements, definition, created_at, updated_at, health, health_detail FROM providers WHERE id = $1 AND project_id = $2
 `
 
 type GetProviderByIDParams struct {
@@ -84,12 +86,14 @@ func (q *Queries) GetProviderByID(ctx context.Context, arg GetProviderByIDParams
 		&i.Definition,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Health,
+		&i.HealthDetail,
 	)
 	return i, err
 }
 
 const getProviderByName = `-- name: GetProviderByName :one
-SELECT id, name, version, project_id, implements, definition, created_at, updated_at FROM providers WHERE name = $1 AND project_id = $2
+SELECT id, name, version, project_id, implements, definition, created_at, updated_at, health, health_detail FROM providers WHERE name = $1 AND project_id = $2
 `
 
 type GetProviderByNameParams struct {
@@ -109,12 +113,14 @@ func (q *Queries) GetProviderByName(ctx context.Context, arg GetProviderByNamePa
 		&i.Definition,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Health,
+		&i.HealthDetail,
 	)
 	return i, err
 }
 
 const globalListProviders = `-- name: GlobalListProviders :many
-SELECT id, name, version, project_id, implements, definition, created_at, updated_at FROM providers
+SELECT id, name, version, project_id, implements, definition, created_at, updated_at, health, health_detail FROM providers
 `
 
 func (q *Queries) GlobalListProviders(ctx context.Context) ([]Provider, error) {
@@ -135,6 +141,8 @@ func (q *Queries) GlobalListProviders(ctx context.Context) ([]Provider, error) {
 			&i.Definition,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Health,
+			&i.HealthDetail,
 		); err != nil {
 			return nil, err
 		}
@@ -150,7 +158,7 @@ func (q *Queries) GlobalListProviders(ctx context.Context) ([]Provider, error) {
 }
 
 const listProvidersByProjectID = `-- name: ListProvidersByProjectID :many
-SELECT id, name, version, project_id, implements, definition, created_at, updated_at FROM providers WHERE project_id = $1
+SELECT id, name, version, project_id, implements, definition, created_at, updated_at, health, health_detail FROM providers WHERE project_id = $1
 `
 
 func (q *Queries) ListProvidersByProjectID(ctx context.Context, projectID uuid.UUID) ([]Provider, error) {
@@ -171,6 +179,8 @@ func (q *Queries) ListProvidersByProjectID(ctx context.Context, projectID uuid.U
 			&i.Definition,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Health,
+			&i.HealthDetail,
 		); err != nil {
 			return nil, err
 		}
@@ -184,3 +194,60 @@ func (q *Queries) ListProvidersByProjectID(ctx context.Context, projectID uuid.U
 	}
 	return items, nil
 }
+
+const updateProvider = `-- name: UpdateProvider :one
+UPDATE providers
+    SET implements = $3, definition = $4::jsonb, updated_at = NOW()
+    WHERE name = $1 AND project_id = $2 RETURNING id, name, version, project_id, implements, definition, created_at, updated_at, health, health_detail
+`
+
+type UpdateProviderParams struct {
+	Name       string          `json:"name"`
+	ProjectID  uuid.UUID       `json:"project_id"`
+	Implements []ProviderType  `json:"implements"`
+	Definition json.RawMessage `json:"definition"`
+}
+
+func (q *Queries) UpdateProvider(ctx context.Context, arg UpdateProviderParams) (Provider, error) {
+	row := q.db.QueryRowContext(ctx, updateProvider,
+		arg.Name,
+		arg.ProjectID,
+		pq.Array(arg.Implements),
+		arg.Definition,
+	)
+	var i Provider
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Version,
+		&i.ProjectID,
+		pq.Array(&i.Implements),
+		&i.Definition,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Health,
+		&i.HealthDetail,
+	)
+	return i, err
+}
+
+const updateProviderHealth = `-- name: UpdateProviderHealth :exec
+UPDATE providers SET health = $3, health_detail = $4, updated_at = NOW() WHERE name = $1 AND project_id = $2
+`
+
+type UpdateProviderHealthParams struct {
+	Name         string         `json:"name"`
+	ProjectID    uuid.UUID      `json:"project_id"`
+	Health       ProviderHealth `json:"health"`
+	HealthDetail string         `json:"health_detail"`
+}
+
+func (q *Queries) UpdateProviderHealth(ctx context.Context, arg UpdateProviderHealthParams) error {
+	_, err := q.db.ExecContext(ctx, updateProviderHealth,
+		arg.Name,
+		arg.ProjectID,
+		arg.Health,
+		arg.HealthDetail,
+	)
+	return err
+}
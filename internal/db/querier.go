@@ -7,6 +7,7 @@ package db
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -14,6 +15,10 @@ import (
 type Querier interface {
 	AddUserProject(ctx context.Context, arg AddUserProjectParams) (UserProject, error)
 	AddUserRole(ctx context.Context, arg AddUserRoleParams) (UserRole, error)
+	// ClaimAlertDigest marks a due digest as sent before delivering it, so that
+	// concurrent flushes don't deliver it twice. It returns no rows if the digest
+	// is not due anymore, e.g. because another flush claimed it.
+	ClaimAlertDigest(ctx context.Context, arg ClaimAlertDigestParams) (AlertDigest, error)
 	CountProfilesByEntityType(ctx context.Context) ([]CountProfilesByEntityTypeRow, error)
 	CountUsers(ctx context.Context) (int64, error)
 	CreateAccessToken(ctx context.Context, arg CreateAccessTokenParams) (ProviderAccessToken, error)
@@ -35,6 +40,7 @@ type Querier interface {
 	DeleteArtifact(ctx context.Context, id uuid.UUID) error
 	DeleteArtifactVersion(ctx context.Context, id uuid.UUID) error
 	DeleteExpiredSessionStates(ctx context.Context) error
+	DeleteGitHubAppInstallationByID(ctx context.Context, appInstallationID int64) error
 	DeleteOldArtifactVersions(ctx context.Context, arg DeleteOldArtifactVersionsParams) error
 	DeleteOrganization(ctx context.Context, id uuid.UUID) error
 	DeleteProfile(ctx context.Context, id uuid.UUID) error
@@ -42,14 +48,24 @@ type Querier interface {
 	DeleteProvider(ctx context.Context, arg DeleteProviderParams) error
 	DeletePullRequest(ctx context.Context, arg DeletePullRequestParams) error
 	DeleteRepository(ctx context.Context, id uuid.UUID) error
+	// DeleteResolvedAlertDigestFindings deletes the findings which were resolved
+	// when listed for a digest. A finding updated since, by an evaluation running
+	// concurrently with the delivery, is kept for the next digest.
+	DeleteResolvedAlertDigestFindings(ctx context.Context, arg DeleteResolvedAlertDigestFindingsParams) error
 	DeleteRole(ctx context.Context, id int32) error
 	DeleteRuleType(ctx context.Context, id uuid.UUID) error
 	DeleteSessionState(ctx context.Context, id int32) error
 	DeleteSessionStateByProjectID(ctx context.Context, arg DeleteSessionStateByProjectIDParams) error
 	DeleteSigningKey(ctx context.Context, arg DeleteSigningKeyParams) error
 	DeleteUser(ctx context.Context, id int32) error
+	// ExpireRemediationApprovals expires the approvals in progress of a rule
+	// evaluation, e.g. because the rule passes again.
+	ExpireRemediationApprovals(ctx context.Context, ruleEvalID uuid.UUID) error
 	GetAccessTokenByProjectID(ctx context.Context, arg GetAccessTokenByProjectIDParams) (ProviderAccessToken, error)
 	GetAccessTokenByProvider(ctx context.Context, provider string) ([]ProviderAccessToken, error)
+	// GetAccessTokenForUpdate locks the token of a provider until the end of the
+	// transaction, so that concurrent refreshes of the token are serialized.
+	GetAccessTokenForUpdate(ctx context.Context, arg GetAccessTokenForUpdateParams) (ProviderAccessToken, error)
 	GetAccessTokenSinceDate(ctx context.Context, arg GetAccessTokenSinceDateParams) (ProviderAccessToken, error)
 	GetArtifactByID(ctx context.Context, id uuid.UUID) (GetArtifactByIDRow, error)
 	GetArtifactVersionByID(ctx context.Context, id uuid.UUID) (ArtifactVersion, error)
@@ -58,6 +74,7 @@ type Querier interface {
 	// GetFeatureInProject verifies if a feature is available for a specific project.
 	// It returns the settings for the feature if it is available.
 	GetFeatureInProject(ctx context.Context, arg GetFeatureInProjectParams) (json.RawMessage, error)
+	GetGitHubAppInstallationByProjectAndProvider(ctx context.Context, arg GetGitHubAppInstallationByProjectAndProviderParams) (ProviderGithubAppInstallation, error)
 	GetOrganization(ctx context.Context, id uuid.UUID) (Project, error)
 	GetOrganizationByName(ctx context.Context, name string) (Project, error)
 	GetOrganizationForUpdate(ctx context.Context, name string) (Project, error)
@@ -75,9 +92,13 @@ type Querier interface {
 	GetProviderByID(ctx context.Context, arg GetProviderByIDParams) (Provider, error)
 	GetProviderByName(ctx context.Context, arg GetProviderByNameParams) (Provider, error)
 	GetPullRequest(ctx context.Context, arg GetPullRequestParams) (PullRequest, error)
+	// GetRemediationApprovalByID returns the same columns as ListRemediationApprovalsByProject.
+	GetRemediationApprovalByID(ctx context.Context, arg GetRemediationApprovalByIDParams) (GetRemediationApprovalByIDRow, error)
 	GetRepositoryByID(ctx context.Context, id uuid.UUID) (Repository, error)
 	GetRepositoryByIDAndProject(ctx context.Context, arg GetRepositoryByIDAndProjectParams) (Repository, error)
-	GetRepositoryByRepoID(ctx context.Context, repoID int32) (Repository, error)
+	// GetRepositoryByRepoID looks up a repository by the ID assigned to it by a
+	// kind of provider, e.g. GitHub.
+	GetRepositoryByRepoID(ctx context.Context, arg GetRepositoryByRepoIDParams) (Repository, error)
 	GetRepositoryByRepoName(ctx context.Context, arg GetRepositoryByRepoNameParams) (Repository, error)
 	GetRoleByID(ctx context.Context, id int32) (Role, error)
 	GetRoleByName(ctx context.Context, arg GetRoleByNameParams) (Role, error)
@@ -93,10 +114,20 @@ type Querier interface {
 	GetUserProjects(ctx context.Context, userID int32) ([]GetUserProjectsRow, error)
 	GetUserRoles(ctx context.Context, userID int32) ([]GetUserRolesRow, error)
 	GlobalListProviders(ctx context.Context) ([]Provider, error)
+	// ListAccessTokensExpiringBefore lists the tokens that expire before the
+	// given time. Tokens that don't expire are stored with a zero expiration
+	// time, so they are left out.
+	ListAccessTokensExpiringBefore(ctx context.Context, expirationTime time.Time) ([]ProviderAccessToken, error)
+	ListAlertDigestFindings(ctx context.Context, arg ListAlertDigestFindingsParams) ([]AlertDigestFinding, error)
 	ListAllRepositories(ctx context.Context, provider string) ([]Repository, error)
 	ListArtifactVersionsByArtifactID(ctx context.Context, arg ListArtifactVersionsByArtifactIDParams) ([]ArtifactVersion, error)
 	ListArtifactVersionsByArtifactIDAndTag(ctx context.Context, arg ListArtifactVersionsByArtifactIDAndTagParams) ([]ArtifactVersion, error)
 	ListArtifactsByRepoID(ctx context.Context, repositoryID uuid.UUID) ([]Artifact, error)
+	// ListDueAlertDigests returns the digests whose schedule has elapsed since they were last sent.
+	ListDueAlertDigests(ctx context.Context) ([]AlertDigest, error)
+	ListGitHubAppInstallationsByID(ctx context.Context, appInstallationID int64) ([]ProviderGithubAppInstallation, error)
+	// ListLatestRemediationApprovals returns the most recent approval of each remediation of a rule evaluation.
+	ListLatestRemediationApprovals(ctx context.Context, ruleEvalID uuid.UUID) ([]RemediationApproval, error)
 	ListOrganizations(ctx context.Context, arg ListOrganizationsParams) ([]Project, error)
 	ListProfilesByProjectID(ctx context.Context, projectID uuid.UUID) ([]ListProfilesByProjectIDRow, error)
 	// get profile information that instantiate a rule. This is done by joining the profiles with entity_profiles, then correlating those
@@ -105,26 +136,58 @@ type Querier interface {
 	ListProfilesInstantiatingRuleType(ctx context.Context, ruleTypeID uuid.UUID) ([]ListProfilesInstantiatingRuleTypeRow, error)
 	ListProvidersByProjectID(ctx context.Context, projectID uuid.UUID) ([]Provider, error)
 	ListRegisteredRepositoriesByProjectIDAndProvider(ctx context.Context, arg ListRegisteredRepositoriesByProjectIDAndProviderParams) ([]Repository, error)
+	ListRemediationApprovalsByProject(ctx context.Context, arg ListRemediationApprovalsByProjectParams) ([]ListRemediationApprovalsByProjectRow, error)
+	// ListRemediationsForRollback returns the remediations of a rule type that
+	// recorded the state of the entity before the remediation, optionally filtered
+	// by the revision of the rule type and by entity. The status is not checked:
+	// the evaluations following a remediation overwrite it, e.g. with 'skipped'
+	// once the rule passes, but keep the recorded state.
+	ListRemediationsForRollback(ctx context.Context, arg ListRemediationsForRollbackParams) ([]ListRemediationsForRollbackRow, error)
 	ListRepositoriesByOwner(ctx context.Context, arg ListRepositoriesByOwnerParams) ([]Repository, error)
 	ListRepositoriesByProjectID(ctx context.Context, arg ListRepositoriesByProjectIDParams) ([]Repository, error)
+	// ListRepositoriesByRepoID lists the repositories registered with an ID through
+	// any provider. Providers of the same kind may be enrolled with different
+	// instances, which assign the same IDs to different repositories.
+	ListRepositoriesByRepoID(ctx context.Context, repoID int32) ([]Repository, error)
 	ListRoles(ctx context.Context, arg ListRolesParams) ([]Role, error)
 	ListRolesByProjectID(ctx context.Context, arg ListRolesByProjectIDParams) ([]Role, error)
+	ListRuleDetailsAlert(ctx context.Context, ruleEvalID uuid.UUID) ([]RuleDetailsAlert, error)
+	ListRuleDetailsRemediate(ctx context.Context, ruleEvalID uuid.UUID) ([]RuleDetailsRemediate, error)
 	ListRuleEvaluationsByProfileId(ctx context.Context, arg ListRuleEvaluationsByProfileIdParams) ([]ListRuleEvaluationsByProfileIdRow, error)
 	ListRuleTypesByProviderAndProject(ctx context.Context, arg ListRuleTypesByProviderAndProjectParams) ([]RuleType, error)
 	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
 	ListUsersByOrganization(ctx context.Context, arg ListUsersByOrganizationParams) ([]User, error)
 	ListUsersByProject(ctx context.Context, arg ListUsersByProjectParams) ([]User, error)
 	ListUsersByRoleId(ctx context.Context, roleID int32) ([]int32, error)
+	MarkAlertDigestFindingsNotified(ctx context.Context, ids []uuid.UUID) error
+	// ReleaseAlertDigest restores the time a claimed digest was last sent at when
+	// delivering it failed, so that it is retried on the next flush.
+	ReleaseAlertDigest(ctx context.Context, arg ReleaseAlertDigestParams) error
+	ResolveAlertDigestFinding(ctx context.Context, arg ResolveAlertDigestFindingParams) error
+	// ReviewRemediationApproval approves or rejects a pending remediation.
+	ReviewRemediationApproval(ctx context.Context, arg ReviewRemediationApprovalParams) (RemediationApproval, error)
+	// RollbackRuleDetailsRemediate forgets the state recorded by a remediation
+	// once it has been restored, so that it is not rolled back twice.
+	RollbackRuleDetailsRemediate(ctx context.Context, arg RollbackRuleDetailsRemediateParams) error
 	UpdateAccessToken(ctx context.Context, arg UpdateAccessTokenParams) (ProviderAccessToken, error)
 	UpdateOrganization(ctx context.Context, arg UpdateOrganizationParams) (Project, error)
+	UpdateProvider(ctx context.Context, arg UpdateProviderParams) (Provider, error)
+	UpdateProviderHealth(ctx context.Context, arg UpdateProviderHealthParams) error
+	UpdateRemediationApprovalStatus(ctx context.Context, arg UpdateRemediationApprovalStatusParams) error
 	// set clone_url if the value is not an empty string
 	UpdateRepository(ctx context.Context, arg UpdateRepositoryParams) (Repository, error)
 	UpdateRepositoryByID(ctx context.Context, arg UpdateRepositoryByIDParams) (Repository, error)
 	UpdateRole(ctx context.Context, arg UpdateRoleParams) (Role, error)
 	UpdateRuleType(ctx context.Context, arg UpdateRuleTypeParams) error
+	UpsertAlertDigest(ctx context.Context, arg UpsertAlertDigestParams) error
+	UpsertAlertDigestFinding(ctx context.Context, arg UpsertAlertDigestFindingParams) (AlertDigestFinding, error)
 	UpsertArtifact(ctx context.Context, arg UpsertArtifactParams) (Artifact, error)
 	UpsertArtifactVersion(ctx context.Context, arg UpsertArtifactVersionParams) (ArtifactVersion, error)
+	UpsertGitHubAppInstallation(ctx context.Context, arg UpsertGitHubAppInstallationParams) (ProviderGithubAppInstallation, error)
 	UpsertPullRequest(ctx context.Context, arg UpsertPullRequestParams) (PullRequest, error)
+	// UpsertRemediationApproval records a remediation waiting for approval, or
+	// refreshes the preview of the one already waiting.
+	UpsertRemediationApproval(ctx context.Context, arg UpsertRemediationApprovalParams) error
 	UpsertRuleDetailsAlert(ctx context.Context, arg UpsertRuleDetailsAlertParams) (uuid.UUID, error)
 	UpsertRuleDetailsEval(ctx context.Context, arg UpsertRuleDetailsEvalParams) (uuid.UUID, error)
 	UpsertRuleDetailsRemediate(ctx context.Context, arg UpsertRuleDetailsRemediateParams) (uuid.UUID, error)
@@ -0,0 +1,303 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.23.0
+// source: remediation_approvals.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const expireRemediationApprovals = `-- name: ExpireRemediationApprovals :exec
+
+UPDATE remediation_approvals SET status = 'expired', updated_at = NOW()
+WHERE rule_eval_id = $1 AND status IN ('pending', 'approved')
+`
+
+// ExpireRemediationApprovals expires the approvals in progress of a rule
+// evaluation, e.g. because the rule passes again.
+func (q *Queries) ExpireRemediationApprovals(ctx context.Context, ruleEvalID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, expireRemediationApprovals, ruleEvalID)
+	return err
+}
+
+const getRemediationApprovalByID = `-- name: GetRemediationApprovalByID :one
+
+SELECT ra.id, ra.action_name, ra.remediation_type, ra.preview, ra.status, ra.created_at, ra.updated_at,
+       re.entity, re.repository_id, re.artifact_id, re.pull_request_id,
+       repo.provider, repo.repo_owner, repo.repo_name, pr.pr_number,
+       p.name AS profile_name, rt.name AS rule_type_name
+FROM remediation_approvals ra
+JOIN rule_evaluations re ON re.id = ra.rule_eval_id
+JOIN profiles p ON p.id = re.profile_id
+JOIN rule_type rt ON rt.id = re.rule_type_id
+LEFT JOIN repositories repo ON repo.id = re.repository_id
+LEFT JOIN pull_requests pr ON pr.id = re.pull_request_id
+WHERE ra.id = $1 AND ra.project_id = $2
+`
+
+type GetRemediationApprovalByIDParams struct {
+	ID        uuid.UUID `json:"id"`
+	ProjectID uuid.UUID `json:"project_id"`
+}
+
+type GetRemediationApprovalByIDRow struct {
+	ID              uuid.UUID                 `json:"id"`
+	ActionName      string                    `json:"action_name"`
+	RemediationType string                    `json:"remediation_type"`
+	Preview         string                    `json:"preview"`
+	Status          RemediationApprovalStatus `json:"status"`
+	CreatedAt       time.Time                 `json:"created_at"`
+	UpdatedAt       time.Time                 `json:"updated_at"`
+	Entity          Entities                  `json:"entity"`
+	RepositoryID    uuid.NullUUID             `json:"repository_id"`
+	ArtifactID      uuid.NullUUID             `json:"artifact_id"`
+	PullRequestID   uuid.NullUUID             `json:"pull_request_id"`
+	Provider        sql.NullString            `json:"provider"`
+	RepoOwner       sql.NullString            `json:"repo_owner"`
+	RepoName        sql.NullString            `json:"repo_name"`
+	PrNumber        sql.NullInt64             `json:"pr_number"`
+	ProfileName     string                    `json:"profile_name"`
+	RuleTypeName    string                    `json:"rule_type_name"`
+}
+
+// GetRemediationApprovalByID returns the same columns as ListRemediationApprovalsByProject.
+func (q *Queries) GetRemediationApprovalByID(ctx context.Context, arg GetRemediationApprovalByIDParams) (GetRemediationApprovalByIDRow, error) {
+	row := q.db.QueryRowContext(ctx, getRemediationApprovalByID, arg.ID, arg.ProjectID)
+	var i GetRemediationApprovalByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.ActionName,
+		&i.RemediationType,
+		&i.Preview,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Entity,
+		&i.RepositoryID,
+		&i.ArtifactID,
+		&i.PullRequestID,
+		&i.Provider,
+		&i.RepoOwner,
+		&i.RepoName,
+		&i.PrNumber,
+		&i.ProfileName,
+		&i.RuleTypeName,
+	)
+	return i, err
+}
+
+const listLatestRemediationApprovals = `-- name: ListLatestRemediationApprovals :many
+
+SELECT DISTINCT ON (action_name) id, project_id, rule_eval_id, action_name, remediation_type, preview, status, reviewed_by, details, created_at, updated_at FROM remediation_approvals
+WHERE rule_eval_id = $1
+ORDER BY action_name, created_at DESC
+`
+
+// ListLatestRemediationApprovals returns the most recent approval of each remediation of a rule evaluation.
+func (q *Queries) ListLatestRemediationApprovals(ctx context.Context, ruleEvalID uuid.UUID) ([]RemediationApproval, error) {
+	rows, err := q.db.QueryContext(ctx, listLatestRemediationApprovals, ruleEvalID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []RemediationApproval{}
+	for rows.Next() {
+		var i RemediationApproval
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProjectID,
+			&i.RuleEvalID,
+			&i.ActionName,
+			&i.RemediationType,
+			&i.Preview,
+			&i.Status,
+			&i.ReviewedBy,
+			&i.Details,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRemediationApprovalsByProject = `-- name: ListRemediationApprovalsByProject :many
+SELECT ra.id, ra.action_name, ra.remediation_type, ra.preview, ra.status, ra.created_at, ra.updated_at,
+       re.entity, re.repository_id, re.artifact_id, re.pull_request_id,
+       repo.provider, repo.repo_owner, repo.repo_name, pr.pr_number,
+       p.name AS profile_name, rt.name AS rule_type_name
+FROM remediation_approvals ra
+JOIN rule_evaluations re ON re.id = ra.rule_eval_id
+JOIN profiles p ON p.id = re.profile_id
+JOIN rule_type rt ON rt.id = re.rule_type_id
+LEFT JOIN repositories repo ON repo.id = re.repository_id
+LEFT JOIN pull_requests pr ON pr.id = re.pull_request_id
+WHERE ra.project_id = $1 AND ra.status = $2
+ORDER BY ra.created_at
+`
+
+type ListRemediationApprovalsByProjectParams struct {
+	ProjectID uuid.UUID                 `json:"project_id"`
+	Status    RemediationApprovalStatus `json:"status"`
+}
+
+type ListRemediationApprovalsByProjectRow struct {
+	ID              uuid.UUID                 `json:"id"`
+	ActionName      string                    `json:"action_name"`
+	RemediationType string                    `json:"remediation_type"`
+	Preview         string                    `json:"preview"`
+	Status          RemediationApprovalStatus `json:"status"`
+	CreatedAt       time.Time                 `json:"created_at"`
+	UpdatedAt       time.Time                 `json:"updated_at"`
+	Entity          Entities                  `json:"entity"`
+	RepositoryID    uuid.NullUUID             `json:"repository_id"`
+	ArtifactID      uuid.NullUUID             `json:"artifact_id"`
+	PullRequestID   uuid.NullUUID             `json:"pull_request_id"`
+	Provider        sql.NullString            `json:"provider"`
+	RepoOwner       sql.NullString            `json:"repo_owner"`
+	RepoName        sql.NullString            `json:"repo_name"`
+	PrNumber        sql.NullInt64             `json:"pr_number"`
+	ProfileName     string                    `json:"profile_name"`
+	RuleTypeName    string                    `json:"rule_type_name"`
+}
+
+func (q *Queries) ListRemediationApprovalsByProject(ctx context.Context, arg ListRemediationApprovalsByProjectParams) ([]ListRemediationApprovalsByProjectRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRemediationApprovalsByProject, arg.ProjectID, arg.Status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListRemediationApprovalsByProjectRow{}
+	for rows.Next() {
+		var i ListRemediationApprovalsByProjectRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ActionName,
+			&i.RemediationType,
+			&i.Preview,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Entity,
+			&i.RepositoryID,
+			&i.ArtifactID,
+			&i.PullRequestID,
+			&i.Provider,
+			&i.RepoOwner,
+			&i.RepoName,
+			&i.PrNumber,
+			&i.ProfileName,
+			&i.RuleTypeName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reviewRemediationApproval = `-- name: ReviewRemediationApproval :one
+
+UPDATE remediation_approvals SET status = $3, reviewed_by = $4, updated_at = NOW()
+WHERE id = $1 AND project_id = $2 AND status = 'pending'
+RETURNING id, project_id, rule_eval_id, action_name, remediation_type, preview, status, reviewed_by, details, created_at, updated_at
+`
+
+type ReviewRemediationApprovalParams struct {
+	ID         uuid.UUID                 `json:"id"`
+	ProjectID  uuid.UUID                 `json:"project_id"`
+	Status     RemediationApprovalStatus `json:"status"`
+	ReviewedBy string                    `json:"reviewed_by"`
+}
+
+// ReviewRemediationApproval approves or rejects a pending remediation.
+func (q *Queries) ReviewRemediationApproval(ctx context.Context, arg ReviewRemediationApprovalParams) (RemediationApproval, error) {
+	row := q.db.QueryRowContext(ctx, reviewRemediationApproval,
+		arg.ID,
+		arg.ProjectID,
+		arg.Status,
+		arg.ReviewedBy,
+	)
+	var i RemediationApproval
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.RuleEvalID,
+		&i.ActionName,
+		&i.RemediationType,
+		&i.Preview,
+		&i.Status,
+		&i.ReviewedBy,
+		&i.Details,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateRemediationApprovalStatus = `-- name: UpdateRemediationApprovalStatus :exec
+UPDATE remediation_approvals SET status = $2, details = $3, updated_at = NOW() WHERE id = $1
+`
+
+type UpdateRemediationApprovalStatusParams struct {
+	ID      uuid.UUID                 `json:"id"`
+	Status  RemediationApprovalStatus `json:"status"`
+	Details string                    `json:"details"`
+}
+
+func (q *Queries) UpdateRemediationApprovalStatus(ctx context.Context, arg UpdateRemediationApprovalStatusParams) error {
+	_, err := q.db.ExecContext(ctx, updateRemediationApprovalStatus, arg.ID, arg.Status, arg.Details)
+	return err
+}
+
+const upsertRemediationApproval = `-- name: UpsertRemediationApproval :exec
+
+INSERT INTO remediation_approvals (project_id, rule_eval_id, action_name, remediation_type, preview)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (rule_eval_id, action_name) WHERE status IN ('pending', 'approved') DO UPDATE SET
+    remediation_type = $4,
+    preview = $5,
+    updated_at = NOW()
+WHERE remediation_approvals.status = 'pending'
+`
+
+type UpsertRemediationApprovalParams struct {
+	ProjectID       uuid.UUID `json:"project_id"`
+	RuleEvalID      uuid.UUID `json:"rule_eval_id"`
+	ActionName      string    `json:"action_name"`
+	RemediationType string    `json:"remediation_type"`
+	Preview         string    `json:"preview"`
+}
+
+// UpsertRemediationApproval records a remediation waiting for approval, or
+// refreshes the preview of the one already waiting.
+func (q *Queries) UpsertRemediationApproval(ctx context.Context, arg UpsertRemediationApprovalParams) error {
+	_, err := q.db.ExecContext(ctx, upsertRemediationApproval,
+		arg.ProjectID,
+		arg.RuleEvalID,
+		arg.ActionName,
+		arg.RemediationType,
+		arg.Preview,
+	)
+	return err
+}
@@ -0,0 +1,131 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.23.0
+// source: remediation_rollbacks.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+const listRemediationsForRollback = `-- name: ListRemediationsForRollback :many
+
+SELECT rdr.id, rdr.action_name, rdr.metadata, rdr.rule_type_revision,
+       re.entity, re.repository_id, re.artifact_id, re.pull_request_id,
+       repo.provider, repo.repo_owner, repo.repo_name, pr.pr_number,
+       p.name AS profile_name, rt.definition AS rule_type_definition
+FROM rule_details_remediate rdr
+JOIN rule_evaluations re ON re.id = rdr.rule_eval_id
+JOIN profiles p ON p.id = re.profile_id
+JOIN rule_type rt ON rt.id = re.rule_type_id
+LEFT JOIN repositories repo ON repo.id = re.repository_id
+LEFT JOIN pull_requests pr ON pr.id = re.pull_request_id
+WHERE p.project_id = $1 AND rt.provider = $2 AND rt.name = $3
+  AND rdr.metadata != '{}'::jsonb
+  AND ($4::integer IS NULL OR rdr.rule_type_revision = $4)
+  AND ($5::uuid IS NULL OR re.repository_id = $5)
+  AND ($6::uuid IS NULL OR re.artifact_id = $6)
+  AND ($7::uuid IS NULL OR re.pull_request_id = $7)
+ORDER BY re.entity, rdr.action_name
+`
+
+type ListRemediationsForRollbackParams struct {
+	ProjectID        uuid.UUID     `json:"project_id"`
+	Provider         string        `json:"provider"`
+	Name             string        `json:"name"`
+	RuleTypeRevision sql.NullInt32 `json:"rule_type_revision"`
+	RepositoryID     uuid.NullUUID `json:"repository_id"`
+	ArtifactID       uuid.NullUUID `json:"artifact_id"`
+	PullRequestID    uuid.NullUUID `json:"pull_request_id"`
+}
+
+type ListRemediationsForRollbackRow struct {
+	ID                 uuid.UUID       `json:"id"`
+	ActionName         string          `json:"action_name"`
+	Metadata           json.RawMessage `json:"metadata"`
+	RuleTypeRevision   int32           `json:"rule_type_revision"`
+	Entity             Entities        `json:"entity"`
+	RepositoryID       uuid.NullUUID   `json:"repository_id"`
+	ArtifactID         uuid.NullUUID   `json:"artifact_id"`
+	PullRequestID      uuid.NullUUID   `json:"pull_request_id"`
+	Provider           sql.NullString  `json:"provider"`
+	RepoOwner          sql.NullString  `json:"repo_owner"`
+	RepoName           sql.NullString  `json:"repo_name"`
+	PrNumber           sql.NullInt64   `json:"pr_number"`
+	ProfileName        string          `json:"profile_name"`
+	RuleTypeDefinition json.RawMessage `json:"rule_type_definition"`
+}
+
+// ListRemediationsForRollback returns the remediations of a rule type that
+// recorded the state of the entity before the remediation, optionally filtered
+// by the revision of the rule type and by entity. The status is not checked:
+// the evaluations following a remediation overwrite it, e.g. with 'skipped'
+// once the rule passes, but keep the recorded state.
+func (q *Queries) ListRemediationsForRollback(ctx context.Context, arg ListRemediationsForRollbackParams) ([]ListRemediationsForRollbackRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRemediationsForRollback,
+		arg.ProjectID,
+		arg.Provider,
+		arg.Name,
+		arg.RuleTypeRevision,
+		arg.RepositoryID,
+		arg.ArtifactID,
+		arg.PullRequestID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListRemediationsForRollbackRow{}
+	for rows.Next() {
+		var i ListRemediationsForRollbackRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ActionName,
+			&i.Metadata,
+			&i.RuleTypeRevision,
+			&i.Entity,
+			&i.RepositoryID,
+			&i.ArtifactID,
+			&i.PullRequestID,
+			&i.Provider,
+			&i.RepoOwner,
+			&i.RepoName,
+			&i.PrNumber,
+			&i.ProfileName,
+			&i.RuleTypeDefinition,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const rollbackRuleDetailsRemediate = `-- name: RollbackRuleDetailsRemediate :exec
+
+UPDATE rule_details_remediate SET metadata = '{}'::jsonb, details = $2, last_updated = NOW()
+WHERE id = $1
+`
+
+type RollbackRuleDetailsRemediateParams struct {
+	ID      uuid.UUID `json:"id"`
+	Details string    `json:"details"`
+}
+
+// RollbackRuleDetailsRemediate forgets the state recorded by a remediation
+// once it has been restored, so that it is not rolled back twice.
+func (q *Queries) RollbackRuleDetailsRemediate(ctx context.Context, arg RollbackRuleDetailsRemediateParams) error {
+	_, err := q.db.ExecContext(ctx, rollbackRuleDetailsRemediate, arg.ID, arg.Details)
+	return err
+}
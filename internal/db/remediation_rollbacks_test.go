@@ -0,0 +1,69 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func upsertRemediation(
+	t *testing.T, ruleEvalID uuid.UUID, remStatus RemediationStatusTypes, metadata json.RawMessage,
+) {
+	t.Helper()
+
+	_, err := testQueries.UpsertRuleDetailsRemediate(context.Background(), UpsertRuleDetailsRemediateParams{
+		RuleEvalID:       ruleEvalID,
+		ActionName:       "rest",
+		Status:           remStatus,
+		Metadata:         metadata,
+		RuleTypeRevision: 1,
+	})
+	require.NoError(t, err)
+}
+
+func TestRollbackRemediationOfPassingRule(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	randomEntities := createTestRandomEntities(t)
+	profile := createRandomProfile(t, randomEntities.prov.Name, randomEntities.proj.ID)
+
+	ruleEvalID, err := testQueries.UpsertRuleEvaluations(ctx, UpsertRuleEvaluationsParams{
+		ProfileID:    profile.ID,
+		RepositoryID: uuid.NullUUID{UUID: randomEntities.repo.ID, Valid: true},
+		RuleTypeID:   randomEntities.ruleType1.ID,
+		Entity:       EntitiesRepository,
+	})
+	require.NoError(t, err)
+
+	listParams := ListRemediationsForRollbackParams{
+		ProjectID: randomEntities.proj.ID,
+		Provider:  randomEntities.prov.Name,
+		Name:      randomEntities.ruleType1.Name,
+	}
+	previous := json.RawMessage(`{"previous": {"private": false}}`)
+
+	// the rule fails and is remediated, recording the previous state
+	upsertRemediation(t, ruleEvalID, RemediationStatusTypesSuccess, previous)
+	// the next evaluation passes, which skips the remediation
+	upsertRemediation(t, ruleEvalID, RemediationStatusTypesSkipped, json.RawMessage(`{}`))
+
+	rows, err := testQueries.ListRemediationsForRollback(ctx, listParams)
+	require.NoError(t, err)
+	require.Len(t, rows, 1, "a skipped evaluation should not lose the remediation to roll back")
+	require.Equal(t, "rest", rows[0].ActionName)
+	require.JSONEq(t, string(previous), string(rows[0].Metadata))
+
+	err = testQueries.RollbackRuleDetailsRemediate(ctx, RollbackRuleDetailsRemediateParams{
+		ID:      rows[0].ID,
+		Details: "remediation rolled back",
+	})
+	require.NoError(t, err)
+
+	rows, err = testQueries.ListRemediationsForRollback(ctx, listParams)
+	require.NoError(t, err)
+	require.Empty(t, rows, "a remediation should only be rolled back once")
+}
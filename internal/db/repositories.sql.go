@@ -24,21 +24,23 @@ INSERT INTO repositories (
     webhook_id,
     webhook_url,
     deploy_url,
-    clone_url) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING id, provider, project_id, repo_owner, repo_name, repo_id, is_private, is_fork, webhook_id, webhook_url, deploy_url, clone_url, created_at, updated_at
+    clone_url,
+    webhook_secret) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) RETURNING id, provider, project_id, repo_owner, repo_name, repo_id, is_private, is_fork, webhook_id, webhook_url, deploy_url, clone_url, created_at, updated_at, webhook_secret
 `
 
 type CreateRepositoryParams struct {
-	Provider   string        `json:"provider"`
-	ProjectID  uuid.UUID     `json:"project_id"`
-	RepoOwner  string        `json:"repo_owner"`
-	RepoName   string        `json:"repo_name"`
-	RepoID     int32         `json:"repo_id"`
-	IsPrivate  bool          `json:"is_private"`
-	IsFork     bool          `json:"is_fork"`
-	WebhookID  sql.NullInt32 `json:"webhook_id"`
-	WebhookUrl string        `json:"webhook_url"`
-	DeployUrl  string        `json:"deploy_url"`
-	CloneUrl   string        `json:"clone_url"`
+	Provider      string        `json:"provider"`
+	ProjectID     uuid.UUID     `json:"project_id"`
+	RepoOwner     string        `json:"repo_owner"`
+	RepoName      string        `json:"repo_name"`
+	RepoID        int32         `json:"repo_id"`
+	IsPrivate     bool          `json:"is_private"`
+	IsFork        bool          `json:"is_fork"`
+	WebhookID     sql.NullInt32 `json:"webhook_id"`
+	WebhookUrl    string        `json:"webhook_url"`
+	DeployUrl     string        `json:"deploy_url"`
+	CloneUrl      string        `json:"clone_url"`
+	WebhookSecret string        `json:"webhook_secret"`
 }
 
 func (q *Queries) CreateRepository(ctx context.Context, arg CreateRepositoryParams) (Repository, error) {
@@ -54,6 +56,7 @@ func (q *Queries) CreateRepository(ctx context.Context, arg CreateRepositoryPara
 		arg.WebhookUrl,
 		arg.DeployUrl,
 		arg.CloneUrl,
+		arg.WebhookSecret,
 	)
 	var i Repository
 	err := row.Scan(
@@ -71,6 +74,7 @@ func (q *Queries) CreateRepository(ctx context.Context, arg CreateRepositoryPara
 		&i.CloneUrl,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.WebhookSecret,
 	)
 	return i, err
 }
@@ -86,7 +90,7 @@ func (q *Queries) DeleteRepository(ctx context.Context, id uuid.UUID) error {
 }
 
 const getRepositoryByID = `-- name: GetRepositoryByID :one
-SELECT id, provider, project_id, repo_owner, repo_name, repo_id, is_private, is_fork, webhook_id, webhook_url, deploy_url, clone_url, created_at, updated_at FROM repositories WHERE id = $1
+SELECT id, provider, project_id, repo_owner, repo_name, repo_id, is_private, is_fork, webhook_id, webhook_url, deploy_url, clone_url, created_at, updated_at, webhook_secret FROM repositories WHERE id = $1
 `
 
 func (q *Queries) GetRepositoryByID(ctx context.Context, id uuid.UUID) (Repository, error) {
@@ -107,12 +111,13 @@ func (q *Queries) GetRepositoryByID(ctx context.Context, id uuid.UUID) (Reposito
 		&i.CloneUrl,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.WebhookSecret,
 	)
 	return i, err
 }
 
 const getRepositoryByIDAndProject = `-- name: GetRepositoryByIDAndProject :one
-SELECT id, provider, project_id, repo_owner, repo_name, repo_id, is_private, is_fork, webhook_id, webhook_url, deploy_url, clone_url, created_at, updated_at FROM repositories WHERE provider = $1 AND repo_id = $2 AND project_id = $3
+SELECT id, provider, project_id, repo_owner, repo_name, repo_id, is_private, is_fork, webhook_id, webhook_url, deploy_url, clone_url, created_at, updated_at, webhook_secret FROM repositories WHERE provider = $1 AND repo_id = $2 AND project_id = $3
 `
 
 type GetRepositoryByIDAndProjectParams struct {
@@ -139,16 +144,26 @@ func (q *Queries) GetRepositoryByIDAndProject(ctx context.Context, arg GetReposi
 		&i.CloneUrl,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.WebhookSecret,
 	)
 	return i, err
 }
 
 const getRepositoryByRepoID = `-- name: GetRepositoryByRepoID :one
-SELECT id, provider, project_id, repo_owner, repo_name, repo_id, is_private, is_fork, webhook_id, webhook_url, deploy_url, clone_url, created_at, updated_at FROM repositories WHERE repo_id = $1
+SELECT r.id, r.provider, r.project_id, r.repo_owner, r.repo_name, r.repo_id, r.is_private, r.is_fork, r.webhook_id, r.webhook_url, r.deploy_url, r.clone_url, r.created_at, r.updated_at, r.webhook_secret FROM repositories r
+JOIN providers p ON p.project_id = r.project_id AND p.name = r.provider
+WHERE r.repo_id = $1 AND $2::provider_type = ANY(p.implements)
 `
 
-func (q *Queries) GetRepositoryByRepoID(ctx context.Context, repoID int32) (Repository, error) {
-	row := q.db.QueryRowContext(ctx, getRepositoryByRepoID, repoID)
+type GetRepositoryByRepoIDParams struct {
+	RepoID       int32        `json:"repo_id"`
+	ProviderType ProviderType `json:"provider_type"`
+}
+
+// GetRepositoryByRepoID looks up a repository by the ID assigned to it by a
+// kind of provider, e.g. GitHub.
+func (q *Queries) GetRepositoryByRepoID(ctx context.Context, arg GetRepositoryByRepoIDParams) (Repository, error) {
+	row := q.db.QueryRowContext(ctx, getRepositoryByRepoID, arg.RepoID, arg.ProviderType)
 	var i Repository
 	err := row.Scan(
 		&i.ID,
@@ -165,12 +180,13 @@ func (q *Queries) GetRepositoryByRepoID(ctx context.Context, repoID int32) (Repo
 		&i.CloneUrl,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.WebhookSecret,
 	)
 	return i, err
 }
 
 const getRepositoryByRepoName = `-- name: GetRepositoryByRepoName :one
-SELECT id, provider, project_id, repo_owner, repo_name, repo_id, is_private, is_fork, webhook_id, webhook_url, deploy_url, clone_url, created_at, updated_at FROM repositories WHERE provider = $1 AND repo_owner = $2 AND repo_name = $3
+SELECT id, provider, project_id, repo_owner, repo_name, repo_id, is_private, is_fork, webhook_id, webhook_url, deploy_url, clone_url, created_at, updated_at, webhook_secret FROM repositories WHERE provider = $1 AND repo_owner = $2 AND repo_name = $3
 `
 
 type GetRepositoryByRepoNameParams struct {
@@ -197,12 +213,13 @@ func (q *Queries) GetRepositoryByRepoName(ctx context.Context, arg GetRepository
 		&i.CloneUrl,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.WebhookSecret,
 	)
 	return i, err
 }
 
 const listAllRepositories = `-- name: ListAllRepositories :many
-SELECT id, provider, project_id, repo_owner, repo_name, repo_id, is_private, is_fork, webhook_id, webhook_url, deploy_url, clone_url, created_at, updated_at FROM repositories WHERE provider = $1
+SELECT id, provider, project_id, repo_owner, repo_name, repo_id, is_private, is_fork, webhook_id, webhook_url, deploy_url, clone_url, created_at, updated_at, webhook_secret FROM repositories WHERE provider = $1
 ORDER BY repo_name
 `
 
@@ -230,6 +247,7 @@ func (q *Queries) ListAllRepositories(ctx context.Context, provider string) ([]R
 			&i.CloneUrl,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.WebhookSecret,
 		); err != nil {
 			return nil, err
 		}
@@ -245,7 +263,7 @@ func (q *Queries) ListAllRepositories(ctx context.Context, provider string) ([]R
 }
 
 const listRegisteredRepositoriesByProjectIDAndProvider = `-- name: ListRegisteredRepositoriesByProjectIDAndProvider :many
-SELECT id, provider, project_id, repo_owner, repo_name, repo_id, is_private, is_fork, webhook_id, webhook_url, deploy_url, clone_url, created_at, updated_at FROM repositories
+SELECT id, provider, project_id, repo_owner, repo_name, repo_id, is_private, is_fork, webhook_id, webhook_url, deploy_url, clone_url, created_at, updated_at, webhook_secret FROM repositories
 WHERE provider = $1 AND project_id = $2 AND webhook_id IS NOT NULL
 ORDER BY repo_name
 `
@@ -279,6 +297,7 @@ func (q *Queries) ListRegisteredRepositoriesByProjectIDAndProvider(ctx context.C
 			&i.CloneUrl,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.WebhookSecret,
 		); err != nil {
 			return nil, err
 		}
@@ -294,7 +313,7 @@ func (q *Queries) ListRegisteredRepositoriesByProjectIDAndProvider(ctx context.C
 }
 
 const listRepositoriesByOwner = `-- name: ListRepositoriesByOwner :many
-SELECT id, provider, project_id, repo_owner, repo_name, repo_id, is_private, is_fork, webhook_id, webhook_url, deploy_url, clone_url, created_at, updated_at FROM repositories
+SELECT id, provider, project_id, repo_owner, repo_name, repo_id, is_private, is_fork, webhook_id, webhook_url, deploy_url, clone_url, created_at, updated_at, webhook_secret FROM repositories
 WHERE provider = $1 AND repo_owner = $2
 ORDER BY repo_name
 LIMIT $3
@@ -337,6 +356,7 @@ func (q *Queries) ListRepositoriesByOwner(ctx context.Context, arg ListRepositor
 			&i.CloneUrl,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.WebhookSecret,
 		); err != nil {
 			return nil, err
 		}
@@ -352,7 +372,7 @@ func (q *Queries) ListRepositoriesByOwner(ctx context.Context, arg ListRepositor
 }
 
 const listRepositoriesByProjectID = `-- name: ListRepositoriesByProjectID :many
-SELECT id, provider, project_id, repo_owner, repo_name, repo_id, is_private, is_fork, webhook_id, webhook_url, deploy_url, clone_url, created_at, updated_at FROM repositories
+SELECT id, provider, project_id, repo_owner, repo_name, repo_id, is_private, is_fork, webhook_id, webhook_url, deploy_url, clone_url, created_at, updated_at, webhook_secret FROM repositories
 WHERE provider = $1 AND project_id = $2
 ORDER BY repo_name
 `
@@ -386,6 +406,53 @@ func (q *Queries) ListRepositoriesByProjectID(ctx context.Context, arg ListRepos
 			&i.CloneUrl,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.WebhookSecret,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRepositoriesByRepoID = `-- name: ListRepositoriesByRepoID :many
+SELECT id, provider, project_id, repo_owner, repo_name, repo_id, is_private, is_fork, webhook_id, webhook_url, deploy_url, clone_url, created_at, updated_at, webhook_secret FROM repositories WHERE repo_id = $1 ORDER BY created_at
+`
+
+// ListRepositoriesByRepoID lists the repositories registered with an ID through
+// any provider. Providers of the same kind may be enrolled with different
+// instances, which assign the same IDs to different repositories.
+func (q *Queries) ListRepositoriesByRepoID(ctx context.Context, repoID int32) ([]Repository, error) {
+	rows, err := q.db.QueryContext(ctx, listRepositoriesByRepoID, repoID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Repository{}
+	for rows.Next() {
+		var i Repository
+		if err := rows.Scan(
+			&i.ID,
+			&i.Provider,
+			&i.ProjectID,
+			&i.RepoOwner,
+			&i.RepoName,
+			&i.RepoID,
+			&i.IsPrivate,
+			&i.IsFork,
+			&i.WebhookID,
+			&i.WebhookUrl,
+			&i.DeployUrl,
+			&i.CloneUrl,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.WebhookSecret,
 		); err != nil {
 			return nil, err
 		}
@@ -414,7 +481,7 @@ deploy_url = $10,
 provider = $11,
 clone_url = CASE WHEN $12::text = '' THEN clone_url ELSE $12::text END,
 updated_at = NOW() 
-WHERE id = $1 RETURNING id, provider, project_id, repo_owner, repo_name, repo_id, is_private, is_fork, webhook_id, webhook_url, deploy_url, clone_url, created_at, updated_at
+WHERE id = $1 RETURNING id, provider, project_id, repo_owner, repo_name, repo_id, is_private, is_fork, webhook_id, webhook_url, deploy_url, clone_url, created_at, updated_at, webhook_secret
 `
 
 type UpdateRepositoryParams struct {
@@ -464,6 +531,7 @@ func (q *Queries) UpdateRepository(ctx context.Context, arg UpdateRepositoryPara
 		&i.CloneUrl,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.WebhookSecret,
 	)
 	return i, err
 }
@@ -481,7 +549,7 @@ deploy_url = $9,
 provider = $10,
 clone_url = CASE WHEN $11::text = '' THEN clone_url ELSE $11::text END,
 updated_at = NOW() 
-WHERE repo_id = $1 RETURNING id, provider, project_id, repo_owner, repo_name, repo_id, is_private, is_fork, webhook_id, webhook_url, deploy_url, clone_url, created_at, updated_at
+WHERE repo_id = $1 RETURNING id, provider, project_id, repo_owner, repo_name, repo_id, is_private, is_fork, webhook_id, webhook_url, deploy_url, clone_url, created_at, updated_at, webhook_secret
 `
 
 type UpdateRepositoryByIDParams struct {
@@ -528,6 +596,7 @@ func (q *Queries) UpdateRepositoryByID(ctx context.Context, arg UpdateRepository
 		&i.CloneUrl,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.WebhookSecret,
 	)
 	return i, err
 }
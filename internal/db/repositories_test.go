@@ -24,6 +24,7 @@ package db
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
@@ -37,8 +38,12 @@ import (
 type RepositoryOption func(*CreateRepositoryParams)
 
 func deleteRepositoryByRepoId(params CreateRepositoryParams) error {
-	repo, err := testQueries.GetRepositoryByRepoID(
-		context.Background(), params.RepoID)
+	repo, err := testQueries.GetRepositoryByIDAndProject(
+		context.Background(), GetRepositoryByIDAndProjectParams{
+			Provider:  params.Provider,
+			RepoID:    params.RepoID,
+			ProjectID: params.ProjectID,
+		})
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil
 	}
@@ -158,6 +163,57 @@ func TestGetRepositoryByRepoName(t *testing.T) {
 	require.Equal(t, repo1.UpdatedAt, repo2.UpdatedAt)
 }
 
+func TestRepoIDUniquePerProvider(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	org := createRandomOrganization(t)
+	group := createRandomProject(t, org.ID)
+	ghProv := createRandomProvider(t, group.ID)
+	glProv, err := testQueries.CreateProvider(ctx, CreateProviderParams{
+		Name:       rand.RandomName(time.Now().UnixNano()),
+		ProjectID:  group.ID,
+		Implements: []ProviderType{ProviderTypeGitlab, ProviderTypeGit},
+		Definition: json.RawMessage("{}"),
+	})
+	require.NoError(t, err)
+
+	const repoID = int32(2001)
+	withRepoID := func(r *CreateRepositoryParams) {
+		r.RepoID = repoID
+	}
+	ghRepo := createRandomRepository(t, group.ID, ghProv.Name, withRepoID)
+	glRepo := createRandomRepository(t, group.ID, glProv.Name, withRepoID)
+
+	repo, err := testQueries.GetRepositoryByRepoID(ctx, GetRepositoryByRepoIDParams{
+		RepoID:       repoID,
+		ProviderType: ProviderTypeGithub,
+	})
+	require.NoError(t, err)
+	require.Equal(t, ghRepo.ID, repo.ID)
+
+	repos, err := testQueries.ListRepositoriesByRepoID(ctx, repoID)
+	require.NoError(t, err)
+	ids := make([]uuid.UUID, 0, len(repos))
+	for _, r := range repos {
+		ids = append(ids, r.ID)
+	}
+	require.Contains(t, ids, ghRepo.ID)
+	require.Contains(t, ids, glRepo.ID)
+
+	// the ID is still unique within a provider
+	_, err = testQueries.CreateRepository(ctx, CreateRepositoryParams{
+		Provider:   ghProv.Name,
+		ProjectID:  group.ID,
+		RepoOwner:  "acme",
+		RepoName:   "other",
+		RepoID:     repoID,
+		WebhookUrl: "https://example.com/hook",
+		DeployUrl:  "https://example.com/deploy",
+	})
+	require.Error(t, err)
+}
+
 func TestListRepositoriesByProjectID(t *testing.T) {
 	t.Parallel()
 
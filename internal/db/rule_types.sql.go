@@ -19,7 +19,7 @@ INSERT INTO rule_type (
     project_id,
     description,
     guidance,
-    definition) VALUES ($1, $2, $3, $4, $5, $6::jsonb) RETURNING id, name, provider, project_id, description, guidance, definition, created_at, updated_at
+    definition) VALUES ($1, $2, $3, $4, $5, $6::jsonb) RETURNING id, name, provider, project_id, description, guidance, definition, created_at, updated_at, revision
 `
 
 type CreateRuleTypeParams struct {
@@ -51,6 +51,7 @@ func (q *Queries) CreateRuleType(ctx context.Context, arg CreateRuleTypeParams)
 		&i.Definition,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Revision,
 	)
 	return i, err
 }
@@ -65,7 +66,7 @@ func (q *Queries) DeleteRuleType(ctx context.Context, id uuid.UUID) error {
 }
 
 const getRuleTypeByID = `-- name: GetRuleTypeByID :one
-SELECT id, name, provider, project_id, description, guidance, definition, created_at, updated_at FROM rule_type WHERE id = $1
+SELECT id, name, provider, project_id, description, guidance, definition, created_at, updated_at, revision FROM rule_type WHERE id = $1
 `
 
 func (q *Queries) GetRuleTypeByID(ctx context.Context, id uuid.UUID) (RuleType, error) {
@@ -81,12 +82,13 @@ func (q *Queries) GetRuleTypeByID(ctx context.Context, id uuid.UUID) (RuleType,
 		&i.Definition,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Revision,
 	)
 	return i, err
 }
 
 const getRuleTypeByName = `-- name: GetRuleTypeByName :one
-SELECT id, name, provider, project_id, description, guidance, definition, created_at, updated_at FROM rule_type WHERE provider = $1 AND project_id = $2 AND name = $3
+SELECT id, name, provider, project_id, description, guidance, definition, created_at, updated_at, revision FROM rule_type WHERE provider = $1 AND project_id = $2 AND name = $3
 `
 
 type GetRuleTypeByNameParams struct {
@@ -108,12 +110,13 @@ func (q *Queries) GetRuleTypeByName(ctx context.Context, arg GetRuleTypeByNamePa
 		&i.Definition,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Revision,
 	)
 	return i, err
 }
 
 const listRuleTypesByProviderAndProject = `-- name: ListRuleTypesByProviderAndProject :many
-SELECT id, name, provider, project_id, description, guidance, definition, created_at, updated_at FROM rule_type WHERE provider = $1 AND project_id = $2
+SELECT id, name, provider, project_id, description, guidance, definition, created_at, updated_at, revision FROM rule_type WHERE provider = $1 AND project_id = $2
 `
 
 type ListRuleTypesByProviderAndProjectParams struct {
@@ -140,6 +143,7 @@ func (q *Queries) ListRuleTypesByProviderAndProject(ctx context.Context, arg Lis
 			&i.Definition,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Revision,
 		); err != nil {
 			return nil, err
 		}
@@ -155,7 +159,7 @@ func (q *Queries) ListRuleTypesByProviderAndProject(ctx context.Context, arg Lis
 }
 
 const updateRuleType = `-- name: UpdateRuleType :exec
-UPDATE rule_type SET description = $2, definition = $3::jsonb WHERE id = $1
+UPDATE rule_type SET description = $2, definition = $3::jsonb, revision = revision + 1 WHERE id = $1
 `
 
 type UpdateRuleTypeParams struct {
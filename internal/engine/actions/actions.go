@@ -24,11 +24,11 @@ import (
 	"fmt"
 
 	"github.com/rs/zerolog"
-	"github.com/sqlc-dev/pqtype"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/stacklok/minder/internal/db"
 	"github.com/stacklok/minder/internal/engine/actions/alert"
+	"github.com/stacklok/minder/internal/engine/actions/alert/digest"
 	"github.com/stacklok/minder/internal/engine/actions/remediate"
 	"github.com/stacklok/minder/internal/engine/actions/remediate/pull_request"
 	enginerr "github.com/stacklok/minder/internal/engine/errors"
@@ -39,37 +39,75 @@ import (
 
 // RuleActionsEngine is the engine responsible for processing all actions i.e., remediation and alerts
 type RuleActionsEngine struct {
-	actions      map[engif.ActionType]engif.Action
-	actionsOnOff map[engif.ActionType]engif.ActionOpt
+	// profile holds the on/off state of the actions
+	profile *minderv1.Profile
+	// remediations are run in order
+	remediations []ruleAction
+	alerts       []ruleAction
+}
+
+// ruleAction is a single remediation or alert of a rule type
+type ruleAction struct {
+	// name identifies the action within the rule type
+	name   string
+	action engif.Action
 }
 
 // NewRuleActions creates a new rule actions engine
 func NewRuleActions(p *minderv1.Profile, rt *minderv1.RuleType, pbuild *providers.ProviderBuilder,
+	digestQueue digest.Queue,
 ) (*RuleActionsEngine, error) {
-	// Create the remediation engine
-	remEngine, err := remediate.NewRuleRemediator(rt, pbuild)
-	if err != nil {
-		return nil, fmt.Errorf("cannot create rule remediator: %w", err)
+	rae := &RuleActionsEngine{profile: p}
+
+	// Create the remediation engines. Rule types without remediations get a
+	// noop one so that the remediation status is still reported.
+	remCfgs := rt.GetDef().GetRemediateActions()
+	if len(remCfgs) == 0 {
+		remCfgs = []*minderv1.RuleType_Definition_Remediate{nil}
+	}
+	for _, remCfg := range remCfgs {
+		remEngine, err := remediate.NewRuleRemediator(remCfg, pbuild)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create rule remediator: %w", err)
+		}
+		rae.remediations = append(rae.remediations, ruleAction{name: remCfg.GetActionName(), action: remEngine})
 	}
 
-	// Create the alert engine
-	alertEngine, err := alert.NewRuleAlert(rt, pbuild)
-	if err != nil {
-		return nil, fmt.Errorf("cannot create rule remediator: %w", err)
+	// Create the alert engines, same as above
+	alertCfgs := rt.GetDef().GetAlertActions()
+	if len(alertCfgs) == 0 {
+		alertCfgs = []*minderv1.RuleType_Definition_Alert{nil}
 	}
+	for _, alertCfg := range alertCfgs {
+		alertEngine, err := alert.NewRuleAlert(alertCfg, pbuild, digestQueue)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create rule alerter: %w", err)
+		}
+		rae.alerts = append(rae.alerts, ruleAction{name: alertCfg.GetActionName(), action: alertEngine})
+	}
+
+	return rae, nil
+}
+
+// getOnOffState reads the on/off state of the action for the given rule from
+// the profile. The setting of the rule takes precedence over the setting of the
+// named action, which takes precedence over the setting of the profile.
+func (rae *RuleActionsEngine) getOnOffState(ra ruleAction, rule *minderv1.Profile_Rule) engif.ActionOpt {
+	onOff := ra.action.GetOnOffState(rae.profile, nil)
 
-	return &RuleActionsEngine{
-		actions: map[engif.ActionType]engif.Action{
-			remEngine.Class():   remEngine,
-			alertEngine.Class(): alertEngine,
-		},
-		// The on/off state of the actions is an integral part of the action engine
-		// and should be set upon creation.
-		actionsOnOff: map[engif.ActionType]engif.ActionOpt{
-			remEngine.Class():   remEngine.GetOnOffState(p),
-			alertEngine.Class(): alertEngine.GetOnOffState(p),
-		},
-	}, nil
+	settings := rae.profile.GetAlertActions()
+	ruleSetting := rule.GetAlert()
+	if ra.action.Class() == remediate.ActionType {
+		settings = rae.profile.GetRemediateActions()
+		ruleSetting = rule.GetRemediate()
+	}
+	if setting, ok := settings[ra.name]; ok {
+		onOff = engif.ActionOptFromString(&setting, onOff)
+	}
+	if ruleSetting != "" {
+		onOff = ra.action.GetOnOffState(rae.profile, rule)
+	}
+	return onOff
 }
 
 // DoActions processes all actions i.e., remediation and alerts
@@ -81,107 +119,177 @@ func (rae *RuleActionsEngine) DoActions(
 	// Get logger
 	logger := zerolog.Ctx(ctx)
 
-	// Default to skipping all actions
-	result := getDefaultResult(ctx)
-	skipRemediate := true
-	skipAlert := true
-
-	// Verify the remediate action engine is available and get its status - on/off/dry-run
-	remediateEngine, ok := rae.actions[remediate.ActionType]
-	if !ok {
-		logger.Error().Str("action_type", string(remediate.ActionType)).Msg("not found")
-		result.RemediateErr = fmt.Errorf("%s:%w", remediate.ActionType, enginerr.ErrActionNotAvailable)
-	} else {
-		skipRemediate = rae.isSkippable(ctx, remediate.ActionType, params.GetEvalErr())
-	}
+	var result enginerr.ActionsError
 
-	// Verify the alert action engine is available and get its status - on/off/dry-run
-	_, ok = rae.actions[alert.ActionType]
-	if !ok {
-		logger.Error().Str("action_type", string(alert.ActionType)).Msg("not found")
-		result.AlertErr = fmt.Errorf("%s:%w", alert.ActionType, enginerr.ErrActionNotAvailable)
-	} else {
-		skipAlert = rae.isSkippable(ctx, alert.ActionType, params.GetEvalErr())
-	}
-
-	// Try remediating
-	if !skipRemediate {
-		// Decide if we should remediate
-		cmd := shouldRemediate(params.GetEvalStatusFromDb(), params.GetEvalErr())
-		// Run remediation
-		result.RemediateMeta, result.RemediateErr = rae.processAction(ctx, remediate.ActionType, cmd, ent, params,
-			nil)
+	// Try remediating, in order. A failed remediation prevents the next ones
+	// from running since they may depend on it.
+	failed := false
+	for _, rem := range rae.remediations {
+		// Default to skipping the action
+		res := getDefaultResult(ctx, rem.name)
+		onOff := rae.getOnOffState(rem, params.GetRule())
+		if failed {
+			logger.Info().Str("remediation", rem.name).Msg("skipping remediation, a previous one failed")
+		} else if !rae.isSkippable(ctx, rem, onOff, params.GetEvalErr()) {
+			// Decide if we should remediate
+			cmd := shouldRemediate(params.GetEvalStatusFromDb(), params.GetEvalErr())
+			// Run remediation
+			var prevMeta *json.RawMessage
+			if prev := params.GetRemediationFromDb(rem.name); prev != nil {
+				prevMeta = &prev.Metadata
+			}
+			var meta json.RawMessage
+			if onOff == engif.ActionOptApproval {
+				meta, res.Err = rae.processApproval(ctx, rem, cmd, ent, params, prevMeta)
+			} else {
+				meta, res.Err = rae.processAction(ctx, rem, cmd, onOff, ent, params, prevMeta)
+			}
+			// Keep the state recorded by a previous remediation, which is
+			// needed to roll it back, unless the remediation recorded a new one
+			if meta != nil {
+				res.Meta = meta
+			} else if prevMeta != nil {
+				res.Meta = *prevMeta
+			}
+			failed = enginerr.IsActionFatalError(res.Err)
+		}
+		result.Remediations = append(result.Remediations, res)
 	}
+	remediated := rae.isRemediated(result.Remediations)
 
-	// Try alerting
-	if !skipAlert {
-		// Decide if we should alert
-		cmd := shouldAlert(params.GetEvalStatusFromDb(), params.GetEvalErr(), result.RemediateErr, remediateEngine.Type())
-		// Run alerting
-		result.AlertMeta, result.AlertErr = rae.processAction(ctx, alert.ActionType, cmd, ent, params,
-			getMeta(params.GetEvalStatusFromDb().AlertMetadata))
+	// Try alerting, each alert keeps track of its own state
+	for _, alrt := range rae.alerts {
+		// Default to skipping the action
+		res := getDefaultResult(ctx, alrt.name)
+		onOff := rae.getOnOffState(alrt, params.GetRule())
+		if !rae.isSkippable(ctx, alrt, onOff, params.GetEvalErr()) {
+			prev := params.GetAlertFromDb(alrt.name)
+			// Decide if we should alert
+			cmd := shouldAlert(params.GetEvalStatusFromDb(), prev, params.GetEvalErr(), remediated)
+			// Run alerting
+			var prevMeta *json.RawMessage
+			if prev != nil {
+				prevMeta = &prev.Metadata
+			}
+			res.Meta, res.Err = rae.processAction(ctx, alrt, cmd, onOff, ent, params, prevMeta)
+		}
+		result.Alerts = append(result.Alerts, res)
 	}
 	return result
 }
 
-// processAction runs the action engine for the given action type, and also sanity checks the result of the action
-func (rae *RuleActionsEngine) processAction(
+// processAction runs the action engine, and also sanity checks the result of the action
+func (_ *RuleActionsEngine) processAction(
 	ctx context.Context,
-	actionType engif.ActionType,
+	ra ruleAction,
 	cmd engif.ActionCmd,
+	onOff engif.ActionOpt,
 	ent protoreflect.ProtoMessage,
 	params engif.ActionsParams,
 	metadata *json.RawMessage,
 ) (json.RawMessage, error) {
-	// Get action engine
-	action := rae.actions[actionType]
 	// Return the result of the action
 	logger := zerolog.Ctx(ctx)
 	logger.Debug().
-		Str("action", string(actionType)).
+		Str("action", string(ra.action.Class())).
+		Str("action_name", ra.name).
 		Str("cmd", string(cmd)).
 		Msg("invoking action")
-	return action.Do(ctx, cmd, rae.actionsOnOff[actionType], ent, params, metadata)
+	return ra.action.Do(ctx, cmd, onOff, ent, params, metadata)
 }
 
-// shouldRemediate returns the action command for remediation taking into account previous evaluations
+// processApproval runs a remediation that waits for the approval of a user. An
+// approved remediation is performed, as long as the rule still fails. Otherwise
+// the remediation is rendered to be reviewed, unless the very same change was
+// rejected already.
+func (rae *RuleActionsEngine) processApproval(
+	ctx context.Context,
+	ra ruleAction,
+	cmd engif.ActionCmd,
+	ent protoreflect.ProtoMessage,
+	params engif.ActionsParams,
+	metadata *json.RawMessage,
+) (json.RawMessage, error) {
+	// dealing with what an approved remediation left behind once the rule
+	// passes doesn't need another approval
+	if cmd == engif.ActionCmdOff {
+		return rae.processAction(ctx, ra, cmd, engif.ActionOptOn, ent, params, metadata)
+	}
+	if !errors.Is(params.GetEvalErr(), enginerr.ErrEvaluationFailed) {
+		return nil, fmt.Errorf("%s : rule is not failing: %w", ra.action.Class(), enginerr.ErrActionSkipped)
+	}
+
+	approval := params.GetRemediationApprovalFromDb(ra.name)
+	if approval != nil && approval.Status == db.RemediationApprovalStatusApproved {
+		zerolog.Ctx(ctx).Info().Str("remediation", ra.name).Msg("performing approved remediation")
+		return rae.processAction(ctx, ra, cmd, engif.ActionOptOn, ent, params, metadata)
+	}
+
+	meta, err := rae.processAction(ctx, ra, cmd, engif.ActionOptApproval, ent, params, metadata)
+	var pending *enginerr.PendingApprovalError
+	if approval != nil && approval.Status == db.RemediationApprovalStatusRejected &&
+		errors.As(err, &pending) && pending.Preview == approval.Preview {
+		return meta, fmt.Errorf("%s : remediation was rejected: %w", ra.action.Class(), enginerr.ErrActionSkipped)
+	}
+	return meta, err
+}
+
+// isRemediated returns true if the remediations fixed the entity right away,
+// which is not the case of pull request remediations until the PR is merged
+func (rae *RuleActionsEngine) isRemediated(results []enginerr.ActionResult) bool {
+	remediated := false
+	for i, res := range results {
+		if enginerr.IsActionFatalError(res.Err) {
+			return false
+		}
+		if res.Err == nil {
+			if rae.remediations[i].action.Type() == pull_request.RemediateType {
+				return false
+			}
+			remediated = true
+		}
+	}
+	return remediated
+}
+
+// shouldRemediate returns the action command for remediation taking into account previous evaluations.
+// A passing rule turns the remediation off, which only pull request remediations act upon to deal
+// with the PR they opened.
 func shouldRemediate(prevEvalFromDb *db.ListRuleEvaluationsByProfileIdRow, evalErr error) engif.ActionCmd {
 	_ = prevEvalFromDb
-	_ = evalErr
-	// To be used in the future in case we decide to implement specific condition handling for performing a remediation
-	// that is not solely based on the current evaluation error.
-	// Example: Skip remediation if remediate_type is PR, the evalErr is failing, but we already have the last
-	// remediate status set to "success", meaning we created a PR remediation,
-	// it's just not merged yet so no need to create another one
+	if evalErr == nil {
+		return engif.ActionCmdOff
+	}
 	return engif.ActionCmdOn
 }
 
 // shouldAlert returns the action command for alerting taking into account previous evaluations
+// and the previous state of the alert, nil if the alert has not been processed yet
 func shouldAlert(
 	prevEvalFromDb *db.ListRuleEvaluationsByProfileIdRow,
+	prevAlertFromDb *db.RuleDetailsAlert,
 	evalErr error,
-	remErr error,
-	remType string,
+	remediated bool,
 ) engif.ActionCmd {
 	// Get current evaluation status
 	newEval := enginerr.ErrorAsEvalStatus(evalErr)
 
-	// Get previous evaluation status
+	// Get previous evaluation status and previous Alert status. An alert that
+	// has not been processed yet, e.g. because it was just added to the rule
+	// type, considers the evaluation as new.
 	prevEval := db.EvalStatusTypesPending
-	if prevEvalFromDb.EvalStatus.Valid {
-		prevEval = prevEvalFromDb.EvalStatus.EvalStatusTypes
-	}
-
-	// Get previous Alert status
 	prevAlert := db.AlertStatusTypesSkipped
-	if prevEvalFromDb.AlertStatus.Valid {
-		prevAlert = prevEvalFromDb.AlertStatus.AlertStatusTypes
+	if prevAlertFromDb != nil {
+		if prevEvalFromDb.EvalStatus.Valid {
+			prevEval = prevEvalFromDb.EvalStatus.EvalStatusTypes
+		}
+		prevAlert = prevAlertFromDb.Status
 	}
 
 	// Start evaluation scenarios
 
 	// Case 1 - Successful remediation of a type that is not PR is considered instant.
-	if remType != pull_request.RemediateType && remErr == nil {
+	if remediated {
 		// If this is the case either skip alerting or turn it off if it was on
 		if prevAlert != db.AlertStatusTypesOff {
 			return engif.ActionCmdOff
@@ -189,8 +297,12 @@ func shouldAlert(
 		return engif.ActionCmdDoNothing
 	}
 
-	// Case 2 - Do nothing if the evaluation status has not changed
+	// Case 2 - Do nothing if the evaluation status has not changed, except
+	// telling the alerts which are on that the rule is still failing
 	if newEval == prevEval && prevAlert != db.AlertStatusTypesError {
+		if newEval == db.EvalStatusTypesFailure && prevAlert == db.AlertStatusTypesOn {
+			return engif.ActionCmdStillOn
+		}
 		return engif.ActionCmdDoNothing
 	}
 
@@ -220,19 +332,18 @@ func shouldAlert(
 }
 
 // isSkippable returns true if the action should be skipped
-func (rae *RuleActionsEngine) isSkippable(ctx context.Context, actionType engif.ActionType, evalErr error) bool {
+func (_ *RuleActionsEngine) isSkippable(
+	ctx context.Context,
+	ra ruleAction,
+	onOff engif.ActionOpt,
+	evalErr error,
+) bool {
 	var skipRemediation bool
 
 	logger := zerolog.Ctx(ctx)
 
-	// Get the profile option set for this action type
-	actionOnOff, ok := rae.actionsOnOff[actionType]
-	if !ok {
-		// If the action is not found, definitely skip it
-		return true
-	}
-	// Check the action option
-	switch actionOnOff {
+	// Check the action option set in the profile
+	switch onOff {
 	case engif.ActionOptOff:
 		// Action is off, skip
 		return true
@@ -240,30 +351,24 @@ func (rae *RuleActionsEngine) isSkippable(ctx context.Context, actionType engif.
 		// Action is unknown, skip
 		logger.Info().Msg("unknown action option, check your profile definition")
 		return true
-	case engif.ActionOptDryRun, engif.ActionOptOn:
-		// Action is on or dry-run, do not skip yet. Check the evaluation error
+	case engif.ActionOptDryRun, engif.ActionOptOn, engif.ActionOptApproval:
+		// Action is on, dry-run or waits for approval, do not skip yet. Check the evaluation error
 		skipRemediation =
 			// rule evaluation was skipped, skip action too
 			errors.Is(evalErr, enginerr.ErrEvaluationSkipped) ||
 				// rule evaluation was skipped silently, skip action
 				errors.Is(evalErr, enginerr.ErrEvaluationSkipSilently) ||
-				// rule evaluation had no error, skip action if actionType IS NOT alert
-				(evalErr == nil && actionType != alert.ActionType)
+				// rule evaluation had no error, skip action if actionType IS NOT alert,
+				// except for pull request remediations which close the PR they opened
+				(evalErr == nil && ra.action.Class() != alert.ActionType &&
+					ra.action.Type() != pull_request.RemediateType)
 	}
 	// Everything else, do not skip
 	return skipRemediation
 }
 
-// getMeta returns the json.RawMessage from the database type, empty if not valid
-func getMeta(rawMsg pqtype.NullRawMessage) *json.RawMessage {
-	if rawMsg.Valid {
-		return &rawMsg.RawMessage
-	}
-	return nil
-}
-
-// getDefaultResult returns the default result for the action engine
-func getDefaultResult(ctx context.Context) enginerr.ActionsError {
+// getDefaultResult returns the default result of the named action
+func getDefaultResult(ctx context.Context, name string) enginerr.ActionResult {
 	// Get logger
 	logger := zerolog.Ctx(ctx)
 
@@ -273,10 +378,9 @@ func getDefaultResult(ctx context.Context) enginerr.ActionsError {
 	if err != nil {
 		logger.Error().Err(err).Msg("error marshaling empty json.RawMessage")
 	}
-	return enginerr.ActionsError{
-		RemediateErr:  enginerr.ErrActionSkipped,
-		AlertErr:      enginerr.ErrActionSkipped,
-		RemediateMeta: m,
-		AlertMeta:     m,
+	return enginerr.ActionResult{
+		Name: name,
+		Err:  enginerr.ErrActionSkipped,
+		Meta: m,
 	}
 }
@@ -0,0 +1,512 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/stacklok/minder/internal/db"
+	"github.com/stacklok/minder/internal/engine/actions/alert"
+	"github.com/stacklok/minder/internal/engine/actions/remediate"
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
+	engif "github.com/stacklok/minder/internal/engine/interfaces"
+	"github.com/stacklok/minder/internal/providers"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+type fakeAction struct {
+	class      engif.ActionType
+	typ        string
+	err        error
+	meta       json.RawMessage
+	called     bool
+	gotCmd     engif.ActionCmd
+	gotSetting engif.ActionOpt
+	gotMeta    *json.RawMessage
+}
+
+func (f *fakeAction) Class() engif.ActionType { return f.class }
+
+func (f *fakeAction) Type() string { return f.typ }
+
+func (f *fakeAction) GetOnOffState(p *pb.Profile, rule *pb.Profile_Rule) engif.ActionOpt {
+	if f.class == remediate.ActionType {
+		return engif.ActionOptFromString(p.GetRemediateSetting(rule), engif.ActionOptOff)
+	}
+	return engif.ActionOptFromString(p.GetAlertSetting(rule), engif.ActionOptOn)
+}
+
+func (f *fakeAction) Do(
+	_ context.Context,
+	cmd engif.ActionCmd,
+	setting engif.ActionOpt,
+	_ protoreflect.ProtoMessage,
+	_ engif.ActionsParams,
+	metadata *json.RawMessage,
+) (json.RawMessage, error) {
+	f.called = true
+	f.gotCmd = cmd
+	f.gotSetting = setting
+	f.gotMeta = metadata
+	return f.meta, f.err
+}
+
+func TestGetOnOffState(t *testing.T) {
+	t.Parallel()
+
+	on := "on"
+	off := "off"
+	rae := &RuleActionsEngine{
+		profile: &pb.Profile{
+			Remediate:        &on,
+			RemediateActions: map[string]string{"branch": "dry_run"},
+		},
+	}
+	branch := ruleAction{name: "branch", action: &fakeAction{class: remediate.ActionType}}
+	other := ruleAction{name: "other", action: &fakeAction{class: remediate.ActionType}}
+	alrt := ruleAction{name: "branch", action: &fakeAction{class: alert.ActionType}}
+
+	assert.Equal(t, engif.ActionOptDryRun, rae.getOnOffState(branch, &pb.Profile_Rule{}),
+		"the setting of the action takes precedence")
+	assert.Equal(t, engif.ActionOptOff, rae.getOnOffState(branch, &pb.Profile_Rule{Remediate: &off}),
+		"the setting of the rule takes precedence over the action")
+	assert.Equal(t, engif.ActionOptOn, rae.getOnOffState(other, &pb.Profile_Rule{}),
+		"the setting of the profile is the default")
+	assert.Equal(t, engif.ActionOptOff, rae.getOnOffState(other, &pb.Profile_Rule{Remediate: &off}),
+		"the setting of the rule overrides the profile")
+	assert.Equal(t, engif.ActionOptOn, rae.getOnOffState(alrt, &pb.Profile_Rule{}),
+		"the settings of remediations don't apply to alerts")
+}
+
+func TestGetOnOffStateRuleOffActionOn(t *testing.T) {
+	t.Parallel()
+
+	on := "on"
+	off := "off"
+	rae := &RuleActionsEngine{
+		profile: &pb.Profile{
+			Remediate:        &on,
+			Alert:            &on,
+			RemediateActions: map[string]string{"branch": "on"},
+			AlertActions:     map[string]string{"webhook": "on"},
+		},
+	}
+	rem := ruleAction{name: "branch", action: &fakeAction{class: remediate.ActionType}}
+	alrt := ruleAction{name: "webhook", action: &fakeAction{class: alert.ActionType}}
+	rule := &pb.Profile_Rule{Remediate: &off, Alert: &off}
+
+	assert.Equal(t, engif.ActionOptOff, rae.getOnOffState(rem, rule),
+		"turning a remediation on in the profile doesn't turn on a rule turned off")
+	assert.Equal(t, engif.ActionOptOff, rae.getOnOffState(alrt, rule),
+		"turning an alert on in the profile doesn't turn on a rule turned off")
+}
+
+func TestDoActionsMultiple(t *testing.T) {
+	t.Parallel()
+
+	failingRem := &fakeAction{class: remediate.ActionType, typ: "rest", err: enginerr.ErrActionFailed}
+	nextRem := &fakeAction{class: remediate.ActionType, typ: "rest"}
+	advisory := &fakeAction{class: alert.ActionType, typ: "security_advisory", meta: json.RawMessage(`{"ghsa_id":"1"}`)}
+	hook := &fakeAction{class: alert.ActionType, typ: "webhook", meta: json.RawMessage(`{"alert_id":"2"}`)}
+
+	on := "on"
+	rae := &RuleActionsEngine{
+		profile: &pb.Profile{Remediate: &on},
+		remediations: []ruleAction{
+			{name: "first", action: failingRem},
+			{name: "second", action: nextRem},
+		},
+		alerts: []ruleAction{
+			{name: "security_advisory", action: advisory},
+			{name: "webhook", action: hook},
+		},
+	}
+
+	prevMeta := json.RawMessage(`{"ghsa_id":"0"}`)
+	params := &engif.EvalStatusParams{
+		EvalStatusFromDb: &db.ListRuleEvaluationsByProfileIdRow{
+			EvalStatus: db.NullEvalStatusTypes{EvalStatusTypes: db.EvalStatusTypesSuccess, Valid: true},
+		},
+		AlertsFromDb: map[string]db.RuleDetailsAlert{
+			"security_advisory": {ActionName: "security_advisory", Status: db.AlertStatusTypesOff, Metadata: prevMeta},
+		},
+	}
+	params.SetEvalErr(enginerr.NewErrEvaluationFailed("failing"))
+
+	result := rae.DoActions(context.Background(), &pb.Repository{}, params)
+
+	require.Len(t, result.Remediations, 2)
+	assert.True(t, failingRem.called)
+	assert.ErrorIs(t, result.Remediations[0].Err, enginerr.ErrActionFailed)
+	assert.False(t, nextRem.called, "a remediation should not run after a failed one")
+	assert.Equal(t, "second", result.Remediations[1].Name)
+	assert.ErrorIs(t, result.Remediations[1].Err, enginerr.ErrActionSkipped)
+	assert.ErrorIs(t, result.RemediateErr(), enginerr.ErrActionFailed)
+
+	require.Len(t, result.Alerts, 2)
+	assert.True(t, advisory.called)
+	require.NotNil(t, advisory.gotMeta)
+	assert.JSONEq(t, string(prevMeta), string(*advisory.gotMeta))
+	assert.JSONEq(t, `{"ghsa_id":"1"}`, string(result.Alerts[0].Meta))
+	assert.True(t, hook.called)
+	assert.Nil(t, hook.gotMeta)
+	assert.JSONEq(t, `{"alert_id":"2"}`, string(result.Alerts[1].Meta))
+	assert.NoError(t, result.AlertErr())
+}
+
+func TestDoActionsKeepsRemediationMeta(t *testing.T) {
+	t.Parallel()
+
+	dryRun := &fakeAction{class: remediate.ActionType, typ: "rest"}
+	branch := &fakeAction{class: remediate.ActionType, typ: "gh_branch_protection",
+		meta: json.RawMessage(`{"branch":"main"}`)}
+
+	on := "on"
+	rae := &RuleActionsEngine{
+		profile: &pb.Profile{Remediate: &on, RemediateActions: map[string]string{"rest": "dry_run"}},
+		remediations: []ruleAction{
+			{name: "rest", action: dryRun},
+			{name: "branch", action: branch},
+		},
+	}
+
+	prevMeta := json.RawMessage(`{"endpoint":"repos/foo/bar"}`)
+	params := &engif.EvalStatusParams{
+		RemediationsFromDb: map[string]db.RuleDetailsRemediate{
+			"rest":   {ActionName: "rest", Metadata: prevMeta},
+			"branch": {ActionName: "branch", Metadata: json.RawMessage(`{"branch":"old"}`)},
+		},
+	}
+	params.SetEvalErr(enginerr.NewErrEvaluationFailed("failing"))
+
+	result := rae.DoActions(context.Background(), &pb.Repository{}, params)
+
+	require.Len(t, result.Remediations, 2)
+	assert.True(t, dryRun.called)
+	assert.JSONEq(t, string(prevMeta), string(result.Remediations[0].Meta),
+		"a remediation without metadata keeps the previous one")
+	assert.True(t, branch.called)
+	assert.JSONEq(t, `{"branch":"main"}`, string(result.Remediations[1].Meta),
+		"a remediation with metadata replaces the previous one")
+}
+
+func TestDoActionsPassingRule(t *testing.T) {
+	t.Parallel()
+
+	rest := &fakeAction{class: remediate.ActionType, typ: "rest"}
+	pr := &fakeAction{class: remediate.ActionType, typ: "pull_request", err: enginerr.ErrActionSkipped}
+
+	on := "on"
+	rae := &RuleActionsEngine{
+		profile: &pb.Profile{Remediate: &on},
+		remediations: []ruleAction{
+			{name: "rest", action: rest},
+			{name: "pull_request", action: pr},
+		},
+	}
+
+	prevMeta := json.RawMessage(`{"pr_number":42}`)
+	params := &engif.EvalStatusParams{
+		RemediationsFromDb: map[string]db.RuleDetailsRemediate{
+			"pull_request": {ActionName: "pull_request", Metadata: prevMeta},
+		},
+	}
+
+	result := rae.DoActions(context.Background(), &pb.Repository{}, params)
+
+	require.Len(t, result.Remediations, 2)
+	assert.False(t, rest.called, "a passing rule does not need remediating")
+	assert.True(t, pr.called, "a pull request remediation deals with its PR once the rule passes")
+	assert.Equal(t, engif.ActionCmdOff, pr.gotCmd)
+	assert.JSONEq(t, string(prevMeta), string(result.Remediations[1].Meta))
+}
+
+func TestDoActionsIssueStillFailing(t *testing.T) {
+	t.Parallel()
+
+	const issuePath = "/repos/stacklok/minder/issues/42"
+
+	var mu sync.Mutex
+	var comments []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, issuePath):
+			fmt.Fprint(w, `{"number": 42, "state": "open", "html_url": "https://github.com/stacklok/minder/issues/42"}`)
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, issuePath+"/comments"):
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				t.Error(err)
+			}
+			mu.Lock()
+			comments = append(comments, string(body))
+			mu.Unlock()
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id": 1}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	pbuild := providers.NewProviderBuilder(
+		&db.Provider{
+			Name:       "github",
+			Version:    provifv1.V1,
+			Implements: []db.ProviderType{db.ProviderTypeGithub, db.ProviderTypeRest},
+			Definition: json.RawMessage(fmt.Sprintf(`{"github": {"endpoint": %q}}`, server.URL+"/")),
+		},
+		db.ProviderAccessToken{},
+		"token",
+	)
+	rt := &pb.RuleType{
+		Name: "security_md",
+		Def: &pb.RuleType_Definition{
+			Alerts: []*pb.RuleType_Definition_Alert{
+				{Type: "issue", Issue: &pb.RuleType_Definition_Alert_AlertTypeIssue{}},
+			},
+		},
+	}
+	profile := &pb.Profile{Name: "acme-profile"}
+
+	rae, err := NewRuleActions(profile, rt, pbuild, nil)
+	require.NoError(t, err)
+
+	meta := json.RawMessage(`{"issue_number": 42, "issue_url": "https://github.com/stacklok/minder/issues/42"}`)
+	params := &engif.EvalStatusParams{
+		Profile:  profile,
+		RuleType: rt,
+		EvalStatusFromDb: &db.ListRuleEvaluationsByProfileIdRow{
+			EvalStatus: db.NullEvalStatusTypes{EvalStatusTypes: db.EvalStatusTypesFailure, Valid: true},
+		},
+		AlertsFromDb: map[string]db.RuleDetailsAlert{
+			"issue": {ActionName: "issue", Status: db.AlertStatusTypesOn, Metadata: meta},
+		},
+	}
+	params.SetEvalErr(enginerr.NewErrEvaluationFailed("SECURITY.md not found"))
+
+	result := rae.DoActions(context.Background(), &pb.Repository{Owner: "stacklok", Name: "minder"}, params)
+
+	require.Len(t, result.Alerts, 1)
+	require.NoError(t, result.Alerts[0].Err)
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(result.Alerts[0].Meta, &got))
+	assert.EqualValues(t, 42, got["issue_number"])
+	assert.NotEmpty(t, got["details_hash"], "the details reported should be remembered")
+	require.Len(t, comments, 1, "the issue of a rule still failing should be commented on")
+	assert.Contains(t, comments[0], "still failing")
+
+	// the next evaluation fails the same way, which was reported already
+	params.AlertsFromDb["issue"] = db.RuleDetailsAlert{
+		ActionName: "issue", Status: db.AlertStatusTypesOn, Metadata: result.Alerts[0].Meta,
+	}
+	result = rae.DoActions(context.Background(), &pb.Repository{Owner: "stacklok", Name: "minder"}, params)
+
+	require.Len(t, result.Alerts, 1)
+	require.ErrorIs(t, result.Alerts[0].Err, enginerr.ErrActionSkipped)
+	require.Len(t, comments, 1, "unchanged failure details should not be commented on again")
+}
+
+func TestProcessApproval(t *testing.T) {
+	t.Parallel()
+
+	failing := enginerr.NewErrEvaluationFailed("failing")
+	preview := "curl -X PATCH https://api.github.com/repos/foo/bar"
+
+	tests := []struct {
+		name        string
+		approval    *db.RemediationApproval
+		evalErr     error
+		wantSetting engif.ActionOpt
+		wantCalled  bool
+		wantErr     error
+	}{
+		{
+			name:        "remediation is rendered for approval",
+			evalErr:     failing,
+			wantSetting: engif.ActionOptApproval,
+			wantCalled:  true,
+			wantErr:     enginerr.ErrActionPendingApproval,
+		},
+		{
+			name:        "pending remediation is rendered again",
+			approval:    &db.RemediationApproval{Status: db.RemediationApprovalStatusPending, Preview: preview},
+			evalErr:     failing,
+			wantSetting: engif.ActionOptApproval,
+			wantCalled:  true,
+			wantErr:     enginerr.ErrActionPendingApproval,
+		},
+		{
+			name:        "approved remediation is performed",
+			approval:    &db.RemediationApproval{Status: db.RemediationApprovalStatusApproved, Preview: preview},
+			evalErr:     failing,
+			wantSetting: engif.ActionOptOn,
+			wantCalled:  true,
+		},
+		{
+			name:     "approved remediation is not performed if the rule passes",
+			approval: &db.RemediationApproval{Status: db.RemediationApprovalStatusApproved, Preview: preview},
+			wantErr:  enginerr.ErrActionSkipped,
+		},
+		{
+			name:        "rejected remediation is not proposed again",
+			approval:    &db.RemediationApproval{Status: db.RemediationApprovalStatusRejected, Preview: preview},
+			evalErr:     failing,
+			wantSetting: engif.ActionOptApproval,
+			wantCalled:  true,
+			wantErr:     enginerr.ErrActionSkipped,
+		},
+		{
+			name:        "rejected remediation is proposed again if the change differs",
+			approval:    &db.RemediationApproval{Status: db.RemediationApprovalStatusRejected, Preview: "other"},
+			evalErr:     failing,
+			wantSetting: engif.ActionOptApproval,
+			wantCalled:  true,
+			wantErr:     enginerr.ErrActionPendingApproval,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rem := &fakeAction{class: remediate.ActionType, typ: "rest"}
+			rem.err = enginerr.NewErrActionPendingApproval("rest", preview)
+			if tt.approval != nil && tt.approval.Status == db.RemediationApprovalStatusApproved {
+				rem.err = nil
+			}
+
+			params := &engif.EvalStatusParams{}
+			params.SetEvalErr(tt.evalErr)
+			if tt.approval != nil {
+				params.RemediationApprovalsFromDb = map[string]db.RemediationApproval{"rest": *tt.approval}
+			}
+
+			rae := &RuleActionsEngine{}
+			_, err := rae.processApproval(context.Background(), ruleAction{name: "rest", action: rem},
+				engif.ActionCmdOn, &pb.Repository{}, params, nil)
+
+			assert.Equal(t, tt.wantCalled, rem.called)
+			if tt.wantCalled {
+				assert.Equal(t, tt.wantSetting, rem.gotSetting)
+			}
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestShouldAlert(t *testing.T) {
+	t.Parallel()
+
+	failing := enginerr.NewErrEvaluationFailed("failing")
+	evalFailed := &db.ListRuleEvaluationsByProfileIdRow{
+		EvalStatus: db.NullEvalStatusTypes{EvalStatusTypes: db.EvalStatusTypesFailure, Valid: true},
+	}
+
+	tests := []struct {
+		name       string
+		prevAlert  *db.RuleDetailsAlert
+		evalErr    error
+		remediated bool
+		want       engif.ActionCmd
+	}{
+		{
+			name:    "new alert on a failing rule is turned on",
+			evalErr: failing,
+			want:    engif.ActionCmdOn,
+		},
+		{
+			name:      "alert already on for a failing rule is told it's still failing",
+			prevAlert: &db.RuleDetailsAlert{Status: db.AlertStatusTypesOn},
+			evalErr:   failing,
+			want:      engif.ActionCmdStillOn,
+		},
+		{
+			name:      "alert turned off for a failing rule does nothing",
+			prevAlert: &db.RuleDetailsAlert{Status: db.AlertStatusTypesOff},
+			evalErr:   failing,
+			want:      engif.ActionCmdDoNothing,
+		},
+		{
+			name:      "alert in error is retried",
+			prevAlert: &db.RuleDetailsAlert{Status: db.AlertStatusTypesError},
+			evalErr:   failing,
+			want:      engif.ActionCmdOn,
+		},
+		{
+			name:      "alert is turned off when the rule passes",
+			prevAlert: &db.RuleDetailsAlert{Status: db.AlertStatusTypesOn},
+			want:      engif.ActionCmdOff,
+		},
+		{
+			name:       "alert is turned off when the rule is remediated",
+			prevAlert:  &db.RuleDetailsAlert{Status: db.AlertStatusTypesOn},
+			evalErr:    failing,
+			remediated: true,
+			want:       engif.ActionCmdOff,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := shouldAlert(evalFailed, tt.prevAlert, tt.evalErr, tt.remediated)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestIsRemediated(t *testing.T) {
+	t.Parallel()
+
+	rae := &RuleActionsEngine{
+		remediations: []ruleAction{
+			{name: "rest", action: &fakeAction{typ: "rest"}},
+			{name: "pull_request", action: &fakeAction{typ: "pull_request"}},
+		},
+	}
+
+	assert.True(t, rae.isRemediated([]enginerr.ActionResult{{Err: nil}, {Err: enginerr.ErrActionSkipped}}))
+	assert.False(t, rae.isRemediated([]enginerr.ActionResult{{Err: nil}, {Err: nil}}),
+		"a pull request remediation is not instant")
+	assert.False(t, rae.isRemediated([]enginerr.ActionResult{
+		{Err: nil}, {Err: errors.Join(errors.New("boom"), enginerr.ErrActionFailed)},
+	}))
+	assert.False(t, rae.isRemediated([]enginerr.ActionResult{
+		{Err: enginerr.ErrActionSkipped}, {Err: enginerr.ErrActionSkipped},
+	}))
+}
@@ -20,8 +20,12 @@ package alert
 import (
 	"fmt"
 
+	"github.com/stacklok/minder/internal/engine/actions/alert/check_run"
+	"github.com/stacklok/minder/internal/engine/actions/alert/digest"
+	"github.com/stacklok/minder/internal/engine/actions/alert/issue"
 	"github.com/stacklok/minder/internal/engine/actions/alert/noop"
 	"github.com/stacklok/minder/internal/engine/actions/alert/security_advisory"
+	"github.com/stacklok/minder/internal/engine/actions/alert/webhook"
 	engif "github.com/stacklok/minder/internal/engine/interfaces"
 	"github.com/stacklok/minder/internal/providers"
 	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
@@ -30,13 +34,51 @@ import (
 // ActionType is the type of the alert engine
 const ActionType engif.ActionType = "alert"
 
-// NewRuleAlert creates a new rule alert engine
-func NewRuleAlert(rt *pb.RuleType, pbuild *providers.ProviderBuilder) (engif.Action, error) {
-	alertCfg := rt.Def.GetAlert()
+// NewRuleAlert creates a new rule alert engine for one of the alerts of a rule
+// type. If the alert is aggregated into digests, findings are enqueued into the
+// digest queue instead.
+func NewRuleAlert(
+	alertCfg *pb.RuleType_Definition_Alert,
+	pbuild *providers.ProviderBuilder,
+	queue digest.Queue,
+) (engif.Action, error) {
 	if alertCfg == nil {
 		return noop.NewNoopAlert(ActionType)
 	}
 
+	action, err := newAlertType(alertCfg, pbuild)
+	if err != nil {
+		return nil, err
+	}
+
+	if alertCfg.Digest == nil {
+		return action, nil
+	}
+	if _, ok := action.(digest.Notifier); !ok {
+		return nil, fmt.Errorf("alert type %s does not support digests", alertCfg.GetType())
+	}
+	if alertCfg.GetType() != webhook.AlertType {
+		if _, _, err := digest.Repository(alertCfg.GetDigest()); err != nil {
+			return nil, err
+		}
+	}
+	return digest.NewDigestAlert(ActionType, alertCfg, pbuild, queue)
+}
+
+// NewDigestNotifier creates the alert type a digest is delivered through
+func NewDigestNotifier(alertCfg *pb.RuleType_Definition_Alert, pbuild *providers.ProviderBuilder) (digest.Notifier, error) {
+	action, err := newAlertType(alertCfg, pbuild)
+	if err != nil {
+		return nil, err
+	}
+	notifier, ok := action.(digest.Notifier)
+	if !ok {
+		return nil, fmt.Errorf("alert type %s does not support digests", alertCfg.GetType())
+	}
+	return notifier, nil
+}
+
+func newAlertType(alertCfg *pb.RuleType_Definition_Alert, pbuild *providers.ProviderBuilder) (engif.Action, error) {
 	// nolint:revive // let's keep the switch here, it would be nicer to extend a switch in the future
 	switch alertCfg.GetType() {
 	case security_advisory.AlertType:
@@ -44,6 +86,21 @@ func NewRuleAlert(rt *pb.RuleType, pbuild *providers.ProviderBuilder) (engif.Act
 			return nil, fmt.Errorf("alert engine missing security-advisory configuration")
 		}
 		return security_advisory.NewSecurityAdvisoryAlert(ActionType, alertCfg.GetSecurityAdvisory(), pbuild)
+	case webhook.AlertType:
+		if alertCfg.GetWebhook() == nil {
+			return nil, fmt.Errorf("alert engine missing webhook configuration")
+		}
+		return webhook.NewWebhookAlert(ActionType, alertCfg.GetWebhook())
+	case issue.AlertType:
+		if alertCfg.GetIssue() == nil {
+			return nil, fmt.Errorf("alert engine missing issue configuration")
+		}
+		return issue.NewIssueAlert(ActionType, alertCfg.GetIssue(), pbuild)
+	case check_run.AlertType:
+		if alertCfg.GetCheckRun() == nil {
+			return nil, fmt.Errorf("alert engine missing check_run configuration")
+		}
+		return check_run.NewCheckRunAlert(ActionType, alertCfg.GetCheckRun(), pbuild)
 	}
 
 	return nil, fmt.Errorf("unknown alert type: %s", alertCfg.GetType())
@@ -0,0 +1,409 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package check_run provides necessary interfaces and implementations for
+// creating alerts of type check_run.
+package check_run
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v53/github"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
+	"github.com/stacklok/minder/internal/engine/interfaces"
+	"github.com/stacklok/minder/internal/providers"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+const (
+	// AlertType is the type of the check run alert engine
+	AlertType = "check_run"
+
+	defaultNamePrefix = "minder/"
+
+	statusCompleted   = "completed"
+	conclusionSuccess = "success"
+	conclusionFailure = "failure"
+
+	// the results of a rule, they match the states of commit statuses
+	ruleSuccess = "success"
+	ruleFailure = "failure"
+	ruleError   = "error"
+
+	stateCommentFmt = "<!-- minder: check-run-state: %s -->"
+	// GitHub truncates longer commit status descriptions
+	maxDescriptionLen = 140
+	// keeps the summary well below the 65535 characters allowed by GitHub
+	maxDetailsLen = 1000
+)
+
+var stateCommentRe = regexp.MustCompile(`<!-- minder: check-run-state: (.*) -->`)
+
+// Alert is the structure backing the check run alert action
+type Alert struct {
+	actionType interfaces.ActionType
+	cli        provifv1.GitHub
+	cfg        *pb.RuleType_Definition_Alert_AlertTypeCheckRun
+}
+
+type alertMetadata struct {
+	CheckRunID int64  `json:"check_run_id,omitempty"`
+	HeadSHA    string `json:"head_sha,omitempty"`
+}
+
+// ruleResult is the result of a rule, as recorded in the check run
+type ruleResult struct {
+	Status  string `json:"status"`
+	Details string `json:"details,omitempty"`
+}
+
+// checkState is the result of each rule of a profile. It is stored in the
+// summary of the check run, so that every rule can update the check run.
+type checkState struct {
+	Rules map[string]ruleResult `json:"rules"`
+}
+
+// target is the commit the results are published on
+type target struct {
+	owner string
+	repo  string
+	sha   string
+}
+
+// NewCheckRunAlert creates a new check run alert action
+func NewCheckRunAlert(
+	actionType interfaces.ActionType,
+	cfg *pb.RuleType_Definition_Alert_AlertTypeCheckRun,
+	pbuild *providers.ProviderBuilder,
+) (*Alert, error) {
+	if actionType == "" {
+		return nil, fmt.Errorf("action type cannot be empty")
+	}
+
+	cli, err := pbuild.GetGitHub(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("cannot get http client: %w", err)
+	}
+
+	return &Alert{
+		actionType: actionType,
+		cli:        cli,
+		cfg:        cfg,
+	}, nil
+}
+
+// Class returns the action type of the check run engine
+func (alert *Alert) Class() interfaces.ActionType {
+	return alert.actionType
+}
+
+// Type returns the action subtype of the check run engine
+func (_ *Alert) Type() string {
+	return AlertType
+}
+
+// GetOnOffState returns the alert action state read from the profile, the
+// setting of the rule taking precedence
+func (_ *Alert) GetOnOffState(p *pb.Profile, rule *pb.Profile_Rule) interfaces.ActionOpt {
+	return interfaces.ActionOptFromString(p.GetAlertSetting(rule), interfaces.ActionOptOn)
+}
+
+// Do publishes the result of the rule on the commit evaluated. Unlike other
+// alerts, the result is published on every evaluation regardless of the
+// command, since every new commit needs its own check run.
+func (alert *Alert) Do(
+	ctx context.Context,
+	_ interfaces.ActionCmd,
+	setting interfaces.ActionOpt,
+	entity protoreflect.ProtoMessage,
+	params interfaces.ActionsParams,
+	_ *json.RawMessage,
+) (json.RawMessage, error) {
+	tgt, err := alert.getTarget(ctx, entity)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the commit to publish on: %w, %w", err, enginerr.ErrActionFailed)
+	}
+	if tgt == nil {
+		return nil, fmt.Errorf("no commit to publish on: %w", enginerr.ErrActionSkipped)
+	}
+
+	name := alert.checkName(params)
+	rule := params.GetRuleType().GetName()
+	result := newRuleResult(params.GetEvalErr())
+
+	var meta json.RawMessage
+	switch setting {
+	case interfaces.ActionOptOn:
+		if alert.cfg.GetCommitStatus() {
+			err = alert.setCommitStatus(ctx, tgt, name, rule, result)
+		} else {
+			meta, err = alert.publish(ctx, tgt, name, rule, result)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error publishing the result: %w, %w", err, enginerr.ErrActionFailed)
+		}
+	case interfaces.ActionOptDryRun:
+		zerolog.Ctx(ctx).Info().
+			Str("check", name).
+			Str("rule", rule).
+			Str("sha", tgt.sha).
+			Msgf("would publish the rule result %s on %s/%s", result.Status, tgt.owner, tgt.repo)
+	case interfaces.ActionOptOff, interfaces.ActionOptUnknown:
+		return nil, fmt.Errorf("unexpected action setting: %w", enginerr.ErrActionFailed)
+	}
+
+	if result.Status == ruleSuccess {
+		return nil, fmt.Errorf("%s : %w", alert.Class(), enginerr.ErrActionTurnedOff)
+	}
+	return meta, nil
+}
+
+// publish records the result of the rule in the check run of the profile,
+// creating it if the commit doesn't have one yet
+func (alert *Alert) publish(
+	ctx context.Context,
+	tgt *target,
+	name, rule string,
+	result ruleResult,
+) (json.RawMessage, error) {
+	runs, err := alert.cli.ListCheckRunsForRef(ctx, tgt.owner, tgt.repo, tgt.sha, name)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list check runs: %w", err)
+	}
+
+	var existing *github.CheckRun
+	if len(runs) > 0 {
+		existing = runs[0]
+	}
+
+	state := parseState(existing.GetOutput().GetSummary())
+	prev, known := state.Rules[rule]
+	if existing != nil && known && prev == result {
+		// nothing changed since the last evaluation of the commit
+		return marshalMetadata(existing)
+	}
+	state.Rules[rule] = result
+
+	output, err := alert.output(state, rule, prev)
+	if err != nil {
+		return nil, err
+	}
+	conclusion := state.conclusion()
+	completedAt := &github.Timestamp{Time: time.Now()}
+
+	var run *github.CheckRun
+	if existing == nil {
+		run, err = alert.cli.CreateCheckRun(ctx, tgt.owner, tgt.repo, github.CreateCheckRunOptions{
+			Name:        name,
+			HeadSHA:     tgt.sha,
+			Status:      github.String(statusCompleted),
+			Conclusion:  github.String(conclusion),
+			CompletedAt: completedAt,
+			Output:      output,
+		})
+	} else {
+		run, err = alert.cli.UpdateCheckRun(ctx, tgt.owner, tgt.repo, existing.GetID(), github.UpdateCheckRunOptions{
+			Name:        name,
+			Status:      github.String(statusCompleted),
+			Conclusion:  github.String(conclusion),
+			CompletedAt: completedAt,
+			Output:      output,
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot publish check run: %w", err)
+	}
+
+	zerolog.Ctx(ctx).Info().
+		Int64("check_run_id", run.GetID()).
+		Str("conclusion", conclusion).
+		Msg("check run published")
+	return marshalMetadata(run)
+}
+
+// setCommitStatus publishes the result of the rule as a commit status of its own
+func (alert *Alert) setCommitStatus(ctx context.Context, tgt *target, name, rule string, result ruleResult) error {
+	description := result.Details
+	if result.Status == ruleSuccess {
+		description = "the rule passes"
+	}
+
+	_, err := alert.cli.SetCommitStatus(ctx, tgt.owner, tgt.repo, tgt.sha, &github.RepoStatus{
+		State:       github.String(result.Status),
+		Context:     github.String(fmt.Sprintf("%s/%s", name, rule)),
+		Description: github.String(truncate(description, maxDescriptionLen)),
+	})
+	if err != nil {
+		return fmt.Errorf("cannot set commit status: %w", err)
+	}
+	return nil
+}
+
+// output renders the summary of the check run. The rule that changed is
+// annotated on the configured path if it started failing.
+func (alert *Alert) output(state *checkState, rule string, prev ruleResult) (*github.CheckRunOutput, error) {
+	stateJSON, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal check run state: %w", err)
+	}
+
+	rules := make([]string, 0, len(state.Rules))
+	failing := 0
+	for r, res := range state.Rules {
+		rules = append(rules, r)
+		if res.Status != ruleSuccess {
+			failing++
+		}
+	}
+	sort.Strings(rules)
+
+	title := fmt.Sprintf("All %d rules pass", len(rules))
+	if failing > 0 {
+		title = fmt.Sprintf("%d of %d rules fail", failing, len(rules))
+	}
+
+	var summary strings.Builder
+	fmt.Fprintf(&summary, stateCommentFmt+"\n\n", stateJSON)
+	fmt.Fprintf(&summary, "**%s**\n\n", title)
+	summary.WriteString("| Rule | Status | Details |\n|---|---|---|\n")
+	for _, r := range rules {
+		res := state.Rules[r]
+		fmt.Fprintf(&summary, "| %s | %s | %s |\n", r, res.Status, markdownCell(res.Details))
+	}
+
+	output := &github.CheckRunOutput{
+		Title:   github.String(title),
+		Summary: github.String(summary.String()),
+	}
+
+	result := state.Rules[rule]
+	if alert.cfg.Path != nil && result.Status != ruleSuccess && prev.Status != result.Status {
+		message := result.Details
+		if message == "" {
+			message = fmt.Sprintf("the rule %s fails", rule)
+		}
+		output.Annotations = []*github.CheckRunAnnotation{{
+			Path:            github.String(alert.cfg.GetPath()),
+			StartLine:       github.Int(1),
+			EndLine:         github.Int(1),
+			AnnotationLevel: github.String("failure"),
+			Title:           github.String(rule),
+			Message:         github.String(message),
+		}}
+	}
+	return output, nil
+}
+
+// getTarget returns the commit the entity was evaluated at, or nil if there's none
+func (alert *Alert) getTarget(ctx context.Context, entity protoreflect.ProtoMessage) (*target, error) {
+	switch entity := entity.(type) {
+	case *pb.PullRequest:
+		return &target{owner: entity.GetRepoOwner(), repo: entity.GetRepoName(), sha: entity.GetCommitSha()}, nil
+	case *pb.Repository:
+		// repositories are evaluated at the head of their default branch
+		repo, err := alert.cli.GetRepository(ctx, entity.GetOwner(), entity.GetName())
+		if err != nil {
+			return nil, fmt.Errorf("cannot get repository: %w", err)
+		}
+		ref, err := alert.cli.GetRef(ctx, entity.GetOwner(), entity.GetName(), "refs/heads/"+repo.GetDefaultBranch())
+		if err != nil {
+			return nil, fmt.Errorf("cannot get the head of the default branch: %w", err)
+		}
+		return &target{owner: entity.GetOwner(), repo: entity.GetName(), sha: ref.GetObject().GetSHA()}, nil
+	case *pb.Artifact:
+		// artifacts are published on the commit they were built from, if known
+		for _, v := range entity.GetVersions() {
+			if sha := v.GetGithubWorkflow().GetCommitSha(); sha != "" {
+				return &target{owner: entity.GetOwner(), repo: entity.GetRepository(), sha: sha}, nil
+			}
+		}
+		return nil, nil
+	}
+	return nil, fmt.Errorf("expected repository, pull request or artifact, got %T", entity)
+}
+
+// checkName returns the name of the check run, which defaults to the profile
+func (alert *Alert) checkName(params interfaces.ActionsParams) string {
+	if alert.cfg.Name != nil {
+		return alert.cfg.GetName()
+	}
+	return defaultNamePrefix + params.GetProfile().GetName()
+}
+
+func newRuleResult(evalErr error) ruleResult {
+	switch {
+	case evalErr == nil:
+		return ruleResult{Status: ruleSuccess}
+	case errors.Is(evalErr, enginerr.ErrEvaluationFailed):
+		return ruleResult{Status: ruleFailure, Details: truncate(evalErr.Error(), maxDetailsLen)}
+	}
+	// a rule that could not be evaluated fails the check as well, the check
+	// must not pass without evaluating the rules
+	return ruleResult{Status: ruleError, Details: truncate(evalErr.Error(), maxDetailsLen)}
+}
+
+// parseState reads the state stored in the summary of a check run
+func parseState(summary string) *checkState {
+	state := &checkState{}
+	if m := stateCommentRe.FindStringSubmatch(summary); m != nil {
+		// a state that can't be parsed is replaced
+		_ = json.Unmarshal([]byte(m[1]), state)
+	}
+	if state.Rules == nil {
+		state.Rules = make(map[string]ruleResult)
+	}
+	return state
+}
+
+// conclusion returns the conclusion of the check run, which fails if any rule does
+func (s *checkState) conclusion() string {
+	for _, res := range s.Rules {
+		if res.Status != ruleSuccess {
+			return conclusionFailure
+		}
+	}
+	return conclusionSuccess
+}
+
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", "\\|")
+	return strings.ReplaceAll(s, "\n", "<br>")
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-3]) + "..."
+}
+
+func marshalMetadata(run *github.CheckRun) (json.RawMessage, error) {
+	newMeta, err := json.Marshal(alertMetadata{CheckRunID: run.GetID(), HeadSHA: run.GetHeadSHA()})
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling alert metadata json: %w", err)
+	}
+	return newMeta, nil
+}
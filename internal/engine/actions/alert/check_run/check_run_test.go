@@ -0,0 +1,291 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check_run
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-github/v53/github"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/stacklok/minder/internal/db"
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
+	"github.com/stacklok/minder/internal/engine/interfaces"
+	"github.com/stacklok/minder/internal/providers"
+	mock_ghclient "github.com/stacklok/minder/internal/providers/github/mock"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+const (
+	repoOwner  = "stacklok"
+	repoName   = "minder"
+	headSha    = "f254eba2db416be8d94aa35bcf3a1c41b6a6926c"
+	checkName  = "minder/acme-profile"
+	checkRunID = 42
+)
+
+var TestActionTypeValid interfaces.ActionType = "alert-test"
+
+func testGithubProviderBuilder() *providers.ProviderBuilder {
+	definitionJSON := `{
+		"github": {
+			"endpoint": "https://api.github.com/"
+		}
+	}`
+
+	return providers.NewProviderBuilder(
+		&db.Provider{
+			Name:       "github",
+			Version:    provifv1.V1,
+			Implements: []db.ProviderType{db.ProviderTypeGithub, db.ProviderTypeRest},
+			Definition: json.RawMessage(definitionJSON),
+		},
+		db.ProviderAccessToken{},
+		"token",
+	)
+}
+
+func testEvalParams(evalErr error) *interfaces.EvalStatusParams {
+	params := &interfaces.EvalStatusParams{
+		Profile:  &pb.Profile{Name: "acme-profile"},
+		RuleType: &pb.RuleType{Name: "security_md"},
+	}
+	params.SetEvalErr(evalErr)
+	return params
+}
+
+// existingRun returns a check run of the profile with the given rule results
+func existingRun(t *testing.T, rules map[string]ruleResult) *github.CheckRun {
+	t.Helper()
+
+	output, err := (&Alert{cfg: &pb.RuleType_Definition_Alert_AlertTypeCheckRun{}}).
+		output(&checkState{Rules: rules}, "", ruleResult{})
+	require.NoError(t, err)
+	return &github.CheckRun{
+		ID:      github.Int64(checkRunID),
+		HeadSHA: github.String(headSha),
+		Output:  output,
+	}
+}
+
+func TestCheckRunAlert(t *testing.T) {
+	t.Parallel()
+
+	pr := &pb.PullRequest{RepoOwner: repoOwner, RepoName: repoName, CommitSha: headSha, Number: 7}
+	evalErr := enginerr.NewErrEvaluationFailed("SECURITY.md not found")
+
+	tests := []struct {
+		name      string
+		cfg       *pb.RuleType_Definition_Alert_AlertTypeCheckRun
+		entity    protoreflect.ProtoMessage
+		setting   interfaces.ActionOpt
+		evalErr   error
+		mockSetup func(*testing.T, *mock_ghclient.MockGitHub)
+		wantMeta  *alertMetadata
+		wantErr   error
+	}{
+		{
+			name:    "creates a failing check run with an annotation",
+			cfg:     &pb.RuleType_Definition_Alert_AlertTypeCheckRun{Path: github.String("SECURITY.md")},
+			entity:  pr,
+			setting: interfaces.ActionOptOn,
+			evalErr: evalErr,
+			mockSetup: func(t *testing.T, mockGitHub *mock_ghclient.MockGitHub) {
+				t.Helper()
+				mockGitHub.EXPECT().
+					ListCheckRunsForRef(gomock.Any(), repoOwner, repoName, headSha, checkName).
+					Return(nil, nil)
+				mockGitHub.EXPECT().
+					CreateCheckRun(gomock.Any(), repoOwner, repoName, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ string, opts github.CreateCheckRunOptions) (*github.CheckRun, error) {
+						require.Equal(t, checkName, opts.Name)
+						require.Equal(t, headSha, opts.HeadSHA)
+						require.Equal(t, conclusionFailure, opts.GetConclusion())
+						require.Equal(t, "1 of 1 rules fail", opts.Output.GetTitle())
+						state := parseState(opts.Output.GetSummary())
+						require.Equal(t, ruleFailure, state.Rules["security_md"].Status)
+						require.Len(t, opts.Output.Annotations, 1)
+						require.Equal(t, "SECURITY.md", opts.Output.Annotations[0].GetPath())
+						return &github.CheckRun{ID: github.Int64(checkRunID), HeadSHA: github.String(headSha)}, nil
+					})
+			},
+			wantMeta: &alertMetadata{CheckRunID: checkRunID, HeadSHA: headSha},
+		},
+		{
+			name:    "updates the check run, which fails while other rules do",
+			cfg:     &pb.RuleType_Definition_Alert_AlertTypeCheckRun{Path: github.String("SECURITY.md")},
+			entity:  pr,
+			setting: interfaces.ActionOptOn,
+			mockSetup: func(t *testing.T, mockGitHub *mock_ghclient.MockGitHub) {
+				t.Helper()
+				mockGitHub.EXPECT().
+					ListCheckRunsForRef(gomock.Any(), repoOwner, repoName, headSha, checkName).
+					Return([]*github.CheckRun{existingRun(t, map[string]ruleResult{
+						"security_md":  {Status: ruleFailure, Details: "SECURITY.md not found"},
+						"branch_rules": {Status: ruleFailure, Details: "main is not protected"},
+					})}, nil)
+				mockGitHub.EXPECT().
+					UpdateCheckRun(gomock.Any(), repoOwner, repoName, int64(checkRunID), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ string, _ int64, opts github.UpdateCheckRunOptions) (*github.CheckRun, error) {
+						require.Equal(t, conclusionFailure, opts.GetConclusion())
+						require.Equal(t, "1 of 2 rules fail", opts.Output.GetTitle())
+						require.Equal(t, ruleSuccess, parseState(opts.Output.GetSummary()).Rules["security_md"].Status)
+						require.Empty(t, opts.Output.Annotations)
+						return &github.CheckRun{ID: github.Int64(checkRunID), HeadSHA: github.String(headSha)}, nil
+					})
+			},
+			wantErr: enginerr.ErrActionTurnedOff,
+		},
+		{
+			name:    "leaves an up to date check run alone",
+			cfg:     &pb.RuleType_Definition_Alert_AlertTypeCheckRun{},
+			entity:  pr,
+			setting: interfaces.ActionOptOn,
+			evalErr: evalErr,
+			mockSetup: func(t *testing.T, mockGitHub *mock_ghclient.MockGitHub) {
+				t.Helper()
+				mockGitHub.EXPECT().
+					ListCheckRunsForRef(gomock.Any(), repoOwner, repoName, headSha, checkName).
+					Return([]*github.CheckRun{existingRun(t, map[string]ruleResult{
+						"security_md": {Status: ruleFailure, Details: evalErr.Error()},
+					})}, nil)
+			},
+			wantMeta: &alertMetadata{CheckRunID: checkRunID, HeadSHA: headSha},
+		},
+		{
+			name:    "publishes repository rules on the head of the default branch",
+			cfg:     &pb.RuleType_Definition_Alert_AlertTypeCheckRun{Name: github.String("security")},
+			entity:  &pb.Repository{Owner: repoOwner, Name: repoName},
+			setting: interfaces.ActionOptOn,
+			evalErr: evalErr,
+			mockSetup: func(t *testing.T, mockGitHub *mock_ghclient.MockGitHub) {
+				t.Helper()
+				mockGitHub.EXPECT().
+					GetRepository(gomock.Any(), repoOwner, repoName).
+					Return(&github.Repository{DefaultBranch: github.String("trunk")}, nil)
+				mockGitHub.EXPECT().
+					GetRef(gomock.Any(), repoOwner, repoName, "refs/heads/trunk").
+					Return(&github.Reference{Object: &github.GitObject{SHA: github.String(headSha)}}, nil)
+				mockGitHub.EXPECT().
+					ListCheckRunsForRef(gomock.Any(), repoOwner, repoName, headSha, "security").
+					Return(nil, nil)
+				mockGitHub.EXPECT().
+					CreateCheckRun(gomock.Any(), repoOwner, repoName, gomock.Any()).
+					Return(&github.CheckRun{ID: github.Int64(checkRunID), HeadSHA: github.String(headSha)}, nil)
+			},
+			wantMeta: &alertMetadata{CheckRunID: checkRunID, HeadSHA: headSha},
+		},
+		{
+			name:    "sets a commit status per rule",
+			cfg:     &pb.RuleType_Definition_Alert_AlertTypeCheckRun{CommitStatus: true},
+			entity:  pr,
+			setting: interfaces.ActionOptOn,
+			evalErr: evalErr,
+			mockSetup: func(t *testing.T, mockGitHub *mock_ghclient.MockGitHub) {
+				t.Helper()
+				mockGitHub.EXPECT().
+					SetCommitStatus(gomock.Any(), repoOwner, repoName, headSha, &github.RepoStatus{
+						State:       github.String(ruleFailure),
+						Context:     github.String(checkName + "/security_md"),
+						Description: github.String(evalErr.Error()),
+					}).
+					Return(&github.RepoStatus{}, nil)
+			},
+		},
+		{
+			name:    "fails if the check run can't be published",
+			cfg:     &pb.RuleType_Definition_Alert_AlertTypeCheckRun{},
+			entity:  pr,
+			setting: interfaces.ActionOptOn,
+			evalErr: evalErr,
+			mockSetup: func(t *testing.T, mockGitHub *mock_ghclient.MockGitHub) {
+				t.Helper()
+				mockGitHub.EXPECT().
+					ListCheckRunsForRef(gomock.Any(), repoOwner, repoName, headSha, checkName).
+					Return(nil, nil)
+				mockGitHub.EXPECT().
+					CreateCheckRun(gomock.Any(), repoOwner, repoName, gomock.Any()).
+					Return(nil, &github.ErrorResponse{Message: "Resource not accessible by integration"})
+			},
+			wantErr: enginerr.ErrActionFailed,
+		},
+		{
+			name:      "skips artifacts built outside of a workflow",
+			cfg:       &pb.RuleType_Definition_Alert_AlertTypeCheckRun{},
+			entity:    &pb.Artifact{Owner: repoOwner, Repository: repoName},
+			setting:   interfaces.ActionOptOn,
+			evalErr:   evalErr,
+			mockSetup: func(_ *testing.T, _ *mock_ghclient.MockGitHub) {},
+			wantErr:   enginerr.ErrActionSkipped,
+		},
+		{
+			name:      "dry run",
+			cfg:       &pb.RuleType_Definition_Alert_AlertTypeCheckRun{},
+			entity:    pr,
+			setting:   interfaces.ActionOptDryRun,
+			evalErr:   evalErr,
+			mockSetup: func(_ *testing.T, _ *mock_ghclient.MockGitHub) {},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			alert, err := NewCheckRunAlert(TestActionTypeValid, tt.cfg, testGithubProviderBuilder())
+			require.NoError(t, err)
+			mockClient := mock_ghclient.NewMockGitHub(ctrl)
+			alert.cli = mockClient
+			tt.mockSetup(t, mockClient)
+
+			meta, err := alert.Do(context.Background(), interfaces.ActionCmdDoNothing, tt.setting,
+				tt.entity, testEvalParams(tt.evalErr), nil)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, meta)
+				return
+			}
+			require.NoError(t, err)
+			if tt.wantMeta == nil {
+				require.Nil(t, meta)
+				return
+			}
+			var got alertMetadata
+			require.NoError(t, json.Unmarshal(meta, &got))
+			require.Equal(t, *tt.wantMeta, got)
+		})
+	}
+}
+
+func TestParseState(t *testing.T) {
+	t.Parallel()
+
+	rules := map[string]ruleResult{
+		"security_md": {Status: ruleFailure, Details: "a | b\n--> <!-- c -->"},
+	}
+	run := existingRun(t, rules)
+	require.Equal(t, rules, parseState(run.GetOutput().GetSummary()).Rules)
+	require.Empty(t, parseState("no state").Rules)
+}
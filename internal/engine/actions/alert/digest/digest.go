@@ -0,0 +1,298 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package digest provides the aggregation of alerts into digests that are
+// sent on a schedule instead of alerting on every evaluation.
+package digest
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/stacklok/minder/internal/db"
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
+	"github.com/stacklok/minder/internal/engine/interfaces"
+	"github.com/stacklok/minder/internal/providers"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	// ScheduleHourly sends the digest once an hour
+	ScheduleHourly = "hourly"
+	// ScheduleDaily sends the digest once a day
+	ScheduleDaily = "daily"
+)
+
+// Queue is the subset of the store used to enqueue findings into a digest
+type Queue interface {
+	UpsertAlertDigest(ctx context.Context, arg db.UpsertAlertDigestParams) error
+	UpsertAlertDigestFinding(ctx context.Context, arg db.UpsertAlertDigestFindingParams) (db.AlertDigestFinding, error)
+	ResolveAlertDigestFinding(ctx context.Context, arg db.ResolveAlertDigestFindingParams) error
+}
+
+// Finding is a single rule and entity pair reported in a digest
+type Finding struct {
+	Profile    string `json:"profile"`
+	Rule       string `json:"rule"`
+	EntityType string `json:"entity_type"`
+	EntityName string `json:"entity_name"`
+	Details    string `json:"details,omitempty"`
+	Guidance   string `json:"guidance,omitempty"`
+}
+
+// Summary is the content of a digest
+type Summary struct {
+	Project string
+	// Since is when the previous digest was sent
+	Since time.Time
+	// New are the findings that started failing since the previous digest
+	New []Finding
+	// StillFailing are the findings already reported that are still failing
+	StillFailing []Finding
+	// Resolved are the findings already reported that are now passing
+	Resolved []Finding
+}
+
+// Empty returns true if there's nothing to report
+func (s *Summary) Empty() bool {
+	return len(s.New) == 0 && len(s.StillFailing) == 0 && len(s.Resolved) == 0
+}
+
+// Notifier is implemented by the alert types able to deliver a digest
+type Notifier interface {
+	NotifyDigest(ctx context.Context, cfg *pb.RuleType_Definition_Alert_Digest, summary *Summary) error
+}
+
+// Alert is the alert action enqueueing findings into a digest, in lieu of the
+// alert type it is configured with
+type Alert struct {
+	actionType  interfaces.ActionType
+	alertType   string
+	queue       Queue
+	projectID   uuid.UUID
+	provider    string
+	channel     string
+	schedule    string
+	alertConfig json.RawMessage
+}
+
+type alertMetadata struct {
+	FindingID string `json:"digest_finding_id,omitempty"`
+}
+
+// NewDigestAlert creates a new digest alert action for the given alert configuration
+func NewDigestAlert(
+	actionType interfaces.ActionType,
+	alertCfg *pb.RuleType_Definition_Alert,
+	pbuild *providers.ProviderBuilder,
+	queue Queue,
+) (*Alert, error) {
+	if actionType == "" {
+		return nil, fmt.Errorf("action type cannot be empty")
+	}
+	if queue == nil {
+		return nil, fmt.Errorf("alert digests are not available")
+	}
+
+	schedule := alertCfg.GetDigest().GetSchedule()
+	if schedule != ScheduleHourly && schedule != ScheduleDaily {
+		return nil, fmt.Errorf("digest schedule must be %s or %s, got %q", ScheduleHourly, ScheduleDaily, schedule)
+	}
+
+	channel, err := Channel(alertCfg)
+	if err != nil {
+		return nil, err
+	}
+	alertConfig, err := protojson.Marshal(alertCfg)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal alert configuration: %w", err)
+	}
+
+	return &Alert{
+		actionType:  actionType,
+		alertType:   alertCfg.GetType(),
+		queue:       queue,
+		projectID:   pbuild.GetProjectID(),
+		provider:    pbuild.GetName(),
+		channel:     channel,
+		schedule:    schedule,
+		alertConfig: alertConfig,
+	}, nil
+}
+
+// Repository returns the owner and name of the repository receiving the digest
+func Repository(cfg *pb.RuleType_Definition_Alert_Digest) (string, string, error) {
+	owner, name, ok := strings.Cut(cfg.GetRepository(), "/")
+	if !ok || owner == "" || name == "" || strings.Contains(name, "/") {
+		return "", "", fmt.Errorf("digest repository must be in the owner/name form, got %q", cfg.GetRepository())
+	}
+	return owner, name, nil
+}
+
+// Channel identifies the digest an alert configuration delivers to. Rule types
+// sharing the same alert configuration share the same digest.
+func Channel(alertCfg *pb.RuleType_Definition_Alert) (string, error) {
+	raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(alertCfg)
+	if err != nil {
+		return "", fmt.Errorf("cannot marshal alert configuration: %w", err)
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Class returns the action type of the digest engine
+func (alert *Alert) Class() interfaces.ActionType {
+	return alert.actionType
+}
+
+// Type returns the alert type the digest is delivered through
+func (alert *Alert) Type() string {
+	return alert.alertType
+}
+
+// GetOnOffState returns the alert action state read from the profile, the
+// setting of the rule taking precedence
+func (_ *Alert) GetOnOffState(p *pb.Profile, rule *pb.Profile_Rule) interfaces.ActionOpt {
+	return interfaces.ActionOptFromString(p.GetAlertSetting(rule), interfaces.ActionOptOn)
+}
+
+// Do enqueues the finding into the digest, or resolves it
+func (alert *Alert) Do(
+	ctx context.Context,
+	cmd interfaces.ActionCmd,
+	setting interfaces.ActionOpt,
+	entity protoreflect.ProtoMessage,
+	params interfaces.ActionsParams,
+	_ *json.RawMessage,
+) (json.RawMessage, error) {
+	entityType, entityName, err := EntityFromProto(entity)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting details: %w", err)
+	}
+
+	switch setting {
+	case interfaces.ActionOptOn:
+	case interfaces.ActionOptDryRun:
+		return nil, alert.runDry(ctx, cmd, entityName)
+	case interfaces.ActionOptOff, interfaces.ActionOptUnknown:
+		return nil, fmt.Errorf("unexpected action setting: %w", enginerr.ErrActionFailed)
+	default:
+		return nil, enginerr.ErrActionSkipped
+	}
+
+	switch cmd {
+	case interfaces.ActionCmdOn:
+		return alert.enqueue(ctx, params, entityType, entityName)
+	case interfaces.ActionCmdOff:
+		err := alert.queue.ResolveAlertDigestFinding(ctx, db.ResolveAlertDigestFindingParams{
+			ProjectID:   alert.projectID,
+			Channel:     alert.channel,
+			ProfileName: params.GetProfile().GetName(),
+			RuleType:    params.GetRuleType().GetName(),
+			EntityType:  entityType,
+			EntityName:  entityName,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error resolving digest finding: %w, %w", err, enginerr.ErrActionFailed)
+		}
+		// Success - return ErrActionTurnedOff to indicate the action was successful
+		return nil, fmt.Errorf("%s : %w", alert.Class(), enginerr.ErrActionTurnedOff)
+	case interfaces.ActionCmdDoNothing:
+		return nil, enginerr.ErrActionSkipped
+	}
+	return nil, enginerr.ErrActionSkipped
+}
+
+func (alert *Alert) enqueue(
+	ctx context.Context,
+	params interfaces.ActionsParams,
+	entityType db.Entities,
+	entityName string,
+) (json.RawMessage, error) {
+	err := alert.queue.UpsertAlertDigest(ctx, db.UpsertAlertDigestParams{
+		ProjectID:   alert.projectID,
+		Channel:     alert.channel,
+		Provider:    alert.provider,
+		Schedule:    alert.schedule,
+		AlertConfig: alert.alertConfig,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating digest: %w, %w", err, enginerr.ErrActionFailed)
+	}
+
+	finding, err := alert.queue.UpsertAlertDigestFinding(ctx, db.UpsertAlertDigestFindingParams{
+		ProjectID:   alert.projectID,
+		Channel:     alert.channel,
+		ProfileName: params.GetProfile().GetName(),
+		RuleType:    params.GetRuleType().GetName(),
+		EntityType:  entityType,
+		EntityName:  entityName,
+		Details:     enginerr.ErrorAsEvalDetails(params.GetEvalErr()),
+		Guidance:    params.GetRuleType().GetGuidance(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error enqueueing digest finding: %w, %w", err, enginerr.ErrActionFailed)
+	}
+
+	newMeta, err := json.Marshal(alertMetadata{FindingID: finding.ID.String()})
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling alert metadata json: %w", err)
+	}
+	zerolog.Ctx(ctx).Info().Str("finding_id", finding.ID.String()).Msg("finding added to digest")
+	return newMeta, nil
+}
+
+func (alert *Alert) runDry(ctx context.Context, cmd interfaces.ActionCmd, entityName string) error {
+	logger := zerolog.Ctx(ctx)
+
+	switch cmd {
+	case interfaces.ActionCmdOn:
+		logger.Info().Str("entity", entityName).Str("schedule", alert.schedule).
+			Msg("finding would be added to the digest")
+		return nil
+	case interfaces.ActionCmdOff:
+		logger.Info().Str("entity", entityName).Str("schedule", alert.schedule).
+			Msg("finding would be resolved in the digest")
+		return fmt.Errorf("%s : %w", alert.Class(), enginerr.ErrActionTurnedOff)
+	case interfaces.ActionCmdDoNothing:
+		return enginerr.ErrActionSkipped
+	}
+	return enginerr.ErrActionSkipped
+}
+
+// EntityFromProto returns the type and a human readable name of the entity
+func EntityFromProto(entity protoreflect.ProtoMessage) (db.Entities, string, error) {
+	switch entity := entity.(type) {
+	case *pb.Repository:
+		return db.EntitiesRepository, fmt.Sprintf("%s/%s", entity.GetOwner(), entity.GetName()), nil
+	case *pb.PullRequest:
+		return db.EntitiesPullRequest,
+			fmt.Sprintf("%s/%s#%d", entity.GetRepoOwner(), entity.GetRepoName(), entity.GetNumber()), nil
+	case *pb.Artifact:
+		return db.EntitiesArtifact,
+			fmt.Sprintf("%s/%s/%s", entity.GetOwner(), entity.GetRepository(), entity.GetName()), nil
+	}
+	return "", "", fmt.Errorf("expected repository, pull request or artifact, got %T", entity)
+}
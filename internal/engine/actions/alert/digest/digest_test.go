@@ -0,0 +1,391 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mockdb "github.com/stacklok/minder/database/mock"
+	"github.com/stacklok/minder/internal/db"
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
+	"github.com/stacklok/minder/internal/engine/interfaces"
+	"github.com/stacklok/minder/internal/providers"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+var (
+	projectID = uuid.New()
+	testRepo  = &pb.Repository{Owner: "stacklok", Name: "minder"}
+)
+
+func testProviderBuilder() *providers.ProviderBuilder {
+	return providers.NewProviderBuilder(
+		&db.Provider{
+			Name:       "github",
+			Version:    provifv1.V1,
+			ProjectID:  projectID,
+			Implements: []db.ProviderType{db.ProviderTypeGithub},
+			Definition: json.RawMessage(`{"github": {}}`),
+		},
+		db.ProviderAccessToken{},
+		"token",
+	)
+}
+
+func testAlertConfig(schedule string) *pb.RuleType_Definition_Alert {
+	return &pb.RuleType_Definition_Alert{
+		Type:    "webhook",
+		Webhook: &pb.RuleType_Definition_Alert_AlertTypeWebhook{Url: "https://example.com/hook"},
+		Digest:  &pb.RuleType_Definition_Alert_Digest{Schedule: schedule},
+	}
+}
+
+func testEvalParams(evalErr error) *interfaces.EvalStatusParams {
+	params := &interfaces.EvalStatusParams{
+		Profile:  &pb.Profile{Name: "acme-profile"},
+		RuleType: &pb.RuleType{Name: "secret_scanning", Guidance: "Enable secret scanning"},
+	}
+	params.SetEvalErr(evalErr)
+	return params
+}
+
+func TestNewDigestAlert(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := mockdb.NewMockStore(ctrl)
+
+	_, err := NewDigestAlert("alert", testAlertConfig(ScheduleDaily), testProviderBuilder(), store)
+	require.NoError(t, err)
+
+	_, err = NewDigestAlert("alert", testAlertConfig("weekly"), testProviderBuilder(), store)
+	require.ErrorContains(t, err, "digest schedule")
+
+	_, err = NewDigestAlert("alert", testAlertConfig(ScheduleDaily), testProviderBuilder(), nil)
+	require.ErrorContains(t, err, "not available")
+}
+
+func TestDigestAlertEnqueue(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := mockdb.NewMockStore(ctrl)
+
+	cfg := testAlertConfig(ScheduleHourly)
+	channel, err := Channel(cfg)
+	require.NoError(t, err)
+
+	alert, err := NewDigestAlert("alert", cfg, testProviderBuilder(), store)
+	require.NoError(t, err)
+	require.Equal(t, "webhook", alert.Type())
+
+	findingID := uuid.New()
+	store.EXPECT().
+		UpsertAlertDigest(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.UpsertAlertDigestParams) error {
+			require.Equal(t, projectID, arg.ProjectID)
+			require.Equal(t, channel, arg.Channel)
+			require.Equal(t, "github", arg.Provider)
+			require.Equal(t, ScheduleHourly, arg.Schedule)
+			return nil
+		})
+	store.EXPECT().
+		UpsertAlertDigestFinding(gomock.Any(), db.UpsertAlertDigestFindingParams{
+			ProjectID:   projectID,
+			Channel:     channel,
+			ProfileName: "acme-profile",
+			RuleType:    "secret_scanning",
+			EntityType:  db.EntitiesRepository,
+			EntityName:  "stacklok/minder",
+			Details:     "evaluation failure: secret scanning is disabled",
+			Guidance:    "Enable secret scanning",
+		}).
+		Return(db.AlertDigestFinding{ID: findingID}, nil)
+
+	meta, err := alert.Do(context.Background(), interfaces.ActionCmdOn, interfaces.ActionOptOn, testRepo,
+		testEvalParams(enginerr.NewErrEvaluationFailed("secret scanning is disabled")), nil)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"digest_finding_id": "`+findingID.String()+`"}`, string(meta))
+
+	store.EXPECT().
+		ResolveAlertDigestFinding(gomock.Any(), db.ResolveAlertDigestFindingParams{
+			ProjectID:   projectID,
+			Channel:     channel,
+			ProfileName: "acme-profile",
+			RuleType:    "secret_scanning",
+			EntityType:  db.EntitiesRepository,
+			EntityName:  "stacklok/minder",
+		}).
+		Return(nil)
+
+	meta, err = alert.Do(context.Background(), interfaces.ActionCmdOff, interfaces.ActionOptOn, testRepo,
+		testEvalParams(nil), nil)
+	require.ErrorIs(t, err, enginerr.ErrActionTurnedOff)
+	require.Nil(t, meta)
+}
+
+func TestDigestAlertEnqueueFails(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := mockdb.NewMockStore(ctrl)
+
+	alert, err := NewDigestAlert("alert", testAlertConfig(ScheduleHourly), testProviderBuilder(), store)
+	require.NoError(t, err)
+
+	store.EXPECT().UpsertAlertDigest(gomock.Any(), gomock.Any()).Return(errors.New("connection reset"))
+
+	_, err = alert.Do(context.Background(), interfaces.ActionCmdOn, interfaces.ActionOptOn, testRepo,
+		testEvalParams(enginerr.NewErrEvaluationFailed("failed")), nil)
+	require.ErrorIs(t, err, enginerr.ErrActionFailed)
+}
+
+func TestChannel(t *testing.T) {
+	t.Parallel()
+
+	a, err := Channel(testAlertConfig(ScheduleHourly))
+	require.NoError(t, err)
+	b, err := Channel(testAlertConfig(ScheduleHourly))
+	require.NoError(t, err)
+	c, err := Channel(testAlertConfig(ScheduleDaily))
+	require.NoError(t, err)
+
+	require.Equal(t, a, b)
+	require.NotEqual(t, a, c)
+}
+
+type fakeNotifier struct {
+	summaries []*Summary
+	err       error
+}
+
+func (f *fakeNotifier) NotifyDigest(_ context.Context, _ *pb.RuleType_Definition_Alert_Digest, s *Summary) error {
+	f.summaries = append(f.summaries, s)
+	return f.err
+}
+
+func TestFlush(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := mockdb.NewMockStore(ctrl)
+
+	lastSent := time.Now().Add(-2 * time.Hour)
+	alertConfig, err := json.Marshal(map[string]any{
+		"type":    "webhook",
+		"webhook": map[string]any{"url": "https://example.com/hook"},
+		"digest":  map[string]any{"schedule": ScheduleHourly},
+	})
+	require.NoError(t, err)
+	due := db.AlertDigest{
+		ProjectID:   projectID,
+		Channel:     "channel",
+		Provider:    "github",
+		Schedule:    ScheduleHourly,
+		AlertConfig: alertConfig,
+		LastSentAt:  lastSent,
+	}
+
+	notified := sql.NullTime{Time: lastSent, Valid: true}
+	fixedAt := lastSent.Add(time.Hour)
+	flakyAt := lastSent.Add(30 * time.Minute)
+	findings := []db.AlertDigestFinding{
+		{ID: uuid.New(), RuleType: "a", EntityName: "repo-new", Status: db.DigestFindingStatusFailing},
+		{ID: uuid.New(), RuleType: "b", EntityName: "repo-old", Status: db.DigestFindingStatusFailing, NotifiedAt: notified},
+		{ID: uuid.New(), RuleType: "c", EntityName: "repo-fixed", Status: db.DigestFindingStatusResolved,
+			NotifiedAt: notified, UpdatedAt: fixedAt},
+		{ID: uuid.New(), RuleType: "d", EntityName: "repo-flaky", Status: db.DigestFindingStatusResolved, UpdatedAt: flakyAt},
+	}
+	ids := []uuid.UUID{findings[0].ID, findings[1].ID, findings[2].ID, findings[3].ID}
+
+	store.EXPECT().ListDueAlertDigests(gomock.Any()).Return([]db.AlertDigest{due}, nil)
+	store.EXPECT().
+		ClaimAlertDigest(gomock.Any(), db.ClaimAlertDigestParams{ProjectID: projectID, Channel: "channel"}).
+		Return(due, nil)
+	store.EXPECT().
+		ListAlertDigestFindings(gomock.Any(), db.ListAlertDigestFindingsParams{ProjectID: projectID, Channel: "channel"}).
+		Return(findings, nil)
+	store.EXPECT().GetProjectByID(gomock.Any(), projectID).Return(db.Project{Name: "acme"}, nil)
+
+	tx := sql.Tx{}
+	store.EXPECT().BeginTransaction().Return(&tx, nil)
+	store.EXPECT().GetQuerierWithTransaction(gomock.Any()).Return(store)
+	store.EXPECT().MarkAlertDigestFindingsNotified(gomock.Any(), ids).Return(nil)
+	// only the findings resolved when listed are deleted, and only if they
+	// weren't updated since
+	store.EXPECT().
+		DeleteResolvedAlertDigestFindings(gomock.Any(), db.DeleteResolvedAlertDigestFindingsParams{
+			Ids:         []uuid.UUID{findings[2].ID, findings[3].ID},
+			ListedUntil: fixedAt,
+		}).
+		Return(nil)
+	store.EXPECT().Commit(gomock.Any()).Return(nil)
+	store.EXPECT().Rollback(gomock.Any()).Return(nil)
+
+	notifier := &fakeNotifier{}
+	flusher := NewFlusher(store, func(
+		_ context.Context, d *db.AlertDigest, cfg *pb.RuleType_Definition_Alert,
+	) (Notifier, error) {
+		require.Equal(t, "github", d.Provider)
+		require.Equal(t, "https://example.com/hook", cfg.GetWebhook().GetUrl())
+		return notifier, nil
+	})
+
+	require.NoError(t, flusher.Flush(context.Background()))
+	require.Len(t, notifier.summaries, 1)
+
+	summary := notifier.summaries[0]
+	require.Equal(t, "acme", summary.Project)
+	require.Equal(t, lastSent, summary.Since)
+	require.Equal(t, []Finding{{Rule: "a", EntityName: "repo-new"}}, summary.New)
+	require.Equal(t, []Finding{{Rule: "b", EntityName: "repo-old"}}, summary.StillFailing)
+	require.Equal(t, []Finding{{Rule: "c", EntityName: "repo-fixed"}}, summary.Resolved)
+}
+
+func TestFlushKeepsUndeliveredDigests(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := mockdb.NewMockStore(ctrl)
+
+	lastSent := time.Now().Add(-2 * time.Hour)
+	due := db.AlertDigest{
+		ProjectID:   projectID,
+		Channel:     "channel",
+		Provider:    "github",
+		AlertConfig: json.RawMessage(`{"type": "webhook"}`),
+		LastSentAt:  lastSent,
+	}
+	store.EXPECT().ListDueAlertDigests(gomock.Any()).Return([]db.AlertDigest{due}, nil)
+	store.EXPECT().ClaimAlertDigest(gomock.Any(), gomock.Any()).Return(due, nil)
+	store.EXPECT().
+		ListAlertDigestFindings(gomock.Any(), gomock.Any()).
+		Return([]db.AlertDigestFinding{{ID: uuid.New(), Status: db.DigestFindingStatusFailing}}, nil)
+	store.EXPECT().GetProjectByID(gomock.Any(), projectID).Return(db.Project{Name: "acme"}, nil)
+	// the claim is released and nothing is marked as sent
+	store.EXPECT().
+		ReleaseAlertDigest(gomock.Any(), db.ReleaseAlertDigestParams{
+			ProjectID:  projectID,
+			Channel:    "channel",
+			LastSentAt: lastSent,
+		}).
+		Return(nil)
+
+	notifier := &fakeNotifier{err: errors.New("webhook responded with status 500")}
+	flusher := NewFlusher(store, func(
+		context.Context, *db.AlertDigest, *pb.RuleType_Definition_Alert,
+	) (Notifier, error) {
+		return notifier, nil
+	})
+
+	require.NoError(t, flusher.Flush(context.Background()))
+	require.Len(t, notifier.summaries, 1)
+}
+
+func TestFlushConcurrently(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := mockdb.NewMockStore(ctrl)
+
+	due := db.AlertDigest{
+		ProjectID:   projectID,
+		Channel:     "channel",
+		Provider:    "github",
+		AlertConfig: json.RawMessage(`{"type": "webhook"}`),
+	}
+	// both flushes see the digest as due, only one of them claims it
+	store.EXPECT().ListDueAlertDigests(gomock.Any()).Return([]db.AlertDigest{due}, nil).Times(2)
+	var mu sync.Mutex
+	claimed := false
+	store.EXPECT().
+		ClaimAlertDigest(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(context.Context, db.ClaimAlertDigestParams) (db.AlertDigest, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if claimed {
+				return db.AlertDigest{}, sql.ErrNoRows
+			}
+			claimed = true
+			return due, nil
+		}).
+		Times(2)
+	store.EXPECT().
+		ListAlertDigestFindings(gomock.Any(), gomock.Any()).
+		Return([]db.AlertDigestFinding{{ID: uuid.New(), Status: db.DigestFindingStatusFailing}}, nil)
+	store.EXPECT().GetProjectByID(gomock.Any(), projectID).Return(db.Project{Name: "acme"}, nil)
+	store.EXPECT().BeginTransaction().Return(&sql.Tx{}, nil)
+	store.EXPECT().GetQuerierWithTransaction(gomock.Any()).Return(store)
+	store.EXPECT().MarkAlertDigestFindingsNotified(gomock.Any(), gomock.Any()).Return(nil)
+	store.EXPECT().DeleteResolvedAlertDigestFindings(gomock.Any(), gomock.Any()).Return(nil)
+	store.EXPECT().Commit(gomock.Any()).Return(nil)
+	store.EXPECT().Rollback(gomock.Any()).Return(nil)
+
+	notifier := &fakeNotifier{}
+	newNotifier := func(context.Context, *db.AlertDigest, *pb.RuleType_Definition_Alert) (Notifier, error) {
+		return notifier, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, NewFlusher(store, newNotifier).Flush(context.Background()))
+		}()
+	}
+	wg.Wait()
+
+	require.Len(t, notifier.summaries, 1, "the digest should be delivered once")
+}
+
+func TestMarkdown(t *testing.T) {
+	t.Parallel()
+
+	out, err := Markdown(&Summary{
+		Project: "acme",
+		Since:   time.Date(2023, 12, 1, 10, 0, 0, 0, time.UTC),
+		New: []Finding{{
+			Profile:    "acme-profile",
+			Rule:       "secret_scanning",
+			EntityName: "stacklok/minder",
+			Details:    "secret scanning\n| is disabled",
+		}},
+		Resolved: []Finding{{Profile: "acme-profile", Rule: "security_md", EntityName: "stacklok/mediator"}},
+	})
+	require.NoError(t, err)
+	require.Contains(t, out, "since 2023-12-01 10:00 UTC: 1 new, 0 still failing and 1 resolved")
+	require.Contains(t, out, "| secret_scanning | stacklok/minder | acme-profile | secret scanning \\| is disabled |")
+	require.Contains(t, out, "| security_md | stacklok/mediator | acme-profile |")
+	require.NotContains(t, out, "Still failing")
+}
@@ -0,0 +1,238 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/stacklok/minder/internal/db"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const defaultFlushInterval = time.Minute
+
+// NotifierFactory creates the notifier of a digest from its alert configuration
+type NotifierFactory func(
+	ctx context.Context,
+	digest *db.AlertDigest,
+	alertCfg *pb.RuleType_Definition_Alert,
+) (Notifier, error)
+
+// Flusher periodically sends the digests whose schedule has elapsed
+type Flusher struct {
+	store       db.Store
+	newNotifier NotifierFactory
+	interval    time.Duration
+}
+
+// NewFlusher creates a new digest flusher
+func NewFlusher(store db.Store, newNotifier NotifierFactory) *Flusher {
+	return &Flusher{
+		store:       store,
+		newNotifier: newNotifier,
+		interval:    defaultFlushInterval,
+	}
+}
+
+// Run checks for due digests until the context is cancelled
+func (f *Flusher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := f.Flush(ctx); err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("error flushing alert digests")
+			}
+		}
+	}
+}
+
+// Flush sends all the digests whose schedule has elapsed. A digest that fails
+// to be delivered is kept and retried on the next flush.
+func (f *Flusher) Flush(ctx context.Context) error {
+	due, err := f.store.ListDueAlertDigests(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing due digests: %w", err)
+	}
+
+	for i := range due {
+		d := &due[i]
+		if err := f.flushDigest(ctx, d); err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).
+				Str("project_id", d.ProjectID.String()).
+				Str("channel", d.Channel).
+				Msg("error sending alert digest")
+		}
+	}
+	return nil
+}
+
+// flushDigest claims the digest before delivering it, a digest claimed by a
+// concurrent flush is left alone. The claim is released if the digest can't be
+// delivered, so that it is retried on the next flush.
+func (f *Flusher) flushDigest(ctx context.Context, d *db.AlertDigest) error {
+	_, err := f.store.ClaimAlertDigest(ctx, db.ClaimAlertDigestParams{
+		ProjectID: d.ProjectID,
+		Channel:   d.Channel,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		zerolog.Ctx(ctx).Debug().
+			Str("project_id", d.ProjectID.String()).
+			Str("channel", d.Channel).
+			Msg("alert digest already claimed")
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("error claiming digest: %w", err)
+	}
+
+	listed, err := f.deliver(ctx, d)
+	if err != nil {
+		relErr := f.store.ReleaseAlertDigest(ctx, db.ReleaseAlertDigestParams{
+			ProjectID:  d.ProjectID,
+			Channel:    d.Channel,
+			LastSentAt: d.LastSentAt,
+		})
+		if relErr != nil {
+			return errors.Join(err, fmt.Errorf("error releasing digest: %w", relErr))
+		}
+		return err
+	}
+
+	return f.markSent(ctx, listed)
+}
+
+// listedFindings are the findings of a digest as they were when listed
+type listedFindings struct {
+	// ids are the IDs of all the findings
+	ids []uuid.UUID
+	// resolved are the IDs of the findings which were resolved
+	resolved []uuid.UUID
+	// resolvedUntil is the latest update of the resolved findings, anything
+	// updated later happened after the listing
+	resolvedUntil time.Time
+}
+
+// deliver sends the findings of the digest, if any, and returns them
+func (f *Flusher) deliver(ctx context.Context, d *db.AlertDigest) (*listedFindings, error) {
+	findings, err := f.store.ListAlertDigestFindings(ctx, db.ListAlertDigestFindingsParams{
+		ProjectID: d.ProjectID,
+		Channel:   d.Channel,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing findings: %w", err)
+	}
+
+	project, err := f.store.GetProjectByID(ctx, d.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting project: %w", err)
+	}
+
+	summary, listed := summarize(project.Name, d.LastSentAt, findings)
+	if summary.Empty() {
+		return listed, nil
+	}
+
+	alertCfg := &pb.RuleType_Definition_Alert{}
+	if err := protojson.Unmarshal(d.AlertConfig, alertCfg); err != nil {
+		return nil, fmt.Errorf("error parsing alert configuration: %w", err)
+	}
+
+	notifier, err := f.newNotifier(ctx, d, alertCfg)
+	if err != nil {
+		return nil, fmt.Errorf("error creating notifier: %w", err)
+	}
+
+	if err := notifier.NotifyDigest(ctx, alertCfg.GetDigest(), summary); err != nil {
+		return nil, fmt.Errorf("error delivering digest: %w", err)
+	}
+	return listed, nil
+}
+
+// markSent marks the findings delivered as notified and deletes the resolved
+// ones. Findings updated after they were listed are left for the next digest.
+func (f *Flusher) markSent(ctx context.Context, listed *listedFindings) error {
+	tx, err := f.store.BeginTransaction()
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer f.store.Rollback(tx)
+
+	qtx := f.store.GetQuerierWithTransaction(tx)
+
+	if err := qtx.MarkAlertDigestFindingsNotified(ctx, listed.ids); err != nil {
+		return fmt.Errorf("error marking findings as notified: %w", err)
+	}
+	if err := qtx.DeleteResolvedAlertDigestFindings(ctx, db.DeleteResolvedAlertDigestFindingsParams{
+		Ids:         listed.resolved,
+		ListedUntil: listed.resolvedUntil,
+	}); err != nil {
+		return fmt.Errorf("error deleting resolved findings: %w", err)
+	}
+
+	return f.store.Commit(tx)
+}
+
+// summarize sorts the findings into the sections of the digest. It also returns
+// the findings handled, resolved findings that were never part of a digest are
+// dropped silently.
+func summarize(project string, since time.Time, findings []db.AlertDigestFinding) (*Summary, *listedFindings) {
+	summary := &Summary{
+		Project: project,
+		Since:   since,
+	}
+	listed := &listedFindings{ids: make([]uuid.UUID, 0, len(findings))}
+
+	for _, dbf := range findings {
+		listed.ids = append(listed.ids, dbf.ID)
+		if dbf.Status == db.DigestFindingStatusResolved {
+			listed.resolved = append(listed.resolved, dbf.ID)
+			if dbf.UpdatedAt.After(listed.resolvedUntil) {
+				listed.resolvedUntil = dbf.UpdatedAt
+			}
+		}
+		finding := Finding{
+			Profile:    dbf.ProfileName,
+			Rule:       dbf.RuleType,
+			EntityType: string(dbf.EntityType),
+			EntityName: dbf.EntityName,
+			Details:    dbf.Details,
+			Guidance:   dbf.Guidance,
+		}
+
+		notified := dbf.NotifiedAt.Valid
+		switch {
+		case dbf.Status == db.DigestFindingStatusFailing && !notified:
+			summary.New = append(summary.New, finding)
+		case dbf.Status == db.DigestFindingStatusFailing:
+			summary.StillFailing = append(summary.StillFailing, finding)
+		case dbf.Status == db.DigestFindingStatusResolved && notified:
+			summary.Resolved = append(summary.Resolved, finding)
+		}
+	}
+
+	return summary, listed
+}
@@ -0,0 +1,83 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+const (
+	tmplMarkdownName = "digest"
+	// nolint:lll
+	tmplMarkdown = `
+Minder alert digest for project **{{.Project}}** since {{.Since.UTC.Format "2006-01-02 15:04 UTC"}}: {{len .New}} new, {{len .StillFailing}} still failing and {{len .Resolved}} resolved.
+{{- if .New}}
+
+**New findings**
+
+| Rule | Entity | Profile | Details |
+|---|---|---|---|
+{{- range .New}}
+| {{.Rule}} | {{.EntityName}} | {{.Profile}} | {{cell .Details}} |
+{{- end}}
+{{- end}}
+{{- if .StillFailing}}
+
+**Still failing**
+
+| Rule | Entity | Profile | Details |
+|---|---|---|---|
+{{- range .StillFailing}}
+| {{.Rule}} | {{.EntityName}} | {{.Profile}} | {{cell .Details}} |
+{{- end}}
+{{- end}}
+{{- if .Resolved}}
+
+**Resolved**
+
+| Rule | Entity | Profile |
+|---|---|---|
+{{- range .Resolved}}
+| {{.Rule}} | {{.EntityName}} | {{.Profile}} |
+{{- end}}
+{{- end}}
+`
+)
+
+var markdownTmpl = template.Must(template.New(tmplMarkdownName).
+	Funcs(template.FuncMap{"cell": tableCell}).
+	Parse(tmplMarkdown))
+
+// Title returns a one line summary of the digest
+func Title(summary *Summary) string {
+	return fmt.Sprintf("minder: alert digest for project %s", summary.Project)
+}
+
+// Markdown renders the digest as markdown, suited for issues and advisories
+func Markdown(summary *Summary) (string, error) {
+	var buf strings.Builder
+	if err := markdownTmpl.Execute(&buf, summary); err != nil {
+		return "", fmt.Errorf("error executing digest template: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// tableCell keeps multi-line details from breaking the table
+func tableCell(s string) string {
+	s = strings.ReplaceAll(s, "|", "\\|")
+	return strings.Join(strings.Fields(s), " ")
+}
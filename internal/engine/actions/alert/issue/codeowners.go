@@ -0,0 +1,78 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package issue
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+// codeownersPaths are the locations GitHub looks for a CODEOWNERS file, in order
+var codeownersPaths = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+// getCodeowners returns the users owning the whole repository, or nothing if
+// the repository has no CODEOWNERS file
+func getCodeowners(ctx context.Context, cli provifv1.GitHub, owner, repo string) ([]string, error) {
+	for _, path := range codeownersPaths {
+		content, err := cli.GetFileContent(ctx, owner, repo, path, "")
+		if errors.Is(err, enginerr.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("error fetching %s: %w", path, err)
+		}
+		return parseCodeowners(content), nil
+	}
+	return nil, nil
+}
+
+// parseCodeowners returns the users of the last rule matching every file in the
+// repository. Teams and e-mail addresses are skipped since issues can only be
+// assigned to users.
+func parseCodeowners(content string) []string {
+	var owners []string
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "*", "/*", "/**", "/":
+		default:
+			continue
+		}
+
+		// later rules take precedence, even if they have no owners
+		owners = nil
+		for _, o := range fields[1:] {
+			login, ok := strings.CutPrefix(o, "@")
+			if !ok || strings.Contains(login, "/") {
+				continue
+			}
+			owners = append(owners, login)
+		}
+	}
+
+	return owners
+}
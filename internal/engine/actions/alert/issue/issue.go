@@ -0,0 +1,537 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package issue provides necessary interfaces and implementations for
+// creating alerts of type issue.
+package issue
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/google/go-github/v53/github"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/stacklok/minder/internal/engine/actions/alert/digest"
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
+	"github.com/stacklok/minder/internal/engine/interfaces"
+	"github.com/stacklok/minder/internal/providers"
+	"github.com/stacklok/minder/internal/util"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+const (
+	// AlertType is the type of the issue alert engine
+	AlertType       = "issue"
+	tmplTitleName   = "title"
+	tmplTitle       = `minder: profile {{.Profile}} failed with rule {{.Rule}}`
+	tmplBodyName    = "body"
+	tmplCommentName = "comment"
+	// nolint:lll
+	tmplBody = `
+Minder has detected that the **{{.Rule}}** rule of the **{{.Profile}}** profile is failing for **{{.Entity}}**.
+
+This issue has been automatically opened because the alert feature is enabled within the **{{.Profile}}** profile, and it will be automatically closed once the rule passes again.
+
+**Guidance**
+
+{{.Guidance}}
+
+**Details**
+
+- Profile: {{.Profile}}
+- Rule: {{.Rule}}
+- Repository: {{.Repository}}
+- Remediation: {{.RuleRemediation}}
+{{- if .Details}}
+
+{{.Details}}
+{{- end}}
+`
+	// nolint:lll
+	tmplStillFailing = `Minder evaluated the **{{.Rule}}** rule of the **{{.Profile}}** profile again and it is still failing for **{{.Entity}}**.
+{{- if .Details}}
+
+{{.Details}}
+{{- end}}
+`
+	// nolint:lll
+	tmplResolved = `The **{{.Rule}}** rule of the **{{.Profile}}** profile is now passing for **{{.Entity}}**, closing this issue.`
+)
+
+// Alert is the structure backing the issue alert action
+type Alert struct {
+	actionType       interfaces.ActionType
+	cli              provifv1.GitHub
+	issueCfg         *pb.RuleType_Definition_Alert_AlertTypeIssue
+	titleTmpl        *template.Template
+	bodyTmpl         *template.Template
+	stillFailingTmpl *template.Template
+	resolvedTmpl     *template.Template
+}
+
+type paramsIssue struct {
+	// Used by the templates
+	Template templateParamsIssue
+	Owner    string
+	Repo     string
+	Metadata *alertMetadata
+}
+
+type templateParamsIssue struct {
+	Profile         string
+	Rule            string
+	Repository      string
+	Entity          string
+	Guidance        string
+	Details         string
+	RuleRemediation string
+}
+
+type alertMetadata struct {
+	Number int    `json:"issue_number,omitempty"`
+	URL    string `json:"issue_url,omitempty"`
+	// DetailsHash is the hash of the failure details last reported on the
+	// issue, so that it's only commented on when they change
+	DetailsHash string `json:"details_hash,omitempty"`
+}
+
+// NewIssueAlert creates a new issue alert action
+func NewIssueAlert(
+	actionType interfaces.ActionType,
+	issueCfg *pb.RuleType_Definition_Alert_AlertTypeIssue,
+	pbuild *providers.ProviderBuilder,
+) (*Alert, error) {
+	if actionType == "" {
+		return nil, fmt.Errorf("action type cannot be empty")
+	}
+
+	titleStr := tmplTitle
+	if issueCfg.Title != nil {
+		titleStr = issueCfg.GetTitle()
+	}
+	titleT, err := util.ParseNewTemplate(&titleStr, tmplTitleName)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse title template: %w", err)
+	}
+	bodyStr := tmplBody
+	if issueCfg.Body != nil {
+		bodyStr = issueCfg.GetBody()
+	}
+	bodyT, err := util.ParseNewTemplate(&bodyStr, tmplBodyName)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse body template: %w", err)
+	}
+	stillFailingT, err := template.New(tmplCommentName).Parse(tmplStillFailing)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse comment template: %w", err)
+	}
+	resolvedT, err := template.New(tmplCommentName).Parse(tmplResolved)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse comment template: %w", err)
+	}
+
+	// Get the GitHub client
+	cli, err := pbuild.GetGitHub(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("cannot get http client: %w", err)
+	}
+
+	return &Alert{
+		actionType:       actionType,
+		cli:              cli,
+		issueCfg:         issueCfg,
+		titleTmpl:        titleT,
+		bodyTmpl:         bodyT,
+		stillFailingTmpl: stillFailingT,
+		resolvedTmpl:     resolvedT,
+	}, nil
+}
+
+// Class returns the action type of the issue engine
+func (alert *Alert) Class() interfaces.ActionType {
+	return alert.actionType
+}
+
+// Type returns the action subtype of the issue engine
+func (_ *Alert) Type() string {
+	return AlertType
+}
+
+// GetOnOffState returns the alert action state read from the profile, the
+// setting of the rule taking precedence
+func (_ *Alert) GetOnOffState(p *pb.Profile, rule *pb.Profile_Rule) interfaces.ActionOpt {
+	return interfaces.ActionOptFromString(p.GetAlertSetting(rule), interfaces.ActionOptOn)
+}
+
+// Do alerts through a repository issue
+func (alert *Alert) Do(
+	ctx context.Context,
+	cmd interfaces.ActionCmd,
+	setting interfaces.ActionOpt,
+	entity protoreflect.ProtoMessage,
+	params interfaces.ActionsParams,
+	metadata *json.RawMessage,
+) (json.RawMessage, error) {
+	p, err := getParamsForIssue(ctx, entity, params, metadata)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting details: %w", err)
+	}
+
+	// Process the command based on the action setting
+	switch setting {
+	case interfaces.ActionOptOn:
+		return alert.run(ctx, p, cmd)
+	case interfaces.ActionOptDryRun:
+		return nil, alert.runDry(ctx, p, cmd)
+	case interfaces.ActionOptOff, interfaces.ActionOptUnknown:
+		return nil, fmt.Errorf("unexpected action setting: %w", enginerr.ErrActionFailed)
+	}
+	return nil, enginerr.ErrActionSkipped
+}
+
+// run runs the issue action
+func (alert *Alert) run(ctx context.Context, params *paramsIssue, cmd interfaces.ActionCmd) (json.RawMessage, error) {
+	switch cmd {
+	case interfaces.ActionCmdOn:
+		return alert.open(ctx, params)
+	case interfaces.ActionCmdStillOn:
+		return alert.comment(ctx, params)
+	case interfaces.ActionCmdOff:
+		return nil, alert.close(ctx, params)
+	case interfaces.ActionCmdDoNothing:
+		return nil, enginerr.ErrActionSkipped
+	}
+	return nil, enginerr.ErrActionSkipped
+}
+
+// comment comments on the tracked issue that the rule is still failing, unless
+// the failure details were already reported. An issue closed manually is left
+// alone.
+func (alert *Alert) comment(ctx context.Context, params *paramsIssue) (json.RawMessage, error) {
+	if params.Metadata != nil && params.Metadata.DetailsHash == hashDetails(params.Template.Details) {
+		return nil, fmt.Errorf("failure details unchanged: %w", enginerr.ErrActionSkipped)
+	}
+
+	existing, err := alert.getOpenIssue(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("error getting issue: %w, %w", err, enginerr.ErrActionFailed)
+	}
+	if existing == nil {
+		return nil, fmt.Errorf("no open issue to comment on: %w", enginerr.ErrActionSkipped)
+	}
+	return alert.commentStillFailing(ctx, params, existing)
+}
+
+// commentStillFailing comments on the open issue that the rule is still failing
+func (alert *Alert) commentStillFailing(
+	ctx context.Context,
+	params *paramsIssue,
+	existing *github.Issue,
+) (json.RawMessage, error) {
+	comment, err := execute(alert.stillFailingTmpl, params.Template)
+	if err != nil {
+		return nil, err
+	}
+	if err := alert.cli.CreateComment(ctx, params.Owner, params.Repo, existing.GetNumber(), comment); err != nil {
+		return nil, fmt.Errorf("error commenting on issue: %w, %w", err, enginerr.ErrActionFailed)
+	}
+	zerolog.Ctx(ctx).Info().Int("issue_number", existing.GetNumber()).Msg("issue still failing, commented")
+	return marshalMetadata(existing, params.Template.Details)
+}
+
+// open opens an issue, or comments on the one already tracked if it's still open
+func (alert *Alert) open(ctx context.Context, params *paramsIssue) (json.RawMessage, error) {
+	logger := zerolog.Ctx(ctx)
+
+	existing, err := alert.getOpenIssue(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("error getting issue: %w, %w", err, enginerr.ErrActionFailed)
+	}
+	if existing != nil {
+		return alert.commentStillFailing(ctx, params, existing)
+	}
+
+	title, err := execute(alert.titleTmpl, params.Template)
+	if err != nil {
+		return nil, err
+	}
+	body, err := execute(alert.bodyTmpl, params.Template)
+	if err != nil {
+		return nil, err
+	}
+	assignees, err := alert.getAssignees(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("error getting assignees: %w, %w", err, enginerr.ErrActionFailed)
+	}
+
+	req := &github.IssueRequest{
+		Title: github.String(title),
+		Body:  github.String(body),
+	}
+	if labels := alert.issueCfg.GetLabels(); len(labels) > 0 {
+		req.Labels = &labels
+	}
+	if len(assignees) > 0 {
+		req.Assignees = &assignees
+	}
+
+	issue, err := alert.cli.CreateIssue(ctx, params.Owner, params.Repo, req)
+	if err != nil {
+		return nil, fmt.Errorf("error creating issue: %w, %w", err, enginerr.ErrActionFailed)
+	}
+	// Success - return the new metadata for storing the issue number
+	logger.Info().Int("issue_number", issue.GetNumber()).Msg("issue opened")
+	return marshalMetadata(issue, params.Template.Details)
+}
+
+// close comments on the tracked issue with the resolution and closes it
+func (alert *Alert) close(ctx context.Context, params *paramsIssue) error {
+	logger := zerolog.Ctx(ctx)
+
+	if params.Metadata == nil || params.Metadata.Number == 0 {
+		// We cannot do anything without the issue number, so we assume that closing this is a success
+		return fmt.Errorf("no issue number provided: %w", enginerr.ErrActionTurnedOff)
+	}
+
+	existing, err := alert.getOpenIssue(ctx, params)
+	if err != nil {
+		return fmt.Errorf("error getting issue: %w, %w", err, enginerr.ErrActionFailed)
+	}
+	if existing == nil {
+		// The issue was closed or deleted manually, there's nothing left to do
+		return fmt.Errorf("issue already closed: %w", enginerr.ErrActionTurnedOff)
+	}
+
+	comment, err := execute(alert.resolvedTmpl, params.Template)
+	if err != nil {
+		return err
+	}
+	if err := alert.cli.CreateComment(ctx, params.Owner, params.Repo, params.Metadata.Number, comment); err != nil {
+		return fmt.Errorf("error commenting on issue: %w, %w", err, enginerr.ErrActionFailed)
+	}
+	if _, err := alert.cli.CloseIssue(ctx, params.Owner, params.Repo, params.Metadata.Number); err != nil {
+		if errors.Is(err, enginerr.ErrNotFound) {
+			return fmt.Errorf("issue already closed: %w, %w", err, enginerr.ErrActionTurnedOff)
+		}
+		return fmt.Errorf("error closing issue: %w, %w", err, enginerr.ErrActionFailed)
+	}
+	logger.Info().Int("issue_number", params.Metadata.Number).Msg("issue closed")
+	// Success - return ErrActionTurnedOff to indicate the action was successful
+	return fmt.Errorf("%s : %w", alert.Class(), enginerr.ErrActionTurnedOff)
+}
+
+// NotifyDigest opens an issue with the digest in the configured repository
+func (alert *Alert) NotifyDigest(
+	ctx context.Context,
+	cfg *pb.RuleType_Definition_Alert_Digest,
+	summary *digest.Summary,
+) error {
+	owner, repo, err := digest.Repository(cfg)
+	if err != nil {
+		return err
+	}
+	body, err := digest.Markdown(summary)
+	if err != nil {
+		return err
+	}
+	assignees, err := alert.getAssignees(ctx, &paramsIssue{Owner: owner, Repo: repo})
+	if err != nil {
+		return fmt.Errorf("error getting assignees: %w", err)
+	}
+
+	req := &github.IssueRequest{
+		Title: github.String(digest.Title(summary)),
+		Body:  github.String(body),
+	}
+	if labels := alert.issueCfg.GetLabels(); len(labels) > 0 {
+		req.Labels = &labels
+	}
+	if len(assignees) > 0 {
+		req.Assignees = &assignees
+	}
+
+	issue, err := alert.cli.CreateIssue(ctx, owner, repo, req)
+	if err != nil {
+		return fmt.Errorf("error creating issue: %w", err)
+	}
+	zerolog.Ctx(ctx).Info().Int("issue_number", issue.GetNumber()).Msg("digest issue opened")
+	return nil
+}
+
+// getOpenIssue returns the issue tracked in the metadata if it's still open
+func (alert *Alert) getOpenIssue(ctx context.Context, params *paramsIssue) (*github.Issue, error) {
+	if params.Metadata == nil || params.Metadata.Number == 0 {
+		return nil, nil
+	}
+
+	issue, err := alert.cli.GetIssue(ctx, params.Owner, params.Repo, params.Metadata.Number)
+	if errors.Is(err, enginerr.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if issue.GetState() != "open" {
+		return nil, nil
+	}
+	return issue, nil
+}
+
+// getAssignees returns the configured assignees and, if enabled, the repository code owners
+func (alert *Alert) getAssignees(ctx context.Context, params *paramsIssue) ([]string, error) {
+	assignees := slices.Clone(alert.issueCfg.GetAssignees())
+
+	if alert.issueCfg.GetAssignCodeowners() {
+		owners, err := getCodeowners(ctx, alert.cli, params.Owner, params.Repo)
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range owners {
+			if !slices.ContainsFunc(assignees, func(a string) bool { return strings.EqualFold(a, o) }) {
+				assignees = append(assignees, o)
+			}
+		}
+	}
+
+	return assignees, nil
+}
+
+// runDry runs the issue action in dry run mode
+func (alert *Alert) runDry(ctx context.Context, params *paramsIssue, cmd interfaces.ActionCmd) error {
+	logger := zerolog.Ctx(ctx)
+
+	switch cmd {
+	case interfaces.ActionCmdOn:
+		endpoint := fmt.Sprintf("repos/%v/%v/issues", params.Owner, params.Repo)
+		title, err := execute(alert.titleTmpl, params.Template)
+		if err != nil {
+			return err
+		}
+		body, err := json.Marshal(map[string]string{"title": title})
+		if err != nil {
+			return fmt.Errorf("cannot marshal issue body: %w", err)
+		}
+		curlCmd, err := util.GenerateCurlCommand("POST", alert.cli.GetBaseURL(), endpoint, string(body))
+		if err != nil {
+			return fmt.Errorf("cannot generate curl command: %w", err)
+		}
+		logger.Info().Msgf("run the following curl command to open an issue: \n%s\n", curlCmd)
+		return nil
+	case interfaces.ActionCmdOff:
+		if params.Metadata == nil || params.Metadata.Number == 0 {
+			// We cannot do anything without the issue number, so we assume that closing this is a success
+			return fmt.Errorf("no issue number provided: %w", enginerr.ErrActionTurnedOff)
+		}
+		endpoint := fmt.Sprintf("repos/%v/%v/issues/%v", params.Owner, params.Repo, params.Metadata.Number)
+		body := "{\"state\": \"closed\", \"state_reason\": \"completed\"}"
+		curlCmd, err := util.GenerateCurlCommand("PATCH", alert.cli.GetBaseURL(), endpoint, body)
+		if err != nil {
+			return fmt.Errorf("cannot generate curl command to close an issue: %w", err)
+		}
+		logger.Info().Msgf("run the following curl command: \n%s\n", curlCmd)
+	case interfaces.ActionCmdDoNothing:
+		return enginerr.ErrActionSkipped
+	}
+	return enginerr.ErrActionSkipped
+}
+
+// getParamsForIssue extracts the details from the entity
+func getParamsForIssue(
+	ctx context.Context,
+	entity protoreflect.ProtoMessage,
+	params interfaces.ActionsParams,
+	metadata *json.RawMessage,
+) (*paramsIssue, error) {
+	logger := zerolog.Ctx(ctx)
+	result := &paramsIssue{}
+
+	// Get the owner and repo from the entity
+	switch entity := entity.(type) {
+	case *pb.Repository:
+		result.Owner = entity.GetOwner()
+		result.Repo = entity.GetName()
+		result.Template.Entity = fmt.Sprintf("%s/%s", result.Owner, result.Repo)
+	case *pb.PullRequest:
+		result.Owner = entity.GetRepoOwner()
+		result.Repo = entity.GetRepoName()
+		result.Template.Entity = fmt.Sprintf("%s/%s#%d", result.Owner, result.Repo, entity.GetNumber())
+	case *pb.Artifact:
+		result.Owner = entity.GetOwner()
+		result.Repo = entity.GetRepository()
+		result.Template.Entity = fmt.Sprintf("artifact %s", entity.GetName())
+	default:
+		return nil, fmt.Errorf("expected repository, pull request or artifact, got %T", entity)
+	}
+	result.Template.Repository = fmt.Sprintf("%s/%s", result.Owner, result.Repo)
+
+	// Unmarshal the existing alert metadata, if any
+	if metadata != nil {
+		meta := &alertMetadata{}
+		err := json.Unmarshal(*metadata, meta)
+		if err != nil {
+			// There's nothing saved apparently, so no need to fail here, but do log the error
+			logger.Debug().Msgf("error unmarshalling alert metadata: %v", err)
+		} else {
+			result.Metadata = meta
+		}
+	}
+
+	result.Template.Profile = params.GetProfile().GetName()
+	result.Template.Rule = params.GetRuleType().GetName()
+	result.Template.Guidance = params.GetRuleType().GetGuidance()
+	result.Template.Details = enginerr.ErrorAsEvalDetails(params.GetEvalErr())
+	// Check if remediation is available for the rule type
+	if params.GetRuleType().GetDef().GetRemediate() != nil {
+		result.Template.RuleRemediation = "already available"
+	} else {
+		result.Template.RuleRemediation = "not available yet"
+	}
+	return result, nil
+}
+
+func execute(tmpl *template.Template, params templateParamsIssue) (string, error) {
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, params); err != nil {
+		return "", fmt.Errorf("error executing %s template: %w", tmpl.Name(), err)
+	}
+	return buf.String(), nil
+}
+
+func marshalMetadata(issue *github.Issue, details string) (json.RawMessage, error) {
+	newMeta, err := json.Marshal(alertMetadata{
+		Number:      issue.GetNumber(),
+		URL:         issue.GetHTMLURL(),
+		DetailsHash: hashDetails(details),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling alert metadata json: %w", err)
+	}
+	return newMeta, nil
+}
+
+// hashDetails returns the hex encoded SHA-256 of the failure details
+func hashDetails(details string) string {
+	sum := sha256.Sum256([]byte(details))
+	return hex.EncodeToString(sum[:])
+}
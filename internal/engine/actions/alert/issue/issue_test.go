@@ -0,0 +1,366 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package issue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-github/v53/github"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stacklok/minder/internal/db"
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
+	"github.com/stacklok/minder/internal/engine/interfaces"
+	"github.com/stacklok/minder/internal/providers"
+	mock_ghclient "github.com/stacklok/minder/internal/providers/github/mock"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+const (
+	repoOwner   = "stacklok"
+	repoName    = "minder"
+	issueNumber = 42
+	issueURL    = "https://github.com/stacklok/minder/issues/42"
+)
+
+var TestActionTypeValid interfaces.ActionType = "alert-test"
+
+func testGithubProviderBuilder() *providers.ProviderBuilder {
+	definitionJSON := `{
+		"github": {
+			"endpoint": "https://api.github.com/"
+		}
+	}`
+
+	return providers.NewProviderBuilder(
+		&db.Provider{
+			Name:       "github",
+			Version:    provifv1.V1,
+			Implements: []db.ProviderType{db.ProviderTypeGithub, db.ProviderTypeRest},
+			Definition: json.RawMessage(definitionJSON),
+		},
+		db.ProviderAccessToken{},
+		"token",
+	)
+}
+
+func testEvalParams(evalErr error) *interfaces.EvalStatusParams {
+	params := &interfaces.EvalStatusParams{
+		Profile: &pb.Profile{Name: "acme-profile"},
+		RuleType: &pb.RuleType{
+			Name:     "security_md",
+			Guidance: "Add a SECURITY.md file",
+			Def:      &pb.RuleType_Definition{},
+		},
+	}
+	params.SetEvalErr(evalErr)
+	return params
+}
+
+func testMeta(number int) *json.RawMessage {
+	meta := json.RawMessage(fmt.Sprintf(`{"issue_number": %d, "issue_url": %q}`, number, issueURL))
+	return &meta
+}
+
+func testMetaWithHash(number int, hash string) *json.RawMessage {
+	meta := json.RawMessage(fmt.Sprintf(`{"issue_number": %d, "issue_url": %q, "details_hash": %q}`,
+		number, issueURL, hash))
+	return &meta
+}
+
+func openIssue(state string) *github.Issue {
+	return &github.Issue{
+		Number:  github.Int(issueNumber),
+		HTMLURL: github.String(issueURL),
+		State:   github.String(state),
+	}
+}
+
+func TestIssueAlert(t *testing.T) {
+	t.Parallel()
+
+	repo := &pb.Repository{Owner: repoOwner, Name: repoName}
+	evalErr := enginerr.NewErrEvaluationFailed("SECURITY.md not found")
+	detailsHash := hashDetails(enginerr.ErrorAsEvalDetails(evalErr))
+
+	tests := []struct {
+		name      string
+		cfg       *pb.RuleType_Definition_Alert_AlertTypeIssue
+		cmd       interfaces.ActionCmd
+		setting   interfaces.ActionOpt
+		evalErr   error
+		metadata  *json.RawMessage
+		mockSetup func(*mock_ghclient.MockGitHub)
+		wantMeta  *alertMetadata
+		wantErr   error
+	}{
+		{
+			name: "opens an issue with labels and assignees",
+			cfg: &pb.RuleType_Definition_Alert_AlertTypeIssue{
+				Title:            github.String("{{.Rule}} failed on {{.Repository}}"),
+				Labels:           []string{"security"},
+				Assignees:        []string{"jakub"},
+				AssignCodeowners: true,
+			},
+			cmd:     interfaces.ActionCmdOn,
+			setting: interfaces.ActionOptOn,
+			evalErr: evalErr,
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					GetFileContent(gomock.Any(), repoOwner, repoName, ".github/CODEOWNERS", "").
+					Return("", fmt.Errorf("missing: %w", enginerr.ErrNotFound))
+				mockGitHub.EXPECT().
+					GetFileContent(gomock.Any(), repoOwner, repoName, "CODEOWNERS", "").
+					Return("# owners\n* @JAKUB @stacklok/security @ozz\n/docs/ @doc-writer\n", nil)
+				mockGitHub.EXPECT().
+					CreateIssue(gomock.Any(), repoOwner, repoName, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ string, req *github.IssueRequest) (*github.Issue, error) {
+						require.Equal(t, "security_md failed on stacklok/minder", req.GetTitle())
+						require.Contains(t, req.GetBody(), "SECURITY.md not found")
+						require.Contains(t, req.GetBody(), "Add a SECURITY.md file")
+						require.Equal(t, []string{"security"}, req.GetLabels())
+						require.Equal(t, []string{"jakub", "ozz"}, req.GetAssignees())
+						return openIssue("open"), nil
+					})
+			},
+			wantMeta: &alertMetadata{Number: issueNumber, URL: issueURL, DetailsHash: detailsHash},
+		},
+		{
+			name:     "comments on the issue if it is still open",
+			cfg:      &pb.RuleType_Definition_Alert_AlertTypeIssue{},
+			cmd:      interfaces.ActionCmdOn,
+			setting:  interfaces.ActionOptOn,
+			evalErr:  evalErr,
+			metadata: testMeta(issueNumber),
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					GetIssue(gomock.Any(), repoOwner, repoName, issueNumber).
+					Return(openIssue("open"), nil)
+				mockGitHub.EXPECT().
+					CreateComment(gomock.Any(), repoOwner, repoName, issueNumber, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ string, _ int, comment string) error {
+						require.Contains(t, comment, "still failing")
+						return nil
+					})
+			},
+			wantMeta: &alertMetadata{Number: issueNumber, URL: issueURL, DetailsHash: detailsHash},
+		},
+		{
+			name:     "opens a new issue if the previous one was closed",
+			cfg:      &pb.RuleType_Definition_Alert_AlertTypeIssue{},
+			cmd:      interfaces.ActionCmdOn,
+			setting:  interfaces.ActionOptOn,
+			evalErr:  evalErr,
+			metadata: testMeta(7),
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					GetIssue(gomock.Any(), repoOwner, repoName, 7).
+					Return(&github.Issue{Number: github.Int(7), State: github.String("closed")}, nil)
+				mockGitHub.EXPECT().
+					CreateIssue(gomock.Any(), repoOwner, repoName, gomock.Any()).
+					Return(openIssue("open"), nil)
+			},
+			wantMeta: &alertMetadata{Number: issueNumber, URL: issueURL, DetailsHash: detailsHash},
+		},
+		{
+			name:     "comments on the issue while the rule is still failing",
+			cfg:      &pb.RuleType_Definition_Alert_AlertTypeIssue{},
+			cmd:      interfaces.ActionCmdStillOn,
+			setting:  interfaces.ActionOptOn,
+			evalErr:  evalErr,
+			metadata: testMeta(issueNumber),
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					GetIssue(gomock.Any(), repoOwner, repoName, issueNumber).
+					Return(openIssue("open"), nil)
+				mockGitHub.EXPECT().
+					CreateComment(gomock.Any(), repoOwner, repoName, issueNumber, gomock.Any()).
+					Return(nil)
+			},
+			wantMeta: &alertMetadata{Number: issueNumber, URL: issueURL, DetailsHash: detailsHash},
+		},
+		{
+			name:      "does not comment again while the failure details are unchanged",
+			cfg:       &pb.RuleType_Definition_Alert_AlertTypeIssue{},
+			cmd:       interfaces.ActionCmdStillOn,
+			setting:   interfaces.ActionOptOn,
+			evalErr:   evalErr,
+			metadata:  testMetaWithHash(issueNumber, detailsHash),
+			mockSetup: func(_ *mock_ghclient.MockGitHub) {},
+			wantErr:   enginerr.ErrActionSkipped,
+		},
+		{
+			name:     "comments again once the failure details change",
+			cfg:      &pb.RuleType_Definition_Alert_AlertTypeIssue{},
+			cmd:      interfaces.ActionCmdStillOn,
+			setting:  interfaces.ActionOptOn,
+			evalErr:  evalErr,
+			metadata: testMetaWithHash(issueNumber, hashDetails("CODEOWNERS not found")),
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					GetIssue(gomock.Any(), repoOwner, repoName, issueNumber).
+					Return(openIssue("open"), nil)
+				mockGitHub.EXPECT().
+					CreateComment(gomock.Any(), repoOwner, repoName, issueNumber, gomock.Any()).
+					Return(nil)
+			},
+			wantMeta: &alertMetadata{Number: issueNumber, URL: issueURL, DetailsHash: detailsHash},
+		},
+		{
+			name:     "does not reopen an issue closed while the rule is still failing",
+			cfg:      &pb.RuleType_Definition_Alert_AlertTypeIssue{},
+			cmd:      interfaces.ActionCmdStillOn,
+			setting:  interfaces.ActionOptOn,
+			evalErr:  evalErr,
+			metadata: testMeta(issueNumber),
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					GetIssue(gomock.Any(), repoOwner, repoName, issueNumber).
+					Return(openIssue("closed"), nil)
+			},
+			wantErr: enginerr.ErrActionSkipped,
+		},
+		{
+			name:     "closes the issue with a resolution comment",
+			cfg:      &pb.RuleType_Definition_Alert_AlertTypeIssue{},
+			cmd:      interfaces.ActionCmdOff,
+			setting:  interfaces.ActionOptOn,
+			metadata: testMeta(issueNumber),
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					GetIssue(gomock.Any(), repoOwner, repoName, issueNumber).
+					Return(openIssue("open"), nil)
+				mockGitHub.EXPECT().
+					CreateComment(gomock.Any(), repoOwner, repoName, issueNumber, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ string, _ int, comment string) error {
+						require.Contains(t, comment, "now passing")
+						return nil
+					})
+				mockGitHub.EXPECT().
+					CloseIssue(gomock.Any(), repoOwner, repoName, issueNumber).
+					Return(openIssue("closed"), nil)
+			},
+			wantErr: enginerr.ErrActionTurnedOff,
+		},
+		{
+			name:     "issue closed manually",
+			cfg:      &pb.RuleType_Definition_Alert_AlertTypeIssue{},
+			cmd:      interfaces.ActionCmdOff,
+			setting:  interfaces.ActionOptOn,
+			metadata: testMeta(issueNumber),
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					GetIssue(gomock.Any(), repoOwner, repoName, issueNumber).
+					Return(openIssue("closed"), nil)
+			},
+			wantErr: enginerr.ErrActionTurnedOff,
+		},
+		{
+			name:      "close without metadata",
+			cfg:       &pb.RuleType_Definition_Alert_AlertTypeIssue{},
+			cmd:       interfaces.ActionCmdOff,
+			setting:   interfaces.ActionOptOn,
+			mockSetup: func(_ *mock_ghclient.MockGitHub) {},
+			wantErr:   enginerr.ErrActionTurnedOff,
+		},
+		{
+			name:    "create fails",
+			cfg:     &pb.RuleType_Definition_Alert_AlertTypeIssue{},
+			cmd:     interfaces.ActionCmdOn,
+			setting: interfaces.ActionOptOn,
+			evalErr: evalErr,
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					CreateIssue(gomock.Any(), repoOwner, repoName, gomock.Any()).
+					Return(nil, errors.New("issues are disabled"))
+			},
+			wantErr: enginerr.ErrActionFailed,
+		},
+		{
+			name:    "dry run",
+			cfg:     &pb.RuleType_Definition_Alert_AlertTypeIssue{},
+			cmd:     interfaces.ActionCmdOn,
+			setting: interfaces.ActionOptDryRun,
+			evalErr: evalErr,
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().GetBaseURL().Return("https://api.github.com/")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			alert, err := NewIssueAlert(TestActionTypeValid, tt.cfg, testGithubProviderBuilder())
+			require.NoError(t, err)
+
+			mockClient := mock_ghclient.NewMockGitHub(ctrl)
+			alert.cli = mockClient
+			tt.mockSetup(mockClient)
+
+			meta, err := alert.Do(context.Background(), tt.cmd, tt.setting, repo, testEvalParams(tt.evalErr), tt.metadata)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, meta)
+				return
+			}
+			require.NoError(t, err)
+
+			if tt.wantMeta == nil {
+				require.Nil(t, meta)
+				return
+			}
+			var got alertMetadata
+			require.NoError(t, json.Unmarshal(meta, &got))
+			require.Equal(t, *tt.wantMeta, got)
+		})
+	}
+}
+
+func TestNewIssueAlertInvalidTemplate(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewIssueAlert(TestActionTypeValid, &pb.RuleType_Definition_Alert_AlertTypeIssue{
+		Title: github.String("{{ .Rule "),
+	}, testGithubProviderBuilder())
+	require.Error(t, err)
+}
+
+func TestParseCodeowners(t *testing.T) {
+	t.Parallel()
+
+	content := strings.Join([]string{
+		"# catch-all",
+		"*       @alice @org/team user@example.com",
+		"*.go    @gopher",
+		"/*      @bob @carol # the last catch-all wins",
+	}, "\n")
+
+	require.Equal(t, []string{"bob", "carol"}, parseCodeowners(content))
+	require.Empty(t, parseCodeowners("docs/ @writer\n"))
+}
@@ -49,7 +49,7 @@ func (_ *Alert) Type() string {
 }
 
 // GetOnOffState returns the off state of the noop engine
-func (_ *Alert) GetOnOffState(_ *pb.Profile) interfaces.ActionOpt {
+func (_ *Alert) GetOnOffState(_ *pb.Profile, _ *pb.Profile_Rule) interfaces.ActionOpt {
 	return interfaces.ActionOptOff
 }
 
@@ -29,6 +29,7 @@ import (
 	"github.com/rs/zerolog"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
+	"github.com/stacklok/minder/internal/engine/actions/alert/digest"
 	enginerr "github.com/stacklok/minder/internal/engine/errors"
 	"github.com/stacklok/minder/internal/engine/interfaces"
 	"github.com/stacklok/minder/internal/providers"
@@ -173,9 +174,10 @@ func (_ *Alert) Type() string {
 	return AlertType
 }
 
-// GetOnOffState returns the alert action state read from the profile
-func (_ *Alert) GetOnOffState(p *pb.Profile) interfaces.ActionOpt {
-	return interfaces.ActionOptFromString(p.Alert, interfaces.ActionOptOn)
+// GetOnOffState returns the alert action state read from the profile, the
+// setting of the rule taking precedence
+func (_ *Alert) GetOnOffState(p *pb.Profile, rule *pb.Profile_Rule) interfaces.ActionOpt {
+	return interfaces.ActionOptFromString(p.GetAlertSetting(rule), interfaces.ActionOptOn)
 }
 
 // Do alerts through security advisory
@@ -254,6 +256,41 @@ func (alert *Alert) run(ctx context.Context, params *paramsSA, cmd interfaces.Ac
 	return nil, enginerr.ErrActionSkipped
 }
 
+// NotifyDigest opens a security advisory with the digest in the configured repository
+func (alert *Alert) NotifyDigest(
+	ctx context.Context,
+	cfg *pb.RuleType_Definition_Alert_Digest,
+	summary *digest.Summary,
+) error {
+	owner, repo, err := digest.Repository(cfg)
+	if err != nil {
+		return err
+	}
+	description, err := digest.Markdown(summary)
+	if err != nil {
+		return err
+	}
+
+	repository := fmt.Sprintf("%s/%s", owner, repo)
+	ecosystem := "other"
+	vulnerabilities := []*github.AdvisoryVulnerability{
+		{
+			Package: &github.VulnerabilityPackage{
+				Name:      &repository,
+				Ecosystem: &ecosystem,
+			},
+		},
+	}
+
+	id, err := alert.cli.CreateSecurityAdvisory(ctx, owner, repo, alert.saCfg.Severity,
+		digest.Title(summary), description, vulnerabilities)
+	if err != nil {
+		return fmt.Errorf("error creating security advisory: %w", err)
+	}
+	zerolog.Ctx(ctx).Info().Str("ghsa_id", id).Msg("digest security advisory opened")
+	return nil
+}
+
 // runDry runs the security advisory action in dry run mode
 func (alert *Alert) runDry(ctx context.Context, params *paramsSA, cmd interfaces.ActionCmd) error {
 	logger := zerolog.Ctx(ctx)
@@ -359,7 +396,7 @@ func (alert *Alert) getParamsForSecurityAdvisory(
 
 	var descriptionStr strings.Builder
 	// Get the description template depending if remediation is available
-	if interfaces.ActionOptFromString(params.GetProfile().Remediate, interfaces.ActionOptOff) == interfaces.ActionOptOn {
+	if interfaces.ActionOptFromString(params.GetProfile().GetRemediateSetting(params.GetRule()), interfaces.ActionOptOff) == interfaces.ActionOptOn {
 		err = alert.descriptionTmpl.Execute(&descriptionStr, result.Template)
 	} else {
 		err = alert.descriptionNoRemTmpl.Execute(&descriptionStr, result.Template)
@@ -0,0 +1,549 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package webhook provides necessary interfaces and implementations for
+// creating alerts of type webhook.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"syscall"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/stacklok/minder/internal/engine/actions/alert/digest"
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
+	"github.com/stacklok/minder/internal/engine/interfaces"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	// AlertType is the type of the webhook alert engine
+	AlertType = "webhook"
+	// PayloadVersion is the version of the payload sent to the webhook.
+	// It is bumped whenever a field is removed or changes its meaning.
+	PayloadVersion = "v1"
+
+	// EventAlertOpened is sent when a rule starts failing
+	EventAlertOpened = "alert_opened"
+	// EventAlertClosed is sent when a previously failing rule passes again
+	EventAlertClosed = "alert_closed"
+	// EventDigest is sent on the schedule of a digest
+	EventDigest = "digest"
+
+	// SignatureHeader carries the hex encoded HMAC-SHA256 of the body, prefixed with "sha256="
+	SignatureHeader = "X-Minder-Signature-256"
+	// EventHeader carries the event of the delivery
+	EventHeader = "X-Minder-Event"
+	// DeliveryHeader carries the unique ID of the delivery
+	DeliveryHeader = "X-Minder-Delivery"
+	// SecretEnvPrefix is the prefix the environment variables holding signing
+	// keys must have, so that rule types can't read arbitrary server secrets
+	SecretEnvPrefix = "MINDER_WEBHOOK_SECRET"
+
+	defaultMaxRetries = 3
+	defaultBackoff    = time.Second
+	maxBackoff        = 30 * time.Second
+	requestTimeout    = 10 * time.Second
+	tmplBodyName      = "body"
+)
+
+// Alert is the structure backing the webhook alert action
+type Alert struct {
+	actionType interfaces.ActionType
+	cli        *http.Client
+	url        string
+	secret     []byte
+	headers    http.Header
+	bodyTmpl   *template.Template
+	maxRetries int
+	// backoff is the delay before the first retry, it doubles on every attempt
+	backoff time.Duration
+}
+
+// Payload is the default body POSTed to the webhook
+type Payload struct {
+	Version string `json:"version"`
+	Event   string `json:"event"`
+	// AlertID stays the same for the opened and closed events of an alert
+	AlertID    string    `json:"alert_id"`
+	DeliveryID string    `json:"delivery_id"`
+	Timestamp  time.Time `json:"timestamp"`
+	Profile    string    `json:"profile"`
+	Rule       string    `json:"rule"`
+	Entity     Entity    `json:"entity"`
+	Status     string    `json:"status"`
+	Details    string    `json:"details,omitempty"`
+	Guidance   string    `json:"guidance,omitempty"`
+}
+
+// Entity describes the entity the alert was raised for
+type Entity struct {
+	Type string          `json:"type"`
+	Name string          `json:"name"`
+	Data json.RawMessage `json:"data"`
+}
+
+// DigestPayload is the body POSTed to the webhook for a digest
+type DigestPayload struct {
+	Version      string           `json:"version"`
+	Event        string           `json:"event"`
+	DeliveryID   string           `json:"delivery_id"`
+	Timestamp    time.Time        `json:"timestamp"`
+	Project      string           `json:"project"`
+	Since        time.Time        `json:"since"`
+	New          []digest.Finding `json:"new"`
+	StillFailing []digest.Finding `json:"still_failing"`
+	Resolved     []digest.Finding `json:"resolved"`
+}
+
+type alertMetadata struct {
+	AlertID    string `json:"alert_id,omitempty"`
+	DeliveryID string `json:"delivery_id,omitempty"`
+}
+
+// NewWebhookAlert creates a new webhook alert action
+func NewWebhookAlert(
+	actionType interfaces.ActionType,
+	whCfg *pb.RuleType_Definition_Alert_AlertTypeWebhook,
+) (*Alert, error) {
+	if actionType == "" {
+		return nil, fmt.Errorf("action type cannot be empty")
+	}
+
+	u, err := url.Parse(whCfg.GetUrl())
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse webhook url: %w", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("webhook url must be an absolute http or https url")
+	}
+
+	var secret []byte
+	if whCfg.SecretEnv != nil {
+		if !strings.HasPrefix(whCfg.GetSecretEnv(), SecretEnvPrefix) {
+			return nil, fmt.Errorf("webhook secret environment variable must start with %s", SecretEnvPrefix)
+		}
+		secret = []byte(os.Getenv(whCfg.GetSecretEnv()))
+		if len(secret) == 0 {
+			return nil, fmt.Errorf("webhook secret environment variable %s is not set", whCfg.GetSecretEnv())
+		}
+	}
+
+	headers := http.Header{}
+	for _, h := range whCfg.GetHeaders() {
+		name, value, ok := strings.Cut(h, ":")
+		if !ok || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("invalid webhook header %q, expected \"Name: value\"", h)
+		}
+		headers.Add(strings.TrimSpace(name), strings.TrimSpace(value))
+	}
+
+	var bodyTmpl *template.Template
+	if whCfg.Template != nil {
+		bodyTmpl, err = template.New(tmplBodyName).
+			Funcs(template.FuncMap{"json": toJSON}).
+			Parse(whCfg.GetTemplate())
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse body template: %w", err)
+		}
+	}
+
+	maxRetries := defaultMaxRetries
+	if whCfg.MaxRetries != nil {
+		if whCfg.GetMaxRetries() < 0 {
+			return nil, fmt.Errorf("max_retries cannot be negative")
+		}
+		maxRetries = int(whCfg.GetMaxRetries())
+	}
+
+	return &Alert{
+		actionType: actionType,
+		cli:        newPublicClient(),
+		url:        u.String(),
+		secret:     secret,
+		headers:    headers,
+		bodyTmpl:   bodyTmpl,
+		maxRetries: maxRetries,
+		backoff:    defaultBackoff,
+	}, nil
+}
+
+// newPublicClient returns a client which refuses to connect to loopback,
+// private and link-local addresses. The check is done on the resolved address
+// of every connection, redirects included, so DNS can't be used to get around it.
+func newPublicClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: requestTimeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || !isPublicIP(ip) {
+				return fmt.Errorf("webhook destination %s is not a public address", host)
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// a proxy would do the dialing for us, skipping the address check
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{Timeout: requestTimeout, Transport: transport}
+}
+
+// isPublicIP returns false for the addresses a webhook must not be sent to
+func isPublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() &&
+		!ip.IsPrivate() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() &&
+		!ip.IsUnspecified()
+}
+
+// Class returns the action type of the webhook engine
+func (alert *Alert) Class() interfaces.ActionType {
+	return alert.actionType
+}
+
+// Type returns the action subtype of the webhook engine
+func (_ *Alert) Type() string {
+	return AlertType
+}
+
+// GetOnOffState returns the alert action state read from the profile, the
+// setting of the rule taking precedence
+func (_ *Alert) GetOnOffState(p *pb.Profile, rule *pb.Profile_Rule) interfaces.ActionOpt {
+	return interfaces.ActionOptFromString(p.GetAlertSetting(rule), interfaces.ActionOptOn)
+}
+
+// Do alerts through the webhook
+func (alert *Alert) Do(
+	ctx context.Context,
+	cmd interfaces.ActionCmd,
+	setting interfaces.ActionOpt,
+	entity protoreflect.ProtoMessage,
+	params interfaces.ActionsParams,
+	metadata *json.RawMessage,
+) (json.RawMessage, error) {
+	var payload *Payload
+	var err error
+
+	switch cmd {
+	case interfaces.ActionCmdOn:
+		payload, err = newPayload(EventAlertOpened, uuid.NewString(), entity, params)
+	case interfaces.ActionCmdOff:
+		meta := getMetadata(ctx, metadata)
+		if meta == nil || meta.AlertID == "" {
+			// We never told the webhook about this alert, so there's nothing to close
+			return nil, fmt.Errorf("no webhook alert ID provided: %w", enginerr.ErrActionTurnedOff)
+		}
+		payload, err = newPayload(EventAlertClosed, meta.AlertID, entity, params)
+	case interfaces.ActionCmdDoNothing:
+		return nil, enginerr.ErrActionSkipped
+	default:
+		return nil, enginerr.ErrActionSkipped
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error extracting details: %w", err)
+	}
+
+	body, err := alert.renderBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	// Process the command based on the action setting
+	switch setting {
+	case interfaces.ActionOptOn:
+		return alert.run(ctx, cmd, payload, body)
+	case interfaces.ActionOptDryRun:
+		return nil, alert.runDry(ctx, payload, body)
+	case interfaces.ActionOptOff, interfaces.ActionOptUnknown:
+		return nil, fmt.Errorf("unexpected action setting: %w", enginerr.ErrActionFailed)
+	}
+	return nil, enginerr.ErrActionSkipped
+}
+
+// run delivers the payload to the webhook
+func (alert *Alert) run(
+	ctx context.Context,
+	cmd interfaces.ActionCmd,
+	payload *Payload,
+	body []byte,
+) (json.RawMessage, error) {
+	logger := zerolog.Ctx(ctx).With().
+		Str("alert_id", payload.AlertID).
+		Str("delivery_id", payload.DeliveryID).
+		Str("event", payload.Event).
+		Logger()
+
+	if err := alert.deliver(ctx, payload.Event, payload.DeliveryID, body); err != nil {
+		return nil, fmt.Errorf("error delivering webhook: %w, %w", err, enginerr.ErrActionFailed)
+	}
+
+	if cmd == interfaces.ActionCmdOff {
+		logger.Info().Msg("webhook alert closed")
+		// Success - return ErrActionTurnedOff to indicate the action was successful
+		return nil, fmt.Errorf("%s : %w", alert.Class(), enginerr.ErrActionTurnedOff)
+	}
+
+	newMeta, err := json.Marshal(alertMetadata{AlertID: payload.AlertID, DeliveryID: payload.DeliveryID})
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling alert metadata json: %w", err)
+	}
+	logger.Info().Msg("webhook alert opened")
+	return newMeta, nil
+}
+
+// runDry logs the payload that would have been delivered
+func (alert *Alert) runDry(ctx context.Context, payload *Payload, body []byte) error {
+	logger := zerolog.Ctx(ctx)
+	logger.Info().
+		Str("url", alert.url).
+		Str("event", payload.Event).
+		Msgf("would deliver the following webhook payload: \n%s\n", body)
+
+	if payload.Event == EventAlertClosed {
+		return fmt.Errorf("%s : %w", alert.Class(), enginerr.ErrActionTurnedOff)
+	}
+	return nil
+}
+
+// deliver POSTs the body to the webhook, retrying with exponential backoff on
+// network errors and on responses that may succeed when repeated
+func (alert *Alert) deliver(ctx context.Context, event, deliveryID string, body []byte) error {
+	logger := zerolog.Ctx(ctx)
+	wait := alert.backoff
+
+	var err error
+	for attempt := 0; ; attempt++ {
+		var retry bool
+		retry, err = alert.post(ctx, event, deliveryID, body)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt >= alert.maxRetries {
+			return err
+		}
+
+		logger.Debug().Err(err).Int("attempt", attempt+1).Dur("backoff", wait).Msg("webhook delivery failed, retrying")
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		case <-time.After(wait):
+		}
+		wait = min(2*wait, maxBackoff)
+	}
+}
+
+// post does a single delivery attempt, it returns whether a failure is worth retrying
+func (alert *Alert) post(ctx context.Context, event, deliveryID string, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, alert.url, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("cannot create request: %w", err)
+	}
+
+	for name, values := range alert.headers {
+		req.Header[name] = values
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "minder-webhook/"+PayloadVersion)
+	req.Header.Set(EventHeader, event)
+	req.Header.Set(DeliveryHeader, deliveryID)
+	if alert.secret != nil {
+		req.Header.Set(SignatureHeader, "sha256="+Sign(alert.secret, body))
+	}
+
+	resp, err := alert.cli.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	// drain the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+
+	err = fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	retry := resp.StatusCode >= 500 ||
+		resp.StatusCode == http.StatusRequestTimeout ||
+		resp.StatusCode == http.StatusTooManyRequests
+	return retry, err
+}
+
+// NotifyDigest delivers a digest to the webhook. Digests always use their own
+// payload, the body template only applies to individual alerts.
+func (alert *Alert) NotifyDigest(
+	ctx context.Context,
+	_ *pb.RuleType_Definition_Alert_Digest,
+	summary *digest.Summary,
+) error {
+	payload := &DigestPayload{
+		Version:      PayloadVersion,
+		Event:        EventDigest,
+		DeliveryID:   uuid.NewString(),
+		Timestamp:    time.Now().UTC(),
+		Project:      summary.Project,
+		Since:        summary.Since.UTC(),
+		New:          emptyIfNil(summary.New),
+		StillFailing: emptyIfNil(summary.StillFailing),
+		Resolved:     emptyIfNil(summary.Resolved),
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("error marshalling digest payload: %w", err)
+	}
+
+	if err := alert.deliver(ctx, payload.Event, payload.DeliveryID, body); err != nil {
+		return fmt.Errorf("error delivering webhook: %w", err)
+	}
+	zerolog.Ctx(ctx).Info().Str("delivery_id", payload.DeliveryID).Msg("webhook digest delivered")
+	return nil
+}
+
+// renderBody serializes the payload, or renders it through the user template if one is set
+func (alert *Alert) renderBody(payload *Payload) ([]byte, error) {
+	if alert.bodyTmpl == nil {
+		body, err := json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling webhook payload: %w", err)
+		}
+		return body, nil
+	}
+
+	var buf bytes.Buffer
+	if err := alert.bodyTmpl.Execute(&buf, payload); err != nil {
+		return nil, fmt.Errorf("error executing body template: %w", err)
+	}
+	if !json.Valid(buf.Bytes()) {
+		return nil, fmt.Errorf("body template did not render valid JSON")
+	}
+	return buf.Bytes(), nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of the body. Receivers can use it to
+// verify the value of the signature header.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	// hash.Hash never returns an error on Write
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func newPayload(
+	event, alertID string,
+	entity protoreflect.ProtoMessage,
+	params interfaces.ActionsParams,
+) (*Payload, error) {
+	ent, err := entityFromProto(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	evalErr := params.GetEvalErr()
+	return &Payload{
+		Version:    PayloadVersion,
+		Event:      event,
+		AlertID:    alertID,
+		DeliveryID: uuid.NewString(),
+		Timestamp:  time.Now().UTC(),
+		Profile:    params.GetProfile().GetName(),
+		Rule:       params.GetRuleType().GetName(),
+		Entity:     *ent,
+		Status:     string(enginerr.ErrorAsEvalStatus(evalErr)),
+		Details:    enginerr.ErrorAsEvalDetails(evalErr),
+		Guidance:   params.GetRuleType().GetGuidance(),
+	}, nil
+}
+
+func entityFromProto(entity protoreflect.ProtoMessage) (*Entity, error) {
+	ent := &Entity{}
+	switch entity := entity.(type) {
+	case *pb.Repository:
+		ent.Type = pb.RepositoryEntity.String()
+		ent.Name = fmt.Sprintf("%s/%s", entity.GetOwner(), entity.GetName())
+	case *pb.PullRequest:
+		ent.Type = pb.PullRequestEntity.String()
+		ent.Name = fmt.Sprintf("%s/%s#%d", entity.GetRepoOwner(), entity.GetRepoName(), entity.GetNumber())
+	case *pb.Artifact:
+		ent.Type = pb.ArtifactEntity.String()
+		ent.Name = fmt.Sprintf("%s/%s/%s", entity.GetOwner(), entity.GetRepository(), entity.GetName())
+	default:
+		return nil, fmt.Errorf("expected repository, pull request or artifact, got %T", entity)
+	}
+
+	data, err := protojson.Marshal(entity)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling entity: %w", err)
+	}
+	ent.Data = data
+	return ent, nil
+}
+
+// getMetadata unmarshals the existing alert metadata, if any
+func getMetadata(ctx context.Context, metadata *json.RawMessage) *alertMetadata {
+	if metadata == nil {
+		return nil
+	}
+	meta := &alertMetadata{}
+	if err := json.Unmarshal(*metadata, meta); err != nil {
+		// There's nothing saved apparently, so no need to fail here, but do log the error
+		zerolog.Ctx(ctx).Debug().Msgf("error unmarshalling alert metadata: %v", err)
+		return nil
+	}
+	return meta
+}
+
+func emptyIfNil(findings []digest.Finding) []digest.Finding {
+	if findings == nil {
+		return []digest.Finding{}
+	}
+	return findings
+}
+
+// toJSON is available to body templates to safely embed values
+func toJSON(v any) (string, error) {
+	out, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
@@ -0,0 +1,431 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stacklok/minder/internal/engine/actions/alert/digest"
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
+	"github.com/stacklok/minder/internal/engine/interfaces"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	testActionType interfaces.ActionType = "alert"
+	testSecretEnv                        = "MINDER_WEBHOOK_SECRET_TEST"
+)
+
+type delivery struct {
+	headers http.Header
+	body    []byte
+}
+
+// webhookStandIn records every delivery and answers with the given status codes
+// in order, the last one is repeated once exhausted
+type webhookStandIn struct {
+	mu         sync.Mutex
+	deliveries []delivery
+	statuses   []int
+}
+
+func newWebhookStandIn(t *testing.T, statuses ...int) (*webhookStandIn, *httptest.Server) {
+	t.Helper()
+
+	s := &webhookStandIn{statuses: statuses}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.deliveries = append(s.deliveries, delivery{headers: r.Header.Clone(), body: body})
+		status := http.StatusOK
+		if len(s.statuses) > 0 {
+			status = s.statuses[min(len(s.deliveries), len(s.statuses))-1]
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return s, server
+}
+
+func (s *webhookStandIn) received() []delivery {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.deliveries
+}
+
+func newTestAlert(t *testing.T, cfg *pb.RuleType_Definition_Alert_AlertTypeWebhook) *Alert {
+	t.Helper()
+
+	alert, err := NewWebhookAlert(testActionType, cfg)
+	require.NoError(t, err)
+	alert.backoff = time.Millisecond
+	// the stand-in listens on loopback, which the default client refuses
+	alert.cli = &http.Client{Timeout: requestTimeout}
+	return alert
+}
+
+func testParams(evalErr error) *interfaces.EvalStatusParams {
+	params := &interfaces.EvalStatusParams{
+		Profile: &pb.Profile{Name: "acme-profile"},
+		RuleType: &pb.RuleType{
+			Name:     "secret_scanning",
+			Guidance: "Enable secret scanning",
+		},
+	}
+	params.SetEvalErr(evalErr)
+	return params
+}
+
+var testRepo = &pb.Repository{Owner: "stacklok", Name: "minder"}
+
+func TestNewWebhookAlert(t *testing.T) {
+	t.Setenv(testSecretEnv, "s3cr3t")
+
+	tests := []struct {
+		name    string
+		cfg     *pb.RuleType_Definition_Alert_AlertTypeWebhook
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			cfg: &pb.RuleType_Definition_Alert_AlertTypeWebhook{
+				Url:       "https://example.com/hook",
+				SecretEnv: strPtr(testSecretEnv),
+				Headers:   []string{"Authorization: Bearer token"},
+			},
+		},
+		{
+			name:    "relative url",
+			cfg:     &pb.RuleType_Definition_Alert_AlertTypeWebhook{Url: "/hook"},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported scheme",
+			cfg:     &pb.RuleType_Definition_Alert_AlertTypeWebhook{Url: "ftp://example.com/hook"},
+			wantErr: true,
+		},
+		{
+			name: "unset secret",
+			cfg: &pb.RuleType_Definition_Alert_AlertTypeWebhook{
+				Url:       "https://example.com/hook",
+				SecretEnv: strPtr("MINDER_WEBHOOK_SECRET_UNSET"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "secret outside of the allowed prefix",
+			cfg: &pb.RuleType_Definition_Alert_AlertTypeWebhook{
+				Url:       "https://example.com/hook",
+				SecretEnv: strPtr("MINDER_AUTH_TOKEN_KEY"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "malformed header",
+			cfg: &pb.RuleType_Definition_Alert_AlertTypeWebhook{
+				Url:     "https://example.com/hook",
+				Headers: []string{"no-colon"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "bad template",
+			cfg: &pb.RuleType_Definition_Alert_AlertTypeWebhook{
+				Url:      "https://example.com/hook",
+				Template: strPtr("{{ .Rule "),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewWebhookAlert(testActionType, tt.cfg)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWebhookRefusesNonPublicDestinations(t *testing.T) {
+	t.Parallel()
+
+	standIn, server := newWebhookStandIn(t)
+	alert, err := NewWebhookAlert(testActionType, &pb.RuleType_Definition_Alert_AlertTypeWebhook{Url: server.URL})
+	require.NoError(t, err)
+	alert.maxRetries = 0
+
+	_, err = alert.Do(context.Background(), interfaces.ActionCmdOn, interfaces.ActionOptOn,
+		testRepo, testParams(enginerr.NewErrEvaluationFailed("failed")), nil)
+	require.ErrorIs(t, err, enginerr.ErrActionFailed)
+	require.ErrorContains(t, err, "not a public address")
+	require.Empty(t, standIn.received())
+}
+
+func TestIsPublicIP(t *testing.T) {
+	t.Parallel()
+
+	for addr, want := range map[string]bool{
+		"8.8.8.8":         true,
+		"2606:4700::1111": true,
+		"127.0.0.1":       false,
+		"::1":             false,
+		"10.1.2.3":        false,
+		"172.16.0.1":      false,
+		"192.168.1.1":     false,
+		"169.254.169.254": false,
+		"fe80::1":         false,
+		"fd00::1":         false,
+		"0.0.0.0":         false,
+	} {
+		assert.Equal(t, want, isPublicIP(net.ParseIP(addr)), addr)
+	}
+}
+
+func TestWebhookOpenAndClose(t *testing.T) {
+	t.Setenv(testSecretEnv, "s3cr3t")
+
+	standIn, server := newWebhookStandIn(t)
+	alert := newTestAlert(t, &pb.RuleType_Definition_Alert_AlertTypeWebhook{
+		Url:       server.URL,
+		SecretEnv: strPtr(testSecretEnv),
+		Headers:   []string{"X-Team: security"},
+	})
+
+	evalErr := enginerr.NewErrEvaluationFailed("secret scanning is disabled")
+	meta, err := alert.Do(context.Background(), interfaces.ActionCmdOn, interfaces.ActionOptOn,
+		testRepo, testParams(evalErr), nil)
+	require.NoError(t, err)
+
+	var stored alertMetadata
+	require.NoError(t, json.Unmarshal(meta, &stored))
+	require.NotEmpty(t, stored.AlertID)
+
+	deliveries := standIn.received()
+	require.Len(t, deliveries, 1)
+	opened := deliveries[0]
+	assert.Equal(t, "sha256="+Sign([]byte("s3cr3t"), opened.body), opened.headers.Get(SignatureHeader))
+	assert.Equal(t, EventAlertOpened, opened.headers.Get(EventHeader))
+	assert.Equal(t, "security", opened.headers.Get("X-Team"))
+
+	var payload Payload
+	require.NoError(t, json.Unmarshal(opened.body, &payload))
+	assert.Equal(t, PayloadVersion, payload.Version)
+	assert.Equal(t, EventAlertOpened, payload.Event)
+	assert.Equal(t, stored.AlertID, payload.AlertID)
+	assert.Equal(t, stored.DeliveryID, payload.DeliveryID)
+	assert.Equal(t, "acme-profile", payload.Profile)
+	assert.Equal(t, "secret_scanning", payload.Rule)
+	assert.Equal(t, "failure", payload.Status)
+	assert.Contains(t, payload.Details, "secret scanning is disabled")
+	assert.Equal(t, "Enable secret scanning", payload.Guidance)
+	assert.Equal(t, "repository", payload.Entity.Type)
+	assert.Equal(t, "stacklok/minder", payload.Entity.Name)
+
+	rawMeta := json.RawMessage(meta)
+	closeMeta, err := alert.Do(context.Background(), interfaces.ActionCmdOff, interfaces.ActionOptOn,
+		testRepo, testParams(nil), &rawMeta)
+	require.ErrorIs(t, err, enginerr.ErrActionTurnedOff)
+	require.Nil(t, closeMeta)
+
+	deliveries = standIn.received()
+	require.Len(t, deliveries, 2)
+	var closed Payload
+	require.NoError(t, json.Unmarshal(deliveries[1].body, &closed))
+	assert.Equal(t, EventAlertClosed, closed.Event)
+	assert.Equal(t, stored.AlertID, closed.AlertID)
+	assert.NotEqual(t, stored.DeliveryID, closed.DeliveryID)
+	assert.Equal(t, "success", closed.Status)
+}
+
+func TestWebhookCloseWithoutMetadata(t *testing.T) {
+	t.Parallel()
+
+	standIn, server := newWebhookStandIn(t)
+	alert := newTestAlert(t, &pb.RuleType_Definition_Alert_AlertTypeWebhook{Url: server.URL})
+
+	_, err := alert.Do(context.Background(), interfaces.ActionCmdOff, interfaces.ActionOptOn,
+		testRepo, testParams(nil), nil)
+	require.ErrorIs(t, err, enginerr.ErrActionTurnedOff)
+	require.Empty(t, standIn.received())
+}
+
+func TestWebhookRetries(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		statuses       []int
+		maxRetries     int32
+		wantErr        bool
+		wantDeliveries int
+	}{
+		{
+			name:           "recovers after server errors",
+			statuses:       []int{http.StatusBadGateway, http.StatusTooManyRequests, http.StatusOK},
+			maxRetries:     3,
+			wantDeliveries: 3,
+		},
+		{
+			name:           "gives up after max retries",
+			statuses:       []int{http.StatusServiceUnavailable},
+			maxRetries:     2,
+			wantErr:        true,
+			wantDeliveries: 3,
+		},
+		{
+			name:           "client errors are not retried",
+			statuses:       []int{http.StatusUnauthorized},
+			maxRetries:     3,
+			wantErr:        true,
+			wantDeliveries: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			standIn, server := newWebhookStandIn(t, tt.statuses...)
+			alert := newTestAlert(t, &pb.RuleType_Definition_Alert_AlertTypeWebhook{
+				Url:        server.URL,
+				MaxRetries: &tt.maxRetries,
+			})
+
+			meta, err := alert.Do(context.Background(), interfaces.ActionCmdOn, interfaces.ActionOptOn,
+				testRepo, testParams(enginerr.NewErrEvaluationFailed("failed")), nil)
+			if tt.wantErr {
+				require.ErrorIs(t, err, enginerr.ErrActionFailed)
+				require.Nil(t, meta)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, meta)
+			}
+			require.Len(t, standIn.received(), tt.wantDeliveries)
+		})
+	}
+}
+
+func TestWebhookTemplate(t *testing.T) {
+	t.Parallel()
+
+	standIn, server := newWebhookStandIn(t)
+	alert := newTestAlert(t, &pb.RuleType_Definition_Alert_AlertTypeWebhook{
+		Url:      server.URL,
+		Template: strPtr(`{"text": {{ printf "%s failed on %s" .Rule .Entity.Name | json }}, "id": {{ json .AlertID }}}`),
+	})
+
+	_, err := alert.Do(context.Background(), interfaces.ActionCmdOn, interfaces.ActionOptOn,
+		testRepo, testParams(enginerr.NewErrEvaluationFailed("failed")), nil)
+	require.NoError(t, err)
+
+	deliveries := standIn.received()
+	require.Len(t, deliveries, 1)
+	var body map[string]string
+	require.NoError(t, json.Unmarshal(deliveries[0].body, &body))
+	assert.Equal(t, "secret_scanning failed on stacklok/minder", body["text"])
+	assert.NotEmpty(t, body["id"])
+	assert.Empty(t, deliveries[0].headers.Get(SignatureHeader))
+}
+
+func TestWebhookTemplateInvalidJSON(t *testing.T) {
+	t.Parallel()
+
+	standIn, server := newWebhookStandIn(t)
+	alert := newTestAlert(t, &pb.RuleType_Definition_Alert_AlertTypeWebhook{
+		Url:      server.URL,
+		Template: strPtr(`{"text": {{ .Rule }}}`),
+	})
+
+	_, err := alert.Do(context.Background(), interfaces.ActionCmdOn, interfaces.ActionOptOn,
+		testRepo, testParams(enginerr.NewErrEvaluationFailed("failed")), nil)
+	require.Error(t, err)
+	require.Empty(t, standIn.received())
+}
+
+func TestWebhookDryRun(t *testing.T) {
+	t.Parallel()
+
+	standIn, server := newWebhookStandIn(t)
+	alert := newTestAlert(t, &pb.RuleType_Definition_Alert_AlertTypeWebhook{Url: server.URL})
+
+	meta, err := alert.Do(context.Background(), interfaces.ActionCmdOn, interfaces.ActionOptDryRun,
+		testRepo, testParams(enginerr.NewErrEvaluationFailed("failed")), nil)
+	require.NoError(t, err)
+	require.Nil(t, meta)
+	require.Empty(t, standIn.received())
+}
+
+func TestWebhookNotifyDigest(t *testing.T) {
+	t.Setenv(testSecretEnv, "s3cr3t")
+
+	standIn, server := newWebhookStandIn(t)
+	alert := newTestAlert(t, &pb.RuleType_Definition_Alert_AlertTypeWebhook{
+		Url:       server.URL,
+		SecretEnv: strPtr(testSecretEnv),
+		// digests don't go through the body template
+		Template: strPtr(`{"text": {{ json .Rule }}}`),
+	})
+
+	since := time.Date(2023, 12, 1, 10, 0, 0, 0, time.UTC)
+	err := alert.NotifyDigest(context.Background(), &pb.RuleType_Definition_Alert_Digest{Schedule: "daily"}, &digest.Summary{
+		Project:  "acme",
+		Since:    since,
+		New:      []digest.Finding{{Rule: "secret_scanning", EntityName: "stacklok/minder"}},
+		Resolved: []digest.Finding{{Rule: "security_md", EntityName: "stacklok/minder"}},
+	})
+	require.NoError(t, err)
+
+	deliveries := standIn.received()
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, EventDigest, deliveries[0].headers.Get(EventHeader))
+	assert.Equal(t, "sha256="+Sign([]byte("s3cr3t"), deliveries[0].body), deliveries[0].headers.Get(SignatureHeader))
+
+	var payload DigestPayload
+	require.NoError(t, json.Unmarshal(deliveries[0].body, &payload))
+	assert.Equal(t, PayloadVersion, payload.Version)
+	assert.Equal(t, "acme", payload.Project)
+	assert.Equal(t, since, payload.Since)
+	assert.Len(t, payload.New, 1)
+	assert.Empty(t, payload.StillFailing)
+	assert.NotNil(t, payload.StillFailing)
+	assert.Len(t, payload.Resolved, 1)
+}
+
+func strPtr(s string) *string {
+	return &s
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/rs/zerolog"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
 	"github.com/stacklok/minder/internal/engine/interfaces"
 	"github.com/stacklok/minder/internal/providers"
 	"github.com/stacklok/minder/internal/util"
@@ -85,6 +86,17 @@ type PatchTemplateParams struct {
 	Params map[string]any
 }
 
+// rollbackMeta is the state of the branch before the remediation, recorded to
+// roll the remediation back
+type rollbackMeta struct {
+	Owner  string `json:"owner"`
+	Repo   string `json:"repo"`
+	Branch string `json:"branch"`
+	// Protection is the protection of the branch before the remediation, nil
+	// if the branch was not protected
+	Protection *github.ProtectionRequest `json:"protection,omitempty"`
+}
+
 // Class returns the action type of the remediation engine
 func (r *GhBranchProtectRemediator) Class() interfaces.ActionType {
 	return r.actionType
@@ -95,9 +107,10 @@ func (_ *GhBranchProtectRemediator) Type() string {
 	return RemediateType
 }
 
-// GetOnOffState returns the alert action state read from the profile
-func (_ *GhBranchProtectRemediator) GetOnOffState(p *pb.Profile) interfaces.ActionOpt {
-	return interfaces.ActionOptFromString(p.Remediate, interfaces.ActionOptOff)
+// GetOnOffState returns the alert action state read from the profile, the
+// setting of the rule taking precedence
+func (_ *GhBranchProtectRemediator) GetOnOffState(p *pb.Profile, rule *pb.Profile_Rule) interfaces.ActionOpt {
+	return interfaces.ActionOptFromString(p.GetRemediateSetting(rule), interfaces.ActionOptOff)
 }
 
 // Do perform the remediation
@@ -126,16 +139,27 @@ func (r *GhBranchProtectRemediator) Do(
 	}
 
 	// get the current protection
+	protected := true
 	res, err := r.cli.GetBranchProtection(ctx, repo.Owner, repo.Name, branch)
 	if errors.Is(err, github.ErrBranchNotProtected) {
 		// this will create a new branch protection using github's defaults
 		// which appear quite sensible
 		res = &github.Protection{}
+		protected = false
 	} else if err != nil {
 		return nil, fmt.Errorf("error getting branch protection: %w", err)
 	}
 
 	req := protectionResultToRequest(res)
+	meta := rollbackMeta{Owner: repo.Owner, Repo: repo.Name, Branch: branch}
+	if protected {
+		meta.Protection = req
+	}
+	// the current protection is recorded before patching the request
+	rawMeta, err := json.Marshal(meta)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling rollback metadata: %w", err)
+	}
 
 	var patch bytes.Buffer
 	err = r.patchTemplate.Execute(&patch, retp)
@@ -153,28 +177,67 @@ func (r *GhBranchProtectRemediator) Do(
 	switch remAction {
 	case interfaces.ActionOptOn:
 		err = r.cli.UpdateBranchProtection(ctx, repo.Owner, repo.Name, branch, updatedRequest)
+		if err == nil {
+			return rawMeta, nil
+		}
 	case interfaces.ActionOptDryRun:
 		err = dryRun(r.cli.GetBaseURL(), repo.Owner, repo.Name, branch, updatedRequest)
+	case interfaces.ActionOptApproval:
+		err = pendingApproval(r.cli.GetBaseURL(), repo.Owner, repo.Name, branch, updatedRequest)
 	case interfaces.ActionOptOff, interfaces.ActionOptUnknown:
 		err = errors.New("unexpected action")
 	}
 	return nil, err
 }
 
+// Rollback restores the protection the branch had before the remediation
+func (r *GhBranchProtectRemediator) Rollback(ctx context.Context, metadata json.RawMessage) error {
+	var meta rollbackMeta
+	if err := json.Unmarshal(metadata, &meta); err != nil {
+		return fmt.Errorf("error unmarshalling rollback metadata: %w", err)
+	}
+	if meta.Owner == "" || meta.Repo == "" || meta.Branch == "" {
+		return errors.New("rollback metadata is missing the branch")
+	}
+
+	if meta.Protection == nil {
+		return r.cli.RemoveBranchProtection(ctx, meta.Owner, meta.Repo, meta.Branch)
+	}
+	return r.cli.UpdateBranchProtection(ctx, meta.Owner, meta.Repo, meta.Branch, meta.Protection)
+}
+
 func dryRun(baseUrl, owner, repo, branch string, req *github.ProtectionRequest) error {
+	curlCmd, err := curlCommand(baseUrl, owner, repo, branch, req)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("run the following curl command: \n%s\n", curlCmd)
+	return nil
+}
+
+// pendingApproval records the protection update as the change to approve
+func pendingApproval(baseUrl, owner, repo, branch string, req *github.ProtectionRequest) error {
+	curlCmd, err := curlCommand(baseUrl, owner, repo, branch, req)
+	if err != nil {
+		return err
+	}
+
+	return enginerr.NewErrActionPendingApproval(RemediateType, curlCmd)
+}
+
+func curlCommand(baseUrl, owner, repo, branch string, req *github.ProtectionRequest) (string, error) {
 	jsonReq, err := json.Marshal(req)
 	if err != nil {
-		log.Fatalf("Error marshalling data: %v", err)
+		return "", fmt.Errorf("error marshalling data: %w", err)
 	}
 
 	endpoint := fmt.Sprintf("repos/%v/%v/branches/%v/protection", owner, repo, branch)
 	curlCmd, err := util.GenerateCurlCommand(http.MethodPut, baseUrl, endpoint, string(jsonReq))
 	if err != nil {
-		return fmt.Errorf("cannot generate curl command: %w", err)
+		return "", fmt.Errorf("cannot generate curl command: %w", err)
 	}
-
-	log.Printf("run the following curl command: \n%s\n", curlCmd)
-	return nil
+	return curlCmd, nil
 }
 
 func patchRequest(
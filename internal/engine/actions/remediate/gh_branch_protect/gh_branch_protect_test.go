@@ -295,6 +295,79 @@ func TestBranchProtectionRemediate(t *testing.T) {
 			}
 
 			require.NoError(t, err, "unexpected error running remediate engine")
+			require.NotNil(t, retMeta, "expected the protection before the remediation")
+		})
+	}
+}
+
+func TestBranchProtectionRollback(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		meta      string
+		mockSetup func(*mock_ghclient.MockGitHub)
+		wantErr   bool
+	}{
+		{
+			name: "previous protection is restored",
+			meta: `{"owner":"stacklok","repo":"minder","branch":"main",` +
+				`"protection":{"required_pull_request_reviews":{"required_approving_review_count":1}}}`,
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					UpdateBranchProtection(gomock.Any(), repoOwner, repoName, "main",
+						eqProtectionRequest(
+							&github.ProtectionRequest{
+								RequiredPullRequestReviews: &github.PullRequestReviewsEnforcementRequest{
+									RequiredApprovingReviewCount: 1,
+								},
+							},
+						),
+					).
+					Return(nil)
+			},
+		},
+		{
+			name: "protection is removed if the branch was not protected",
+			meta: `{"owner":"stacklok","repo":"minder","branch":"main"}`,
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					RemoveBranchProtection(gomock.Any(), repoOwner, repoName, "main").
+					Return(nil)
+			},
+		},
+		{
+			name:      "metadata without a branch",
+			meta:      `{}`,
+			mockSetup: func(_ *mock_ghclient.MockGitHub) {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			engine, err := NewGhBranchProtectRemediator(TestActionTypeValid,
+				&pb.RuleType_Definition_Remediate_GhBranchProtectionType{Patch: reviewCountPatch},
+				testGithubProviderBuilder(ghApiUrl))
+			require.NoError(t, err, "unexpected error creating remediate engine")
+
+			mockClient := mock_ghclient.NewMockGitHub(ctrl)
+			tt.mockSetup(mockClient)
+			engine.cli = mockClient
+
+			err = engine.Rollback(context.Background(), json.RawMessage(tt.meta))
+			if tt.wantErr {
+				require.Error(t, err, "expected error")
+				return
+			}
+			require.NoError(t, err, "unexpected error rolling back")
 		})
 	}
 }
@@ -49,7 +49,7 @@ func (_ *Remediator) Type() string {
 }
 
 // GetOnOffState returns the off state of the noop engine
-func (_ *Remediator) GetOnOffState(_ *pb.Profile) interfaces.ActionOpt {
+func (_ *Remediator) GetOnOffState(_ *pb.Profile, _ *pb.Profile_Rule) interfaces.ActionOpt {
 	return interfaces.ActionOptOff
 }
 
@@ -0,0 +1,239 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pull_request
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v53/github"
+	"github.com/rs/zerolog"
+
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
+	"github.com/stacklok/minder/internal/engine/interfaces"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	prStateOpen              = "open"
+	prStateClosed            = "closed"
+	prReviewApproved         = "APPROVED"
+	prReviewChangesRequested = "CHANGES_REQUESTED"
+	prReviewDismissed        = "DISMISSED"
+	prResolvedComment        = "The rule remediated by this pull request passes now."
+	prClosedCommentFmt       = "%s Closing the pull request, it is not needed anymore."
+)
+
+// prMetadata is the state of the remediation, which tracks the PR it opened
+type prMetadata struct {
+	// Number is the number of the PR opened by the remediation
+	Number int `json:"pr_number,omitempty"`
+	// Resolved is set once the PR was closed or commented on because the
+	// rule passes
+	Resolved bool `json:"resolved,omitempty"`
+}
+
+func parsePrMetadata(metadata *json.RawMessage) (*prMetadata, error) {
+	meta := &prMetadata{}
+	if metadata == nil || len(*metadata) == 0 {
+		return meta, nil
+	}
+	if err := json.Unmarshal(*metadata, meta); err != nil {
+		return nil, fmt.Errorf("cannot parse remediation metadata: %w", err)
+	}
+	return meta, nil
+}
+
+// track records the PR as the one opened by the remediation
+func (m *prMetadata) track(pr *github.PullRequest) {
+	if pr == nil {
+		return
+	}
+	m.Number = pr.GetNumber()
+	m.Resolved = false
+}
+
+func (m *prMetadata) marshal() (json.RawMessage, error) {
+	newMeta, err := json.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling remediation metadata: %w", err)
+	}
+	return newMeta, nil
+}
+
+// openPr returns the open PR of the remediation, either the one it opened
+// before or one with the same contents. If the PR was closed without merging
+// recently, the remediation is skipped instead of opening it again.
+func (r *Remediator) openPr(
+	ctx context.Context,
+	repo *pb.Repository,
+	meta *prMetadata,
+	magicComment string,
+) (*github.PullRequest, error) {
+	if meta.Number != 0 {
+		pr, err := r.cli.GetPullRequest(ctx, repo.GetOwner(), repo.GetName(), meta.Number)
+		if err != nil {
+			return nil, fmt.Errorf("cannot get pull request: %w", err)
+		}
+		if pr.GetState() == prStateOpen {
+			return pr, nil
+		}
+		if r.closedRecently(pr, meta) {
+			return nil, fmt.Errorf("PR #%d was closed without merging, won't open it again: %w",
+				pr.GetNumber(), enginerr.ErrActionSkipped)
+		}
+		// the PR was merged, or closed long enough ago, open a new one
+		*meta = prMetadata{}
+	}
+
+	pr, err := findPrWithMagicComment(ctx, r.cli, repo, magicComment)
+	if err != nil {
+		return nil, fmt.Errorf("cannot check if PR already exists: %w", err)
+	}
+	return pr, nil
+}
+
+// closedRecently returns true if somebody closed the PR without merging it
+// less than reopenAfterDays ago
+func (r *Remediator) closedRecently(pr *github.PullRequest, meta *prMetadata) bool {
+	if r.reopenAfterDays == 0 || pr.GetMerged() || meta.Resolved {
+		return false
+	}
+	return time.Since(pr.GetClosedAt().Time) < time.Duration(r.reopenAfterDays)*24*time.Hour
+}
+
+// resolve closes or comments on the PR opened by the remediation once the rule passes
+func (r *Remediator) resolve(
+	ctx context.Context,
+	remAction interfaces.ActionOpt,
+	repo *pb.Repository,
+	meta *prMetadata,
+) (json.RawMessage, error) {
+	if meta.Number == 0 || meta.Resolved || r.onResolved == pb.PrOnResolvedKeep {
+		return nil, fmt.Errorf("no pull request to resolve: %w", enginerr.ErrActionSkipped)
+	}
+
+	pr, err := r.cli.GetPullRequest(ctx, repo.GetOwner(), repo.GetName(), meta.Number)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get pull request: %w", err)
+	}
+	if pr.GetState() != prStateOpen {
+		return nil, fmt.Errorf("PR #%d is not open: %w", meta.Number, enginerr.ErrActionSkipped)
+	}
+
+	if remAction == interfaces.ActionOptDryRun {
+		fmt.Printf("%s pull request #%d\n", r.onResolved, meta.Number)
+		return nil, nil
+	}
+
+	comment := prResolvedComment
+	if r.onResolved == pb.PrOnResolvedClose {
+		comment = fmt.Sprintf(prClosedCommentFmt, prResolvedComment)
+	}
+	if err := r.cli.CreateComment(ctx, repo.GetOwner(), repo.GetName(), meta.Number, comment); err != nil {
+		return nil, fmt.Errorf("cannot comment on pull request: %w", err)
+	}
+
+	if r.onResolved == pb.PrOnResolvedClose {
+		_, err := r.cli.UpdatePullRequest(ctx, repo.GetOwner(), repo.GetName(), meta.Number,
+			&github.PullRequest{State: github.String(prStateClosed)})
+		if err != nil {
+			return nil, fmt.Errorf("cannot close pull request: %w", err)
+		}
+		zerolog.Ctx(ctx).Info().Int("pr", meta.Number).Msg("closed PR, the rule passes")
+	}
+
+	meta.Resolved = true
+	return meta.marshal()
+}
+
+// mergeIfReady merges the PR if it meets the auto-merge requirements. Errors
+// are only logged, the PR is checked again on the next evaluation.
+func (r *Remediator) mergeIfReady(ctx context.Context, repo *pb.Repository, pr *github.PullRequest) {
+	logger := zerolog.Ctx(ctx).With().Int("pr", pr.GetNumber()).Logger()
+
+	ready, err := r.readyToMerge(ctx, repo, pr)
+	if err != nil {
+		logger.Error().Err(err).Msg("cannot check if the PR can be merged")
+		return
+	}
+	if !ready {
+		logger.Debug().Msg("PR does not meet the auto-merge requirements yet")
+		return
+	}
+
+	_, err = r.cli.MergePullRequest(ctx, repo.GetOwner(), repo.GetName(), pr.GetNumber(), "",
+		r.autoMerge.GetMergeMethod())
+	if err != nil {
+		logger.Error().Err(err).Msg("cannot merge PR")
+		return
+	}
+	logger.Info().Msg("merged PR")
+}
+
+// readyToMerge returns true if GitHub reports the PR as mergeable and it has
+// the required labels and approvals
+func (r *Remediator) readyToMerge(ctx context.Context, repo *pb.Repository, pr *github.PullRequest) (bool, error) {
+	// listed PRs don't report if they are mergeable
+	if pr.Mergeable == nil {
+		var err error
+		pr, err = r.cli.GetPullRequest(ctx, repo.GetOwner(), repo.GetName(), pr.GetNumber())
+		if err != nil {
+			return false, fmt.Errorf("cannot get pull request: %w", err)
+		}
+	}
+	if !pr.GetMergeable() {
+		return false, nil
+	}
+
+	labels := make(map[string]bool, len(pr.Labels))
+	for _, label := range pr.Labels {
+		labels[label.GetName()] = true
+	}
+	for _, label := range r.autoMerge.GetRequiredLabels() {
+		if !labels[label] {
+			return false, nil
+		}
+	}
+
+	if len(r.autoMerge.GetRequiredReviewers()) == 0 {
+		return true, nil
+	}
+
+	// TODO: pagination
+	reviews, err := r.cli.ListReviews(ctx, repo.GetOwner(), repo.GetName(), pr.GetNumber(),
+		&github.ListOptions{PerPage: 100})
+	if err != nil {
+		return false, fmt.Errorf("cannot list reviews: %w", err)
+	}
+
+	// the reviews are listed in chronological order, the latest review of
+	// each user that approves or requests changes counts
+	latest := make(map[string]string)
+	for _, review := range reviews {
+		switch review.GetState() {
+		case prReviewApproved, prReviewChangesRequested, prReviewDismissed:
+			latest[review.GetUser().GetLogin()] = review.GetState()
+		}
+	}
+	for _, reviewer := range r.autoMerge.GetRequiredReviewers() {
+		if latest[reviewer] != prReviewApproved {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,365 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pull_request
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-github/v53/github"
+	"github.com/stretchr/testify/require"
+
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
+	"github.com/stacklok/minder/internal/engine/interfaces"
+	mock_ghclient "github.com/stacklok/minder/internal/providers/github/mock"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const trackedPr = 42
+
+func trackedMeta(resolved bool) *json.RawMessage {
+	meta := json.RawMessage(`{"pr_number":42}`)
+	if resolved {
+		meta = json.RawMessage(`{"pr_number":42,"resolved":true}`)
+	}
+	return &meta
+}
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		onResolved string
+		meta       *json.RawMessage
+		mockSetup  func(*mock_ghclient.MockGitHub)
+		wantMeta   string
+		wantErr    error
+	}{
+		{
+			name:       "close the PR",
+			onResolved: pb.PrOnResolvedClose,
+			meta:       trackedMeta(false),
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					GetPullRequest(gomock.Any(), repoOwner, repoName, trackedPr).
+					Return(&github.PullRequest{Number: github.Int(trackedPr), State: github.String(prStateOpen)}, nil)
+				mockGitHub.EXPECT().
+					CreateComment(gomock.Any(), repoOwner, repoName, trackedPr, gomock.Any()).
+					Return(nil)
+				mockGitHub.EXPECT().
+					UpdatePullRequest(gomock.Any(), repoOwner, repoName, trackedPr,
+						&github.PullRequest{State: github.String(prStateClosed)}).
+					Return(&github.PullRequest{}, nil)
+			},
+			wantMeta: `{"pr_number":42,"resolved":true}`,
+		},
+		{
+			name:       "comment on the PR",
+			onResolved: pb.PrOnResolvedComment,
+			meta:       trackedMeta(false),
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					GetPullRequest(gomock.Any(), repoOwner, repoName, trackedPr).
+					Return(&github.PullRequest{Number: github.Int(trackedPr), State: github.String(prStateOpen)}, nil)
+				mockGitHub.EXPECT().
+					CreateComment(gomock.Any(), repoOwner, repoName, trackedPr, prResolvedComment).
+					Return(nil)
+			},
+			wantMeta: `{"pr_number":42,"resolved":true}`,
+		},
+		{
+			name:       "the PR was resolved already",
+			onResolved: pb.PrOnResolvedComment,
+			meta:       trackedMeta(true),
+			mockSetup:  func(_ *mock_ghclient.MockGitHub) {},
+			wantErr:    enginerr.ErrActionSkipped,
+		},
+		{
+			name:       "the PR was merged",
+			onResolved: pb.PrOnResolvedClose,
+			meta:       trackedMeta(false),
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					GetPullRequest(gomock.Any(), repoOwner, repoName, trackedPr).
+					Return(&github.PullRequest{
+						Number: github.Int(trackedPr),
+						State:  github.String(prStateClosed),
+						Merged: github.Bool(true),
+					}, nil)
+			},
+			wantErr: enginerr.ErrActionSkipped,
+		},
+		{
+			name:       "keep the PR",
+			onResolved: pb.PrOnResolvedKeep,
+			meta:       trackedMeta(false),
+			mockSetup:  func(_ *mock_ghclient.MockGitHub) {},
+			wantErr:    enginerr.ErrActionSkipped,
+		},
+		{
+			name:       "no PR was opened",
+			onResolved: pb.PrOnResolvedClose,
+			mockSetup:  func(_ *mock_ghclient.MockGitHub) {},
+			wantErr:    enginerr.ErrActionSkipped,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mock_ghclient.NewMockGitHub(ctrl)
+			tt.mockSetup(mockClient)
+
+			prRem := dependabotPrRem()
+			prRem.OnResolved = &tt.onResolved
+			engine, err := NewPullRequestRemediate(TestActionTypeValid, prRem, testGithubProviderBuilder(ghApiUrl))
+			require.NoError(t, err)
+			engine.cli = mockClient
+
+			args := createTestRemArgs()
+			meta, err := engine.Do(context.Background(), interfaces.ActionCmdOff, interfaces.ActionOptOn,
+				args.ent, &interfaces.EvalStatusParams{}, tt.meta)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, meta)
+				return
+			}
+			require.NoError(t, err)
+			require.JSONEq(t, tt.wantMeta, string(meta))
+		})
+	}
+}
+
+func TestOpenPr(t *testing.T) {
+	t.Parallel()
+
+	magicComment := "<!-- minder: pr-remediation-body: { \"ContentSha\": \"sha\" } -->"
+	closedAgo := func(d time.Duration) *github.PullRequest {
+		return &github.PullRequest{
+			Number:   github.Int(trackedPr),
+			State:    github.String(prStateClosed),
+			ClosedAt: &github.Timestamp{Time: time.Now().Add(-d)},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		meta      *json.RawMessage
+		mockSetup func(*mock_ghclient.MockGitHub)
+		wantPr    int
+		wantMeta  prMetadata
+		wantErr   error
+	}{
+		{
+			name: "the tracked PR is open",
+			meta: trackedMeta(false),
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					GetPullRequest(gomock.Any(), repoOwner, repoName, trackedPr).
+					Return(&github.PullRequest{Number: github.Int(trackedPr), State: github.String(prStateOpen)}, nil)
+			},
+			wantPr:   trackedPr,
+			wantMeta: prMetadata{Number: trackedPr},
+		},
+		{
+			name: "the tracked PR was closed recently",
+			meta: trackedMeta(false),
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					GetPullRequest(gomock.Any(), repoOwner, repoName, trackedPr).
+					Return(closedAgo(24*time.Hour), nil)
+			},
+			wantErr: enginerr.ErrActionSkipped,
+		},
+		{
+			name: "the tracked PR was closed a while ago",
+			meta: trackedMeta(false),
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					GetPullRequest(gomock.Any(), repoOwner, repoName, trackedPr).
+					Return(closedAgo(10*24*time.Hour), nil)
+				mockGitHub.EXPECT().
+					ListPullRequests(gomock.Any(), repoOwner, repoName, gomock.Any()).
+					Return([]*github.PullRequest{}, nil)
+			},
+		},
+		{
+			name: "the tracked PR was closed by minder",
+			meta: trackedMeta(true),
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					GetPullRequest(gomock.Any(), repoOwner, repoName, trackedPr).
+					Return(closedAgo(time.Hour), nil)
+				mockGitHub.EXPECT().
+					ListPullRequests(gomock.Any(), repoOwner, repoName, gomock.Any()).
+					Return([]*github.PullRequest{}, nil)
+			},
+		},
+		{
+			name: "a PR with the same contents is open",
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					ListPullRequests(gomock.Any(), repoOwner, repoName, gomock.Any()).
+					Return([]*github.PullRequest{
+						{Number: github.Int(7), Body: github.String("something else")},
+						{Number: github.Int(8), Body: github.String(magicComment)},
+					}, nil)
+			},
+			wantPr: 8,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mock_ghclient.NewMockGitHub(ctrl)
+			tt.mockSetup(mockClient)
+
+			engine := &Remediator{cli: mockClient, reopenAfterDays: 7}
+			meta, err := parsePrMetadata(tt.meta)
+			require.NoError(t, err)
+
+			args := createTestRemArgs()
+			pr, err := engine.openPr(context.Background(), args.ent.(*pb.Repository), meta, magicComment)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantPr, pr.GetNumber())
+			require.Equal(t, tt.wantMeta, *meta)
+		})
+	}
+}
+
+func TestMergeIfReady(t *testing.T) {
+	t.Parallel()
+
+	review := func(login, state string) *github.PullRequestReview {
+		return &github.PullRequestReview{User: &github.User{Login: github.String(login)}, State: github.String(state)}
+	}
+	labeledPr := func(mergeable bool, labels ...string) *github.PullRequest {
+		pr := &github.PullRequest{Number: github.Int(trackedPr), Mergeable: github.Bool(mergeable)}
+		for _, l := range labels {
+			pr.Labels = append(pr.Labels, &github.Label{Name: github.String(l)})
+		}
+		return pr
+	}
+
+	tests := []struct {
+		name      string
+		pr        *github.PullRequest
+		mockSetup func(*mock_ghclient.MockGitHub)
+		wantMerge bool
+	}{
+		{
+			name: "labeled and approved",
+			pr:   labeledPr(true, "minder", "automerge"),
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					ListReviews(gomock.Any(), repoOwner, repoName, trackedPr, gomock.Any()).
+					Return([]*github.PullRequestReview{
+						review("alice", prReviewChangesRequested),
+						review("alice", prReviewApproved),
+						review("alice", "COMMENTED"),
+					}, nil)
+			},
+			wantMerge: true,
+		},
+		{
+			name: "missing a label",
+			pr:   labeledPr(true, "minder"),
+		},
+		{
+			name: "not approved",
+			pr:   labeledPr(true, "automerge"),
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					ListReviews(gomock.Any(), repoOwner, repoName, trackedPr, gomock.Any()).
+					Return([]*github.PullRequestReview{
+						review("alice", prReviewApproved),
+						review("alice", prReviewChangesRequested),
+						review("bob", prReviewApproved),
+					}, nil)
+			},
+		},
+		{
+			name: "not mergeable",
+			pr:   labeledPr(false, "automerge"),
+		},
+		{
+			name: "listed PRs are fetched to check if they are mergeable",
+			pr:   &github.PullRequest{Number: github.Int(trackedPr)},
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					GetPullRequest(gomock.Any(), repoOwner, repoName, trackedPr).
+					Return(labeledPr(true, "automerge"), nil)
+				mockGitHub.EXPECT().
+					ListReviews(gomock.Any(), repoOwner, repoName, trackedPr, gomock.Any()).
+					Return([]*github.PullRequestReview{review("alice", prReviewApproved)}, nil)
+			},
+			wantMerge: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mock_ghclient.NewMockGitHub(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockClient)
+			}
+			if tt.wantMerge {
+				mockClient.EXPECT().
+					MergePullRequest(gomock.Any(), repoOwner, repoName, trackedPr, "", pb.PrMergeMethodSquash).
+					Return(&github.PullRequestMergeResult{}, nil)
+			}
+
+			engine := &Remediator{
+				cli: mockClient,
+				autoMerge: &pb.RuleType_Definition_Remediate_PullRequestRemediation_AutoMerge{
+					Method:            pb.PrMergeMethodSquash,
+					RequiredLabels:    []string{"automerge"},
+					RequiredReviewers: []string{"alice"},
+				},
+			}
+
+			args := createTestRemArgs()
+			engine.mergeIfReady(context.Background(), args.ent.(*pb.Repository), tt.pr)
+		})
+	}
+}
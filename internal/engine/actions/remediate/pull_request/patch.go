@@ -0,0 +1,188 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pull_request
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// currentFile lazily fetches the content of a file in the branch the pull
+// request is opened from, so that only the contents that need it fetch it
+type currentFile struct {
+	fetch   func() (string, error)
+	loaded  bool
+	exists  bool
+	content string
+}
+
+// get returns the content of the file and whether it exists
+func (f *currentFile) get() (string, bool, error) {
+	if f.loaded {
+		return f.content, f.exists, nil
+	}
+
+	content, err := f.fetch()
+	if errors.Is(err, enginerr.ErrNotFound) {
+		f.loaded = true
+		return "", false, nil
+	} else if err != nil {
+		return "", false, err
+	}
+
+	f.loaded, f.exists, f.content = true, true, content
+	return content, true, nil
+}
+
+// patchContent applies the rendered content of an entry to the current content
+// of its file, according to the action of the entry
+func patchContent(entry *prEntry, current, rendered string) (string, error) {
+	switch entry.action {
+	case pb.PrContentActionReplaceRegex:
+		return entry.pattern.ReplaceAllString(current, rendered), nil
+	case pb.PrContentActionAppendLine:
+		return appendLines(current, rendered), nil
+	case pb.PrContentActionMergeYAML:
+		return mergeYAML(current, rendered)
+	}
+	return rendered, nil
+}
+
+// appendLines appends the lines the content is missing
+func appendLines(current, lines string) string {
+	existing := make(map[string]bool)
+	for _, line := range strings.Split(current, "\n") {
+		existing[strings.TrimSpace(line)] = true
+	}
+
+	var b strings.Builder
+	b.WriteString(current)
+	for _, line := range strings.Split(strings.TrimRight(lines, "\n"), "\n") {
+		if existing[strings.TrimSpace(line)] {
+			continue
+		}
+		if b.Len() > 0 && !strings.HasSuffix(b.String(), "\n") {
+			b.WriteString("\n")
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+		existing[strings.TrimSpace(line)] = true
+	}
+	return b.String()
+}
+
+// mergeYAML merges a YAML document into another one. Mappings are merged key
+// by key, the items missing from a sequence are appended to it, and any other
+// value is replaced.
+func mergeYAML(current, patch string) (string, error) {
+	if strings.TrimSpace(current) == "" {
+		return patch, nil
+	}
+
+	var dst, src yaml.Node
+	if err := yaml.Unmarshal([]byte(current), &dst); err != nil {
+		return "", fmt.Errorf("cannot parse the file as YAML: %w", err)
+	}
+	if err := yaml.Unmarshal([]byte(patch), &src); err != nil {
+		return "", fmt.Errorf("cannot parse the content as YAML: %w", err)
+	}
+
+	if err := mergeYAMLNodes(&dst, &src); err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&dst); err != nil {
+		return "", fmt.Errorf("cannot encode the merged YAML: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return "", fmt.Errorf("cannot encode the merged YAML: %w", err)
+	}
+	return buf.String(), nil
+}
+
+func mergeYAMLNodes(dst, src *yaml.Node) error {
+	switch {
+	case dst.Kind == yaml.DocumentNode && src.Kind == yaml.DocumentNode:
+		if len(src.Content) == 0 {
+			return nil
+		}
+		if len(dst.Content) == 0 {
+			dst.Content = src.Content
+			return nil
+		}
+		return mergeYAMLNodes(dst.Content[0], src.Content[0])
+	case dst.Kind == yaml.MappingNode && src.Kind == yaml.MappingNode:
+		for i := 0; i+1 < len(src.Content); i += 2 {
+			key, value := src.Content[i], src.Content[i+1]
+			if dstValue := yamlMappingValue(dst, key.Value); dstValue != nil {
+				if err := mergeYAMLNodes(dstValue, value); err != nil {
+					return err
+				}
+				continue
+			}
+			dst.Content = append(dst.Content, key, value)
+		}
+	case dst.Kind == yaml.SequenceNode && src.Kind == yaml.SequenceNode:
+		for _, item := range src.Content {
+			found, err := yamlSequenceContains(dst, item)
+			if err != nil {
+				return err
+			}
+			if !found {
+				dst.Content = append(dst.Content, item)
+			}
+		}
+	default:
+		*dst = *src
+	}
+	return nil
+}
+
+func yamlMappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func yamlSequenceContains(seq *yaml.Node, item *yaml.Node) (bool, error) {
+	var want any
+	if err := item.Decode(&want); err != nil {
+		return false, fmt.Errorf("cannot decode YAML: %w", err)
+	}
+	for _, existing := range seq.Content {
+		var got any
+		if err := existing.Decode(&got); err != nil {
+			return false, fmt.Errorf("cannot decode YAML: %w", err)
+		}
+		if reflect.DeepEqual(want, got) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,171 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pull_request
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const dependabotConfig = `version: 2
+updates:
+  - package-ecosystem: gomod
+    directory: /
+    schedule:
+      interval: daily
+`
+
+func TestPatchContent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		entry    *prEntry
+		current  string
+		rendered string
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "replace ignores the current content",
+			entry:    &prEntry{action: pb.PrContentActionReplace},
+			current:  "old",
+			rendered: "new",
+			want:     "new",
+		},
+		{
+			name: "replace_regex replaces the matches",
+			entry: &prEntry{
+				action:  pb.PrContentActionReplaceRegex,
+				pattern: regexp.MustCompile(`interval: \w+`),
+			},
+			current:  dependabotConfig,
+			rendered: "interval: weekly",
+			want: `version: 2
+updates:
+  - package-ecosystem: gomod
+    directory: /
+    schedule:
+      interval: weekly
+`,
+		},
+		{
+			name:     "append_line appends the missing lines only",
+			entry:    &prEntry{action: pb.PrContentActionAppendLine},
+			current:  "*.log\nbin/",
+			rendered: "bin/\n.env\n",
+			want:     "*.log\nbin/\n.env\n",
+		},
+		{
+			name:     "append_line on a missing file",
+			entry:    &prEntry{action: pb.PrContentActionAppendLine},
+			rendered: ".env",
+			want:     ".env\n",
+		},
+		{
+			name:    "merge_yaml adds an ecosystem to the dependabot config",
+			entry:   &prEntry{action: pb.PrContentActionMergeYAML},
+			current: dependabotConfig,
+			rendered: `updates:
+  - package-ecosystem: npm
+    directory: /
+    schedule:
+      interval: daily
+`,
+			want: `version: 2
+updates:
+  - package-ecosystem: gomod
+    directory: /
+    schedule:
+      interval: daily
+  - package-ecosystem: npm
+    directory: /
+    schedule:
+      interval: daily
+`,
+		},
+		{
+			name:    "merge_yaml does not duplicate existing items",
+			entry:   &prEntry{action: pb.PrContentActionMergeYAML},
+			current: dependabotConfig,
+			rendered: `updates:
+  - package-ecosystem: gomod
+    directory: /
+    schedule:
+      interval: daily
+`,
+			want: dependabotConfig,
+		},
+		{
+			name:     "merge_yaml replaces scalars",
+			entry:    &prEntry{action: pb.PrContentActionMergeYAML},
+			current:  "a: 1\nb:\n  c: 2\n",
+			rendered: "b:\n  c: 3\n  d: 4\n",
+			want:     "a: 1\nb:\n  c: 3\n  d: 4\n",
+		},
+		{
+			name:     "merge_yaml on a missing file",
+			entry:    &prEntry{action: pb.PrContentActionMergeYAML},
+			rendered: dependabotConfig,
+			want:     dependabotConfig,
+		},
+		{
+			name:     "merge_yaml with an invalid file",
+			entry:    &prEntry{action: pb.PrContentActionMergeYAML},
+			current:  "a: [",
+			rendered: "a: 1",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := patchContent(tt.entry, tt.current, tt.rendered)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCurrentFile(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	missing := &currentFile{fetch: func() (string, error) {
+		calls++
+		return "", enginerr.ErrNotFound
+	}}
+
+	for i := 0; i < 2; i++ {
+		content, exists, err := missing.get()
+		require.NoError(t, err)
+		require.False(t, exists)
+		require.Empty(t, content)
+	}
+	require.Equal(t, 1, calls, "expected the file to be fetched once")
+}
@@ -25,6 +25,7 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"regexp"
 	"strings"
 	"text/template"
 
@@ -32,6 +33,7 @@ import (
 	"github.com/rs/zerolog"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
 	"github.com/stacklok/minder/internal/engine/interfaces"
 	"github.com/stacklok/minder/internal/providers"
 	"github.com/stacklok/minder/internal/util"
@@ -74,6 +76,13 @@ type prEntry struct {
 	contentTemplate *template.Template
 	Content         string
 	Mode            string
+
+	// action is how the content patches the file
+	action string
+	// pattern is the expression replaced by the replace_regex action
+	pattern *regexp.Regexp
+	// unchanged is set if the file already has the expanded content
+	unchanged bool
 }
 
 // Remediator is the remediation engine for the Pull Request remediation type
@@ -84,6 +93,10 @@ type Remediator struct {
 	titleTemplate *template.Template
 	bodyTemplate  *template.Template
 	entries       []prEntry
+
+	onResolved      string
+	reopenAfterDays int
+	autoMerge       *pb.RuleType_Definition_Remediate_PullRequestRemediation_AutoMerge
 }
 
 // NewPullRequestRemediate creates a new PR remediation engine
@@ -124,6 +137,10 @@ func NewPullRequestRemediate(
 		titleTemplate: titleTmpl,
 		bodyTemplate:  bodyTmpl,
 		entries:       entries,
+
+		onResolved:      prCfg.GetOnResolvedAction(),
+		reopenAfterDays: int(prCfg.GetReopenAfterDays()),
+		autoMerge:       prCfg.GetAutoMerge(),
 	}, nil
 }
 
@@ -142,10 +159,20 @@ func prConfigToEntries(prCfg *pb.RuleType_Definition_Remediate_PullRequestRemedi
 			mode = *cnt.Mode
 		}
 
+		var pattern *regexp.Regexp
+		if cnt.GetContentAction() == pb.PrContentActionReplaceRegex {
+			pattern, err = regexp.Compile(cnt.GetPattern())
+			if err != nil {
+				return nil, fmt.Errorf("cannot parse pattern (index %d): %w", i, err)
+			}
+		}
+
 		entries[i] = prEntry{
 			Path:            cnt.Path,
 			Mode:            mode,
 			contentTemplate: contentTemplate,
+			action:          cnt.GetContentAction(),
+			pattern:         pattern,
 		}
 	}
 
@@ -162,6 +189,20 @@ type PrTemplateParams struct {
 	Params map[string]any
 }
 
+// ContentTemplateParams are the parameters of the content templates
+type ContentTemplateParams struct {
+	PrTemplateParams
+
+	file *currentFile
+}
+
+// FileContent returns the content of the file in the branch the pull request
+// is opened from, or an empty string if the file does not exist
+func (p *ContentTemplateParams) FileContent() (string, error) {
+	content, _, err := p.file.get()
+	return content, err
+}
+
 // Class returns the action type of the remediation engine
 func (r *Remediator) Class() interfaces.ActionType {
 	return r.actionType
@@ -172,25 +213,36 @@ func (_ *Remediator) Type() string {
 	return RemediateType
 }
 
-// GetOnOffState returns the alert action state read from the profile
-func (_ *Remediator) GetOnOffState(p *pb.Profile) interfaces.ActionOpt {
-	return interfaces.ActionOptFromString(p.Remediate, interfaces.ActionOptOff)
+// GetOnOffState returns the alert action state read from the profile, the
+// setting of the rule taking precedence
+func (_ *Remediator) GetOnOffState(p *pb.Profile, rule *pb.Profile_Rule) interfaces.ActionOpt {
+	return interfaces.ActionOptFromString(p.GetRemediateSetting(rule), interfaces.ActionOptOff)
 }
 
 // Do performs the remediation
 func (r *Remediator) Do(
 	ctx context.Context,
-	_ interfaces.ActionCmd,
+	cmd interfaces.ActionCmd,
 	remAction interfaces.ActionOpt,
 	ent protoreflect.ProtoMessage,
 	params interfaces.ActionsParams,
-	_ *json.RawMessage,
+	metadata *json.RawMessage,
 ) (json.RawMessage, error) {
 	repo, ok := ent.(*pb.Repository)
 	if !ok {
 		return nil, fmt.Errorf("expected repository, got %T", ent)
 	}
 
+	meta, err := parsePrMetadata(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	// the rule passes, deal with the PR opened to remediate it
+	if cmd == interfaces.ActionCmdOff {
+		return r.resolve(ctx, remAction, repo, meta)
+	}
+
 	tmplParams := &PrTemplateParams{
 		Entity:  ent,
 		Profile: params.GetRule().Def.AsMap(),
@@ -202,34 +254,54 @@ func (r *Remediator) Do(
 		return nil, fmt.Errorf("cannot execute title template: %w", err)
 	}
 
-	if err := r.expandContents(tmplParams); err != nil {
+	branchFrom := getBranchFrom(ctx, params.GetRule().Params.AsMap())
+	if err := r.expandContents(ctx, repo, branchFrom, tmplParams); err != nil {
 		return nil, fmt.Errorf("cannot expand contents: %w", err)
 	}
+	if !r.hasChanges() {
+		zerolog.Ctx(ctx).Info().Msg("files are up to date, won't create a PR")
+		return nil, fmt.Errorf("files are up to date: %w", enginerr.ErrActionSkipped)
+	}
 
 	prFullBodyText, magicComment, err := r.getPrBodyText(tmplParams)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create PR full body text: %w", err)
 	}
 
-	var remErr error
 	switch remAction {
-	case interfaces.ActionOptOn:
-		alreadyExists, err := prAlreadyExists(ctx, r.cli, repo, magicComment)
+	case interfaces.ActionOptOn, interfaces.ActionOptApproval:
+		pr, err := r.openPr(ctx, repo, meta, magicComment)
 		if err != nil {
-			return nil, fmt.Errorf("cannot check if PR already exists: %w", err)
+			return nil, err
 		}
-		if alreadyExists {
+		if pr != nil && strings.Contains(pr.GetBody(), magicComment) {
 			zerolog.Ctx(ctx).Info().Msg("PR already exists, won't create a new one")
-			return nil, nil
+			meta.track(pr)
+			if remAction == interfaces.ActionOptOn && r.autoMerge != nil {
+				r.mergeIfReady(ctx, repo, pr)
+			}
+			return meta.marshal()
+		}
+		if remAction == interfaces.ActionOptApproval {
+			newMeta, err := meta.marshal()
+			if err != nil {
+				return nil, err
+			}
+			return newMeta, pendingApproval(title.String(), prFullBodyText, r.entries)
+		}
+		pr, err = r.run(ctx, repo, title.String(), prFullBodyText, params.GetRule().Params.AsMap(), pr)
+		if err != nil {
+			return nil, err
 		}
-		remErr = r.run(ctx, repo, title.String(), prFullBodyText, params.GetRule().Params.AsMap())
+		meta.track(pr)
+		return meta.marshal()
 	case interfaces.ActionOptDryRun:
 		dryRun(title.String(), prFullBodyText, r.entries)
-		remErr = nil
+		return nil, nil
 	case interfaces.ActionOptOff, interfaces.ActionOptUnknown:
-		remErr = errors.New("unexpected action")
+		return nil, errors.New("unexpected action")
 	}
-	return nil, remErr
+	return nil, nil
 }
 
 func dryRun(title, body string, entries []prEntry) {
@@ -246,43 +318,85 @@ func dryRun(title, body string, entries []prEntry) {
 	}
 }
 
+// pendingApproval records the pull request to be opened as the change to approve
+func pendingApproval(title, body string, entries []prEntry) error {
+	tmpl, err := template.New(dryRunTemplateName).Parse(dryRunTmpl)
+	if err != nil {
+		return fmt.Errorf("cannot parse template: %w", err)
+	}
+
+	preview := new(bytes.Buffer)
+	fmt.Fprintf(preview, "title:\n%s\n", title)
+	fmt.Fprintf(preview, "body:\n%s\n", body)
+	if err := tmpl.Execute(preview, entries); err != nil {
+		return fmt.Errorf("cannot execute template: %w", err)
+	}
+
+	return enginerr.NewErrActionPendingApproval(RemediateType, preview.String())
+}
+
 func (r *Remediator) run(
 	ctx context.Context,
 	repo *pb.Repository,
 	title, body string,
 	params map[string]any,
-) error {
+	existing *github.PullRequest,
+) (*github.PullRequest, error) {
 	branchFrom := getBranchFrom(ctx, params)
 
 	commitShaFrom, err := r.getCommitShaFrom(ctx, repo, branchFrom)
 	if err != nil {
-		return fmt.Errorf("cannot get ref from: %w", err)
+		return nil, fmt.Errorf("cannot get ref from: %w", err)
 	}
 
 	treeShaFrom, err := r.getTreeShaFrom(ctx, repo, commitShaFrom)
 	if err != nil {
-		return fmt.Errorf("cannot get tree from: %w", err)
+		return nil, fmt.Errorf("cannot get tree from: %w", err)
 	}
 
 	tree, err := r.createTree(ctx, repo, treeShaFrom)
 	if err != nil {
-		return fmt.Errorf("error creating tree: %w", err)
+		return nil, fmt.Errorf("error creating tree: %w", err)
+	}
+
+	branch := branchBaseName(title)
+	if existing != nil {
+		// push the new contents to the PR that is already open
+		branch = existing.GetHead().GetRef()
 	}
 
-	newBranch, err := r.createOrUpdateBranch(ctx, repo, title, tree, commitShaFrom)
+	newBranch, err := r.createOrUpdateBranch(ctx, repo, branch, title, tree, commitShaFrom)
 	if err != nil {
-		return fmt.Errorf("error creating or updating branch: %w", err)
+		return nil, fmt.Errorf("error creating or updating branch: %w", err)
 	}
 
-	_, err = r.cli.CreatePullRequest(
+	if existing != nil {
+		pr, err := r.cli.UpdatePullRequest(ctx, repo.GetOwner(), repo.GetName(), existing.GetNumber(),
+			&github.PullRequest{
+				Title: github.String(title),
+				Body:  github.String(body),
+			})
+		if err != nil {
+			return nil, fmt.Errorf("cannot update pull request: %w", err)
+		}
+		return pr, nil
+	}
+
+	pr, err := r.cli.CreatePullRequest(
 		ctx, repo.GetOwner(), repo.GetName(),
 		title, body,
 		newBranch, dflBranchTo,
 	)
 	if err != nil {
-		return fmt.Errorf("cannot create pull request: %w", err)
+		return nil, fmt.Errorf("cannot create pull request: %w", err)
 	}
-	return nil
+
+	if reviewers := r.autoMerge.GetRequiredReviewers(); pr != nil && len(reviewers) > 0 {
+		if err := r.cli.RequestReviewers(ctx, repo.GetOwner(), repo.GetName(), pr.GetNumber(), reviewers); err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Int("pr", pr.GetNumber()).Msg("cannot request reviewers")
+		}
+	}
+	return pr, nil
 }
 
 func (r *Remediator) getCommitShaFrom(ctx context.Context, repo *pb.Repository, branchFrom string) (string, error) {
@@ -321,21 +435,60 @@ func (r *Remediator) createTree(ctx context.Context, repo *pb.Repository, treeSh
 	return tree, nil
 }
 
+// expandContents renders the content of each entry and applies it to the
+// current content of its file
 func (r *Remediator) expandContents(
+	ctx context.Context,
+	repo *pb.Repository,
+	branchFrom string,
 	tmplParams *PrTemplateParams,
 ) error {
 	for i := range r.entries {
 		entry := &r.entries[i]
+		file := &currentFile{
+			fetch: func() (string, error) {
+				return r.cli.GetFileContent(ctx, repo.GetOwner(), repo.GetName(), entry.Path, branchFrom)
+			},
+		}
+
 		content := new(bytes.Buffer)
-		if err := entry.contentTemplate.Execute(content, tmplParams); err != nil {
+		cntParams := &ContentTemplateParams{PrTemplateParams: *tmplParams, file: file}
+		if err := entry.contentTemplate.Execute(content, cntParams); err != nil {
 			return fmt.Errorf("cannot execute content template (index %d): %w", i, err)
 		}
+
 		entry.Content = content.String()
+		if entry.action != pb.PrContentActionReplace {
+			current, exists, err := file.get()
+			if err != nil {
+				return fmt.Errorf("cannot get the content of %s: %w", entry.Path, err)
+			}
+			if !exists && entry.action == pb.PrContentActionReplaceRegex {
+				return fmt.Errorf("cannot patch %s, the file does not exist", entry.Path)
+			}
+			entry.Content, err = patchContent(entry, current, entry.Content)
+			if err != nil {
+				return fmt.Errorf("cannot patch %s: %w", entry.Path, err)
+			}
+		}
+
+		// the file is only known to be up to date if it was fetched
+		entry.unchanged = file.loaded && file.exists && file.content == entry.Content
 	}
 
 	return nil
 }
 
+// hasChanges returns true if any of the files differ from the expanded contents
+func (r *Remediator) hasChanges() bool {
+	for i := range r.entries {
+		if !r.entries[i].unchanged {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *Remediator) createTreeEntries(
 	ctx context.Context,
 	repo *pb.Repository,
@@ -344,6 +497,9 @@ func (r *Remediator) createTreeEntries(
 
 	for i := range r.entries {
 		entry := r.entries[i]
+		if entry.unchanged {
+			continue
+		}
 		blob := &github.Blob{
 			Content:  github.String(entry.Content),
 			Encoding: github.String("utf-8"),
@@ -367,7 +523,7 @@ func (r *Remediator) createTreeEntries(
 func (r *Remediator) createOrUpdateBranch(
 	ctx context.Context,
 	repo *pb.Repository,
-	title string,
+	branch, title string,
 	tree *github.Tree,
 	commitShaFrom string,
 ) (string, error) {
@@ -376,7 +532,7 @@ func (r *Remediator) createOrUpdateBranch(
 		return "", fmt.Errorf("error creating commit: %w", err)
 	}
 
-	newBranch := refFromBranch(branchBaseName(title))
+	newBranch := refFromBranch(branch)
 	_, err = r.cli.CreateRef(ctx, repo.GetOwner(), repo.GetName(), newBranch, *commit.SHA)
 	if err != nil {
 		var ghErr *github.ErrorResponse
@@ -489,23 +645,23 @@ func createReviewBody(prText, magicComment string) (string, error) {
 	return buf.String(), nil
 }
 
-// returns true if an open PR with the magic comment already exists
-func prAlreadyExists(
+// returns the open PR with the magic comment, if any
+func findPrWithMagicComment(
 	ctx context.Context,
 	cli provifv1.GitHub,
 	repo *pb.Repository,
 	magicComment string,
-) (bool, error) {
+) (*github.PullRequest, error) {
 	// TODO(jakub): pagination
 	openPrs, err := cli.ListPullRequests(ctx, repo.GetOwner(), repo.GetName(), &github.PullRequestListOptions{})
 	if err != nil {
-		return false, fmt.Errorf("cannot list pull requests: %w", err)
+		return nil, fmt.Errorf("cannot list pull requests: %w", err)
 	}
 
 	for _, pr := range openPrs {
 		if strings.Contains(pr.GetBody(), magicComment) {
-			return true, nil
+			return pr, nil
 		}
 	}
-	return false, nil
+	return nil, nil
 }
@@ -99,6 +99,25 @@ func dependabotPrRem() *pb.RuleType_Definition_Remediate_PullRequestRemediation
 	}
 }
 
+func mergeDependabotPrRem() *pb.RuleType_Definition_Remediate_PullRequestRemediation {
+	return &pb.RuleType_Definition_Remediate_PullRequestRemediation{
+		Title: "Add Dependabot configuration for {{.Profile.package_ecosystem }}",
+		Body:  "Adds Dependabot configuration for {{.Profile.package_ecosystem }}",
+		Contents: []*pb.RuleType_Definition_Remediate_PullRequestRemediation_Content{
+			{
+				Path:   ".github/dependabot.yml",
+				Action: pb.PrContentActionMergeYAML,
+				Content: `updates:
+  - package-ecosystem: {{.Profile.package_ecosystem }}
+    directory: /
+    schedule:
+      interval: daily
+`,
+			},
+		},
+	}
+}
+
 type remediateArgs struct {
 	remAction interfaces.ActionOpt
 	ent       protoreflect.ProtoMessage
@@ -128,6 +147,10 @@ func happyPathMockSetup(mockGitHub *mock_ghclient.MockGitHub) {
 	// no pull requst so far
 	mockGitHub.EXPECT().
 		ListPullRequests(gomock.Any(), repoOwner, repoName, gomock.Any()).Return([]*github.PullRequest{}, nil)
+	pushMockSetup(mockGitHub)
+}
+
+func pushMockSetup(mockGitHub *mock_ghclient.MockGitHub) {
 	mockGitHub.EXPECT().
 		GetRef(gomock.Any(), repoOwner, repoName, refFromBranch(branchFrom)).
 		Return(
@@ -216,6 +239,7 @@ func TestPullRequestRemediate(t *testing.T) {
 		name        string
 		newRemArgs  *newPullRequestRemediateArgs
 		remArgs     *remediateArgs
+		meta        *json.RawMessage
 		mockSetup   func(*mock_ghclient.MockGitHub)
 		wantErr     bool
 		wantInitErr bool
@@ -299,6 +323,56 @@ func TestPullRequestRemediate(t *testing.T) {
 					}, nil)
 			},
 		},
+		{
+			name: "update the PR opened before",
+			newRemArgs: &newPullRequestRemediateArgs{
+				prRem:      dependabotPrRem(),
+				pbuild:     testGithubProviderBuilder(ghApiUrl),
+				actionType: TestActionTypeValid,
+			},
+			remArgs: createTestRemArgs(),
+			meta:    trackedMeta(false),
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					GetPullRequest(gomock.Any(), repoOwner, repoName, trackedPr).
+					Return(&github.PullRequest{
+						Number: github.Int(trackedPr),
+						State:  github.String(prStateOpen),
+						Body:   github.String("outdated contents"),
+						Head:   &github.PullRequestBranch{Ref: github.String("minder_outdated")},
+					}, nil)
+				pushMockSetup(mockGitHub)
+				mockGitHub.EXPECT().
+					CreateRef(gomock.Any(), repoOwner, repoName, refFromBranch("minder_outdated"), newCommitSha).
+					Return(nil, &github.ErrorResponse{
+						Response: &http.Response{StatusCode: http.StatusUnprocessableEntity},
+					})
+				mockGitHub.EXPECT().
+					UpdateRef(gomock.Any(), repoOwner, repoName, refFromBranch("minder_outdated"), newCommitSha, true).
+					Return(nil, nil)
+				mockGitHub.EXPECT().
+					UpdatePullRequest(gomock.Any(), repoOwner, repoName, trackedPr, &github.PullRequest{
+						Title: github.String(commitTitle),
+						Body:  github.String(prBody),
+					}).
+					Return(&github.PullRequest{Number: github.Int(trackedPr)}, nil)
+			},
+		},
+		{
+			name: "The files are already up to date",
+			newRemArgs: &newPullRequestRemediateArgs{
+				prRem:      mergeDependabotPrRem(),
+				pbuild:     testGithubProviderBuilder(ghApiUrl),
+				actionType: TestActionTypeValid,
+			},
+			remArgs: createTestRemArgs(),
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					GetFileContent(gomock.Any(), repoOwner, repoName, ".github/dependabot.yml", branchFrom).
+					Return(dependabotConfig, nil)
+			},
+			wantErr: true,
+		},
 	}
 
 	mockClient := mock_ghclient.NewMockGitHub(ctrl)
@@ -340,7 +414,7 @@ func TestPullRequestRemediate(t *testing.T) {
 				},
 			}
 
-			retMeta, err := engine.Do(context.Background(), interfaces.ActionCmdOn, tt.remArgs.remAction, tt.remArgs.ent, evalParams, nil)
+			retMeta, err := engine.Do(context.Background(), interfaces.ActionCmdOn, tt.remArgs.remAction, tt.remArgs.ent, evalParams, tt.meta)
 			if tt.wantErr {
 				require.Error(t, err, "expected error")
 				require.Nil(t, retMeta, "expected nil metadata")
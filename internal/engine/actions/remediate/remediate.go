@@ -32,9 +32,8 @@ import (
 // ActionType is the type of the remediation engine
 const ActionType engif.ActionType = "remediate"
 
-// NewRuleRemediator creates a new rule remediator
-func NewRuleRemediator(rt *pb.RuleType, pbuild *providers.ProviderBuilder) (engif.Action, error) {
-	rem := rt.Def.GetRemediate()
+// NewRuleRemediator creates a new rule remediator for one of the remediations of a rule type
+func NewRuleRemediator(rem *pb.RuleType_Definition_Remediate, pbuild *providers.ProviderBuilder) (engif.Action, error) {
 	if rem == nil {
 		return noop.NewNoopRemediate(ActionType)
 	}
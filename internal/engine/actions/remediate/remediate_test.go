@@ -120,7 +120,7 @@ func TestNewRuleRemediator(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			result, err := remediate.NewRuleRemediator(tt.ruleType, tt.provBuilder)
+			result, err := remediate.NewRuleRemediator(tt.ruleType.GetDef().GetRemediate(), tt.provBuilder)
 			if tt.wantError {
 				require.Error(t, err)
 				return
@@ -98,6 +98,14 @@ type EndpointTemplateParams struct {
 	Params map[string]any
 }
 
+// rollbackMeta records the values a remediation changed, as they were before
+// the remediation, to roll the remediation back
+type rollbackMeta struct {
+	Method   string         `json:"method"`
+	Endpoint string         `json:"endpoint"`
+	Previous map[string]any `json:"previous"`
+}
+
 // Class returns the action type of the remediation engine
 func (r *Remediator) Class() interfaces.ActionType {
 	return r.actionType
@@ -108,9 +116,10 @@ func (_ *Remediator) Type() string {
 	return RemediateType
 }
 
-// GetOnOffState returns the alert action state read from the profile
-func (_ *Remediator) GetOnOffState(p *pb.Profile) interfaces.ActionOpt {
-	return interfaces.ActionOptFromString(p.Remediate, interfaces.ActionOptOff)
+// GetOnOffState returns the alert action state read from the profile, the
+// setting of the rule taking precedence
+func (_ *Remediator) GetOnOffState(p *pb.Profile, rule *pb.Profile_Rule) interfaces.ActionOpt {
+	return interfaces.ActionOptFromString(p.GetRemediateSetting(rule), interfaces.ActionOptOff)
 }
 
 // Do perform the remediation
@@ -146,16 +155,22 @@ func (r *Remediator) Do(
 	var err error
 	switch setting {
 	case interfaces.ActionOptOn:
-		err = r.run(ctx, endpoint.String(), body.Bytes())
+		prev := r.previousState(ctx, endpoint.String(), body.Bytes())
+		err = r.run(ctx, r.method, endpoint.String(), body.Bytes())
+		if err == nil {
+			return prev, nil
+		}
 	case interfaces.ActionOptDryRun:
 		err = r.dryRun(endpoint.String(), body.String())
+	case interfaces.ActionOptApproval:
+		err = r.pendingApproval(endpoint.String(), body.String())
 	case interfaces.ActionOptOff, interfaces.ActionOptUnknown:
 		err = errors.New("unexpected action")
 	}
 	return nil, err
 }
 
-func (r *Remediator) run(ctx context.Context, endpoint string, body []byte) error {
+func (r *Remediator) run(ctx context.Context, method, endpoint string, body []byte) error {
 	// create an empty map, not a nil map to avoid passing nil to NewRequest
 	bodyJson := make(map[string]any)
 
@@ -166,7 +181,7 @@ func (r *Remediator) run(ctx context.Context, endpoint string, body []byte) erro
 		}
 	}
 
-	req, err := r.cli.NewRequest(r.method, endpoint, bodyJson)
+	req, err := r.cli.NewRequest(method, endpoint, bodyJson)
 	if err != nil {
 		return fmt.Errorf("cannot create request: %w", err)
 	}
@@ -195,6 +210,85 @@ func (r *Remediator) run(ctx context.Context, endpoint string, body []byte) erro
 	return nil
 }
 
+// previousState reads the resource about to be remediated and records the
+// fields the remediation sets. Only PATCH and PUT requests with a body, which
+// update the resource in place, are recorded.
+func (r *Remediator) previousState(ctx context.Context, endpoint string, body []byte) json.RawMessage {
+	if (r.method != http.MethodPatch && r.method != http.MethodPut) || len(body) == 0 {
+		return nil
+	}
+
+	logger := zerolog.Ctx(ctx)
+	// forget the state recorded by previous remediations if the current one
+	// can't be read, it would restore the wrong values
+	noState := json.RawMessage("{}")
+
+	changed := make(map[string]any)
+	if err := json.Unmarshal(body, &changed); err != nil {
+		return noState
+	}
+
+	req, err := r.cli.NewRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		logger.Warn().Err(err).Msg("cannot create request to read the state before remediating")
+		return noState
+	}
+
+	resp, err := r.cli.Do(ctx, req)
+	if err != nil {
+		logger.Warn().Err(err).Msg("cannot read the state before remediating")
+		return noState
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.Printf("cannot close response body: %v", err)
+		}
+	}()
+	if engerrors.HTTPErrorCodeToErr(resp.StatusCode) != nil {
+		logger.Warn().Int("status", resp.StatusCode).Msg("cannot read the state before remediating")
+		return noState
+	}
+
+	current := make(map[string]any)
+	if err := json.NewDecoder(resp.Body).Decode(&current); err != nil {
+		logger.Warn().Err(err).Msg("cannot decode the state before remediating")
+		return noState
+	}
+
+	previous := make(map[string]any, len(changed))
+	for key := range changed {
+		if value, ok := current[key]; ok {
+			previous[key] = value
+		}
+	}
+
+	meta, err := json.Marshal(rollbackMeta{Method: r.method, Endpoint: endpoint, Previous: previous})
+	if err != nil {
+		return noState
+	}
+	return meta
+}
+
+// Rollback sends the values recorded before the remediation back to the
+// endpoint that was remediated
+func (r *Remediator) Rollback(ctx context.Context, metadata json.RawMessage) error {
+	var meta rollbackMeta
+	if err := json.Unmarshal(metadata, &meta); err != nil {
+		return fmt.Errorf("error unmarshalling rollback metadata: %w", err)
+	}
+	if meta.Endpoint == "" || len(meta.Previous) == 0 {
+		return errors.New("rollback metadata is missing the previous state")
+	}
+
+	body, err := json.Marshal(meta.Previous)
+	if err != nil {
+		return fmt.Errorf("error marshalling previous state: %w", err)
+	}
+
+	// restore with the method of the remediation, not the current one of the rule type
+	return r.run(ctx, meta.Method, meta.Endpoint, body)
+}
+
 func (r *Remediator) dryRun(endpoint, body string) error {
 	curlCmd, err := util.GenerateCurlCommand(r.method, r.cli.GetBaseURL(), endpoint, body)
 	if err != nil {
@@ -204,3 +298,13 @@ func (r *Remediator) dryRun(endpoint, body string) error {
 	log.Printf("run the following curl command: \n%s\n", curlCmd)
 	return nil
 }
+
+// pendingApproval records the request to be made as the change to approve
+func (r *Remediator) pendingApproval(endpoint, body string) error {
+	curlCmd, err := util.GenerateCurlCommand(r.method, r.cli.GetBaseURL(), endpoint, body)
+	if err != nil {
+		return fmt.Errorf("cannot generate curl command: %w", err)
+	}
+
+	return engerrors.NewErrActionPendingApproval(RemediateType, curlCmd)
+}
@@ -31,6 +31,7 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/stacklok/minder/internal/db"
+	enginerr "github.com/stacklok/minder/internal/engine/errors"
 	"github.com/stacklok/minder/internal/engine/interfaces"
 	"github.com/stacklok/minder/internal/providers"
 	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
@@ -227,6 +228,7 @@ func TestRestRemediate(t *testing.T) {
 		remArgs     remediateArgs
 		testHandler http.HandlerFunc
 		wantErr     bool
+		wantMeta    string
 	}{
 		{
 			name: "valid remediate",
@@ -249,6 +251,11 @@ func TestRestRemediate(t *testing.T) {
 			},
 			testHandler: func(writer http.ResponseWriter, request *http.Request) {
 				assert.Equal(t, "/repos/OwnerVar/NameVar/actions/permissions", request.URL.Path, "unexpected path")
+				if request.Method == http.MethodGet {
+					_, err := writer.Write([]byte(`{"enabled": false, "allowed_actions": "all", "selected_actions_url": "foo"}`))
+					assert.NoError(t, err, "unexpected error writing response")
+					return
+				}
 				assert.Equal(t, http.MethodPatch, request.Method, "unexpected method")
 
 				var requestBody struct {
@@ -265,6 +272,8 @@ func TestRestRemediate(t *testing.T) {
 				writer.WriteHeader(http.StatusOK)
 			},
 			wantErr: false,
+			wantMeta: `{"method": "PATCH", "endpoint": "/repos/OwnerVar/NameVar/actions/permissions",` +
+				`"previous": {"enabled": false, "allowed_actions": "all"}}`,
 		},
 		{
 			name: "valid remediate with PUT and no body",
@@ -324,6 +333,10 @@ func TestRestRemediate(t *testing.T) {
 			},
 			testHandler: func(writer http.ResponseWriter, request *http.Request) {
 				assert.Equal(t, "/repos/OwnerVar/NameVar/branches/main/protection", request.URL.Path, "unexpected path")
+				if request.Method == http.MethodGet {
+					writer.WriteHeader(http.StatusNotFound)
+					return
+				}
 				assert.Equal(t, http.MethodPut, request.Method, "unexpected method")
 
 				var requestBody struct {
@@ -339,7 +352,8 @@ func TestRestRemediate(t *testing.T) {
 				defer request.Body.Close()
 				writer.WriteHeader(http.StatusOK)
 			},
-			wantErr: false,
+			wantErr:  false,
+			wantMeta: `{}`,
 		},
 		{
 			name: "valid dry run",
@@ -452,6 +466,79 @@ func TestRestRemediate(t *testing.T) {
 			}
 
 			require.NoError(t, err, "unexpected error creating remediate engine")
+			if tt.wantMeta == "" {
+				require.Nil(t, retMeta, "expected nil metadata")
+			} else {
+				require.JSONEq(t, tt.wantMeta, string(retMeta), "unexpected metadata")
+			}
 		})
 	}
 }
+
+func TestRestRollback(t *testing.T) {
+	t.Parallel()
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		defer request.Body.Close()
+
+		assert.Equal(t, "/repos/OwnerVar/NameVar/actions/permissions", request.URL.Path, "unexpected path")
+		assert.Equal(t, http.MethodPatch, request.Method, "unexpected method")
+
+		var requestBody map[string]any
+		err := json.NewDecoder(request.Body).Decode(&requestBody)
+		assert.NoError(t, err, "unexpected error decoding body")
+		assert.Equal(t, map[string]any{"enabled": false, "allowed_actions": "all"}, requestBody, "unexpected body")
+
+		writer.WriteHeader(http.StatusOK)
+	}))
+	defer testServer.Close()
+
+	// the method of the remediation is used even if the rule type changed it
+	engine, err := NewRestRemediate(TestActionTypeValid, &pb.RestType{
+		Endpoint: "/repos/{{.Entity.Owner}}/{{.Entity.Name}}/actions/permissions",
+		Body:     &bodyTemplateWithVars,
+		Method:   http.MethodPut,
+	}, testGithubProviderBuilder(testServer.URL))
+	require.NoError(t, err, "unexpected error creating remediate engine")
+
+	err = engine.Rollback(context.Background(), json.RawMessage(
+		`{"method": "PATCH", "endpoint": "/repos/OwnerVar/NameVar/actions/permissions",`+
+			`"previous": {"enabled": false, "allowed_actions": "all"}}`))
+	require.NoError(t, err, "unexpected error rolling back")
+
+	err = engine.Rollback(context.Background(), json.RawMessage(`{}`))
+	require.Error(t, err, "expected error rolling back without a previous state")
+}
+
+func TestRestRemediateApproval(t *testing.T) {
+	t.Parallel()
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		assert.Fail(t, "unexpected request")
+	}))
+	defer testServer.Close()
+
+	engine, err := NewRestRemediate(TestActionTypeValid, &pb.RestType{
+		Endpoint: "/repos/{{.Entity.Owner}}/{{.Entity.Name}}/actions/permissions",
+		Body:     &bodyTemplateWithVars,
+	}, testGithubProviderBuilder(testServer.URL))
+	require.NoError(t, err, "unexpected error creating remediate engine")
+
+	structPol, err := structpb.NewStruct(map[string]any{"allowed_actions": "selected"})
+	require.NoError(t, err)
+	evalParams := &interfaces.EvalStatusParams{
+		Rule: &pb.Profile_Rule{
+			Def:    structPol,
+			Params: &structpb.Struct{},
+		},
+	}
+
+	_, err = engine.Do(context.Background(), interfaces.ActionCmdOn, interfaces.ActionOptApproval,
+		&pb.Repository{Owner: "OwnerVar", Name: "NameVar"}, evalParams, nil)
+
+	var pending *enginerr.PendingApprovalError
+	require.ErrorAs(t, err, &pending, "expected the remediation to wait for approval")
+	assert.Equal(t, RemediateType, pending.Type)
+	assert.Contains(t, pending.Preview, "/repos/OwnerVar/NameVar/actions/permissions")
+	assert.Contains(t, pending.Preview, `"allowed_actions": "selected"`)
+}
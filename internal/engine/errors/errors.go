@@ -57,7 +57,8 @@ var ErrActionSkipped = errors.New("action not performed")
 
 // IsActionInformativeError returns true if the error is an informative error that should not be reported to the user
 func IsActionInformativeError(err error) bool {
-	return errors.Is(err, ErrActionSkipped) || errors.Is(err, ErrActionNotAvailable) || errors.Is(err, ErrActionTurnedOff)
+	return errors.Is(err, ErrActionSkipped) || errors.Is(err, ErrActionNotAvailable) ||
+		errors.Is(err, ErrActionTurnedOff) || errors.Is(err, ErrActionPendingApproval)
 }
 
 // IsActionFatalError returns true if the error is a fatal error that should stop be reported to the user
@@ -80,12 +81,75 @@ var ErrActionNotAvailable = errors.New("action not available")
 // ErrActionTurnedOff is an error code that indicates that the action is turned off for this rule_type
 var ErrActionTurnedOff = errors.New("action turned off")
 
+// ErrActionPendingApproval is an error code that indicates that the remediation was rendered
+// but waits for the approval of a user before being performed
+var ErrActionPendingApproval = errors.New("action pending approval")
+
+// PendingApprovalError carries the change a remediation would make, to be
+// reviewed by a user
+type PendingApprovalError struct {
+	// Type is the type of the remediation
+	Type string
+	// Preview is the rendered change, e.g. the request or patch to apply
+	Preview string
+}
+
+// NewErrActionPendingApproval creates a new pending approval error for the
+// given remediation type and rendered change
+func NewErrActionPendingApproval(remType, preview string) error {
+	return &PendingApprovalError{Type: remType, Preview: preview}
+}
+
+func (e *PendingApprovalError) Error() string {
+	return fmt.Sprintf("%s: %s remediation", ErrActionPendingApproval, e.Type)
+}
+
+// Unwrap returns ErrActionPendingApproval so that errors.Is works
+func (_ *PendingApprovalError) Unwrap() error {
+	return ErrActionPendingApproval
+}
+
+// ActionResult is the result of a single remediation or alert
+type ActionResult struct {
+	// Name identifies the action within the rule type
+	Name string
+	Err  error
+	Meta json.RawMessage
+}
+
 // ActionsError is the error wrapper for actions
 type ActionsError struct {
-	RemediateErr  error
-	RemediateMeta json.RawMessage
-	AlertErr      error
-	AlertMeta     json.RawMessage
+	Remediations []ActionResult
+	Alerts       []ActionResult
+}
+
+// RemediateErr returns the most relevant error of the remediations
+func (ae ActionsError) RemediateErr() error {
+	return mostRelevantErr(ae.Remediations)
+}
+
+// AlertErr returns the most relevant error of the alerts
+func (ae ActionsError) AlertErr() error {
+	return mostRelevantErr(ae.Alerts)
+}
+
+// mostRelevantErr returns the first fatal error, else the first success, else
+// the first informative error of the results
+func mostRelevantErr(results []ActionResult) error {
+	if len(results) == 0 {
+		return ErrActionSkipped
+	}
+	for _, res := range results {
+		if IsActionFatalError(res.Err) {
+			return res.Err
+		}
+	}
+	for _, res := range results {
+		if res.Err == nil {
+			return nil
+		}
+	}
+	return results[0].Err
 }
 
 // ErrorAsEvalStatus returns the evaluation status for a given error
@@ -122,6 +186,8 @@ func ErrorAsRemediationStatus(err error) db.RemediationStatusTypes {
 		return db.RemediationStatusTypesSkipped
 	case errors.Is(err, ErrActionNotAvailable):
 		return db.RemediationStatusTypesNotAvailable
+	case errors.Is(err, ErrActionPendingApproval):
+		return db.RemediationStatusTypesPendingApproval
 	}
 	return db.RemediationStatusTypesError
 }
@@ -0,0 +1,82 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package actions provides an evaluator that checks the hardening of GitHub Actions workflows
+package actions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	evalerrors "github.com/stacklok/minder/internal/engine/errors"
+	engif "github.com/stacklok/minder/internal/engine/interfaces"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	// ActionsEvalType is the type of the actions evaluator
+	ActionsEvalType = "actions"
+)
+
+// Evaluator is the actions evaluator. It parses the workflows in the
+// filesystem returned by the git ingester and runs the checks enabled in the
+// profile on each of them.
+type Evaluator struct{}
+
+// NewActionsEvaluator creates a new actions evaluator
+func NewActionsEvaluator(_ *pb.RuleType_Definition_Eval_Actions) (*Evaluator, error) {
+	return &Evaluator{}, nil
+}
+
+// Eval implements the Evaluator interface.
+func (*Evaluator) Eval(_ context.Context, pol map[string]any, res *engif.Result) error {
+	if res.Fs == nil {
+		return fmt.Errorf("the actions evaluator requires a filesystem, use the git ingester")
+	}
+
+	ruleConfig, err := parseConfig(pol)
+	if err != nil {
+		return fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	workflows, violations, err := loadWorkflows(res.Fs)
+	if err != nil {
+		return err
+	}
+
+	for _, wf := range workflows {
+		for _, c := range checks {
+			violations = append(violations, c(ruleConfig, wf)...)
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(violations, func(i, j int) bool {
+		if violations[i].Workflow != violations[j].Workflow {
+			return violations[i].Workflow < violations[j].Workflow
+		}
+		return violations[i].Line < violations[j].Line
+	})
+
+	msgs := make([]string, 0, len(violations))
+	for _, v := range violations {
+		msgs = append(msgs, v.String())
+	}
+	return evalerrors.NewErrEvaluationFailed("Workflow violations: \n - %s", strings.Join(msgs, "\n - "))
+}
@@ -0,0 +1,220 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package actions provides an evaluator that checks the hardening of GitHub Actions workflows
+package actions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	evalerrors "github.com/stacklok/minder/internal/engine/errors"
+	engif "github.com/stacklok/minder/internal/engine/interfaces"
+)
+
+const hardenedWorkflow = `name: CI
+on:
+  push:
+    branches: [main]
+permissions:
+  contents: read
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@b4ffde65f46336ab88eb53be808477a3936bae11
+      - uses: ./.github/actions/setup
+      - run: echo "${{ github.event.pull_request.number }}"
+`
+
+const weakWorkflow = `name: Triage
+on: [pull_request_target, issue_comment]
+permissions: write-all
+jobs:
+  triage:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v4
+        with:
+          ref: ${{ github.event.pull_request.head.sha }}
+      - uses: evil-corp/action@main
+      - run: |
+          echo "checking"
+          echo "${{ github.event.issue.title }}"
+      - uses: actions/github-script@60a0d83039c74a4aee543508d2ffcb1c3799cdea
+        with:
+          script: console.log("${{ github.event.comment.body }}")
+  reuse:
+    uses: octo-org/workflows/.github/workflows/build.yml@v1
+`
+
+func checkWorkflows(t *testing.T, pol map[string]any, workflows map[string]string) []violation {
+	t.Helper()
+
+	fs := memfs.New()
+	for name, content := range workflows {
+		require.NoError(t, util.WriteFile(fs, ".github/workflows/"+name, []byte(content), 0644))
+	}
+
+	cfg, err := parseConfig(pol)
+	require.NoError(t, err)
+
+	loaded, violations, err := loadWorkflows(fs)
+	require.NoError(t, err)
+	for _, wf := range loaded {
+		for _, c := range checks {
+			violations = append(violations, c(cfg, wf)...)
+		}
+	}
+	return violations
+}
+
+func TestChecks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		pol      map[string]any
+		expected []violation
+	}{
+		{
+			name: "pin to sha",
+			pol:  map[string]any{"pin_to_sha": true},
+			expected: []violation{
+				{Workflow: ".github/workflows/weak.yml", Line: 8, Check: "pin_to_sha",
+					Message: "actions/checkout@v4 is not pinned to a full commit SHA"},
+				{Workflow: ".github/workflows/weak.yml", Line: 11, Check: "pin_to_sha",
+					Message: "evil-corp/action@main is not pinned to a full commit SHA"},
+				{Workflow: ".github/workflows/weak.yml", Line: 19, Check: "pin_to_sha",
+					Message: "octo-org/workflows/.github/workflows/build.yml@v1 is not pinned to a full commit SHA"},
+			},
+		},
+		{
+			name: "allowed owners",
+			pol:  map[string]any{"allowed_owners": []any{"Actions", "octo-org"}},
+			expected: []violation{
+				{Workflow: ".github/workflows/weak.yml", Line: 11, Check: "allowed_owners",
+					Message: "evil-corp/action@main is owned by evil-corp, which is not an allowed owner"},
+			},
+		},
+		{
+			name: "top-level permissions",
+			pol:  map[string]any{"top_level_permissions": map[string]any{"required": true}},
+			expected: []violation{
+				{Workflow: ".github/workflows/weak.yml", Line: 3, Check: "top_level_permissions",
+					Message: "the workflow grants write access to all scopes"},
+			},
+		},
+		{
+			name: "forbidden triggers",
+			pol:  map[string]any{"forbidden_triggers": []any{"issue_comment", "workflow_run"}},
+			expected: []violation{
+				{Workflow: ".github/workflows/weak.yml", Line: 2, Check: "forbidden_triggers",
+					Message: "the workflow is triggered by issue_comment"},
+			},
+		},
+		{
+			name: "pull_request_target checkout",
+			pol:  map[string]any{"forbid_pull_request_target_checkout": true},
+			expected: []violation{
+				{Workflow: ".github/workflows/weak.yml", Line: 10, Check: "pull_request_target_checkout",
+					Message: "job triage checks out the pull request head in a pull_request_target workflow"},
+			},
+		},
+		{
+			name: "script injection",
+			pol:  map[string]any{"forbid_script_injection": true},
+			expected: []violation{
+				{Workflow: ".github/workflows/weak.yml", Line: 14, Check: "script_injection",
+					Message: "the script interpolates github.event.issue.title, pass it through an environment variable instead"},
+				{Workflow: ".github/workflows/weak.yml", Line: 17, Check: "script_injection",
+					Message: "the script interpolates github.event.comment.body, pass it through an environment variable instead"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			violations := checkWorkflows(t, tt.pol, map[string]string{
+				"ci.yml":    hardenedWorkflow,
+				"weak.yml":  weakWorkflow,
+				"README.md": "not a workflow",
+			})
+			assert.ElementsMatch(t, tt.expected, violations)
+		})
+	}
+}
+
+func TestTopLevelPermissions(t *testing.T) {
+	t.Parallel()
+
+	workflows := map[string]string{
+		"missing.yml": "on: push\njobs: {}\n",
+		"scoped.yml":  "on: push\npermissions:\n  contents: write\n  packages: write\njobs: {}\n",
+	}
+
+	violations := checkWorkflows(t, map[string]any{
+		"top_level_permissions": map[string]any{
+			"required":      true,
+			"allowed_write": []any{"packages"},
+		},
+	}, workflows)
+	assert.ElementsMatch(t, []violation{
+		{Workflow: ".github/workflows/missing.yml", Line: 1, Check: "top_level_permissions",
+			Message: "the workflow does not set top-level permissions"},
+		{Workflow: ".github/workflows/scoped.yml", Line: 3, Check: "top_level_permissions",
+			Message: "the workflow grants write access to contents"},
+	}, violations)
+
+	// without required, a missing block is fine
+	violations = checkWorkflows(t, map[string]any{
+		"top_level_permissions": map[string]any{},
+	}, workflows)
+	assert.Len(t, violations, 2)
+}
+
+func TestEval(t *testing.T) {
+	t.Parallel()
+
+	e, err := NewActionsEvaluator(nil)
+	require.NoError(t, err)
+
+	pol := map[string]any{"pin_to_sha": true}
+
+	// no workflows at all
+	err = e.Eval(context.Background(), pol, &engif.Result{Fs: memfs.New()})
+	require.NoError(t, err)
+
+	fs := memfs.New()
+	require.NoError(t, util.WriteFile(fs, ".github/workflows/ci.yml", []byte(hardenedWorkflow), 0644))
+	require.NoError(t, util.WriteFile(fs, ".github/workflows/broken.yaml", []byte("on: [push"), 0644))
+	err = e.Eval(context.Background(), pol, &engif.Result{Fs: fs})
+	require.ErrorIs(t, err, evalerrors.ErrEvaluationFailed)
+	assert.Contains(t, err.Error(), ".github/workflows/broken.yaml:1: [valid_workflow]")
+	assert.NotContains(t, err.Error(), "ci.yml")
+
+	err = e.Eval(context.Background(), map[string]any{}, &engif.Result{Fs: fs})
+	require.ErrorContains(t, err, "no checks are enabled")
+
+	err = e.Eval(context.Background(), pol, &engif.Result{})
+	require.Error(t, err)
+}
@@ -0,0 +1,276 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package actions provides an evaluator that checks the hardening of GitHub Actions workflows
+package actions
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// violation is a single finding in a workflow
+type violation struct {
+	Workflow string
+	Line     int
+	Check    string
+	Message  string
+}
+
+func (v violation) String() string {
+	return fmt.Sprintf("%s:%d: [%s] %s", v.Workflow, v.Line, v.Check, v.Message)
+}
+
+// check inspects a workflow and returns its violations, checks that are not
+// enabled in the configuration return nothing
+type check func(cfg *config, wf *workflow) []violation
+
+var checks = []check{
+	checkPinToSha,
+	checkAllowedOwners,
+	checkTopLevelPermissions,
+	checkForbiddenTriggers,
+	checkPullRequestTargetCheckout,
+	checkScriptInjection,
+}
+
+var shaRef = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+func checkPinToSha(cfg *config, wf *workflow) []violation {
+	if !cfg.PinToSha {
+		return nil
+	}
+
+	var violations []violation
+	for _, uses := range wf.usesNodes() {
+		ref := parseUses(uses.Value)
+		if ref.local || ref.docker || shaRef.MatchString(ref.ref) {
+			continue
+		}
+		violations = append(violations, violation{
+			Workflow: wf.path,
+			Line:     uses.Line,
+			Check:    "pin_to_sha",
+			Message:  fmt.Sprintf("%s is not pinned to a full commit SHA", uses.Value),
+		})
+	}
+	return violations
+}
+
+func checkAllowedOwners(cfg *config, wf *workflow) []violation {
+	if len(cfg.AllowedOwners) == 0 {
+		return nil
+	}
+
+	var violations []violation
+	for _, uses := range wf.usesNodes() {
+		ref := parseUses(uses.Value)
+		if ref.local || ref.docker {
+			continue
+		}
+		if slices.ContainsFunc(cfg.AllowedOwners, func(owner string) bool {
+			return strings.EqualFold(owner, ref.owner)
+		}) {
+			continue
+		}
+		violations = append(violations, violation{
+			Workflow: wf.path,
+			Line:     uses.Line,
+			Check:    "allowed_owners",
+			Message:  fmt.Sprintf("%s is owned by %s, which is not an allowed owner", uses.Value, ref.owner),
+		})
+	}
+	return violations
+}
+
+func checkTopLevelPermissions(cfg *config, wf *workflow) []violation {
+	if cfg.TopLevelPermissions == nil {
+		return nil
+	}
+
+	key, perms := mappingValue(wf.root, "permissions")
+	if perms == nil {
+		if !cfg.TopLevelPermissions.Required {
+			return nil
+		}
+		return []violation{{
+			Workflow: wf.path,
+			Line:     wf.root.Line,
+			Check:    "top_level_permissions",
+			Message:  "the workflow does not set top-level permissions",
+		}}
+	}
+
+	newViolation := func(line int, msg string) violation {
+		return violation{
+			Workflow: wf.path,
+			Line:     line,
+			Check:    "top_level_permissions",
+			Message:  msg,
+		}
+	}
+
+	switch perms.Kind {
+	case yaml.ScalarNode:
+		if perms.Value == "write-all" {
+			return []violation{newViolation(key.Line, "the workflow grants write access to all scopes")}
+		}
+	case yaml.MappingNode:
+		var violations []violation
+		for i := 0; i+1 < len(perms.Content); i += 2 {
+			scope, access := perms.Content[i], perms.Content[i+1]
+			if access.Value != "write" || slices.Contains(cfg.TopLevelPermissions.AllowedWrite, scope.Value) {
+				continue
+			}
+			violations = append(violations, newViolation(scope.Line,
+				fmt.Sprintf("the workflow grants write access to %s", scope.Value)))
+		}
+		return violations
+	}
+
+	return nil
+}
+
+func checkForbiddenTriggers(cfg *config, wf *workflow) []violation {
+	if len(cfg.ForbiddenTriggers) == 0 {
+		return nil
+	}
+
+	triggers := wf.triggers()
+	var violations []violation
+	for _, forbidden := range cfg.ForbiddenTriggers {
+		node, ok := triggers[forbidden]
+		if !ok {
+			continue
+		}
+		violations = append(violations, violation{
+			Workflow: wf.path,
+			Line:     node.Line,
+			Check:    "forbidden_triggers",
+			Message:  fmt.Sprintf("the workflow is triggered by %s", forbidden),
+		})
+	}
+	return violations
+}
+
+// prHeadExpression matches the expressions that refer to the untrusted head of a pull request
+var prHeadExpression = regexp.MustCompile(
+	`github\.event\.pull_request\.head\.|github\.head_ref|refs/pull/[^/]+/(head|merge)`)
+
+func checkPullRequestTargetCheckout(cfg *config, wf *workflow) []violation {
+	if !cfg.ForbidPullRequestTargetCheckout {
+		return nil
+	}
+
+	if _, ok := wf.triggers()["pull_request_target"]; !ok {
+		return nil
+	}
+
+	var violations []violation
+	for _, j := range wf.jobs() {
+		for _, s := range j.steps {
+			if s.uses == nil {
+				continue
+			}
+			ref := parseUses(s.uses.Value)
+			if ref.owner != "actions" || ref.repo != "checkout" {
+				continue
+			}
+			for _, input := range []string{"ref", "repository"} {
+				_, val := mappingValue(s.with, input)
+				if val == nil || !prHeadExpression.MatchString(val.Value) {
+					continue
+				}
+				violations = append(violations, violation{
+					Workflow: wf.path,
+					Line:     val.Line,
+					Check:    "pull_request_target_checkout",
+					Message: fmt.Sprintf("job %s checks out the pull request head in a pull_request_target workflow",
+						j.name),
+				})
+			}
+		}
+	}
+	return violations
+}
+
+var (
+	expression = regexp.MustCompile(`\$\{\{(.*?)\}\}`)
+	// untrustedContext matches the contexts an attacker can control, as listed in
+	// https://securitylab.github.com/research/github-actions-untrusted-input/
+	untrustedContext = regexp.MustCompile(`github\.event\.(` + strings.Join([]string{
+		`issue\.(title|body)`,
+		`pull_request\.(title|body)`,
+		`pull_request\.head\.(ref|label)`,
+		`pull_request\.head\.repo\.default_branch`,
+		`(comment|review|review_comment)\.body`,
+		`discussion\.(title|body)`,
+		`pages(\[[^\]]*\]|\.\*)\.page_name`,
+		`commits(\[[^\]]*\]|\.\*)\.(message|author\.(email|name))`,
+		`head_commit\.(message|author\.(email|name))`,
+		`workflow_run\.(head_branch|head_commit\.(message|author\.(email|name)))`,
+	}, "|") + `)|github\.head_ref`)
+)
+
+func checkScriptInjection(cfg *config, wf *workflow) []violation {
+	if !cfg.ForbidScriptInjection {
+		return nil
+	}
+
+	var violations []violation
+	for _, j := range wf.jobs() {
+		for _, s := range j.steps {
+			scripts := []*yaml.Node{s.run}
+			if s.uses != nil {
+				ref := parseUses(s.uses.Value)
+				if ref.owner == "actions" && ref.repo == "github-script" {
+					_, script := mappingValue(s.with, "script")
+					scripts = append(scripts, script)
+				}
+			}
+
+			for _, script := range scripts {
+				if script == nil || script.Kind != yaml.ScalarNode {
+					continue
+				}
+				violations = append(violations, findInjections(wf.path, script)...)
+			}
+		}
+	}
+	return violations
+}
+
+func findInjections(wfPath string, script *yaml.Node) []violation {
+	var violations []violation
+	for n, line := range strings.Split(script.Value, "\n") {
+		for _, match := range expression.FindAllStringSubmatch(line, -1) {
+			ctx := untrustedContext.FindString(match[1])
+			if ctx == "" {
+				continue
+			}
+			violations = append(violations, violation{
+				Workflow: wfPath,
+				Line:     scalarLine(script, n),
+				Check:    "script_injection",
+				Message: fmt.Sprintf("the script interpolates %s, pass it through an environment variable instead",
+					ctx),
+			})
+		}
+	}
+	return violations
+}
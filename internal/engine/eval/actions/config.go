@@ -0,0 +1,73 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package actions provides an evaluator that checks the hardening of GitHub Actions workflows
+package actions
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mitchellh/mapstructure"
+)
+
+type permissionsConfig struct {
+	// Required makes workflows without a top-level permissions block fail
+	Required bool `json:"required" mapstructure:"required"`
+	// AllowedWrite lists the scopes that the top-level permissions may grant write access to
+	AllowedWrite []string `json:"allowed_write" mapstructure:"allowed_write"`
+}
+
+// config is the configuration for the actions evaluator. Every field enables
+// one of the checks, the checks that are not configured are not run.
+type config struct {
+	// PinToSha requires every action and reusable workflow to be referenced by a full commit SHA
+	PinToSha bool `json:"pin_to_sha" mapstructure:"pin_to_sha"`
+	// AllowedOwners, if set, is the list of the only owners whose actions may be used
+	AllowedOwners []string `json:"allowed_owners" mapstructure:"allowed_owners"`
+	// TopLevelPermissions checks the permissions block at the top of the workflow
+	TopLevelPermissions *permissionsConfig `json:"top_level_permissions" mapstructure:"top_level_permissions"`
+	// ForbiddenTriggers lists the events that must not trigger a workflow
+	ForbiddenTriggers []string `json:"forbidden_triggers" mapstructure:"forbidden_triggers"`
+	// ForbidPullRequestTargetCheckout flags workflows triggered by pull_request_target
+	// that check out the head of the pull request
+	ForbidPullRequestTargetCheckout bool `json:"forbid_pull_request_target_checkout" mapstructure:"forbid_pull_request_target_checkout"`
+	// ForbidScriptInjection flags scripts that interpolate attacker-controlled expressions
+	ForbidScriptInjection bool `json:"forbid_script_injection" mapstructure:"forbid_script_injection"`
+}
+
+func parseConfig(ruleCfg map[string]any) (*config, error) {
+	if ruleCfg == nil {
+		return nil, errors.New("config was missing")
+	}
+
+	var conf config
+	validate := validator.New(validator.WithRequiredStructEnabled())
+
+	if err := mapstructure.Decode(ruleCfg, &conf); err != nil {
+		return nil, fmt.Errorf("could not parse config: %w", err)
+	}
+
+	if err := validate.Struct(&conf); err != nil {
+		return nil, fmt.Errorf("config failed validation: %w", err)
+	}
+
+	if !conf.PinToSha && len(conf.AllowedOwners) == 0 && conf.TopLevelPermissions == nil &&
+		len(conf.ForbiddenTriggers) == 0 && !conf.ForbidPullRequestTargetCheckout && !conf.ForbidScriptInjection {
+		return nil, errors.New("config failed validation: no checks are enabled")
+	}
+
+	return &conf, nil
+}
@@ -0,0 +1,248 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package actions provides an evaluator that checks the hardening of GitHub Actions workflows
+package actions
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-billy/v5"
+	"gopkg.in/yaml.v3"
+)
+
+const workflowsDir = ".github/workflows"
+
+// workflow is a parsed workflow file. The YAML nodes are kept rather than
+// decoding into structs so that violations can point at the offending line.
+type workflow struct {
+	path string
+	root *yaml.Node
+}
+
+type job struct {
+	name string
+	// uses is set for jobs that call a reusable workflow
+	uses  *yaml.Node
+	steps []*step
+}
+
+type step struct {
+	node *yaml.Node
+	uses *yaml.Node
+	run  *yaml.Node
+	with *yaml.Node
+}
+
+// loadWorkflows reads all the workflows in the repository. A repository
+// without workflows is not an error.
+func loadWorkflows(fs billy.Filesystem) ([]*workflow, []violation, error) {
+	entries, err := fs.ReadDir(workflowsDir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil, nil
+	} else if err != nil {
+		return nil, nil, fmt.Errorf("could not list workflows: %w", err)
+	}
+
+	var workflows []*workflow
+	var invalid []violation
+	for _, entry := range entries {
+		ext := path.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".yml" && ext != ".yaml") {
+			continue
+		}
+
+		wfPath := path.Join(workflowsDir, entry.Name())
+		contents, err := readFile(fs, wfPath)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		wf, err := parseWorkflow(wfPath, contents)
+		if err != nil {
+			// GitHub won't run the workflow either, but it's still worth fixing
+			invalid = append(invalid, violation{
+				Workflow: wfPath,
+				Line:     1,
+				Check:    "valid_workflow",
+				Message:  err.Error(),
+			})
+			continue
+		}
+		workflows = append(workflows, wf)
+	}
+
+	sort.Slice(workflows, func(i, j int) bool {
+		return workflows[i].path < workflows[j].path
+	})
+
+	return workflows, invalid, nil
+}
+
+func readFile(fs billy.Filesystem, filePath string) ([]byte, error) {
+	f, err := fs.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("could not open %s: %w", filePath, err)
+	}
+	defer f.Close()
+
+	contents, err := io.ReadAll(f)
+	if err != nil {
+		return nil, fmt.Errorf("could not read %s: %w", filePath, err)
+	}
+	return contents, nil
+}
+
+func parseWorkflow(wfPath string, contents []byte) (*workflow, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(contents, &doc); err != nil {
+		return nil, fmt.Errorf("could not parse workflow: %w", err)
+	}
+
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) != 1 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, errors.New("workflow is not a mapping")
+	}
+
+	return &workflow{
+		path: wfPath,
+		root: doc.Content[0],
+	}, nil
+}
+
+// mappingValue returns the key and value nodes of a key in a mapping node
+func mappingValue(node *yaml.Node, key string) (*yaml.Node, *yaml.Node) {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i], node.Content[i+1]
+		}
+	}
+	return nil, nil
+}
+
+// triggers returns the events that trigger the workflow, keyed by event name.
+// The "on" key may be a single event, a list of events or a mapping of events
+// to their filters.
+func (w *workflow) triggers() map[string]*yaml.Node {
+	_, on := mappingValue(w.root, "on")
+	if on == nil {
+		return nil
+	}
+
+	triggers := make(map[string]*yaml.Node)
+	switch on.Kind {
+	case yaml.ScalarNode:
+		triggers[on.Value] = on
+	case yaml.SequenceNode:
+		for _, ev := range on.Content {
+			if ev.Kind == yaml.ScalarNode {
+				triggers[ev.Value] = ev
+			}
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(on.Content); i += 2 {
+			triggers[on.Content[i].Value] = on.Content[i]
+		}
+	}
+	return triggers
+}
+
+func (w *workflow) jobs() []*job {
+	_, jobsNode := mappingValue(w.root, "jobs")
+	if jobsNode == nil || jobsNode.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	jobs := make([]*job, 0, len(jobsNode.Content)/2)
+	for i := 0; i+1 < len(jobsNode.Content); i += 2 {
+		jobNode := jobsNode.Content[i+1]
+		j := &job{name: jobsNode.Content[i].Value}
+		_, j.uses = mappingValue(jobNode, "uses")
+
+		_, stepsNode := mappingValue(jobNode, "steps")
+		if stepsNode != nil && stepsNode.Kind == yaml.SequenceNode {
+			for _, stepNode := range stepsNode.Content {
+				s := &step{node: stepNode}
+				_, s.uses = mappingValue(stepNode, "uses")
+				_, s.run = mappingValue(stepNode, "run")
+				_, s.with = mappingValue(stepNode, "with")
+				j.steps = append(j.steps, s)
+			}
+		}
+		jobs = append(jobs, j)
+	}
+	return jobs
+}
+
+// usesNodes returns every reference to an action or reusable workflow
+func (w *workflow) usesNodes() []*yaml.Node {
+	var uses []*yaml.Node
+	for _, j := range w.jobs() {
+		if j.uses != nil {
+			uses = append(uses, j.uses)
+		}
+		for _, s := range j.steps {
+			if s.uses != nil {
+				uses = append(uses, s.uses)
+			}
+		}
+	}
+	return uses
+}
+
+// actionRef is a parsed "uses" reference, e.g. actions/checkout@v4 or
+// octo-org/repo/.github/workflows/build.yml@main
+type actionRef struct {
+	owner string
+	repo  string
+	ref   string
+	// local actions and docker images are not fetched from a repository
+	local  bool
+	docker bool
+}
+
+func parseUses(uses string) actionRef {
+	if strings.HasPrefix(uses, "./") {
+		return actionRef{local: true}
+	}
+	if strings.HasPrefix(uses, "docker://") {
+		return actionRef{docker: true}
+	}
+
+	name, ref, _ := strings.Cut(uses, "@")
+	owner, rest, _ := strings.Cut(name, "/")
+	repo, _, _ := strings.Cut(rest, "/")
+	return actionRef{
+		owner: owner,
+		repo:  repo,
+		ref:   ref,
+	}
+}
+
+// scalarLine returns the line of the n-th line of a scalar's value. The value
+// of block scalars starts on the line after the indicator.
+func scalarLine(node *yaml.Node, n int) int {
+	if node.Style == yaml.LiteralStyle || node.Style == yaml.FoldedStyle {
+		return node.Line + 1 + n
+	}
+	return node.Line + n
+}
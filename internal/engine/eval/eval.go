@@ -21,7 +21,9 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/stacklok/minder/internal/engine/eval/actions"
 	"github.com/stacklok/minder/internal/engine/eval/jq"
+	"github.com/stacklok/minder/internal/engine/eval/license"
 	"github.com/stacklok/minder/internal/engine/eval/rego"
 	"github.com/stacklok/minder/internal/engine/eval/trusty"
 	"github.com/stacklok/minder/internal/engine/eval/vulncheck"
@@ -49,6 +51,10 @@ func NewRuleEvaluator(rt *pb.RuleType, cli *providers.ProviderBuilder) (engif.Ev
 		return rego.NewRegoEvaluator(e.GetRego())
 	case vulncheck.VulncheckEvalType:
 		return vulncheck.NewVulncheckEvaluator(e.GetVulncheck(), cli)
+	case actions.ActionsEvalType:
+		return actions.NewActionsEvaluator(e.GetActions())
+	case license.LicenseEvalType:
+		return license.NewLicenseEvaluator(e.GetLicense(), cli)
 	case trusty.TrustyEvalType:
 		trustyEvalConfig := e.GetTrusty()
 		if trustyEvalConfig == nil {
@@ -0,0 +1,276 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package license provides the license compliance evaluator
+package license
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/google/go-github/v53/github"
+	"github.com/rs/zerolog"
+
+	"github.com/stacklok/minder/internal/engine/eval/pr_actions"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+const (
+	reviewBodyMagicComment = "<!-- minder: pr-license-review-body -->"
+	commitStatusContext    = "minder.stacklok.dev/pr-license"
+	violationsFoundText    = `
+Minder found dependencies in this PR whose license is not permitted by the license policy.
+`
+	violationsFoundTextShort = `
+License policy violations found.
+`
+	noViolationsFoundText = `
+Minder analyzed this PR and found no license policy violations.
+`
+	reviewBodyDismissCommentText = `
+Previous Minder license review was dismissed because the PR was updated.
+`
+
+	violationsTableTmplName = "licenseViolationsTable"
+	violationsTableTmpl     = `
+### License policy violations
+<table>
+  <tr>
+    <th>Ecosystem</th>
+    <th>Name</th>
+    <th>Version</th>
+    <th>File</th>
+    <th>Reason</th>
+  </tr>
+  {{- range . }}
+  <tr>
+    <td>{{ .Dependency.Ecosystem.AsString }}</td>
+    <td>{{ .Dependency.Name }}</td>
+    <td>{{ .Dependency.Version }}</td>
+    <td>{{ .File }}</td>
+    <td>{{ .Reason }}</td>
+  </tr>
+  {{- end }}
+</table>
+`
+)
+
+type licenseViolation struct {
+	Dependency *pb.Dependency
+	File       string
+	Reason     string
+}
+
+type prStatusHandler interface {
+	trackViolation(dep *pb.PrDependencies_ContextualDependency, reason string)
+	submit(ctx context.Context) error
+}
+
+func newPrStatusHandler(
+	ctx context.Context,
+	action pr_actions.Action,
+	pr *pb.PullRequest,
+	cli provifv1.GitHub,
+) (prStatusHandler, error) {
+	switch action {
+	case pr_actions.ActionReviewPr, pr_actions.ActionComment, pr_actions.ActionCommitStatus, pr_actions.ActionSummary:
+		return newPrHandler(ctx, action, pr, cli)
+	case pr_actions.ActionProfileOnly:
+		return &profileOnlyPrHandler{}, nil
+	default:
+		return nil, fmt.Errorf("unknown action: %s", action)
+	}
+}
+
+// prHandler reports the violations found in a PR. The summary action only
+// posts a comment, the other actions submit a review which the commit_status
+// action complements with a commit status to eventually block the PR.
+type prHandler struct {
+	action pr_actions.Action
+	cli    provifv1.GitHub
+	pr     *pb.PullRequest
+
+	failStatus *string
+	violations []licenseViolation
+	tableTmpl  *template.Template
+
+	logger zerolog.Logger
+}
+
+func newPrHandler(
+	ctx context.Context,
+	action pr_actions.Action,
+	pr *pb.PullRequest,
+	cli provifv1.GitHub,
+) (*prHandler, error) {
+	if pr == nil {
+		return nil, fmt.Errorf("pr was nil, can't review")
+	}
+
+	logger := zerolog.Ctx(ctx).With().
+		Int32("pull-number", pr.Number).
+		Str("repo-owner", pr.RepoOwner).
+		Str("repo-name", pr.RepoName).
+		Logger()
+
+	tableTmpl, err := template.New(violationsTableTmplName).Parse(violationsTableTmpl)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse violations template: %w", err)
+	}
+
+	handler := &prHandler{
+		action:     action,
+		cli:        cli,
+		pr:         pr,
+		failStatus: github.String("COMMENT"),
+		tableTmpl:  tableTmpl,
+		logger:     logger,
+	}
+
+	if action == pr_actions.ActionReviewPr {
+		cliUser, err := cli.GetAuthenticatedUser(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get authenticated user: %w", err)
+		}
+
+		// requesting changes on your own PR is not possible
+		if pr.AuthorId != cliUser.GetID() {
+			handler.failStatus = github.String("REQUEST_CHANGES")
+		}
+	}
+
+	return handler, nil
+}
+
+func (h *prHandler) trackViolation(dep *pb.PrDependencies_ContextualDependency, reason string) {
+	h.violations = append(h.violations, licenseViolation{
+		Dependency: dep.Dep,
+		File:       dep.GetFile().GetName(),
+		Reason:     reason,
+	})
+
+	h.logger.Debug().
+		Str("dep-name", dep.Dep.Name).
+		Str("reason", reason).
+		Msg("license policy violation found")
+}
+
+func (h *prHandler) generateBody() (string, error) {
+	if len(h.violations) == 0 {
+		return noViolationsFoundText, nil
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(violationsFoundText)
+	if err := h.tableTmpl.Execute(&buf, h.violations); err != nil {
+		return "", fmt.Errorf("could not execute template: %w", err)
+	}
+	return buf.String(), nil
+}
+
+func (h *prHandler) submit(ctx context.Context) error {
+	body, err := h.generateBody()
+	if err != nil {
+		return err
+	}
+
+	if h.action == pr_actions.ActionSummary {
+		err := h.cli.CreateComment(ctx, h.pr.GetRepoOwner(), h.pr.GetRepoName(), int(h.pr.GetNumber()), body)
+		if err != nil {
+			return fmt.Errorf("could not create comment: %w", err)
+		}
+		return nil
+	}
+
+	if err := h.dismissPreviousReview(ctx); err != nil {
+		return err
+	}
+
+	status := github.String("COMMENT")
+	if len(h.violations) > 0 {
+		status = h.failStatus
+	}
+
+	_, err = h.cli.CreateReview(ctx, h.pr.RepoOwner, h.pr.RepoName, int(h.pr.Number), &github.PullRequestReviewRequest{
+		CommitID: github.String(h.pr.CommitSha),
+		Event:    status,
+		Body:     github.String(reviewBodyMagicComment + "\n\n" + body),
+	})
+	if err != nil {
+		return fmt.Errorf("could not create review: %w", err)
+	}
+
+	if h.action == pr_actions.ActionCommitStatus {
+		return h.setCommitStatus(ctx)
+	}
+
+	return nil
+}
+
+func (h *prHandler) dismissPreviousReview(ctx context.Context) error {
+	reviews, err := h.cli.ListReviews(ctx, h.pr.RepoOwner, h.pr.RepoName, int(h.pr.Number), nil)
+	if err != nil {
+		return fmt.Errorf("could not list reviews: %w", err)
+	}
+
+	for _, r := range reviews {
+		if !strings.HasPrefix(r.GetBody(), reviewBodyMagicComment) || r.GetState() == "DISMISSED" {
+			continue
+		}
+
+		_, err := h.cli.DismissReview(ctx, h.pr.RepoOwner, h.pr.RepoName, int(h.pr.Number), r.GetID(),
+			&github.PullRequestReviewDismissalRequest{
+				Message: github.String(reviewBodyDismissCommentText),
+			})
+		if err != nil {
+			// not fatal, the new review supersedes the old one anyway
+			h.logger.Error().Err(err).Int64("review-id", r.GetID()).Msg("could not dismiss previous review")
+		}
+	}
+
+	return nil
+}
+
+func (h *prHandler) setCommitStatus(ctx context.Context) error {
+	commitStatus := &github.RepoStatus{
+		Context: github.String(commitStatusContext),
+	}
+
+	if len(h.violations) > 0 {
+		commitStatus.State = github.String("failure")
+		commitStatus.Description = github.String(violationsFoundTextShort)
+	} else {
+		commitStatus.State = github.String("success")
+		commitStatus.Description = github.String(noViolationsFoundText)
+	}
+
+	_, err := h.cli.SetCommitStatus(ctx, h.pr.RepoOwner, h.pr.RepoName, h.pr.CommitSha, commitStatus)
+	if err != nil {
+		return fmt.Errorf("could not set commit status: %w", err)
+	}
+	return nil
+}
+
+// just satisfies the interface but really does nothing. Useful for testing.
+type profileOnlyPrHandler struct{}
+
+func (profileOnlyPrHandler) trackViolation(_ *pb.PrDependencies_ContextualDependency, _ string) {}
+
+func (profileOnlyPrHandler) submit(_ context.Context) error {
+	return nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package license provides the license compliance evaluator
+package license
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/stacklok/minder/internal/engine/eval/pr_actions"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+type packageRepository struct {
+	Url string `json:"url" mapstructure:"url" validate:"required"`
+}
+
+type ecosystemConfig struct {
+	Name              string            `json:"name" mapstructure:"name" validate:"required"`
+	PackageRepository packageRepository `json:"package_repository" mapstructure:"package_repository" validate:"required"`
+}
+
+// config is the configuration for the license evaluator
+type config struct {
+	Action          pr_actions.Action `json:"action" mapstructure:"action" validate:"required"`
+	EcosystemConfig []ecosystemConfig `json:"ecosystem_config" mapstructure:"ecosystem_config" validate:"required"`
+	// Allowed, if set, is the list of the only licenses that may be used
+	Allowed []string `json:"allowed,omitempty" mapstructure:"allowed"`
+	// Denied is the list of licenses that must not be used
+	Denied []string `json:"denied,omitempty" mapstructure:"denied"`
+	// AllowUnknown controls whether packages whose license could not be
+	// determined pass the check
+	AllowUnknown bool `json:"allow_unknown,omitempty" mapstructure:"allow_unknown"`
+}
+
+func parseConfig(ruleCfg map[string]any) (*config, error) {
+	if ruleCfg == nil {
+		return nil, errors.New("config was missing")
+	}
+
+	var conf config
+	validate := validator.New(validator.WithRequiredStructEnabled())
+
+	if err := mapstructure.Decode(ruleCfg, &conf); err != nil {
+		return nil, fmt.Errorf("could not parse config: %w", err)
+	}
+
+	if err := validate.Struct(&conf); err != nil {
+		return nil, fmt.Errorf("config failed validation: %w", err)
+	}
+
+	if len(conf.Allowed) == 0 && len(conf.Denied) == 0 {
+		return nil, errors.New("config failed validation: at least one of allowed or denied must be set")
+	}
+
+	for _, list := range [][]string{conf.Allowed, conf.Denied} {
+		for _, entry := range list {
+			if err := validateLicenseEntry(entry); err != nil {
+				return nil, fmt.Errorf("config failed validation: %w", err)
+			}
+		}
+	}
+
+	return &conf, nil
+}
+
+func validateLicenseEntry(entry string) error {
+	expr, err := parseSpdxExpression(entry)
+	if err != nil {
+		return err
+	}
+	if expr.op != "" {
+		return fmt.Errorf("%q must be a single license, not a compound expression", entry)
+	}
+	return nil
+}
+
+func (c *config) getEcosystemConfig(ecosystem pb.DepEcosystem) *ecosystemConfig {
+	sEco := ecosystem.AsString()
+	if sEco == "" {
+		return nil
+	}
+	sEco = strings.ToLower(sEco)
+
+	for _, eco := range c.EcosystemConfig {
+		if strings.ToLower(eco.Name) == sEco {
+			return &eco
+		}
+	}
+
+	return nil
+}
+
+// permitted returns true if a single license may be used according to the policy
+func (c *config) permitted(license string) bool {
+	for _, denied := range c.Denied {
+		if licenseMatches(denied, license) {
+			return false
+		}
+	}
+
+	if len(c.Allowed) == 0 {
+		return true
+	}
+
+	for _, allowed := range c.Allowed {
+		if licenseMatches(allowed, license) {
+			return true
+		}
+	}
+	return false
+}
+
+// check returns an empty string if the license expression of a package complies
+// with the policy or the reason why it doesn't
+func (c *config) check(expression string) string {
+	if expression == "" {
+		if c.AllowUnknown {
+			return ""
+		}
+		return "license could not be determined"
+	}
+
+	expr, err := parseSpdxExpression(expression)
+	if err != nil {
+		if c.AllowUnknown {
+			return ""
+		}
+		return fmt.Sprintf("license %q is not a valid SPDX expression", expression)
+	}
+
+	if !expr.satisfiable(c.permitted) {
+		return fmt.Sprintf("license %s is not permitted", expression)
+	}
+
+	return ""
+}
@@ -0,0 +1,160 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package license provides the license compliance evaluator
+package license
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	evalerrors "github.com/stacklok/minder/internal/engine/errors"
+	engif "github.com/stacklok/minder/internal/engine/interfaces"
+	"github.com/stacklok/minder/internal/providers"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+const (
+	// LicenseEvalType is the type of the license evaluator
+	LicenseEvalType = "license"
+)
+
+// Evaluator is the license evaluator
+type Evaluator struct {
+	cli provifv1.GitHub
+}
+
+// NewLicenseEvaluator creates a new license evaluator
+func NewLicenseEvaluator(_ *pb.RuleType_Definition_Eval_License, pbuild *providers.ProviderBuilder) (*Evaluator, error) {
+	if pbuild == nil {
+		return nil, fmt.Errorf("provider builder is nil")
+	}
+
+	ghcli, err := pbuild.GetGitHub(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get github client: %w", err)
+	}
+
+	return &Evaluator{
+		cli: ghcli,
+	}, nil
+}
+
+// Eval implements the Evaluator interface. It accepts both the dependencies
+// added in a pull request and the dependency inventory of a repository, only
+// the former gets feedback on the PR.
+func (e *Evaluator) Eval(ctx context.Context, pol map[string]any, res *engif.Result) error {
+	var deps []*pb.PrDependencies_ContextualDependency
+	var pr *pb.PullRequest
+
+	switch obj := res.Object.(type) {
+	case pb.PrDependencies:
+		deps = obj.GetDeps()
+		pr = obj.GetPr()
+	case pb.RepoDependencies:
+		deps = obj.GetDeps()
+	default:
+		return fmt.Errorf("invalid object type for license evaluator")
+	}
+
+	if len(deps) == 0 {
+		return nil
+	}
+
+	ruleConfig, err := parseConfig(pol)
+	if err != nil {
+		return fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	var handler prStatusHandler = &profileOnlyPrHandler{}
+	if pr != nil {
+		handler, err = newPrStatusHandler(ctx, ruleConfig.Action, pr, e.cli)
+		if err != nil {
+			return fmt.Errorf("failed to create pr action: %w", err)
+		}
+	}
+
+	violations, err := e.checkDependencies(ctx, ruleConfig, deps, handler)
+	if err != nil {
+		return err
+	}
+
+	if err := handler.submit(ctx); err != nil {
+		return fmt.Errorf("failed to submit pr action: %w", err)
+	}
+
+	if len(violations) > 0 {
+		return evalerrors.NewErrEvaluationFailed("license policy violations: %s", strings.Join(violations, ", "))
+	}
+
+	return nil
+}
+
+func (_ *Evaluator) checkDependencies(
+	ctx context.Context,
+	ruleConfig *config,
+	deps []*pb.PrDependencies_ContextualDependency,
+	handler prStatusHandler,
+) ([]string, error) {
+	logger := zerolog.Ctx(ctx)
+	resolvers := newResolverCache()
+	// the same package is often pulled in from several dependency files
+	checked := make(map[string]bool)
+	var violations []string
+
+	for _, dep := range deps {
+		if dep.Dep == nil || dep.Dep.Version == "" {
+			continue
+		}
+
+		ecoConfig := ruleConfig.getEcosystemConfig(dep.Dep.Ecosystem)
+		if ecoConfig == nil {
+			logger.Debug().
+				Str("dependency", dep.Dep.Name).
+				Str("ecosystem", dep.Dep.Ecosystem.AsString()).
+				Msg("no config for ecosystem, skipping")
+			continue
+		}
+
+		key := fmt.Sprintf("%s/%s@%s", ecoConfig.Name, dep.Dep.Name, dep.Dep.Version)
+		if checked[key] {
+			continue
+		}
+		checked[key] = true
+
+		resolver, err := resolvers.newResolver(ecoConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create license resolver: %w", err)
+		}
+
+		license, err := resolver.Resolve(ctx, dep.Dep)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve license of %s: %w", dep.Dep.Name, err)
+		}
+
+		reason := ruleConfig.check(license)
+		if reason == "" {
+			continue
+		}
+
+		violations = append(violations, fmt.Sprintf("%s@%s: %s", dep.Dep.Name, dep.Dep.Version, reason))
+		handler.trackViolation(dep, reason)
+	}
+
+	return violations, nil
+}
@@ -0,0 +1,141 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package license provides the license compliance evaluator
+package license
+
+import (
+	"strings"
+)
+
+// commonLicenseNames maps license names that are commonly used in package
+// metadata instead of SPDX identifiers. Keys are lowercase.
+var commonLicenseNames = map[string]string{
+	"apache 2.0":                  "Apache-2.0",
+	"apache 2":                    "Apache-2.0",
+	"apache-2":                    "Apache-2.0",
+	"apache license 2.0":          "Apache-2.0",
+	"apache license, version 2.0": "Apache-2.0",
+	"apache software license":     "Apache-2.0",
+	"asl 2.0":                     "Apache-2.0",
+	"mit license":                 "MIT",
+	"the mit license":             "MIT",
+	"expat":                       "MIT",
+	"bsd-3":                       "BSD-3-Clause",
+	"new bsd":                     "BSD-3-Clause",
+	"new bsd license":             "BSD-3-Clause",
+	"3-clause bsd":                "BSD-3-Clause",
+	"simplified bsd":              "BSD-2-Clause",
+	"2-clause bsd":                "BSD-2-Clause",
+	"isc license":                 "ISC",
+	"mpl 2.0":                     "MPL-2.0",
+	"mpl-2":                       "MPL-2.0",
+	"gplv2":                       "GPL-2.0-only",
+	"gplv2+":                      "GPL-2.0-or-later",
+	"gplv3":                       "GPL-3.0-only",
+	"gplv3+":                      "GPL-3.0-or-later",
+	"lgplv3":                      "LGPL-3.0-only",
+	"lgplv3+":                     "LGPL-3.0-or-later",
+	"agplv3":                      "AGPL-3.0-only",
+	"psf":                         "PSF-2.0",
+	"public domain":               "Unlicense",
+}
+
+// pypiClassifiers maps the last component of the PyPI "License ::" trove
+// classifiers to SPDX identifiers. Classifiers that don't identify a single
+// license, like "Other/Proprietary License", are deliberately missing.
+var pypiClassifiers = map[string]string{
+	"MIT License":             "MIT",
+	"Apache Software License": "Apache-2.0",
+	// the classifier doesn't say which variant, the 3-clause one is by far the most common
+	"BSD License":                                             "BSD-3-Clause",
+	"ISC License (ISCL)":                                      "ISC",
+	"Mozilla Public License 2.0 (MPL 2.0)":                    "MPL-2.0",
+	"GNU General Public License v2 (GPLv2)":                   "GPL-2.0-only",
+	"GNU General Public License v2 or later (GPLv2+)":         "GPL-2.0-or-later",
+	"GNU General Public License v3 (GPLv3)":                   "GPL-3.0-only",
+	"GNU General Public License v3 or later (GPLv3+)":         "GPL-3.0-or-later",
+	"GNU Lesser General Public License v2 (LGPLv2)":           "LGPL-2.0-only",
+	"GNU Lesser General Public License v2 or later (LGPLv2+)": "LGPL-2.0-or-later",
+	"GNU Lesser General Public License v3 (LGPLv3)":           "LGPL-3.0-only",
+	"GNU Lesser General Public License v3 or later (LGPLv3+)": "LGPL-3.0-or-later",
+	"GNU Affero General Public License v3":                    "AGPL-3.0-only",
+	"GNU Affero General Public License v3 or later (AGPLv3+)": "AGPL-3.0-or-later",
+	"Python Software Foundation License":                      "PSF-2.0",
+	"The Unlicense (Unlicense)":                               "Unlicense",
+	"Eclipse Public License 2.0 (EPL-2.0)":                    "EPL-2.0",
+	"Boost Software License 1.0 (BSL-1.0)":                    "BSL-1.0",
+}
+
+// normalizeLicense turns the declared license of a package into an SPDX
+// expression where possible. Values that can't be mapped are returned as-is and
+// are later reported as not being valid SPDX expressions.
+func normalizeLicense(license string) string {
+	license = strings.TrimSpace(license)
+	if license == "" || strings.EqualFold(license, "UNKNOWN") || strings.EqualFold(license, "NOASSERTION") {
+		return ""
+	}
+
+	if spdx, ok := commonLicenseNames[strings.ToLower(license)]; ok {
+		return spdx
+	}
+
+	return license
+}
+
+// licenseTextMarker identifies a license by phrases from its text. The order
+// matters, e.g. the LGPL text mentions the GPL.
+type licenseTextMarker struct {
+	spdx    string
+	phrases []string
+}
+
+var licenseTextMarkers = []licenseTextMarker{
+	{"AGPL-3.0-only", []string{"GNU AFFERO GENERAL PUBLIC LICENSE", "Version 3"}},
+	{"LGPL-3.0-only", []string{"GNU LESSER GENERAL PUBLIC LICENSE", "Version 3"}},
+	{"LGPL-2.1-only", []string{"GNU LESSER GENERAL PUBLIC LICENSE", "Version 2.1"}},
+	{"GPL-3.0-only", []string{"GNU GENERAL PUBLIC LICENSE", "Version 3"}},
+	{"GPL-2.0-only", []string{"GNU GENERAL PUBLIC LICENSE", "Version 2"}},
+	{"MPL-2.0", []string{"Mozilla Public License", "Version 2.0"}},
+	{"Apache-2.0", []string{"Apache License", "Version 2.0"}},
+	{"EPL-2.0", []string{"Eclipse Public License - v 2.0"}},
+	{"BSL-1.0", []string{"Boost Software License - Version 1.0"}},
+	{"Unlicense", []string{"This is free and unencumbered software released into the public domain"}},
+	{"ISC", []string{"Permission to use, copy, modify, and/or distribute this software for any purpose"}},
+	{"MIT", []string{"Permission is hereby granted, free of charge"}},
+	{"BSD-3-Clause", []string{"Redistribution and use in source and binary forms", "endorse or promote products"}},
+	{"BSD-2-Clause", []string{"Redistribution and use in source and binary forms"}},
+}
+
+// classifyLicenseText identifies the license from the text of a license file,
+// returning an empty string if the license is not recognized
+func classifyLicenseText(text string) string {
+	// normalize whitespace so that line wrapping doesn't matter
+	text = strings.Join(strings.Fields(text), " ")
+
+	for _, m := range licenseTextMarkers {
+		matches := true
+		for _, phrase := range m.phrases {
+			if !strings.Contains(text, phrase) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return m.spdx
+		}
+	}
+
+	return ""
+}
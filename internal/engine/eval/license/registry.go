@@ -0,0 +1,311 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package license provides the license compliance evaluator
+package license
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/puzpuzpuz/xsync"
+	"golang.org/x/mod/module"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// maxModuleZipSize caps how much of a Go module zip we are willing to download
+// just to find its license
+const maxModuleZipSize = 64 << 20
+
+// licenseResolver looks up the declared license of a package in its registry.
+// It returns the license as an SPDX expression, or an empty string if the
+// package doesn't declare a license or doesn't exist.
+type licenseResolver interface {
+	Resolve(ctx context.Context, dep *pb.Dependency) (string, error)
+}
+
+type resolverCache struct {
+	cache *xsync.MapOf[string, licenseResolver]
+}
+
+func newResolverCache() *resolverCache {
+	return &resolverCache{
+		cache: xsync.NewMapOf[licenseResolver](),
+	}
+}
+
+func (rc *resolverCache) newResolver(ecoConfig *ecosystemConfig) (licenseResolver, error) {
+	if res, exists := rc.cache.Load(ecoConfig.Name); exists {
+		return res, nil
+	}
+
+	var res licenseResolver
+	switch ecoConfig.Name {
+	case "npm":
+		res = newNpmResolver(ecoConfig.PackageRepository.Url)
+	case "go":
+		res = newGoProxyResolver(ecoConfig.PackageRepository.Url)
+	case "pypi":
+		res = newPyPIResolver(ecoConfig.PackageRepository.Url)
+	default:
+		return nil, fmt.Errorf("unknown ecosystem: %s", ecoConfig.Name)
+	}
+
+	rc.cache.Store(ecoConfig.Name, res)
+	return res, nil
+}
+
+// sendRequest sends a GET request and returns the body. A nil body and no
+// error means that the package was not found.
+func sendRequest(ctx context.Context, client *http.Client, u *url.URL, limit int64) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not create request: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("received non-200 response: %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("could not read response: %w", err)
+	}
+	if int64(len(body)) > limit {
+		return nil, fmt.Errorf("response exceeds %d bytes", limit)
+	}
+
+	return body, nil
+}
+
+type npmResolver struct {
+	client   *http.Client
+	endpoint string
+}
+
+func newNpmResolver(endpoint string) *npmResolver {
+	return &npmResolver{
+		client:   &http.Client{},
+		endpoint: endpoint,
+	}
+}
+
+// npmVersionReply is the subset of the npm registry version document we need.
+// Older packages use the deprecated object and array forms of the license.
+type npmVersionReply struct {
+	License  json.RawMessage `json:"license"`
+	Licenses []struct {
+		Type string `json:"type"`
+	} `json:"licenses"`
+}
+
+func (n *npmResolver) Resolve(ctx context.Context, dep *pb.Dependency) (string, error) {
+	u, err := url.Parse(n.endpoint)
+	if err != nil {
+		return "", fmt.Errorf("could not parse endpoint: %w", err)
+	}
+	u = u.JoinPath(dep.Name, dep.Version)
+
+	body, err := sendRequest(ctx, n.client, u, 1<<20)
+	if err != nil || body == nil {
+		return "", err
+	}
+
+	var reply npmVersionReply
+	if err := json.Unmarshal(body, &reply); err != nil {
+		return "", fmt.Errorf("could not unmarshal response: %w", err)
+	}
+
+	var license string
+	if err := json.Unmarshal(reply.License, &license); err != nil {
+		var licenseObj struct {
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal(reply.License, &licenseObj); err == nil {
+			license = licenseObj.Type
+		}
+	}
+
+	if license == "" && len(reply.Licenses) > 0 {
+		types := make([]string, 0, len(reply.Licenses))
+		for _, l := range reply.Licenses {
+			types = append(types, normalizeLicense(l.Type))
+		}
+		// multiple licenses in the legacy format mean the user can choose
+		license = strings.Join(types, " OR ")
+	}
+
+	return normalizeLicense(license), nil
+}
+
+type pypiResolver struct {
+	client   *http.Client
+	endpoint string
+}
+
+func newPyPIResolver(endpoint string) *pypiResolver {
+	return &pypiResolver{
+		client:   &http.Client{},
+		endpoint: endpoint,
+	}
+}
+
+type pypiReply struct {
+	Info struct {
+		License           string   `json:"license"`
+		LicenseExpression string   `json:"license_expression"`
+		Classifiers       []string `json:"classifiers"`
+	} `json:"info"`
+}
+
+func (p *pypiResolver) Resolve(ctx context.Context, dep *pb.Dependency) (string, error) {
+	u, err := url.Parse(p.endpoint)
+	if err != nil {
+		return "", fmt.Errorf("could not parse endpoint: %w", err)
+	}
+	u = u.JoinPath(dep.Name, dep.Version, "json")
+
+	body, err := sendRequest(ctx, p.client, u, 8<<20)
+	if err != nil || body == nil {
+		return "", err
+	}
+
+	var reply pypiReply
+	if err := json.Unmarshal(body, &reply); err != nil {
+		return "", fmt.Errorf("could not unmarshal response: %w", err)
+	}
+
+	// PEP 639 metadata is the most precise, then the trove classifiers, then the
+	// free-form license field which is often the whole license text
+	if reply.Info.LicenseExpression != "" {
+		return reply.Info.LicenseExpression, nil
+	}
+
+	var fromClassifiers []string
+	for _, c := range reply.Info.Classifiers {
+		if !strings.HasPrefix(c, "License ::") {
+			continue
+		}
+		parts := strings.Split(c, " :: ")
+		if spdx, ok := pypiClassifiers[parts[len(parts)-1]]; ok {
+			fromClassifiers = append(fromClassifiers, spdx)
+		}
+	}
+	if len(fromClassifiers) > 0 {
+		return strings.Join(fromClassifiers, " OR "), nil
+	}
+
+	license := strings.TrimSpace(reply.Info.License)
+	if strings.Contains(license, "\n") {
+		return classifyLicenseText(license), nil
+	}
+	return normalizeLicense(license), nil
+}
+
+type goProxyResolver struct {
+	client   *http.Client
+	endpoint string
+}
+
+func newGoProxyResolver(endpoint string) *goProxyResolver {
+	return &goProxyResolver{
+		client:   &http.Client{},
+		endpoint: endpoint,
+	}
+}
+
+// Resolve downloads the module zip from the proxy and classifies the license
+// file in the module root, since the module proxy protocol has no notion of
+// license metadata.
+func (g *goProxyResolver) Resolve(ctx context.Context, dep *pb.Dependency) (string, error) {
+	escPath, err := module.EscapePath(dep.Name)
+	if err != nil {
+		return "", fmt.Errorf("invalid module path: %w", err)
+	}
+	escVersion, err := module.EscapeVersion(dep.Version)
+	if err != nil {
+		return "", fmt.Errorf("invalid module version: %w", err)
+	}
+
+	u, err := url.Parse(g.endpoint)
+	if err != nil {
+		return "", fmt.Errorf("could not parse endpoint: %w", err)
+	}
+	u = u.JoinPath(escPath, "@v", escVersion+".zip")
+
+	body, err := sendRequest(ctx, g.client, u, maxModuleZipSize)
+	if err != nil || body == nil {
+		return "", err
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+	if err != nil {
+		return "", fmt.Errorf("could not read module zip: %w", err)
+	}
+
+	root := fmt.Sprintf("%s@%s/", dep.Name, dep.Version)
+	for _, f := range zr.File {
+		name := strings.TrimPrefix(f.Name, root)
+		if name == f.Name || strings.Contains(name, "/") || !isLicenseFileName(name) {
+			continue
+		}
+
+		text, err := readZipFile(f)
+		if err != nil {
+			return "", err
+		}
+		if license := classifyLicenseText(text); license != "" {
+			return license, nil
+		}
+	}
+
+	return "", nil
+}
+
+func isLicenseFileName(name string) bool {
+	base := strings.ToUpper(strings.TrimSuffix(name, path.Ext(name)))
+	return base == "LICENSE" || base == "LICENCE" || base == "COPYING"
+}
+
+func readZipFile(f *zip.File) (string, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return "", fmt.Errorf("could not open %s: %w", f.Name, err)
+	}
+	defer rc.Close()
+
+	text, err := io.ReadAll(io.LimitReader(rc, 1<<20))
+	if err != nil {
+		return "", fmt.Errorf("could not read %s: %w", f.Name, err)
+	}
+	return string(text), nil
+}
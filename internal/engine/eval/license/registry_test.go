@@ -0,0 +1,218 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package license provides the license compliance evaluator
+package license
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const mitLicenseText = `MIT License
+
+Copyright (c) 2023 Someone
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction.
+`
+
+func TestNpmResolver(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		reply    string
+		status   int
+		expected string
+	}{
+		{
+			name:     "spdx string",
+			reply:    `{"name": "left-pad", "version": "1.3.0", "license": "WTFPL OR MIT"}`,
+			status:   http.StatusOK,
+			expected: "WTFPL OR MIT",
+		},
+		{
+			name:     "legacy object",
+			reply:    `{"name": "left-pad", "version": "1.3.0", "license": {"type": "Apache 2.0"}}`,
+			status:   http.StatusOK,
+			expected: "Apache-2.0",
+		},
+		{
+			name:     "legacy array",
+			reply:    `{"name": "left-pad", "version": "1.3.0", "licenses": [{"type": "MIT"}, {"type": "GPLv2"}]}`,
+			status:   http.StatusOK,
+			expected: "MIT OR GPL-2.0-only",
+		},
+		{
+			name:     "not found",
+			status:   http.StatusNotFound,
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/left-pad/1.3.0", r.URL.Path)
+				w.WriteHeader(tt.status)
+				_, err := w.Write([]byte(tt.reply))
+				assert.NoError(t, err)
+			}))
+			defer server.Close()
+
+			license, err := newNpmResolver(server.URL).Resolve(context.Background(), &pb.Dependency{
+				Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_NPM,
+				Name:      "left-pad",
+				Version:   "1.3.0",
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, license)
+		})
+	}
+}
+
+func TestNpmResolverServerError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	_, err := newNpmResolver(server.URL).Resolve(context.Background(), &pb.Dependency{
+		Name:    "left-pad",
+		Version: "1.3.0",
+	})
+	require.Error(t, err)
+}
+
+func TestPyPIResolver(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		reply    string
+		expected string
+	}{
+		{
+			name:     "license expression",
+			reply:    `{"info": {"license_expression": "Apache-2.0 OR BSD-2-Clause", "license": "whatever"}}`,
+			expected: "Apache-2.0 OR BSD-2-Clause",
+		},
+		{
+			name: "classifiers",
+			reply: `{"info": {"license": "Apache 2.0", "classifiers": [
+				"Development Status :: 5 - Production/Stable",
+				"License :: OSI Approved :: Apache Software License"
+			]}}`,
+			expected: "Apache-2.0",
+		},
+		{
+			name:     "free-form license field",
+			reply:    `{"info": {"license": "MIT License", "classifiers": []}}`,
+			expected: "MIT",
+		},
+		{
+			name:     "license text",
+			reply:    `{"info": {"license": "Permission is hereby granted, free of charge,\nto any person"}}`,
+			expected: "MIT",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/requests/2.31.0/json", r.URL.Path)
+				_, err := w.Write([]byte(tt.reply))
+				assert.NoError(t, err)
+			}))
+			defer server.Close()
+
+			license, err := newPyPIResolver(server.URL).Resolve(context.Background(), &pb.Dependency{
+				Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_PYPI,
+				Name:      "requests",
+				Version:   "2.31.0",
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, license)
+		})
+	}
+}
+
+func TestGoProxyResolver(t *testing.T) {
+	t.Parallel()
+
+	var zipBuf bytes.Buffer
+	zw := zip.NewWriter(&zipBuf)
+	// unlike the proxy URL, the paths inside the module zip are not case-encoded
+	for name, content := range map[string]string{
+		"github.com/BurntSushi/toml@v1.3.2/LICENSE":             mitLicenseText,
+		"github.com/BurntSushi/toml@v1.3.2/internal/LICENSE":    "Apache License Version 2.0",
+		"github.com/BurntSushi/toml@v1.3.2/decode.go":           "package toml",
+		"github.com/BurntSushi/toml@v1.3.2/cmd/tomlv/README.md": "readme",
+	} {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// module paths must be case-encoded when talking to the proxy
+		assert.Equal(t, "/github.com/!burnt!sushi/toml/@v/v1.3.2.zip", r.URL.Path)
+		_, err := w.Write(zipBuf.Bytes())
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	license, err := newGoProxyResolver(server.URL).Resolve(context.Background(), &pb.Dependency{
+		Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_GO,
+		Name:      "github.com/BurntSushi/toml",
+		Version:   "v1.3.2",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "MIT", license)
+}
+
+func TestClassifyLicenseText(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "MIT", classifyLicenseText(mitLicenseText))
+	assert.Equal(t, "LGPL-3.0-only", classifyLicenseText(`GNU LESSER GENERAL PUBLIC LICENSE
+                       Version 3, 29 June 2007
+ This version of the GNU Lesser General Public License incorporates
+the terms and conditions of version 3 of the GNU General Public License`))
+	assert.Equal(t, "BSD-3-Clause", classifyLicenseText(`Redistribution and use in source and binary
+forms, with or without modification, are permitted... Neither the name of the copyright holder
+nor the names of its contributors may be used to endorse or promote products derived`))
+	assert.Equal(t, "", classifyLicenseText("All rights reserved."))
+}
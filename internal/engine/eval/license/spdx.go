@@ -0,0 +1,233 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package license provides the license compliance evaluator
+package license
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+var errInvalidExpression = errors.New("invalid SPDX expression")
+
+const (
+	opAnd = "AND"
+	opOr  = "OR"
+)
+
+// spdxExpr is a parsed SPDX license expression. Leaves carry the license
+// (including any WITH exception), inner nodes carry the operator.
+type spdxExpr struct {
+	op       string
+	license  string
+	children []*spdxExpr
+}
+
+// parseSpdxExpression parses an SPDX license expression as specified in
+// https://spdx.github.io/spdx-spec/v2.3/SPDX-license-expressions/
+// Operators are accepted in any case, because package metadata in the wild
+// often uses lowercase ones.
+func parseSpdxExpression(expr string) (*spdxExpr, error) {
+	p := &spdxParser{tokens: tokenizeSpdx(expr)}
+	if len(p.tokens) == 0 {
+		return nil, fmt.Errorf("%w: empty expression", errInvalidExpression)
+	}
+
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.pos != len(p.tokens) {
+		return nil, fmt.Errorf("%w: unexpected %q", errInvalidExpression, p.tokens[p.pos])
+	}
+
+	return node, nil
+}
+
+func tokenizeSpdx(expr string) []string {
+	var tokens []string
+	var cur strings.Builder
+
+	flush := func() {
+		if cur.Len() > 0 {
+			tokens = append(tokens, cur.String())
+			cur.Reset()
+		}
+	}
+
+	for _, r := range expr {
+		switch {
+		case r == '(' || r == ')':
+			flush()
+			tokens = append(tokens, string(r))
+		case unicode.IsSpace(r):
+			flush()
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	flush()
+
+	return tokens
+}
+
+type spdxParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *spdxParser) peekOperator(op string) bool {
+	return p.pos < len(p.tokens) && strings.EqualFold(p.tokens[p.pos], op)
+}
+
+func (p *spdxParser) parseOr() (*spdxExpr, error) {
+	return p.parseBinary(opOr, p.parseAnd)
+}
+
+func (p *spdxParser) parseAnd() (*spdxExpr, error) {
+	return p.parseBinary(opAnd, p.parseTerm)
+}
+
+func (p *spdxParser) parseBinary(op string, next func() (*spdxExpr, error)) (*spdxExpr, error) {
+	first, err := next()
+	if err != nil {
+		return nil, err
+	}
+
+	children := []*spdxExpr{first}
+	for p.peekOperator(op) {
+		p.pos++
+		child, err := next()
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+	}
+
+	if len(children) == 1 {
+		return first, nil
+	}
+	return &spdxExpr{op: op, children: children}, nil
+}
+
+func (p *spdxParser) parseTerm() (*spdxExpr, error) {
+	if p.pos >= len(p.tokens) {
+		return nil, fmt.Errorf("%w: unexpected end of expression", errInvalidExpression)
+	}
+
+	tok := p.tokens[p.pos]
+	if tok == "(" {
+		p.pos++
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.pos >= len(p.tokens) || p.tokens[p.pos] != ")" {
+			return nil, fmt.Errorf("%w: missing closing parenthesis", errInvalidExpression)
+		}
+		p.pos++
+		return node, nil
+	}
+
+	if !isLicenseID(tok) {
+		return nil, fmt.Errorf("%w: unexpected %q", errInvalidExpression, tok)
+	}
+	p.pos++
+
+	license := tok
+	if p.peekOperator("WITH") {
+		p.pos++
+		if p.pos >= len(p.tokens) || !isLicenseID(p.tokens[p.pos]) {
+			return nil, fmt.Errorf("%w: missing exception after WITH", errInvalidExpression)
+		}
+		license = fmt.Sprintf("%s WITH %s", tok, p.tokens[p.pos])
+		p.pos++
+	}
+
+	return &spdxExpr{license: license}, nil
+}
+
+func isLicenseID(tok string) bool {
+	if tok == "" || strings.EqualFold(tok, opAnd) || strings.EqualFold(tok, opOr) || strings.EqualFold(tok, "WITH") {
+		return false
+	}
+
+	for i, r := range tok {
+		isValid := unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '.' || r == ':'
+		// the "or later" suffix is only valid at the end
+		if r == '+' && i == len(tok)-1 {
+			isValid = true
+		}
+		if !isValid {
+			return false
+		}
+	}
+	return true
+}
+
+// satisfiable returns true if the licensing terms of the expression can be met
+// using only licenses for which permitted returns true
+func (e *spdxExpr) satisfiable(permitted func(string) bool) bool {
+	switch e.op {
+	case opAnd:
+		for _, c := range e.children {
+			if !c.satisfiable(permitted) {
+				return false
+			}
+		}
+		return true
+	case opOr:
+		for _, c := range e.children {
+			if c.satisfiable(permitted) {
+				return true
+			}
+		}
+		return false
+	default:
+		return permitted(e.license)
+	}
+}
+
+// licenses returns all the licenses the expression refers to
+func (e *spdxExpr) licenses() []string {
+	if e.op == "" {
+		return []string{e.license}
+	}
+
+	var out []string
+	for _, c := range e.children {
+		out = append(out, c.licenses()...)
+	}
+	return out
+}
+
+// licenseMatches returns true if the license from a package matches an entry
+// of the profile. An entry without an exception also matches the license with
+// any exception, e.g. GPL-2.0-only matches GPL-2.0-only WITH Classpath-exception-2.0
+func licenseMatches(entry, license string) bool {
+	if strings.EqualFold(entry, license) {
+		return true
+	}
+
+	if strings.Contains(strings.ToUpper(entry), " WITH ") {
+		return false
+	}
+
+	base, _, found := strings.Cut(license, " WITH ")
+	return found && strings.EqualFold(entry, base)
+}
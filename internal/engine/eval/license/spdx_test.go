@@ -0,0 +1,199 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package license provides the license compliance evaluator
+package license
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSpdxExpression(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		expr     string
+		licenses []string
+		wantErr  bool
+	}{
+		{
+			name:     "single license",
+			expr:     "MIT",
+			licenses: []string{"MIT"},
+		},
+		{
+			name:     "or later and exception",
+			expr:     "GPL-2.0+ WITH Classpath-exception-2.0",
+			licenses: []string{"GPL-2.0+ WITH Classpath-exception-2.0"},
+		},
+		{
+			name:     "compound with parentheses and lowercase operators",
+			expr:     "(MIT or Apache-2.0) and BSD-3-Clause",
+			licenses: []string{"MIT", "Apache-2.0", "BSD-3-Clause"},
+		},
+		{
+			name:    "empty",
+			expr:    "  ",
+			wantErr: true,
+		},
+		{
+			name:    "unbalanced parentheses",
+			expr:    "(MIT OR Apache-2.0",
+			wantErr: true,
+		},
+		{
+			name:    "dangling operator",
+			expr:    "MIT AND",
+			wantErr: true,
+		},
+		{
+			name:    "free text",
+			expr:    "SEE LICENSE IN LICENSE.md",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			expr, err := parseSpdxExpression(tt.expr)
+			if tt.wantErr {
+				require.ErrorIs(t, err, errInvalidExpression)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.licenses, expr.licenses())
+		})
+	}
+}
+
+func TestConfigCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		cfg        config
+		expression string
+		violation  bool
+	}{
+		{
+			name:       "allowed license",
+			cfg:        config{Allowed: []string{"MIT", "Apache-2.0"}},
+			expression: "MIT",
+		},
+		{
+			name:       "license not on the allow list",
+			cfg:        config{Allowed: []string{"MIT", "Apache-2.0"}},
+			expression: "GPL-3.0-only",
+			violation:  true,
+		},
+		{
+			name:       "dual licensed, one choice allowed",
+			cfg:        config{Allowed: []string{"MIT"}},
+			expression: "GPL-3.0-only OR MIT",
+		},
+		{
+			name:       "conjunction needs all licenses allowed",
+			cfg:        config{Allowed: []string{"MIT"}},
+			expression: "MIT AND BSD-3-Clause",
+			violation:  true,
+		},
+		{
+			name:       "denied license",
+			cfg:        config{Denied: []string{"AGPL-3.0-only"}},
+			expression: "AGPL-3.0-only",
+			violation:  true,
+		},
+		{
+			name:       "denied license also denies exceptions",
+			cfg:        config{Denied: []string{"GPL-2.0-only"}},
+			expression: "GPL-2.0-only WITH Classpath-exception-2.0",
+			violation:  true,
+		},
+		{
+			name:       "allowed exception",
+			cfg:        config{Allowed: []string{"GPL-2.0-only WITH Classpath-exception-2.0"}},
+			expression: "GPL-2.0-only WITH Classpath-exception-2.0",
+		},
+		{
+			name:       "deny wins over allow",
+			cfg:        config{Allowed: []string{"GPL-3.0-only"}, Denied: []string{"GPL-3.0-only"}},
+			expression: "GPL-3.0-only",
+			violation:  true,
+		},
+		{
+			name:       "unknown license",
+			cfg:        config{Denied: []string{"GPL-3.0-only"}},
+			expression: "",
+			violation:  true,
+		},
+		{
+			name:       "unknown license allowed",
+			cfg:        config{Denied: []string{"GPL-3.0-only"}, AllowUnknown: true},
+			expression: "SEE LICENSE IN LICENSE",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			reason := tt.cfg.check(tt.expression)
+			if tt.violation {
+				assert.NotEmpty(t, reason)
+			} else {
+				assert.Empty(t, reason)
+			}
+		})
+	}
+}
+
+func TestParseConfig(t *testing.T) {
+	t.Parallel()
+
+	ecosystems := []any{
+		map[string]any{
+			"name":               "npm",
+			"package_repository": map[string]any{"url": "https://registry.npmjs.org"},
+		},
+	}
+
+	cfg, err := parseConfig(map[string]any{
+		"action":           "summary",
+		"ecosystem_config": ecosystems,
+		"allowed":          []any{"MIT", "Apache-2.0"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"MIT", "Apache-2.0"}, cfg.Allowed)
+
+	_, err = parseConfig(map[string]any{
+		"action":           "summary",
+		"ecosystem_config": ecosystems,
+	})
+	require.ErrorContains(t, err, "at least one of allowed or denied")
+
+	_, err = parseConfig(map[string]any{
+		"action":           "summary",
+		"ecosystem_config": ecosystems,
+		"denied":           []any{"MIT OR GPL-3.0-only"},
+	})
+	require.ErrorContains(t, err, "compound expression")
+}
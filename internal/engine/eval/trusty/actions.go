@@ -30,6 +30,27 @@ import (
 const (
 	noLowScoresText = "Minder analyzed this PR and found no low scores for any of the dependencies."
 
+	flaggedTmplName     = "flaggedTable"
+	flaggedTemplateBody = `### Summary of flagged packages
+Minder detected that this PR is adding dependencies that were flagged by Trusty. These are reported
+regardless of their score.
+
+<table>
+  <tr>
+    <td> Ecosystem </td>
+    <td> DependencyName </td>
+    <td> Reason </td>
+  </tr>
+  {{ range .Flagged }}
+  <tr>
+    <td>{{ .Ecosystem }}</td>
+    <td><a href="{{ $.BaseUrl }}/{{ .Ecosystem }}/{{ .Name }}" >{{ .Name }}</a></td>
+    <td>{{ .Reasons }}</td>
+  </tr>
+  {{ end }}
+</table>
+`
+
 	tableHeaderTmplName = "alternativesTableHeader"
 	tableTemplateHeader = `### Summary of packages with low scores
 Minder detected that this PR is adding dependencies whose score is lower than the threshold configured with
@@ -64,6 +85,31 @@ type dependencyAlternatives struct {
 	trustyReply *Reply
 }
 
+type flaggedDependency struct {
+	Dependency *pb.Dependency
+	Reasons    []string
+}
+
+// reportHandler collects the packages the evaluator found lacking and reports
+// them once all the dependencies were checked
+type reportHandler interface {
+	trackAlternatives(dep *pb.PrDependencies_ContextualDependency, trustyReply *Reply)
+	trackFlagged(dep *pb.PrDependencies_ContextualDependency, reasons []string)
+	submit(ctx context.Context) error
+}
+
+// profileOnlyHandler is a reportHandler that doesn't report anything, the
+// evaluation result is only reflected in the profile status
+type profileOnlyHandler struct{}
+
+func (profileOnlyHandler) trackAlternatives(_ *pb.PrDependencies_ContextualDependency, _ *Reply) {}
+
+func (profileOnlyHandler) trackFlagged(_ *pb.PrDependencies_ContextualDependency, _ []string) {}
+
+func (profileOnlyHandler) submit(_ context.Context) error {
+	return nil
+}
+
 // summaryPrHandler is a prStatusHandler that adds a summary text to the PR as a comment.
 type summaryPrHandler struct {
 	cli       provifv1.GitHub
@@ -71,8 +117,10 @@ type summaryPrHandler struct {
 	trustyUrl string
 
 	trackedAlternatives []dependencyAlternatives
+	trackedFlagged      []flaggedDependency
 	headerTmpl          *template.Template
 	rowsTmpl            *template.Template
+	flaggedTmpl         *template.Template
 }
 
 func (sph *summaryPrHandler) trackAlternatives(
@@ -85,6 +133,16 @@ func (sph *summaryPrHandler) trackAlternatives(
 	})
 }
 
+func (sph *summaryPrHandler) trackFlagged(
+	dep *pb.PrDependencies_ContextualDependency,
+	reasons []string,
+) {
+	sph.trackedFlagged = append(sph.trackedFlagged, flaggedDependency{
+		Dependency: dep.Dep,
+		Reasons:    reasons,
+	})
+}
+
 func (sph *summaryPrHandler) submit(ctx context.Context) error {
 	summary, err := sph.generateSummary()
 	if err != nil {
@@ -101,11 +159,21 @@ func (sph *summaryPrHandler) submit(ctx context.Context) error {
 
 func (sph *summaryPrHandler) generateSummary() (string, error) {
 	var summary strings.Builder
-	if len(sph.trackedAlternatives) == 0 {
+	if len(sph.trackedAlternatives) == 0 && len(sph.trackedFlagged) == 0 {
 		summary.WriteString(noLowScoresText)
 		return summary.String(), nil
 	}
 
+	if len(sph.trackedFlagged) > 0 {
+		if err := sph.generateFlaggedTable(&summary); err != nil {
+			return "", err
+		}
+	}
+
+	if len(sph.trackedAlternatives) == 0 {
+		return summary.String(), nil
+	}
+
 	var headerBuf bytes.Buffer
 	if err := sph.headerTmpl.Execute(&headerBuf, nil); err != nil {
 		return "", fmt.Errorf("could not execute template: %w", err)
@@ -144,6 +212,37 @@ func (sph *summaryPrHandler) generateSummary() (string, error) {
 	return summary.String(), nil
 }
 
+func (sph *summaryPrHandler) generateFlaggedTable(summary *strings.Builder) error {
+	type flaggedRow struct {
+		Ecosystem string
+		Name      string
+		Reasons   string
+	}
+
+	rows := make([]flaggedRow, 0, len(sph.trackedFlagged))
+	for _, f := range sph.trackedFlagged {
+		rows = append(rows, flaggedRow{
+			Ecosystem: strings.ToLower(f.Dependency.Ecosystem.AsString()),
+			Name:      f.Dependency.Name,
+			Reasons:   strings.Join(f.Reasons, ", "),
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := sph.flaggedTmpl.Execute(&buf, struct {
+		Flagged []flaggedRow
+		BaseUrl string
+	}{
+		Flagged: rows,
+		BaseUrl: constants.TrustyHttpURL,
+	}); err != nil {
+		return fmt.Errorf("could not execute template: %w", err)
+	}
+	summary.WriteString(buf.String())
+
+	return nil
+}
+
 func newSummaryPrHandler(
 	pr *pb.PullRequest,
 	cli provifv1.GitHub,
@@ -157,6 +256,10 @@ func newSummaryPrHandler(
 	if err != nil {
 		return nil, fmt.Errorf("could not parse vulnerability template: %w", err)
 	}
+	flaggedTmpl, err := template.New(flaggedTmplName).Parse(flaggedTemplateBody)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse flagged packages template: %w", err)
+	}
 
 	return &summaryPrHandler{
 		cli:                 cli,
@@ -164,6 +267,7 @@ func newSummaryPrHandler(
 		trustyUrl:           trustyUrl,
 		headerTmpl:          headerTmpl,
 		rowsTmpl:            rowsTmpl,
+		flaggedTmpl:         flaggedTmpl,
 		trackedAlternatives: make([]dependencyAlternatives, 0),
 	}, nil
 }
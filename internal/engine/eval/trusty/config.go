@@ -37,6 +37,14 @@ type ecosystemConfig struct {
 type config struct {
 	Action          pr_actions.Action `json:"action" mapstructure:"action" validate:"required"`
 	EcosystemConfig []ecosystemConfig `json:"ecosystem_config" mapstructure:"ecosystem_config" validate:"required"`
+	// DenyDeprecated flags packages that were deprecated by their maintainers
+	DenyDeprecated bool `json:"deny_deprecated" mapstructure:"deny_deprecated"`
+	// DenyArchived flags packages whose source repository is archived
+	DenyArchived bool `json:"deny_archived" mapstructure:"deny_archived"`
+	// DenyTyposquats flags packages whose name likely imitates a popular package
+	DenyTyposquats bool `json:"deny_typosquats" mapstructure:"deny_typosquats"`
+	// FailOnUnknown flags packages trusty has no data or score for instead of skipping them
+	FailOnUnknown bool `json:"fail_on_unknown" mapstructure:"fail_on_unknown"`
 }
 
 func parseConfig(ruleCfg map[string]any) (*config, error) {
@@ -73,3 +81,29 @@ func (c *config) getEcosystemConfig(ecosystem pb.DepEcosystem) *ecosystemConfig
 
 	return nil
 }
+
+// typosquatThreshold is the trusty typosquatting score below which a package
+// is considered to imitate the name of a popular package
+const typosquatThreshold = 3.0
+
+// flagReasons returns the reasons, if any, why a package should be flagged
+// regardless of its score. Malicious packages are always flagged.
+func (c *config) flagReasons(reply *Reply) []string {
+	var reasons []string
+
+	if reply.PackageData.Malicious != nil {
+		reasons = append(reasons, "malicious")
+	}
+	if c.DenyDeprecated && reply.PackageData.Deprecated {
+		reasons = append(reasons, "deprecated")
+	}
+	if c.DenyArchived && reply.PackageData.Archived {
+		reasons = append(reasons, "archived")
+	}
+	typosquatting := reply.Summary.Description.Typosquatting
+	if c.DenyTyposquats && typosquatting > 0 && typosquatting < typosquatThreshold {
+		reasons = append(reasons, "a typosquat candidate")
+	}
+
+	return reasons
+}
@@ -18,9 +18,11 @@ package trusty
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/rs/zerolog"
 
+	evalerrors "github.com/stacklok/minder/internal/engine/errors"
 	"github.com/stacklok/minder/internal/engine/eval/pr_actions"
 	engif "github.com/stacklok/minder/internal/engine/interfaces"
 	"github.com/stacklok/minder/internal/providers"
@@ -63,17 +65,24 @@ func NewTrustyEvaluator(
 	}, nil
 }
 
-// Eval implements the Evaluator interface.
+// Eval implements the Evaluator interface. It accepts both the dependencies
+// added in a pull request and the dependency inventory of a repository, only
+// the former gets a summary comment.
 func (e *Evaluator) Eval(ctx context.Context, pol map[string]any, res *engif.Result) error {
-	var evalErr error
-
-	//nolint:govet
-	prdeps, ok := res.Object.(pb.PrDependencies)
-	if !ok {
-		return fmt.Errorf("invalid object type for vulncheck evaluator")
+	var deps []*pb.PrDependencies_ContextualDependency
+	var pr *pb.PullRequest
+
+	switch obj := res.Object.(type) {
+	case pb.PrDependencies:
+		deps = obj.GetDeps()
+		pr = obj.GetPr()
+	case pb.RepoDependencies:
+		deps = obj.GetDeps()
+	default:
+		return fmt.Errorf("invalid object type for trusty evaluator")
 	}
 
-	if len(prdeps.Deps) == 0 {
+	if len(deps) == 0 {
 		return nil
 	}
 
@@ -86,15 +95,12 @@ func (e *Evaluator) Eval(ctx context.Context, pol map[string]any, res *engif.Res
 		return fmt.Errorf("action %s is not implemented", ruleConfig.Action)
 	}
 
-	logger := zerolog.Ctx(ctx).With().
-		Int32("pull-number", prdeps.Pr.Number).
-		Str("repo-owner", prdeps.Pr.RepoOwner).
-		Str("repo-name", prdeps.Pr.RepoName).
-		Logger()
-
-	prSummaryHandler, err := newSummaryPrHandler(prdeps.Pr, e.cli, e.endpoint)
-	if err != nil {
-		return fmt.Errorf("failed to create summary handler: %w", err)
+	var handler reportHandler = &profileOnlyHandler{}
+	if pr != nil && ruleConfig.Action == pr_actions.ActionSummary {
+		handler, err = newSummaryPrHandler(pr, e.cli, e.endpoint)
+		if err != nil {
+			return fmt.Errorf("failed to create summary handler: %w", err)
+		}
 	}
 
 	piCli := newPiClient(e.endpoint)
@@ -102,7 +108,39 @@ func (e *Evaluator) Eval(ctx context.Context, pol map[string]any, res *engif.Res
 		return fmt.Errorf("failed to create pi client")
 	}
 
-	for _, dep := range prdeps.Deps {
+	failures, err := checkDependencies(ctx, piCli, ruleConfig, deps, handler)
+	if err != nil {
+		return err
+	}
+
+	if err := handler.submit(ctx); err != nil {
+		return fmt.Errorf("failed to submit summary: %w", err)
+	}
+
+	if len(failures) > 0 {
+		return evalerrors.NewErrEvaluationFailed("%s", strings.Join(failures, ", "))
+	}
+
+	return nil
+}
+
+func checkDependencies(
+	ctx context.Context,
+	piCli *trustyClient,
+	ruleConfig *config,
+	deps []*pb.PrDependencies_ContextualDependency,
+	handler reportHandler,
+) ([]string, error) {
+	logger := zerolog.Ctx(ctx)
+	// trusty scores packages, not versions, so each package is only checked once
+	checked := make(map[string]bool)
+	var failures []string
+
+	for _, dep := range deps {
+		if dep.GetDep() == nil {
+			continue
+		}
+
 		ecoConfig := ruleConfig.getEcosystemConfig(dep.Dep.Ecosystem)
 		if ecoConfig == nil {
 			logger.Info().
@@ -112,22 +150,45 @@ func (e *Evaluator) Eval(ctx context.Context, pol map[string]any, res *engif.Res
 			continue
 		}
 
+		key := fmt.Sprintf("%s/%s", ecoConfig.Name, dep.Dep.Name)
+		if checked[key] {
+			continue
+		}
+		checked[key] = true
+
 		resp, err := piCli.SendRecvRequest(ctx, dep.Dep)
 		if err != nil {
-			return fmt.Errorf("failed to send request: %w", err)
+			return nil, fmt.Errorf("failed to send request: %w", err)
 		}
 
-		if resp == nil || resp.PackageName == "" {
-			logger.Info().
-				Str("dependency", dep.Dep.Name).
-				Msgf("no trusty data for dependency, skipping")
-			continue
+		// the flags are reported whether or not trusty scored the package,
+		// a malicious package may not have a score yet
+		flagged := false
+		if resp != nil {
+			if reasons := ruleConfig.flagReasons(resp); len(reasons) > 0 {
+				logger.Debug().
+					Str("dependency", dep.Dep.Name).
+					Strs("reasons", reasons).
+					Msgf("the dependency was flagged, tracking")
+
+				failures = append(failures, fmt.Sprintf("%s is %s", dep.Dep.Name, strings.Join(reasons, " and ")))
+				handler.trackFlagged(dep, reasons)
+				flagged = true
+			}
 		}
 
-		if resp.Summary.Score == 0 {
-			logger.Info().
-				Str("dependency", dep.Dep.Name).
-				Msgf("the dependency has no score, skipping")
+		if resp == nil || resp.PackageName == "" || resp.Summary.Score == 0 {
+			if flagged {
+				continue
+			}
+			if !ruleConfig.FailOnUnknown {
+				logger.Info().
+					Str("dependency", dep.Dep.Name).
+					Msgf("no trusty data or score for dependency, skipping")
+				continue
+			}
+			failures = append(failures, fmt.Sprintf("%s is unknown to trusty", dep.Dep.Name))
+			handler.trackFlagged(dep, []string{"unknown to Trusty"})
 			continue
 		}
 
@@ -146,19 +207,15 @@ func (e *Evaluator) Eval(ctx context.Context, pol map[string]any, res *engif.Res
 			Float64("threshold", ecoConfig.Score).
 			Msgf("the dependency has lower score than threshold, tracking")
 
-		evalErr = fmt.Errorf("score for %s is %f is lower than threshold %f",
-			dep.Dep.Name, resp.Summary.Score, ecoConfig.Score)
+		failures = append(failures, fmt.Sprintf("score for %s is %f is lower than threshold %f",
+			dep.Dep.Name, resp.Summary.Score, ecoConfig.Score))
 
-		prSummaryHandler.trackAlternatives(dep, resp)
-	}
-
-	if err := prSummaryHandler.submit(ctx); err != nil {
-		return fmt.Errorf("failed to submit summary: %w", err)
+		handler.trackAlternatives(dep, resp)
 	}
 
-	return evalErr
+	return failures, nil
 }
 
 func isActionImplemented(action pr_actions.Action) bool {
-	return action == pr_actions.ActionSummary
+	return action == pr_actions.ActionSummary || action == pr_actions.ActionProfileOnly
 }
@@ -58,13 +58,31 @@ type Alternative struct {
 	Score       float64 `json:"score"`
 }
 
+// Malicious describes why the package intelligence API considers a package malicious
+type Malicious struct {
+	Summary   string `json:"summary"`
+	Details   string `json:"details"`
+	Published string `json:"published"`
+	Source    string `json:"source"`
+}
+
 // Reply is the response from the package intelligence API
 type Reply struct {
 	PackageName string `json:"package_name"`
 	PackageType string `json:"package_type"`
 	Summary     struct {
-		Score float64 `json:"score"`
+		Score       float64 `json:"score"`
+		Description struct {
+			// Typosquatting is a score from 0 to 5, the lower the score the more
+			// likely it is that the name imitates a popular package
+			Typosquatting float64 `json:"typosquatting"`
+		} `json:"description"`
 	} `json:"summary"`
+	PackageData struct {
+		Archived   bool       `json:"archived"`
+		Deprecated bool       `json:"is_deprecated"`
+		Malicious  *Malicious `json:"malicious"`
+	} `json:"package_data"`
 	Alternatives struct {
 		Status   string        `json:"status"`
 		Packages []Alternative `json:"packages"`
@@ -84,14 +102,15 @@ func (p *trustyClient) newRequest(ctx context.Context, dep *pb.Dependency) (*htt
 		return nil, fmt.Errorf("could not parse endpoint: %w", err)
 	}
 
-	req, err := http.NewRequest("GET", u.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
 	if err != nil {
 		return nil, fmt.Errorf("could not create request: %w", err)
 	}
-	req = req.WithContext(ctx)
 	return req, nil
 }
 
+// SendRecvRequest queries the package intelligence API for a dependency. A nil
+// reply without an error means that the package is not known to the API.
 func (p *trustyClient) SendRecvRequest(ctx context.Context, dep *pb.Dependency) (*Reply, error) {
 	req, err := p.newRequest(ctx, dep)
 	if err != nil {
@@ -104,6 +123,11 @@ func (p *trustyClient) SendRecvRequest(ctx context.Context, dep *pb.Dependency)
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		// the package is unknown to trusty
+		return nil, nil
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("received non-200 response: %d", resp.StatusCode)
 	}
@@ -0,0 +1,116 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package trusty provides an evaluator that uses the trusty API
+package trusty
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestSendRecvRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		status  int
+		reply   string
+		check   func(t *testing.T, reply *Reply)
+		wantErr bool
+	}{
+		{
+			name:   "full reply",
+			status: http.StatusOK,
+			reply: `{
+  "package_name": "colors",
+  "package_type": "npm",
+  "summary": {"score": 4.2, "description": {"typosquatting": 5.0}},
+  "package_data": {
+    "archived": true,
+    "is_deprecated": true,
+    "malicious": {"summary": "protestware", "source": "osv"}
+  },
+  "alternatives": {"status": "ok", "packages": [{"package_name": "chalk", "score": 8.1}]}
+}`,
+			check: func(t *testing.T, reply *Reply) {
+				t.Helper()
+				require.NotNil(t, reply)
+				assert.Equal(t, "colors", reply.PackageName)
+				assert.Equal(t, 4.2, reply.Summary.Score)
+				assert.Equal(t, 5.0, reply.Summary.Description.Typosquatting)
+				assert.True(t, reply.PackageData.Archived)
+				assert.True(t, reply.PackageData.Deprecated)
+				require.NotNil(t, reply.PackageData.Malicious)
+				assert.Equal(t, "protestware", reply.PackageData.Malicious.Summary)
+				assert.Equal(t, []Alternative{{PackageName: "chalk", Score: 8.1}}, reply.Alternatives.Packages)
+			},
+		},
+		{
+			name:   "unknown package",
+			status: http.StatusNotFound,
+			check: func(t *testing.T, reply *Reply) {
+				t.Helper()
+				assert.Nil(t, reply)
+			},
+		},
+		{
+			name:    "server error",
+			status:  http.StatusInternalServerError,
+			wantErr: true,
+		},
+		{
+			name:    "malformed reply",
+			status:  http.StatusOK,
+			reply:   "{",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/pi/v1/report", r.URL.Path)
+				assert.Equal(t, "colors", r.URL.Query().Get("package_name"))
+				assert.Equal(t, "npm", r.URL.Query().Get("package_type"))
+				w.WriteHeader(tt.status)
+				_, err := w.Write([]byte(tt.reply))
+				assert.NoError(t, err)
+			}))
+			defer server.Close()
+
+			reply, err := newPiClient(server.URL).SendRecvRequest(context.Background(), &pb.Dependency{
+				Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_NPM,
+				Name:      "colors",
+				Version:   "1.4.44-liberty-2",
+			})
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			tt.check(t, reply)
+		})
+	}
+}
@@ -0,0 +1,232 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package trusty provides an evaluator that uses the trusty API
+package trusty
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	evalerrors "github.com/stacklok/minder/internal/engine/errors"
+	engif "github.com/stacklok/minder/internal/engine/interfaces"
+	mockgh "github.com/stacklok/minder/internal/providers/github/mock"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// newTrustyStandIn returns a server that answers for the packages in replies
+// and with a 404 for any other package
+func newTrustyStandIn(t *testing.T, replies map[string]string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reply, ok := replies[r.URL.Query().Get("package_name")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, err := w.Write([]byte(reply))
+		assert.NoError(t, err)
+	}))
+	// the subtests run in parallel, so the server must outlive the test function
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func npmDep(name string) *pb.PrDependencies_ContextualDependency {
+	return &pb.PrDependencies_ContextualDependency{
+		Dep: &pb.Dependency{
+			Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_NPM,
+			Name:      name,
+			Version:   "1.0.0",
+		},
+		File: &pb.PrDependencies_ContextualDependency_FilePatch{
+			Name: "package-lock.json",
+		},
+	}
+}
+
+func TestEvalRepoDependencies(t *testing.T) {
+	t.Parallel()
+
+	server := newTrustyStandIn(t, map[string]string{
+		"good":       `{"package_name": "good", "summary": {"score": 8.0, "description": {"typosquatting": 5.0}}}`,
+		"deprecated": `{"package_name": "deprecated", "summary": {"score": 8.0}, "package_data": {"is_deprecated": true}}`,
+		"archived":   `{"package_name": "archived", "summary": {"score": 8.0}, "package_data": {"archived": true}}`,
+		"typosquat":  `{"package_name": "typosquat", "summary": {"score": 8.0, "description": {"typosquatting": 1.2}}}`,
+		"malicious":  `{"package_name": "malicious", "summary": {"score": 8.0}, "package_data": {"malicious": {"summary": "steals tokens"}}}`,
+		"low":        `{"package_name": "low", "summary": {"score": 2.0}}`,
+		"unscored":   `{"package_name": "unscored", "summary": {"score": 0}, "package_data": {"malicious": {"summary": "steals tokens"}}}`,
+	})
+
+	tests := []struct {
+		name     string
+		deps     []string
+		settings map[string]any
+		failure  string
+	}{
+		{
+			name: "no issues",
+			deps: []string{"good", "good"},
+		},
+		{
+			name:    "malicious is always flagged",
+			deps:    []string{"good", "malicious"},
+			failure: "malicious is malicious",
+		},
+		{
+			name:    "malicious is flagged without a score",
+			deps:    []string{"unscored"},
+			failure: "unscored is malicious",
+		},
+		{
+			name: "opt-in flags are ignored by default",
+			deps: []string{"deprecated", "archived", "typosquat"},
+		},
+		{
+			name: "opt-in flags",
+			deps: []string{"deprecated", "archived", "typosquat"},
+			settings: map[string]any{
+				"deny_deprecated": true,
+				"deny_archived":   true,
+				"deny_typosquats": true,
+			},
+			failure: "deprecated is deprecated, archived is archived, typosquat is a typosquat candidate",
+		},
+		{
+			name:    "low score",
+			deps:    []string{"low"},
+			failure: "score for low",
+		},
+		{
+			name: "unknown packages are skipped by default",
+			deps: []string{"unknown"},
+		},
+		{
+			name:     "fail on unknown",
+			deps:     []string{"unknown"},
+			settings: map[string]any{"fail_on_unknown": true},
+			failure:  "unknown is unknown to trusty",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pol := map[string]any{
+				"action": "profile_only",
+				"ecosystem_config": []any{
+					map[string]any{"name": "npm", "score": 5.0},
+				},
+			}
+			for k, v := range tt.settings {
+				pol[k] = v
+			}
+
+			deps := make([]*pb.PrDependencies_ContextualDependency, 0, len(tt.deps))
+			for _, d := range tt.deps {
+				deps = append(deps, npmDep(d))
+			}
+
+			// no GitHub calls are expected for a repository
+			e := &Evaluator{endpoint: server.URL}
+			err := e.Eval(context.Background(), pol, &engif.Result{
+				Object: pb.RepoDependencies{
+					Repo:   &pb.Repository{Owner: "stacklok", Name: "minder"},
+					Branch: "main",
+					Deps:   deps,
+				},
+			})
+			if tt.failure == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, evalerrors.ErrEvaluationFailed)
+			assert.Contains(t, err.Error(), tt.failure)
+		})
+	}
+}
+
+func TestEvalPrSummary(t *testing.T) {
+	t.Parallel()
+
+	server := newTrustyStandIn(t, map[string]string{
+		"malicious": `{"package_name": "malicious", "summary": {"score": 8.0}, "package_data": {"malicious": {"summary": "steals tokens"}}}`,
+		"low": `{"package_name": "low", "summary": {"score": 2.0},
+			"alternatives": {"packages": [{"package_name": "better", "score": 9.0}]}}`,
+	})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pr := &pb.PullRequest{
+		Number:    1,
+		RepoOwner: "stacklok",
+		RepoName:  "minder",
+	}
+
+	var comment string
+	mockGh := mockgh.NewMockGitHub(ctrl)
+	mockGh.EXPECT().
+		CreateComment(gomock.Any(), pr.RepoOwner, pr.RepoName, int(pr.Number), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, _ int, body string) error {
+			comment = body
+			return nil
+		})
+
+	e := &Evaluator{cli: mockGh, endpoint: server.URL}
+	err := e.Eval(context.Background(), map[string]any{
+		"action": "summary",
+		"ecosystem_config": []any{
+			map[string]any{"name": "npm", "score": 5.0},
+		},
+		"fail_on_unknown": true,
+	}, &engif.Result{
+		Object: pb.PrDependencies{
+			Pr:   pr,
+			Deps: []*pb.PrDependencies_ContextualDependency{npmDep("malicious"), npmDep("low"), npmDep("unknown")},
+		},
+	})
+	require.ErrorIs(t, err, evalerrors.ErrEvaluationFailed)
+
+	assert.Contains(t, comment, "### Summary of flagged packages")
+	assert.Contains(t, comment, "<td>malicious</td>")
+	assert.Contains(t, comment, "<td>unknown to Trusty</td>")
+	assert.Contains(t, comment, "### Summary of packages with low scores")
+	assert.Contains(t, comment, ">better</a>")
+}
+
+func TestFlagReasons(t *testing.T) {
+	t.Parallel()
+
+	var reply Reply
+	require.NoError(t, json.Unmarshal([]byte(`{
+  "summary": {"score": 1.0, "description": {"typosquatting": 0}},
+  "package_data": {"is_deprecated": true}
+}`), &reply))
+
+	cfg := config{DenyDeprecated: true, DenyTyposquats: true}
+	// a missing typosquatting score is not a typosquat
+	assert.Equal(t, []string{"deprecated"}, cfg.flagReasons(&reply))
+}
@@ -33,6 +33,11 @@ type prStatusHandler interface {
 		vulnResp *VulnerabilityResponse,
 		patch patchLocatorFormatter,
 	) error
+	trackSuppressedDep(
+		ctx context.Context,
+		dep *pb.PrDependencies_ContextualDependency,
+		suppressed []suppressedVulnerability,
+	) error
 	submit(ctx context.Context) error
 }
 
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/mitchellh/mapstructure"
@@ -47,10 +48,44 @@ type ecosystemConfig struct {
 	SumRepository     packageRepository `json:"sum_repository" mapstructure:"sum_repository" validate:"required"`
 }
 
+// ignoredVulnerability is a vulnerability that was accepted as a risk, optionally
+// only until the expiry date.
+type ignoredVulnerability struct {
+	ID      string `json:"id" mapstructure:"id" validate:"required"`
+	Reason  string `json:"reason" mapstructure:"reason" validate:"required"`
+	Expires string `json:"expires,omitempty" mapstructure:"expires"`
+
+	// parsed from Expires after the config is decoded
+	expiresAt time.Time
+}
+
+// allowedPackage is a package whose vulnerabilities are never reported. If
+// Version is empty, all versions of the package are allowed.
+type allowedPackage struct {
+	Ecosystem string `json:"ecosystem" mapstructure:"ecosystem" validate:"required"`
+	Name      string `json:"name" mapstructure:"name" validate:"required"`
+	Version   string `json:"version,omitempty" mapstructure:"version"`
+	Reason    string `json:"reason" mapstructure:"reason" validate:"required"`
+}
+
+// vulnerabilityFilter configures which of the vulnerabilities found are
+// actually reported. Everything that is filtered out is still shown as
+// suppressed in the PR feedback, along with the reason.
+type vulnerabilityFilter struct {
+	//nolint:lll
+	MinSeverity string `json:"min_severity,omitempty" mapstructure:"min_severity" validate:"omitempty,oneof=low medium moderate high critical"`
+	//nolint:lll
+	MinCvssScore     float64                `json:"min_cvss_score,omitempty" mapstructure:"min_cvss_score" validate:"gte=0,lte=10"`
+	OnlyFixAvailable bool                   `json:"only_if_fix_available,omitempty" mapstructure:"only_if_fix_available"`
+	Ignore           []ignoredVulnerability `json:"ignore,omitempty" mapstructure:"ignore" validate:"dive"`
+	AllowedPackages  []allowedPackage       `json:"allowed_packages,omitempty" mapstructure:"allowed_packages" validate:"dive"`
+}
+
 // config is the configuration for the vulncheck evaluator
 type config struct {
-	Action          pr_actions.Action `json:"action" mapstructure:"action" validate:"required"`
-	EcosystemConfig []ecosystemConfig `json:"ecosystem_config" mapstructure:"ecosystem_config" validate:"required"`
+	Action          pr_actions.Action   `json:"action" mapstructure:"action" validate:"required"`
+	EcosystemConfig []ecosystemConfig   `json:"ecosystem_config" mapstructure:"ecosystem_config" validate:"required"`
+	Filter          vulnerabilityFilter `json:"filter" mapstructure:"filter"`
 }
 
 func parseConfig(ruleCfg map[string]any) (*config, error) {
@@ -69,9 +104,32 @@ func parseConfig(ruleCfg map[string]any) (*config, error) {
 		return nil, fmt.Errorf("config failed validation: %w", err)
 	}
 
+	for i := range conf.Filter.Ignore {
+		ign := &conf.Filter.Ignore[i]
+		if ign.Expires == "" {
+			continue
+		}
+
+		expiresAt, err := parseExpiry(ign.Expires)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expiry for ignored vulnerability %s: %w", ign.ID, err)
+		}
+		ign.expiresAt = expiresAt
+	}
+
 	return &conf, nil
 }
 
+// parseExpiry accepts either a plain date, in which case the ignore is valid
+// until the end of that day (UTC), or a full RFC3339 timestamp.
+func parseExpiry(expires string) (time.Time, error) {
+	if t, err := time.Parse(time.DateOnly, expires); err == nil {
+		return t.AddDate(0, 0, 1), nil
+	}
+
+	return time.Parse(time.RFC3339, expires)
+}
+
 func (c *config) getEcosystemConfig(ecosystem pb.DepEcosystem) *ecosystemConfig {
 	sEco := ecosystem.AsString()
 	if sEco == "" {
@@ -0,0 +1,130 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vulncheck provides the vulnerability check evaluator
+package vulncheck
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+var (
+	cvssAttackVector       = map[string]float64{"N": 0.85, "A": 0.62, "L": 0.55, "P": 0.2}
+	cvssAttackComplexity   = map[string]float64{"L": 0.77, "H": 0.44}
+	cvssPrivilegesUnscoped = map[string]float64{"N": 0.85, "L": 0.62, "H": 0.27}
+	cvssPrivilegesScoped   = map[string]float64{"N": 0.85, "L": 0.68, "H": 0.5}
+	cvssUserInteraction    = map[string]float64{"N": 0.85, "R": 0.62}
+	cvssImpact             = map[string]float64{"H": 0.56, "L": 0.22, "N": 0}
+)
+
+// cvssV3BaseScore computes the base score from a CVSS v3.0 or v3.1 vector string
+// as specified in https://www.first.org/cvss/v3.1/specification-document
+func cvssV3BaseScore(vector string) (float64, error) {
+	parts := strings.Split(vector, "/")
+	if len(parts) < 2 || !strings.HasPrefix(parts[0], "CVSS:3") {
+		return 0, fmt.Errorf("not a CVSS v3 vector: %s", vector)
+	}
+
+	metrics := make(map[string]string, len(parts)-1)
+	for _, part := range parts[1:] {
+		key, value, found := strings.Cut(part, ":")
+		if !found {
+			return 0, fmt.Errorf("malformed metric %s", part)
+		}
+		metrics[key] = value
+	}
+
+	lookup := func(table map[string]float64, key string) (float64, error) {
+		val, ok := table[metrics[key]]
+		if !ok {
+			return 0, fmt.Errorf("missing or invalid metric %s", key)
+		}
+		return val, nil
+	}
+
+	scope := metrics["S"]
+	if scope != "U" && scope != "C" {
+		return 0, fmt.Errorf("missing or invalid metric S")
+	}
+
+	privileges := cvssPrivilegesUnscoped
+	if scope == "C" {
+		privileges = cvssPrivilegesScoped
+	}
+
+	var values [7]float64
+	for i, m := range []struct {
+		table map[string]float64
+		key   string
+	}{
+		{cvssAttackVector, "AV"},
+		{cvssAttackComplexity, "AC"},
+		{privileges, "PR"},
+		{cvssUserInteraction, "UI"},
+		{cvssImpact, "C"},
+		{cvssImpact, "I"},
+		{cvssImpact, "A"},
+	} {
+		val, err := lookup(m.table, m.key)
+		if err != nil {
+			return 0, err
+		}
+		values[i] = val
+	}
+
+	iss := 1 - (1-values[4])*(1-values[5])*(1-values[6])
+	var impact float64
+	if scope == "U" {
+		impact = 6.42 * iss
+	} else {
+		impact = 7.52*(iss-0.029) - 3.25*math.Pow(iss-0.02, 15)
+	}
+	if impact <= 0 {
+		return 0, nil
+	}
+
+	exploitability := 8.22 * values[0] * values[1] * values[2] * values[3]
+	if scope == "U" {
+		return cvssRoundUp(math.Min(impact+exploitability, 10)), nil
+	}
+	return cvssRoundUp(math.Min(1.08*(impact+exploitability), 10)), nil
+}
+
+// cvssRoundUp rounds up to one decimal place, avoiding floating point
+// artifacts the way the CVSS v3.1 specification recommends
+func cvssRoundUp(val float64) float64 {
+	intInput := int64(math.Round(val * 100000))
+	if intInput%10000 == 0 {
+		return float64(intInput) / 100000.0
+	}
+	return (math.Floor(float64(intInput)/10000) + 1) / 10.0
+}
+
+// severityFromCvssScore maps a CVSS score to the qualitative rating scale
+func severityFromCvssScore(score float64) string {
+	switch {
+	case score >= 9.0:
+		return "critical"
+	case score >= 7.0:
+		return "high"
+	case score >= 4.0:
+		return "medium"
+	case score > 0:
+		return "low"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vulncheck provides the vulnerability check evaluator
+package vulncheck
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var severityRank = map[string]int{
+	"low":      1,
+	"medium":   2,
+	"moderate": 2,
+	"high":     3,
+	"critical": 4,
+}
+
+// suppressedVulnerability is a vulnerability that was found, but not reported
+// because of the filter in the rule configuration
+type suppressedVulnerability struct {
+	Vulnerability
+	Reason string
+}
+
+type dependencySuppressions struct {
+	Dependency *pb.Dependency
+	Suppressed []suppressedVulnerability
+}
+
+// apply splits the vulnerabilities found for a dependency into those that should
+// be reported and those that were suppressed by the filter.
+func (f *vulnerabilityFilter) apply(
+	dep *pb.Dependency,
+	vulns []Vulnerability,
+	now time.Time,
+) ([]Vulnerability, []suppressedVulnerability) {
+	if allowed := f.allowedPackage(dep); allowed != nil {
+		suppressed := make([]suppressedVulnerability, 0, len(vulns))
+		for _, v := range vulns {
+			suppressed = append(suppressed, suppressedVulnerability{
+				Vulnerability: v,
+				Reason:        fmt.Sprintf("package is allowed: %s", allowed.Reason),
+			})
+		}
+		return nil, suppressed
+	}
+
+	var kept []Vulnerability
+	var suppressed []suppressedVulnerability
+	for _, v := range vulns {
+		if reason := f.suppressionReason(&v, now); reason != "" {
+			suppressed = append(suppressed, suppressedVulnerability{
+				Vulnerability: v,
+				Reason:        reason,
+			})
+			continue
+		}
+		kept = append(kept, v)
+	}
+
+	return kept, suppressed
+}
+
+func (f *vulnerabilityFilter) allowedPackage(dep *pb.Dependency) *allowedPackage {
+	for i := range f.AllowedPackages {
+		ap := &f.AllowedPackages[i]
+		if !strings.EqualFold(ap.Ecosystem, dep.Ecosystem.AsString()) || ap.Name != dep.Name {
+			continue
+		}
+		if ap.Version != "" && ap.Version != dep.Version {
+			continue
+		}
+		return ap
+	}
+	return nil
+}
+
+// suppressionReason returns a human-readable reason why the vulnerability
+// should not be reported or an empty string if it should be reported.
+func (f *vulnerabilityFilter) suppressionReason(v *Vulnerability, now time.Time) string {
+	for _, ign := range f.Ignore {
+		if ign.ID != v.ID && !slices.Contains(v.Aliases, ign.ID) {
+			continue
+		}
+		if !ign.expiresAt.IsZero() && !now.Before(ign.expiresAt) {
+			// the risk acceptance expired, the vulnerability is reported again
+			continue
+		}
+		if ign.Expires != "" {
+			return fmt.Sprintf("ignored until %s: %s", ign.Expires, ign.Reason)
+		}
+		return fmt.Sprintf("ignored: %s", ign.Reason)
+	}
+
+	if f.OnlyFixAvailable && v.Fixed == "" {
+		return "no fix available"
+	}
+
+	severity := v.Severity
+	if severity == "" {
+		severity = severityFromCvssScore(v.CvssScore)
+	}
+
+	// vulnerabilities with unknown severity or score are always reported,
+	// it's safer to err on the side of caution
+	if f.MinSeverity != "" && severity != "" {
+		if severityRank[severity] < severityRank[strings.ToLower(f.MinSeverity)] {
+			return fmt.Sprintf("severity %s is below the threshold of %s", severity, f.MinSeverity)
+		}
+	}
+
+	if f.MinCvssScore > 0 && v.CvssScore > 0 && v.CvssScore < f.MinCvssScore {
+		return fmt.Sprintf("CVSS score %.1f is below the threshold of %.1f", v.CvssScore, f.MinCvssScore)
+	}
+
+	return ""
+}
@@ -0,0 +1,256 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vulncheck provides the vulnerability check evaluator
+package vulncheck
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestCvssV3BaseScore(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		vector  string
+		score   float64
+		wantErr bool
+	}{
+		{
+			name:   "critical, unchanged scope",
+			vector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+			score:  9.8,
+		},
+		{
+			name:   "changed scope",
+			vector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:R/S:C/C:L/I:L/A:N",
+			score:  6.1,
+		},
+		{
+			name:   "no impact",
+			vector: "CVSS:3.0/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:N",
+			score:  0,
+		},
+		{
+			name:   "low",
+			vector: "CVSS:3.1/AV:L/AC:H/PR:H/UI:R/S:U/C:L/I:N/A:N",
+			score:  1.8,
+		},
+		{
+			name:    "v2 vector",
+			vector:  "AV:N/AC:L/Au:N/C:P/I:P/A:P",
+			wantErr: true,
+		},
+		{
+			name:    "missing metric",
+			vector:  "CVSS:3.1/AV:N/AC:L/PR:N/S:U/C:H/I:H/A:H",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			score, err := cvssV3BaseScore(tt.vector)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.score, score)
+		})
+	}
+}
+
+func TestParseConfigFilter(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := parseConfig(map[string]any{
+		"action": "review",
+		"ecosystem_config": []any{
+			map[string]any{
+				"name":                            "npm",
+				"vulnerability_database_type":     "osv",
+				"vulnerability_database_endpoint": "https://api.osv.dev/v1/query",
+				"package_repository":              map[string]any{"url": "https://registry.npmjs.org"},
+				"sum_repository":                  map[string]any{"url": "https://sum.golang.org"},
+			},
+		},
+		"filter": map[string]any{
+			"min_severity": "high",
+			"ignore": []any{
+				map[string]any{"id": "GHSA-1", "reason": "not reachable", "expires": "2024-01-31"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, cfg.Filter.Ignore, 1)
+	assert.Equal(t, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), cfg.Filter.Ignore[0].expiresAt)
+
+	_, err = parseConfig(map[string]any{
+		"action":           "review",
+		"ecosystem_config": []any{},
+		"filter": map[string]any{
+			"ignore": []any{
+				map[string]any{"id": "GHSA-1", "reason": "not reachable", "expires": "next week"},
+			},
+		},
+	})
+	require.ErrorContains(t, err, "invalid expiry")
+
+	_, err = parseConfig(map[string]any{
+		"action":           "review",
+		"ecosystem_config": []any{},
+		"filter": map[string]any{
+			"min_severity": "whatever",
+		},
+	})
+	require.ErrorContains(t, err, "config failed validation")
+}
+
+func TestVulnerabilityFilterApply(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	dep := &pb.Dependency{
+		Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_NPM,
+		Name:      "mongodb",
+		Version:   "0.5.0",
+	}
+
+	tests := []struct {
+		name           string
+		filter         vulnerabilityFilter
+		vulns          []Vulnerability
+		wantKept       []string
+		wantSuppressed map[string]string
+	}{
+		{
+			name:     "empty filter reports everything",
+			vulns:    []Vulnerability{{ID: "GHSA-1"}, {ID: "GHSA-2", Severity: "low"}},
+			wantKept: []string{"GHSA-1", "GHSA-2"},
+		},
+		{
+			name: "allowed package suppresses everything",
+			filter: vulnerabilityFilter{
+				AllowedPackages: []allowedPackage{
+					{Ecosystem: "npm", Name: "mongodb", Reason: "vendored fork"},
+				},
+			},
+			vulns: []Vulnerability{{ID: "GHSA-1"}},
+			wantSuppressed: map[string]string{
+				"GHSA-1": "package is allowed: vendored fork",
+			},
+		},
+		{
+			name: "allowed package with a different version",
+			filter: vulnerabilityFilter{
+				AllowedPackages: []allowedPackage{
+					{Ecosystem: "npm", Name: "mongodb", Version: "0.4.0", Reason: "vendored fork"},
+				},
+			},
+			vulns:    []Vulnerability{{ID: "GHSA-1"}},
+			wantKept: []string{"GHSA-1"},
+		},
+		{
+			name: "ignore by alias and expired ignore",
+			filter: vulnerabilityFilter{
+				Ignore: []ignoredVulnerability{
+					{ID: "CVE-2023-1", Reason: "not reachable"},
+					{ID: "GHSA-2", Reason: "fixed soon", Expires: "2024-01-01", expiresAt: now.AddDate(0, 0, -1)},
+				},
+			},
+			vulns: []Vulnerability{
+				{ID: "GHSA-1", Aliases: []string{"CVE-2023-1"}},
+				{ID: "GHSA-2"},
+			},
+			wantKept: []string{"GHSA-2"},
+			wantSuppressed: map[string]string{
+				"GHSA-1": "ignored: not reachable",
+			},
+		},
+		{
+			name: "only fixable",
+			filter: vulnerabilityFilter{
+				OnlyFixAvailable: true,
+			},
+			vulns:    []Vulnerability{{ID: "GHSA-1", Fixed: "1.0.0"}, {ID: "GHSA-2"}},
+			wantKept: []string{"GHSA-1"},
+			wantSuppressed: map[string]string{
+				"GHSA-2": "no fix available",
+			},
+		},
+		{
+			name: "severity threshold",
+			filter: vulnerabilityFilter{
+				MinSeverity: "high",
+			},
+			vulns: []Vulnerability{
+				{ID: "GHSA-1", Severity: "moderate"},
+				{ID: "GHSA-2", Severity: "critical"},
+				{ID: "GHSA-3", CvssScore: 5.3},
+				{ID: "GHSA-4"},
+			},
+			wantKept: []string{"GHSA-2", "GHSA-4"},
+			wantSuppressed: map[string]string{
+				"GHSA-1": "severity moderate is below the threshold of high",
+				"GHSA-3": "severity medium is below the threshold of high",
+			},
+		},
+		{
+			name: "cvss threshold",
+			filter: vulnerabilityFilter{
+				MinCvssScore: 7.0,
+			},
+			vulns:    []Vulnerability{{ID: "GHSA-1", CvssScore: 6.1}, {ID: "GHSA-2", CvssScore: 9.8}},
+			wantKept: []string{"GHSA-2"},
+			wantSuppressed: map[string]string{
+				"GHSA-1": "CVSS score 6.1 is below the threshold of 7.0",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			kept, suppressed := tt.filter.apply(dep, tt.vulns, now)
+
+			var keptIDs []string
+			for _, v := range kept {
+				keptIDs = append(keptIDs, v.ID)
+			}
+			assert.Equal(t, tt.wantKept, keptIDs)
+
+			gotSuppressed := make(map[string]string)
+			for _, s := range suppressed {
+				gotSuppressed[s.ID] = s.Reason
+			}
+			if tt.wantSuppressed == nil {
+				tt.wantSuppressed = map[string]string{}
+			}
+			assert.Equal(t, tt.wantSuppressed, gotSuppressed)
+		})
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vulncheck provides the vulnerability check evaluator
+package vulncheck
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// dependencyLocator is implemented by the formatters of manifests where the
+// dependency line can only be recognized in the context of its enclosing block,
+// e.g. a require block in go.mod. LocateDependency returns the index of the
+// line or -1 if the dependency was not found.
+type dependencyLocator interface {
+	LocateDependency(lines []string) int
+}
+
+// formatterForFile adapts the patch returned by the package repository to the
+// file the dependency was found in. The package repositories produce patches
+// for the lockfiles, manifests only need their version bumped in place.
+func formatterForFile(fileName string, patch patchLocatorFormatter) patchLocatorFormatter {
+	switch pkg := patch.(type) {
+	case *goModPackage:
+		if filepath.Base(fileName) == "go.mod" {
+			return &goModRequire{pkg: pkg}
+		}
+	case *packageJson:
+		if filepath.Base(fileName) == "package.json" {
+			return &packageJsonManifest{pkg: pkg}
+		}
+	}
+	return patch
+}
+
+// dependencyUpdateGuidance returns instructions for the files that have to be
+// updated together with the one the suggestion is for, or an empty string.
+func dependencyUpdateGuidance(fileName string, patch patchLocatorFormatter) string {
+	switch pkg := patch.(type) {
+	case *goModRequire:
+		return "After accepting the suggestion, run `go mod tidy` to update `go.sum`."
+	case *goModPackage:
+		return fmt.Sprintf("The requirement in `go.mod` must be bumped too, run `go get %s@%s` and `go mod tidy` "+
+			"rather than only accepting the suggestion.", pkg.Name, pkg.Version)
+	case *packageJsonManifest:
+		return "After accepting the suggestion, run `npm install` to update `package-lock.json`."
+	case *packageJson:
+		return fmt.Sprintf("If `package.json` pins an older range of %s, update it as well, otherwise "+
+			"the next `npm install` may revert this change.", pkg.Name)
+	case *PyPiReply:
+		if filepath.Ext(fileName) == ".txt" {
+			return "If this file is generated, e.g. by `pip-compile`, update the source requirements " +
+				"and regenerate it instead."
+		}
+	}
+	return ""
+}
+
+// goModRequire bumps a requirement in go.mod, either in a require block or
+// in a single-line require directive
+type goModRequire struct {
+	pkg *goModPackage
+}
+
+func (g *goModRequire) requirement(line string, inBlock bool) []string {
+	if idx := strings.Index(line, "//"); idx != -1 {
+		line = line[:idx]
+	}
+	line = strings.TrimSpace(line)
+	if !inBlock {
+		if !strings.HasPrefix(line, "require ") {
+			return nil
+		}
+		line = strings.TrimPrefix(line, "require ")
+	}
+	return strings.Fields(line)
+}
+
+func (g *goModRequire) matches(fields []string) bool {
+	return len(fields) == 2 && fields[0] == g.pkg.Name && fields[1] == g.pkg.oldVersion
+}
+
+// LocateDependency finds the requirement, skipping replace and exclude blocks
+// that may mention the same module
+func (g *goModRequire) LocateDependency(lines []string) int {
+	inRequire := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, "require (") || strings.HasPrefix(trimmed, "require("):
+			inRequire = true
+			continue
+		case inRequire && strings.HasPrefix(trimmed, ")"):
+			inRequire = false
+			continue
+		}
+
+		if g.matches(g.requirement(line, inRequire)) {
+			return i
+		}
+	}
+	return -1
+}
+
+// LineHasDependency returns true for a single-line require directive of the dependency
+func (g *goModRequire) LineHasDependency(line string) bool {
+	return g.matches(g.requirement(line, false))
+}
+
+// IndentedString keeps the line as is, including the indirect comment, and
+// only replaces the version
+func (g *goModRequire) IndentedString(_ int, oldDepLine string, _ *pb.Dependency) string {
+	return strings.Replace(oldDepLine, " "+g.pkg.oldVersion, " "+g.pkg.Version, 1)
+}
+
+var packageJsonDepBlock = regexp.MustCompile(
+	`^\s*"(dependencies|devDependencies|optionalDependencies|peerDependencies)"\s*:\s*\{`)
+
+// packageJsonManifest bumps the version range of a dependency in package.json
+type packageJsonManifest struct {
+	pkg *packageJson
+}
+
+func (p *packageJsonManifest) entry() *regexp.Regexp {
+	// the prefix keeps the range operator, e.g. ^ or ~, so that the
+	// suggestion doesn't change how the dependency is updated later
+	return regexp.MustCompile(`^(\s*"` + regexp.QuoteMeta(p.pkg.Name) + `"\s*:\s*"[~^>=v ]*)([^"]*)(".*)$`)
+}
+
+// LocateDependency only matches entries inside one of the dependency blocks,
+// a script or a config key may well have the same name as a package
+func (p *packageJsonManifest) LocateDependency(lines []string) int {
+	entry := p.entry()
+	inDeps := false
+	for i, line := range lines {
+		if packageJsonDepBlock.MatchString(line) {
+			inDeps = true
+			continue
+		}
+		if !inDeps {
+			continue
+		}
+		if strings.HasPrefix(strings.TrimSpace(line), "}") {
+			inDeps = false
+			continue
+		}
+		if entry.MatchString(line) {
+			return i
+		}
+	}
+	return -1
+}
+
+// LineHasDependency returns true if the line is an entry for the dependency
+func (p *packageJsonManifest) LineHasDependency(line string) bool {
+	return p.entry().MatchString(line)
+}
+
+// IndentedString replaces the version in the range of the entry
+func (p *packageJsonManifest) IndentedString(_ int, oldDepLine string, _ *pb.Dependency) string {
+	return p.entry().ReplaceAllString(oldDepLine, "${1}"+p.pkg.Version+"${3}")
+}
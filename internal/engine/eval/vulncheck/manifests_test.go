@@ -0,0 +1,101 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vulncheck provides the vulnerability check evaluator
+package vulncheck
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestGoModRequire(t *testing.T) {
+	t.Parallel()
+
+	gomod := strings.Split(`module github.com/example/app
+
+go 1.21
+
+replace (
+	golang.org/x/text v0.3.7 => golang.org/x/text v0.3.6
+)
+
+require github.com/google/uuid v1.3.1
+
+require (
+	github.com/stretchr/testify v1.8.4
+	golang.org/x/text v0.3.7 // indirect
+)`, "\n")
+
+	patch := formatterForFile("go.mod", &goModPackage{
+		oldVersion: "v0.3.7",
+		Name:       "golang.org/x/text",
+		Version:    "v0.3.8",
+	})
+	locator, ok := patch.(dependencyLocator)
+	require.True(t, ok)
+
+	idx := locator.LocateDependency(gomod)
+	require.Equal(t, 12, idx, "the requirement, not the replace directive")
+	assert.Equal(t, "\tgolang.org/x/text v0.3.8 // indirect", patch.IndentedString(0, gomod[idx], nil))
+	assert.Contains(t, dependencyUpdateGuidance("go.mod", patch), "go mod tidy")
+
+	single := formatterForFile("go.mod", &goModPackage{
+		oldVersion: "v1.3.1",
+		Name:       "github.com/google/uuid",
+		Version:    "v1.3.2",
+	})
+	idx = single.(dependencyLocator).LocateDependency(gomod)
+	require.Equal(t, 8, idx)
+	assert.Equal(t, "require github.com/google/uuid v1.3.2", single.IndentedString(0, gomod[idx], nil))
+
+	// go.sum keeps the existing formatter
+	_, ok = formatterForFile("go.sum", &goModPackage{}).(*goModPackage)
+	assert.True(t, ok)
+}
+
+func TestPackageJsonManifest(t *testing.T) {
+	t.Parallel()
+
+	pkgJson := strings.Split(`{
+  "name": "app",
+  "scripts": {
+    "lodash": "echo not a dependency"
+  },
+  "dependencies": {
+    "express": "4.18.2",
+    "lodash": "^4.17.15"
+  }
+}`, "\n")
+
+	patch := formatterForFile("web/package.json", &packageJson{
+		Name:    "lodash",
+		Version: "4.17.21",
+	})
+	locator, ok := patch.(dependencyLocator)
+	require.True(t, ok)
+
+	idx := locator.LocateDependency(pkgJson)
+	require.Equal(t, 7, idx, "the dependency, not the script")
+	assert.Equal(t, `    "lodash": "^4.17.21"`, patch.IndentedString(0, pkgJson[idx], &pb.Dependency{Version: "4.17.15"}))
+	assert.Contains(t, dependencyUpdateGuidance("web/package.json", patch), "npm install")
+
+	missing := formatterForFile("package.json", &packageJson{Name: "mongodb"})
+	assert.Equal(t, -1, missing.(dependencyLocator).LocateDependency(pkgJson))
+}
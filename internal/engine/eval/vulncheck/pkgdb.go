@@ -55,7 +55,9 @@ type patchLocatorFormatter interface {
 
 // RepoQuerier is the interface for querying a repository
 type RepoQuerier interface {
-	SendRecvRequest(ctx context.Context, dep *pb.Dependency) (patchLocatorFormatter, error)
+	// SendRecvRequest fetches the metadata of the given version of the dependency,
+	// or of the latest version if the version is empty
+	SendRecvRequest(ctx context.Context, dep *pb.Dependency, version string) (patchLocatorFormatter, error)
 }
 
 type repoCache struct {
@@ -153,8 +155,12 @@ func (p *PyPiReply) LineHasDependency(line string) bool {
 	return name == p.Info.Name
 }
 
-func (p *pypiRepository) SendRecvRequest(ctx context.Context, dep *pb.Dependency) (patchLocatorFormatter, error) {
-	req, err := p.newRequest(ctx, dep)
+func (p *pypiRepository) SendRecvRequest(
+	ctx context.Context,
+	dep *pb.Dependency,
+	version string,
+) (patchLocatorFormatter, error) {
+	req, err := p.newRequest(ctx, dep, version)
 	if err != nil {
 		return nil, fmt.Errorf("could not create request: %w", err)
 	}
@@ -185,8 +191,12 @@ func newPyPIRepository(endpoint string) *pypiRepository {
 	}
 }
 
-func (p *pypiRepository) newRequest(ctx context.Context, dep *pb.Dependency) (*http.Request, error) {
-	u, err := urlFromEndpointAndPaths(p.endpoint, dep.Name, "json")
+func (p *pypiRepository) newRequest(ctx context.Context, dep *pb.Dependency, version string) (*http.Request, error) {
+	pathComponents := []string{dep.Name, "json"}
+	if version != "" {
+		pathComponents = []string{dep.Name, version, "json"}
+	}
+	u, err := urlFromEndpointAndPaths(p.endpoint, pathComponents...)
 	if err != nil {
 		return nil, fmt.Errorf("could not parse endpoint: %w", err)
 	}
@@ -214,8 +224,11 @@ func newNpmRepository(endpoint string) *npmRepository {
 // check that npmRepository implements RepoQuerier
 var _ RepoQuerier = (*npmRepository)(nil)
 
-func (n *npmRepository) newRequest(ctx context.Context, dep *pb.Dependency) (*http.Request, error) {
-	u, err := urlFromEndpointAndPaths(n.endpoint, dep.Name, "latest")
+func (n *npmRepository) newRequest(ctx context.Context, dep *pb.Dependency, version string) (*http.Request, error) {
+	if version == "" {
+		version = "latest"
+	}
+	u, err := urlFromEndpointAndPaths(n.endpoint, dep.Name, version)
 	if err != nil {
 		return nil, fmt.Errorf("could not parse endpoint: %w", err)
 	}
@@ -228,8 +241,12 @@ func (n *npmRepository) newRequest(ctx context.Context, dep *pb.Dependency) (*ht
 	return req, nil
 }
 
-func (n *npmRepository) SendRecvRequest(ctx context.Context, dep *pb.Dependency) (patchLocatorFormatter, error) {
-	req, err := n.newRequest(ctx, dep)
+func (n *npmRepository) SendRecvRequest(
+	ctx context.Context,
+	dep *pb.Dependency,
+	version string,
+) (patchLocatorFormatter, error) {
+	req, err := n.newRequest(ctx, dep, version)
 	if err != nil {
 		return nil, fmt.Errorf("could not create request: %w", err)
 	}
@@ -296,8 +313,12 @@ func newGoProxySumRepository(proxyEndpoint, sumEndpoint string) *goProxyReposito
 // check that npmRepository implements RepoQuerier
 var _ RepoQuerier = (*goProxyRepository)(nil)
 
-func (r *goProxyRepository) goProxyRequest(ctx context.Context, dep *pb.Dependency) (*http.Request, error) {
-	u, err := urlFromEndpointAndPaths(r.proxyEndpoint, dep.Name, "@latest")
+func (r *goProxyRepository) goProxyRequest(ctx context.Context, dep *pb.Dependency, version string) (*http.Request, error) {
+	pathComponents := []string{dep.Name, "@latest"}
+	if version != "" {
+		pathComponents = []string{dep.Name, "@v", version + ".info"}
+	}
+	u, err := urlFromEndpointAndPaths(r.proxyEndpoint, pathComponents...)
 	if err != nil {
 		return nil, fmt.Errorf("could not parse endpoint: %w", err)
 	}
@@ -362,8 +383,12 @@ func parseGoSumReply(goPkg *goModPackage, reply io.Reader) error {
 	return nil
 }
 
-func (r *goProxyRepository) SendRecvRequest(ctx context.Context, dep *pb.Dependency) (patchLocatorFormatter, error) {
-	proxyReq, err := r.goProxyRequest(ctx, dep)
+func (r *goProxyRepository) SendRecvRequest(
+	ctx context.Context,
+	dep *pb.Dependency,
+	version string,
+) (patchLocatorFormatter, error) {
+	proxyReq, err := r.goProxyRequest(ctx, dep, version)
 	if err != nil {
 		return nil, fmt.Errorf("could not create pkg db request: %w", err)
 	}
@@ -111,7 +111,7 @@ func TestNpmPkgDb(t *testing.T) {
 				Name: tt.depName,
 			}
 
-			reply, err := repo.SendRecvRequest(context.Background(), dep)
+			reply, err := repo.SendRecvRequest(context.Background(), dep, "")
 			if tt.expectError {
 				assert.Error(t, err, "Expected error")
 			} else {
@@ -266,7 +266,7 @@ func TestPyPiPkgDb(t *testing.T) {
 				Name: tt.depName,
 			}
 
-			reply, err := repo.SendRecvRequest(context.Background(), dep)
+			reply, err := repo.SendRecvRequest(context.Background(), dep, "")
 			if tt.expectError {
 				assert.Error(t, err, "Expected error")
 			} else {
@@ -427,7 +427,7 @@ golang.org/x/text v0.13.0 h1:ablQoSUd0tRdKxZewP80B+BaqeKJuVhuRxj/dkrun3k=`))
 				Name: tt.depName,
 			}
 
-			reply, err := repo.SendRecvRequest(context.Background(), dep)
+			reply, err := repo.SendRecvRequest(context.Background(), dep, "")
 			if tt.expectError {
 				assert.Error(t, err, "Expected error")
 			} else {
@@ -489,3 +489,43 @@ func TestRepoCache(t *testing.T) {
 		})
 	}
 }
+
+func TestPkgDbFixedVersion(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		switch r.URL.Path {
+		case "/lodash/4.17.21":
+			_, err = w.Write([]byte(`{"name": "lodash", "version": "4.17.21"}`))
+		case "/requests/2.20.0/json":
+			_, err = w.Write([]byte(`{"info": {"name": "requests", "version": "2.20.0"}}`))
+		case "/golang.org/x/text/@v/v0.3.8.info":
+			_, err = w.Write([]byte(`{"Version": "v0.3.8"}`))
+		case "/lookup/golang.org/x/text@v0.3.8":
+			_, err = w.Write([]byte(`1
+golang.org/x/text v0.3.8 h1:mod=
+golang.org/x/text v0.3.8/go.mod h1:gomod=`))
+		default:
+			t.Errorf("unexpected request for %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	npmReply, err := newNpmRepository(server.URL).SendRecvRequest(context.Background(),
+		&pb.Dependency{Name: "lodash", Version: "4.17.15"}, "4.17.21")
+	require.NoError(t, err)
+	assert.Equal(t, "4.17.21", npmReply.(*packageJson).Version)
+
+	pypiReply, err := newPyPIRepository(server.URL).SendRecvRequest(context.Background(),
+		&pb.Dependency{Name: "requests", Version: "2.19.0"}, "2.20.0")
+	require.NoError(t, err)
+	assert.Equal(t, "2.20.0", pypiReply.(*PyPiReply).Info.Version)
+
+	goReply, err := newGoProxySumRepository(server.URL, server.URL).SendRecvRequest(context.Background(),
+		&pb.Dependency{Name: "golang.org/x/text", Version: "v0.3.7"}, "v0.3.8")
+	require.NoError(t, err)
+	assert.Equal(t, "v0.3.8", goReply.(*goModPackage).Version)
+}
@@ -49,6 +49,55 @@ Previous Minder review was dismissed because the PR was updated.
 `
 )
 
+const (
+	suppressedTemplateName = "suppressedVulnerabilities"
+	suppressedTmplStr      = `
+### Suppressed vulnerabilities
+The following vulnerabilities were found, but not reported because of the rule configuration:
+<table>
+  <tr>
+    <th>Ecosystem</th>
+    <th>Name</th>
+    <th>Version</th>
+    <th>Vulnerability ID</th>
+    <th>Reason</th>
+  </tr>
+  {{- range . }}
+  {{- $dep := .Dependency }}
+  {{- range .Suppressed }}
+  <tr>
+    <td>{{ $dep.Ecosystem.AsString }}</td>
+    <td>{{ $dep.Name }}</td>
+    <td>{{ $dep.Version }}</td>
+    <td>{{ .ID }}</td>
+    <td>{{ .Reason }}</td>
+  </tr>
+  {{- end }}
+  {{- end }}
+</table>
+`
+)
+
+// renderSuppressed renders the table of suppressed vulnerabilities or an empty
+// string if nothing was suppressed
+func renderSuppressed(suppressed []dependencySuppressions) (string, error) {
+	if len(suppressed) == 0 {
+		return "", nil
+	}
+
+	tmpl, err := template.New(suppressedTemplateName).Parse(suppressedTmplStr)
+	if err != nil {
+		return "", fmt.Errorf("could not parse suppressed template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, suppressed); err != nil {
+		return "", fmt.Errorf("could not execute suppressed template: %w", err)
+	}
+
+	return buf.String(), nil
+}
+
 const (
 	reviewTemplateName = "reviewBody"
 	reviewTmplStr      = "{{.MagicComment}}\n\n{{.ReviewText}}"
@@ -121,15 +170,24 @@ func locateDepInPr(
 
 	loc := reviewLocation{}
 	lines := strings.Split(string(content), "\n")
-	for i, line := range lines {
-		if patch.LineHasDependency(line) {
-			loc.leadingWhitespace = countLeadingWhitespace(line)
-			loc.lineToChange = i + 1
-			loc.line = line
-			break
+	idx := -1
+	if locator, ok := patch.(dependencyLocator); ok {
+		idx = locator.LocateDependency(lines)
+	} else {
+		for i, line := range lines {
+			if patch.LineHasDependency(line) {
+				idx = i
+				break
+			}
 		}
 	}
 
+	if idx != -1 {
+		loc.leadingWhitespace = countLeadingWhitespace(lines[idx])
+		loc.lineToChange = idx + 1
+		loc.line = lines[idx]
+	}
+
 	if loc.lineToChange == 0 {
 		return nil, fmt.Errorf("could not locate dependency in PR")
 	}
@@ -141,6 +199,13 @@ func reviewBodyWithSuggestion(comment string) string {
 	return fmt.Sprintf("```suggestion\n%s\n```\n", comment)
 }
 
+func reviewBodyWithGuidance(body, guidance string) string {
+	if guidance == "" {
+		return body
+	}
+	return fmt.Sprintf("%s\n%s\n", body, guidance)
+}
+
 type reviewPrHandler struct {
 	cli provifv1.GitHub
 	pr  *pb.PullRequest
@@ -148,9 +213,10 @@ type reviewPrHandler struct {
 	minderReview *github.PullRequestReview
 	failStatus   *string
 
-	comments []*github.DraftReviewComment
-	status   *string
-	text     *string
+	comments   []*github.DraftReviewComment
+	suppressed []dependencySuppressions
+	status     *string
+	text       *string
 
 	logger zerolog.Logger
 }
@@ -217,13 +283,14 @@ func (ra *reviewPrHandler) trackVulnerableDep(
 	_ *VulnerabilityResponse,
 	patch patchLocatorFormatter,
 ) error {
+	patch = formatterForFile(dep.File.Name, patch)
 	location, err := locateDepInPr(ctx, ra.cli, dep, patch)
 	if err != nil {
 		return fmt.Errorf("could not locate dependency in PR: %w", err)
 	}
 
 	comment := patch.IndentedString(location.leadingWhitespace, location.line, dep.Dep)
-	body := reviewBodyWithSuggestion(comment)
+	body := reviewBodyWithGuidance(reviewBodyWithSuggestion(comment), dependencyUpdateGuidance(dep.File.Name, patch))
 	lineTo := len(strings.Split(comment, "\n")) - 1
 
 	reviewComment := &github.DraftReviewComment{
@@ -247,6 +314,24 @@ func (ra *reviewPrHandler) trackVulnerableDep(
 	return nil
 }
 
+func (ra *reviewPrHandler) trackSuppressedDep(
+	_ context.Context,
+	dep *pb.PrDependencies_ContextualDependency,
+	suppressed []suppressedVulnerability,
+) error {
+	ra.suppressed = append(ra.suppressed, dependencySuppressions{
+		Dependency: dep.Dep,
+		Suppressed: suppressed,
+	})
+
+	ra.logger.Debug().
+		Str("dep-name", dep.Dep.Name).
+		Int("suppressed", len(suppressed)).
+		Msg("vulnerabilities suppressed")
+
+	return nil
+}
+
 func (ra *reviewPrHandler) submit(ctx context.Context) error {
 	if err := ra.findPreviousReview(ctx); err != nil {
 		return fmt.Errorf("could not find previous review: %w", err)
@@ -265,7 +350,9 @@ func (ra *reviewPrHandler) submit(ctx context.Context) error {
 	}
 
 	// either there are changes to request or just send the first review mentioning that everything is ok
-	ra.setStatus()
+	if err := ra.setStatus(); err != nil {
+		return fmt.Errorf("could not set review status: %w", err)
+	}
 	if err := ra.submitReview(ctx); err != nil {
 		return fmt.Errorf("could not submit review: %w", err)
 	}
@@ -273,7 +360,7 @@ func (ra *reviewPrHandler) submit(ctx context.Context) error {
 	return nil
 }
 
-func (ra *reviewPrHandler) setStatus() {
+func (ra *reviewPrHandler) setStatus() error {
 	if len(ra.comments) > 0 {
 		// if this pass produced comments, request changes
 		ra.text = github.String(vulnsFoundText)
@@ -286,7 +373,16 @@ func (ra *reviewPrHandler) setStatus() {
 		ra.logger.Debug().Msg("no vulnerabilities found")
 	}
 
+	suppressedText, err := renderSuppressed(ra.suppressed)
+	if err != nil {
+		return err
+	}
+	if suppressedText != "" {
+		ra.text = github.String(*ra.text + suppressedText)
+	}
+
 	ra.logger.Debug().Str("status", *ra.status).Msg("will set review status")
+	return nil
 }
 
 func (ra *reviewPrHandler) findPreviousReview(ctx context.Context) error {
@@ -424,10 +520,11 @@ type summaryPrHandler struct {
 	cli provifv1.GitHub
 	pr  *pb.PullRequest
 
-	logger      zerolog.Logger
-	trackedDeps []dependencyVulnerabilities
-	headerTmpl  *template.Template
-	rowsTmpl    *template.Template
+	logger         zerolog.Logger
+	trackedDeps    []dependencyVulnerabilities
+	suppressedDeps []dependencySuppressions
+	headerTmpl     *template.Template
+	rowsTmpl       *template.Template
 }
 
 const (
@@ -480,6 +577,18 @@ func (sph *summaryPrHandler) trackVulnerableDep(
 	return nil
 }
 
+func (sph *summaryPrHandler) trackSuppressedDep(
+	_ context.Context,
+	dep *pb.PrDependencies_ContextualDependency,
+	suppressed []suppressedVulnerability,
+) error {
+	sph.suppressedDeps = append(sph.suppressedDeps, dependencySuppressions{
+		Dependency: dep.Dep,
+		Suppressed: suppressed,
+	})
+	return nil
+}
+
 func (sph *summaryPrHandler) submit(ctx context.Context) error {
 	summary, err := sph.generateSummary()
 	if err != nil {
@@ -496,8 +605,14 @@ func (sph *summaryPrHandler) submit(ctx context.Context) error {
 
 func (sph *summaryPrHandler) generateSummary() (string, error) {
 	var summary strings.Builder
+	suppressedText, err := renderSuppressed(sph.suppressedDeps)
+	if err != nil {
+		return "", err
+	}
+
 	if len(sph.trackedDeps) == 0 {
 		summary.WriteString(noVulsFoundText)
+		summary.WriteString(suppressedText)
 		return summary.String(), nil
 	}
 
@@ -526,6 +641,7 @@ func (sph *summaryPrHandler) generateSummary() (string, error) {
 		summary.WriteString(rowBuf.String())
 	}
 	summary.WriteString(tableVulnerabilitiesFooter)
+	summary.WriteString(suppressedText)
 
 	return summary.String(), nil
 }
@@ -572,6 +688,14 @@ func (profileOnlyPrHandler) trackVulnerableDep(
 	return nil
 }
 
+func (profileOnlyPrHandler) trackSuppressedDep(
+	_ context.Context,
+	_ *pb.PrDependencies_ContextualDependency,
+	_ []suppressedVulnerability,
+) error {
+	return nil
+}
+
 func (profileOnlyPrHandler) submit(_ context.Context) error {
 	return nil
 }
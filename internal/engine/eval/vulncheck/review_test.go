@@ -151,7 +151,9 @@ func TestReviewPrHandlerVulnerabilitiesDifferentIdentities(t *testing.T) {
 
 	expBody, err := createReviewBody(vulnsFoundText)
 	require.NoError(t, err)
-	expCommentBody := reviewBodyWithSuggestion(patchPackage.IndentedString(0, "", nil))
+	expCommentBody := reviewBodyWithGuidance(
+		reviewBodyWithSuggestion(patchPackage.IndentedString(0, "", nil)),
+		dependencyUpdateGuidance(dep.File.Name, patchPackage))
 
 	mockClient.EXPECT().
 		ListReviews(gomock.Any(), pr.RepoOwner, pr.RepoName, int(pr.Number), nil).
@@ -342,7 +344,9 @@ func TestCommitStatusPrHandlerWithVulnerabilities(t *testing.T) {
 
 	expBody, err := createReviewBody(vulnsFoundText)
 	require.NoError(t, err)
-	expCommentBody := reviewBodyWithSuggestion(patchPackage.IndentedString(0, "", nil))
+	expCommentBody := reviewBodyWithGuidance(
+		reviewBodyWithSuggestion(patchPackage.IndentedString(0, "", nil)),
+		dependencyUpdateGuidance(dep.File.Name, patchPackage))
 
 	mockClient.EXPECT().
 		ListReviews(gomock.Any(), pr.RepoOwner, pr.RepoName, int(pr.Number), nil).
@@ -372,3 +376,61 @@ func TestCommitStatusPrHandlerWithVulnerabilities(t *testing.T) {
 	err = handler.submit(context.Background())
 	require.NoError(t, err)
 }
+
+func TestReviewPrHandlerSuppressedVulnerabilities(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mock_ghclient.NewMockGitHub(ctrl)
+	pr := &pb.PullRequest{
+		Url:       "https://api.github.com/repos/jakubtestorg/bad-npm/pulls/43",
+		CommitSha: commitSHA,
+		Number:    43,
+		RepoOwner: "jakubtestorg",
+		RepoName:  "bad-npm",
+		AuthorId:  githubSubmitterID,
+	}
+
+	mockClient.EXPECT().GetAuthenticatedUser(gomock.Any()).Return(&github.User{
+		ID: github.Int64(githubMinderID),
+	}, nil)
+	handler, err := newReviewPrHandler(context.TODO(), pr, mockClient)
+	require.NoError(t, err)
+	require.NotNil(t, handler)
+
+	dep := &pb.PrDependencies_ContextualDependency{
+		Dep: &pb.Dependency{
+			Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_NPM,
+			Name:      "mongodb",
+			Version:   "0.5.0",
+		},
+	}
+	err = handler.trackSuppressedDep(context.TODO(), dep, []suppressedVulnerability{
+		{
+			Vulnerability: Vulnerability{ID: "GHSA-1234"},
+			Reason:        "ignored: not reachable",
+		},
+	})
+	require.NoError(t, err)
+
+	mockClient.EXPECT().
+		ListReviews(gomock.Any(), pr.RepoOwner, pr.RepoName, int(pr.Number), nil).
+		Return([]*github.PullRequestReview{}, nil)
+
+	mockClient.EXPECT().
+		CreateReview(gomock.Any(), pr.RepoOwner, pr.RepoName, int(pr.Number), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, _ int, review *github.PullRequestReviewRequest) (*github.PullRequestReview, error) {
+			// nothing was reported, so the review must not request changes
+			assert.Equal(t, "COMMENT", review.GetEvent())
+			assert.Empty(t, review.Comments)
+			assert.Contains(t, review.GetBody(), noVulsFoundText)
+			assert.Contains(t, review.GetBody(), "<td>GHSA-1234</td>")
+			assert.Contains(t, review.GetBody(), "<td>ignored: not reachable</td>")
+			return &github.PullRequestReview{}, nil
+		})
+
+	err = handler.submit(context.Background())
+	require.NoError(t, err)
+}
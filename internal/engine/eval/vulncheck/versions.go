@@ -0,0 +1,207 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vulncheck provides the vulnerability check evaluator
+package vulncheck
+
+import (
+	"cmp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/mod/semver"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// minimumFixedVersion returns the lowest version that is newer than the
+// current version and fixes all the vulnerabilities, or an empty string if at
+// least one of them has no fix yet. Each vulnerability can be fixed in several
+// release lines, the fix we need is the first one after the current version.
+func minimumFixedVersion(eco pb.DepEcosystem, current string, vulns []Vulnerability) string {
+	var target string
+	for _, v := range vulns {
+		fix := ""
+		for _, candidate := range v.Fixes {
+			candidate = normalizeVersion(eco, candidate)
+			if compareVersions(eco, candidate, current) <= 0 {
+				continue
+			}
+			if fix == "" || compareVersions(eco, candidate, fix) < 0 {
+				fix = candidate
+			}
+		}
+		if fix == "" {
+			return ""
+		}
+		if target == "" || compareVersions(eco, fix, target) > 0 {
+			target = fix
+		}
+	}
+	return target
+}
+
+// normalizeVersion converts a version as reported by OSV to the form used by
+// the ecosystem, OSV omits the v prefix of Go module versions
+func normalizeVersion(eco pb.DepEcosystem, version string) string {
+	if eco == pb.DepEcosystem_DEP_ECOSYSTEM_GO && !strings.HasPrefix(version, "v") {
+		return "v" + version
+	}
+	return version
+}
+
+// compareVersions returns -1, 0 or +1 depending on whether a < b, a == b or a > b
+func compareVersions(eco pb.DepEcosystem, a, b string) int {
+	switch eco {
+	case pb.DepEcosystem_DEP_ECOSYSTEM_PYPI:
+		return comparePep440(a, b)
+	case pb.DepEcosystem_DEP_ECOSYSTEM_GO, pb.DepEcosystem_DEP_ECOSYSTEM_NPM:
+		return compareSemver(a, b)
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+func compareSemver(a, b string) int {
+	va, vb := "v"+strings.TrimPrefix(a, "v"), "v"+strings.TrimPrefix(b, "v")
+	if !semver.IsValid(va) || !semver.IsValid(vb) {
+		return strings.Compare(a, b)
+	}
+	return semver.Compare(va, vb)
+}
+
+// pep440Version is a simplified PEP 440 version: the release segment, an
+// optional pre-release, post-release and dev-release. Epochs and local
+// versions are rare enough in requirements files to be ignored.
+type pep440Version struct {
+	release []int
+	// preKind orders a < b < rc, a final release has no pre-release and
+	// sorts after all of them
+	preKind int
+	pre     int
+	post    int
+	dev     int
+	hasDev  bool
+}
+
+const pep440Final = 4
+
+var pep440PreKinds = map[string]int{
+	"a": 1, "alpha": 1,
+	"b": 2, "beta": 2,
+	"c": 3, "rc": 3, "pre": 3, "preview": 3,
+}
+
+func parsePep440(version string) pep440Version {
+	v := pep440Version{preKind: pep440Final, post: -1}
+	s := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(version), "v"))
+	if idx := strings.Index(s, "+"); idx != -1 {
+		s = s[:idx]
+	}
+	if idx := strings.Index(s, "!"); idx != -1 {
+		s = s[idx+1:]
+	}
+
+	// the release segment is a dot-separated list of numbers
+	i := 0
+	for i < len(s) {
+		j := i
+		for j < len(s) && unicode.IsDigit(rune(s[j])) {
+			j++
+		}
+		if j == i {
+			break
+		}
+		n, _ := strconv.Atoi(s[i:j])
+		v.release = append(v.release, n)
+		i = j
+		if i < len(s) && s[i] == '.' && i+1 < len(s) && unicode.IsDigit(rune(s[i+1])) {
+			i++
+			continue
+		}
+		break
+	}
+
+	rest := strings.TrimLeft(s[i:], ".-_")
+	for rest != "" {
+		j := 0
+		for j < len(rest) && unicode.IsLetter(rune(rest[j])) {
+			j++
+		}
+		kind := rest[:j]
+		rest = strings.TrimLeft(rest[j:], ".-_")
+		k := 0
+		for k < len(rest) && unicode.IsDigit(rune(rest[k])) {
+			k++
+		}
+		n, _ := strconv.Atoi(rest[:k])
+		rest = strings.TrimLeft(rest[k:], ".-_")
+
+		switch {
+		case pep440PreKinds[kind] != 0:
+			v.preKind, v.pre = pep440PreKinds[kind], n
+		case kind == "post" || kind == "rev" || kind == "r":
+			v.post = n
+		case kind == "dev":
+			v.dev, v.hasDev = n, true
+		default:
+			// not a version we understand, stop rather than loop forever
+			return v
+		}
+	}
+
+	// a dev release of a final version comes before its pre-releases
+	if v.hasDev && v.preKind == pep440Final && v.post == -1 {
+		v.preKind = 0
+	}
+
+	return v
+}
+
+func comparePep440(a, b string) int {
+	va, vb := parsePep440(a), parsePep440(b)
+
+	for i := 0; i < len(va.release) || i < len(vb.release); i++ {
+		var ra, rb int
+		if i < len(va.release) {
+			ra = va.release[i]
+		}
+		if i < len(vb.release) {
+			rb = vb.release[i]
+		}
+		if c := cmp.Compare(ra, rb); c != 0 {
+			return c
+		}
+	}
+
+	for _, c := range []int{
+		cmp.Compare(va.preKind, vb.preKind),
+		cmp.Compare(va.pre, vb.pre),
+		cmp.Compare(va.post, vb.post),
+	} {
+		if c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case va.hasDev && !vb.hasDev:
+		return -1
+	case !va.hasDev && vb.hasDev:
+		return 1
+	default:
+		return cmp.Compare(va.dev, vb.dev)
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vulncheck provides the vulnerability check evaluator
+package vulncheck
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestComparePep440(t *testing.T) {
+	t.Parallel()
+
+	ordered := []string{
+		"1.0.dev1",
+		"1.0a1",
+		"1.0a2.dev1",
+		"1.0a2",
+		"1.0b1",
+		"1.0rc1",
+		"1.0",
+		"1.0.post1",
+		"1.0.1",
+		"1.1",
+		"1.10",
+		"2.0",
+	}
+
+	for i := range ordered {
+		for j := range ordered {
+			expected := 0
+			if i < j {
+				expected = -1
+			} else if i > j {
+				expected = 1
+			}
+			assert.Equal(t, expected, comparePep440(ordered[i], ordered[j]), "%s vs %s", ordered[i], ordered[j])
+		}
+	}
+
+	assert.Equal(t, 0, comparePep440("1.0", "1.0.0"))
+}
+
+func TestMinimumFixedVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		eco      pb.DepEcosystem
+		current  string
+		vulns    []Vulnerability
+		expected string
+	}{
+		{
+			name:    "fix in the release line of the current version",
+			eco:     pb.DepEcosystem_DEP_ECOSYSTEM_NPM,
+			current: "4.17.15",
+			vulns: []Vulnerability{
+				{ID: "GHSA-1", Fixes: []string{"3.10.2", "4.17.19", "5.0.1"}},
+			},
+			expected: "4.17.19",
+		},
+		{
+			name:    "the highest of the minimum fixes",
+			eco:     pb.DepEcosystem_DEP_ECOSYSTEM_NPM,
+			current: "4.17.15",
+			vulns: []Vulnerability{
+				{ID: "GHSA-1", Fixes: []string{"4.17.19"}},
+				{ID: "GHSA-2", Fixes: []string{"4.17.21"}},
+			},
+			expected: "4.17.21",
+		},
+		{
+			name:    "go versions get the v prefix",
+			eco:     pb.DepEcosystem_DEP_ECOSYSTEM_GO,
+			current: "v0.3.7",
+			vulns: []Vulnerability{
+				{ID: "GO-1", Fixes: []string{"0.3.8"}},
+			},
+			expected: "v0.3.8",
+		},
+		{
+			name:    "pypi",
+			eco:     pb.DepEcosystem_DEP_ECOSYSTEM_PYPI,
+			current: "2.19.0",
+			vulns: []Vulnerability{
+				{ID: "PYSEC-1", Fixes: []string{"2.20.0", "2.3.0"}},
+			},
+			expected: "2.20.0",
+		},
+		{
+			name:    "a vulnerability without a fix",
+			eco:     pb.DepEcosystem_DEP_ECOSYSTEM_NPM,
+			current: "1.0.0",
+			vulns: []Vulnerability{
+				{ID: "GHSA-1", Fixes: []string{"1.0.1"}},
+				{ID: "GHSA-2"},
+			},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, minimumFixedVersion(tt.eco, tt.current, tt.vulns))
+		})
+	}
+}
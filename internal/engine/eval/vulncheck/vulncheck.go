@@ -18,6 +18,7 @@ package vulncheck
 import (
 	"context"
 	"fmt"
+	"time"
 
 	engif "github.com/stacklok/minder/internal/engine/interfaces"
 	"github.com/stacklok/minder/internal/providers"
@@ -79,6 +80,7 @@ func (e *Evaluator) Eval(ctx context.Context, pol map[string]any, res *engif.Res
 	}
 
 	pkgRepoCache := newRepoCache()
+	now := time.Now()
 
 	for _, dep := range prdeps.Deps {
 		if dep.Dep == nil || dep.Dep.Version == "" {
@@ -101,9 +103,17 @@ func (e *Evaluator) Eval(ctx context.Context, pol map[string]any, res *engif.Res
 			return fmt.Errorf("failed to query vulncheck db: %w", err)
 		}
 
-		if len(response.Vulns) == 0 {
+		vulns, suppressed := ruleConfig.Filter.apply(dep.Dep, response.Vulns, now)
+		if len(suppressed) > 0 {
+			if err := prReplyHandler.trackSuppressedDep(ctx, dep, suppressed); err != nil {
+				return fmt.Errorf("failed to track suppressed vulnerabilities: %w", err)
+			}
+		}
+
+		if len(vulns) == 0 {
 			continue
 		}
+		response.Vulns = vulns
 
 		// TODO(jhrozek): this should be a list of vulnerabilities
 		evalErr = fmt.Errorf("vulnerabilities found for %s", dep.Dep.Name)
@@ -113,7 +123,10 @@ func (e *Evaluator) Eval(ctx context.Context, pol map[string]any, res *engif.Res
 			return fmt.Errorf("failed to create package repository: %w", err)
 		}
 
-		patch, err := pkgRepo.SendRecvRequest(ctx, dep.Dep)
+		// suggest the smallest upgrade that fixes all the vulnerabilities, the
+		// latest version is only a fallback if some of them aren't fixed yet
+		fixedVersion := minimumFixedVersion(dep.Dep.Ecosystem, dep.Dep.Version, vulns)
+		patch, err := pkgRepo.SendRecvRequest(ctx, dep.Dep, fixedVersion)
 		if err != nil {
 			return fmt.Errorf("failed to send package request: %w", err)
 		}
@@ -150,7 +163,7 @@ func (_ *Evaluator) queryVulnDb(
 		return nil, fmt.Errorf("failed to create vulncheck request: %w", err)
 	}
 
-	response, err := db.SendRecvRequest(req)
+	response, err := db.SendRecvRequest(req, dep, ecosystem)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send vulncheck request: %w", err)
 	}
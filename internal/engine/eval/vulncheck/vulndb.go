@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
@@ -33,6 +34,14 @@ type Vulnerability struct {
 	Details    string `json:"details"`
 	Introduced string `json:"introduced,omitempty"`
 	Fixed      string `json:"fixed,omitempty"`
+	// Fixes are all the versions that fix the vulnerability, one per affected range
+	Fixes []string `json:"fixes,omitempty"`
+	// Aliases are other IDs of the same vulnerability, e.g. the CVE for a GHSA
+	Aliases []string `json:"aliases,omitempty"`
+	// Severity is the qualitative severity as reported by the database, if any
+	Severity string `json:"severity,omitempty"`
+	// CvssScore is the CVSS v3 base score, zero if not known
+	CvssScore float64 `json:"cvss_score,omitempty"`
 }
 
 // VulnerabilityResponse is a response from the vulnerability database
@@ -43,7 +52,7 @@ type VulnerabilityResponse struct {
 // TODO(jakub): it's ugly that we depend on types from ingester/diff
 type vulnDb interface {
 	NewQuery(ctx context.Context, dep *pb.Dependency, eco pb.DepEcosystem) (*http.Request, error)
-	SendRecvRequest(r *http.Request) (*VulnerabilityResponse, error)
+	SendRecvRequest(r *http.Request, dep *pb.Dependency, eco pb.DepEcosystem) (*VulnerabilityResponse, error)
 }
 
 // OSVResponse is a response from the OSV database
@@ -91,18 +100,39 @@ type OSVResponse struct {
 	} `json:"vulns"`
 }
 
-func toVulnerabilityResponse(osvResp *OSVResponse) *VulnerabilityResponse {
+// toVulnerabilityResponse converts the OSV response to a query about the
+// dependency. An advisory may list several packages, only the ranges of the
+// queried one are taken into account.
+func toVulnerabilityResponse(osvResp *OSVResponse, dep *pb.Dependency, eco pb.DepEcosystem) *VulnerabilityResponse {
 	var vulnResp VulnerabilityResponse
 
 	for _, osvVuln := range osvResp.Vulns {
 		vuln := Vulnerability{
-			ID:      osvVuln.ID,
-			Summary: osvVuln.Summary,
-			Details: osvVuln.Details,
+			ID:       osvVuln.ID,
+			Summary:  osvVuln.Summary,
+			Details:  osvVuln.Details,
+			Aliases:  osvVuln.Aliases,
+			Severity: strings.ToLower(osvVuln.DatabaseSpecific.Severity),
+		}
+
+		for _, sev := range osvVuln.Severity {
+			if sev.Type != "CVSS_V3" {
+				continue
+			}
+			score, err := cvssV3BaseScore(sev.Score)
+			if err != nil {
+				// a malformed vector shouldn't fail the evaluation, we just
+				// don't know the score
+				continue
+			}
+			vuln.CvssScore = score
 		}
 
-		// TODO(jakub): this only takes the first introduced/fixed version
 		for _, affected := range osvVuln.Affected {
+			if !strings.EqualFold(affected.Package.Name, dep.GetName()) ||
+				!strings.EqualFold(affected.Package.Ecosystem, eco.AsString()) {
+				continue
+			}
 			for _, r := range affected.Ranges {
 				for _, event := range r.Events {
 					if event.Introduced != "" {
@@ -110,6 +140,10 @@ func toVulnerabilityResponse(osvResp *OSVResponse) *VulnerabilityResponse {
 					}
 					if event.Fixed != "" {
 						vuln.Fixed = event.Fixed
+						// GIT ranges are commit hashes, not versions we can suggest
+						if r.Type != "GIT" {
+							vuln.Fixes = append(vuln.Fixes, event.Fixed)
+						}
 					}
 				}
 			}
@@ -155,7 +189,9 @@ func (o *osvdb) NewQuery(ctx context.Context, dep *pb.Dependency, eco pb.DepEcos
 	return req, nil
 }
 
-func (_ *osvdb) SendRecvRequest(r *http.Request) (*VulnerabilityResponse, error) {
+func (_ *osvdb) SendRecvRequest(
+	r *http.Request, dep *pb.Dependency, eco pb.DepEcosystem,
+) (*VulnerabilityResponse, error) {
 	client := &http.Client{}
 	resp, err := client.Do(r)
 	if err != nil {
@@ -174,5 +210,5 @@ func (_ *osvdb) SendRecvRequest(r *http.Request) (*VulnerabilityResponse, error)
 		return nil, fmt.Errorf("could not decode response body: %w", err)
 	}
 
-	return toVulnerabilityResponse(&response), nil
+	return toVulnerabilityResponse(&response, dep, eco), nil
 }
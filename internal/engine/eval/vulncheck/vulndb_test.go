@@ -0,0 +1,85 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vulncheck
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// osvMultiPackageReply is an advisory affecting several packages, in the
+// queried ecosystem and in another one
+const osvMultiPackageReply = `{
+  "vulns": [
+    {
+      "id": "GHSA-xxxx-xxxx-xxxx",
+      "summary": "Prototype pollution",
+      "affected": [
+        {
+          "package": {"name": "other-package", "ecosystem": "npm"},
+          "ranges": [{"type": "SEMVER", "events": [{"introduced": "0"}, {"fixed": "9.9.9"}]}]
+        },
+        {
+          "package": {"name": "lodash", "ecosystem": "npm"},
+          "ranges": [{"type": "SEMVER", "events": [{"introduced": "4.0.0"}, {"fixed": "4.17.21"}]}]
+        },
+        {
+          "package": {"name": "lodash", "ecosystem": "PyPI"},
+          "ranges": [{"type": "ECOSYSTEM", "events": [{"introduced": "0"}, {"fixed": "1.0.0"}]}]
+        }
+      ]
+    }
+  ]
+}`
+
+func TestOsvDbFixesOfQueriedPackage(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(osvMultiPackageReply))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}))
+	defer server.Close()
+
+	db := newOsvDb(server.URL)
+	dep := &pb.Dependency{
+		Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_NPM,
+		Name:      "lodash",
+		Version:   "4.17.0",
+	}
+
+	req, err := db.NewQuery(context.Background(), dep, dep.Ecosystem)
+	require.NoError(t, err)
+
+	resp, err := db.SendRecvRequest(req, dep, dep.Ecosystem)
+	require.NoError(t, err)
+	require.Len(t, resp.Vulns, 1)
+
+	vuln := resp.Vulns[0]
+	assert.Equal(t, "GHSA-xxxx-xxxx-xxxx", vuln.ID)
+	assert.Equal(t, "4.0.0", vuln.Introduced)
+	assert.Equal(t, "4.17.21", vuln.Fixed)
+	assert.Equal(t, []string{"4.17.21"}, vuln.Fixes)
+}
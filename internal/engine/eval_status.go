@@ -46,6 +46,7 @@ func (e *Executor) createEvalStatusParams(
 		Rule:       rule,
 		Profile:    profile,
 		ProfileID:  profileID,
+		ProjectID:  *inf.ProjectID,
 		RepoID:     uuid.MustParse(inf.OwnershipData[RepositoryIDEventKey]),
 		EntityType: entities.EntityTypeToDB(inf.Type),
 	}
@@ -104,6 +105,36 @@ func (e *Executor) createEvalStatusParams(
 	// Save the current rule evaluation status to the evalParams
 	params.EvalStatusFromDb = &evalStatus
 
+	// Get the state of each remediation and alert, if the rule was evaluated before
+	if evalStatus.RuleEvaluationID != uuid.Nil {
+		remediations, err := e.querier.ListRuleDetailsRemediate(ctx, evalStatus.RuleEvaluationID)
+		if err != nil {
+			return nil, fmt.Errorf("error getting rule remediation details from db: %w", err)
+		}
+		params.RemediationsFromDb = make(map[string]db.RuleDetailsRemediate, len(remediations))
+		for _, rem := range remediations {
+			params.RemediationsFromDb[rem.ActionName] = rem
+		}
+
+		alerts, err := e.querier.ListRuleDetailsAlert(ctx, evalStatus.RuleEvaluationID)
+		if err != nil {
+			return nil, fmt.Errorf("error getting rule alert details from db: %w", err)
+		}
+		params.AlertsFromDb = make(map[string]db.RuleDetailsAlert, len(alerts))
+		for _, alert := range alerts {
+			params.AlertsFromDb[alert.ActionName] = alert
+		}
+
+		approvals, err := e.querier.ListLatestRemediationApprovals(ctx, evalStatus.RuleEvaluationID)
+		if err != nil {
+			return nil, fmt.Errorf("error getting remediation approvals from db: %w", err)
+		}
+		params.RemediationApprovalsFromDb = make(map[string]db.RemediationApproval, len(approvals))
+		for _, approval := range approvals {
+			params.RemediationApprovalsFromDb[approval.ActionName] = approval
+		}
+	}
+
 	return params, nil
 }
 
@@ -163,34 +194,105 @@ func (e *Executor) createOrUpdateEvalStatus(
 			Msg("error upserting rule evaluation details")
 		return err
 	}
-	// Upsert remediation details
-	_, err = e.querier.UpsertRuleDetailsRemediate(ctx, db.UpsertRuleDetailsRemediateParams{
-		RuleEvalID: id,
-		Status:     evalerrors.ErrorAsRemediationStatus(params.GetActionsErr().RemediateErr),
-		Details:    errorAsActionDetails(params.GetActionsErr().RemediateErr),
-	})
-	if err != nil {
-		logger.Err(err).
-			Str("repo_id", params.RepoID.String()).
-			Str("entity_type", string(params.EntityType)).
-			Str("profile_id", params.ProfileID.String()).
-			Msg("error upserting rule remediation details")
+	// Upsert remediation details, one per remediation
+	for _, rem := range params.GetActionsErr().Remediations {
+		_, err = e.querier.UpsertRuleDetailsRemediate(ctx, db.UpsertRuleDetailsRemediateParams{
+			RuleEvalID:       id,
+			ActionName:       rem.Name,
+			Status:           evalerrors.ErrorAsRemediationStatus(rem.Err),
+			Details:          errorAsActionDetails(rem.Err),
+			Metadata:         rem.Meta,
+			RuleTypeRevision: params.RuleType.GetRevision(),
+		})
+		if err != nil {
+			logger.Err(err).
+				Str("repo_id", params.RepoID.String()).
+				Str("entity_type", string(params.EntityType)).
+				Str("profile_id", params.ProfileID.String()).
+				Str("action_name", rem.Name).
+				Msg("error upserting rule remediation details")
+		}
 	}
-	// Upsert alert details
-	_, err = e.querier.UpsertRuleDetailsAlert(ctx, db.UpsertRuleDetailsAlertParams{
-		RuleEvalID: id,
-		Status:     evalerrors.ErrorAsAlertStatus(params.GetActionsErr().AlertErr),
-		Details:    errorAsActionDetails(params.GetActionsErr().AlertErr),
-		Metadata:   params.GetActionsErr().AlertMeta,
-	})
-	if err != nil {
+	// Keep track of the remediations waiting for approval
+	if err := e.updateRemediationApprovals(ctx, id, params); err != nil {
 		logger.Err(err).
 			Str("repo_id", params.RepoID.String()).
 			Str("entity_type", string(params.EntityType)).
 			Str("profile_id", params.ProfileID.String()).
-			Msg("error upserting rule alert details")
+			Msg("error updating remediation approvals")
+	}
+	// Upsert alert details, one per alert
+	var alertErr error
+	for _, alert := range params.GetActionsErr().Alerts {
+		_, err := e.querier.UpsertRuleDetailsAlert(ctx, db.UpsertRuleDetailsAlertParams{
+			RuleEvalID: id,
+			ActionName: alert.Name,
+			Status:     evalerrors.ErrorAsAlertStatus(alert.Err),
+			Details:    errorAsActionDetails(alert.Err),
+			Metadata:   alert.Meta,
+		})
+		if err != nil {
+			logger.Err(err).
+				Str("repo_id", params.RepoID.String()).
+				Str("entity_type", string(params.EntityType)).
+				Str("profile_id", params.ProfileID.String()).
+				Str("action_name", alert.Name).
+				Msg("error upserting rule alert details")
+			alertErr = errors.Join(alertErr, err)
+		}
+	}
+	return alertErr
+}
+
+// updateRemediationApprovals records the remediations rendered for approval and
+// the outcome of the approved ones. Approvals in progress expire once the rule
+// passes again.
+func (e *Executor) updateRemediationApprovals(
+	ctx context.Context,
+	ruleEvalID uuid.UUID,
+	params *engif.EvalStatusParams,
+) error {
+	if evalerrors.ErrorAsEvalStatus(params.GetEvalErr()) == db.EvalStatusTypesSuccess {
+		for _, approval := range params.RemediationApprovalsFromDb {
+			if approval.Status == db.RemediationApprovalStatusPending ||
+				approval.Status == db.RemediationApprovalStatusApproved {
+				return e.querier.ExpireRemediationApprovals(ctx, ruleEvalID)
+			}
+		}
+		return nil
+	}
+
+	var errs error
+	for _, rem := range params.GetActionsErr().Remediations {
+		var pending *evalerrors.PendingApprovalError
+		if errors.As(rem.Err, &pending) {
+			errs = errors.Join(errs, e.querier.UpsertRemediationApproval(ctx, db.UpsertRemediationApprovalParams{
+				ProjectID:       params.ProjectID,
+				RuleEvalID:      ruleEvalID,
+				ActionName:      rem.Name,
+				RemediationType: pending.Type,
+				Preview:         pending.Preview,
+			}))
+			continue
+		}
+
+		approval := params.GetRemediationApprovalFromDb(rem.Name)
+		if approval == nil || approval.Status != db.RemediationApprovalStatusApproved ||
+			evalerrors.IsActionInformativeError(rem.Err) {
+			continue
+		}
+		// the approved remediation was performed
+		status := db.RemediationApprovalStatusExecuted
+		if rem.Err != nil {
+			status = db.RemediationApprovalStatusFailed
+		}
+		errs = errors.Join(errs, e.querier.UpdateRemediationApprovalStatus(ctx, db.UpdateRemediationApprovalStatusParams{
+			ID:      approval.ID,
+			Status:  status,
+			Details: errorAsActionDetails(rem.Err),
+		}))
 	}
-	return err
+	return errs
 }
 
 func errorAsActionDetails(err error) string {
@@ -16,6 +16,7 @@ package engine
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/ThreeDotsLabs/watermill/message"
@@ -25,11 +26,14 @@ import (
 	"github.com/stacklok/minder/internal/config"
 	"github.com/stacklok/minder/internal/crypto"
 	"github.com/stacklok/minder/internal/db"
+	"github.com/stacklok/minder/internal/engine/actions/alert"
+	"github.com/stacklok/minder/internal/engine/actions/alert/digest"
 	evalerrors "github.com/stacklok/minder/internal/engine/errors"
 	"github.com/stacklok/minder/internal/engine/ingestcache"
 	engif "github.com/stacklok/minder/internal/engine/interfaces"
 	"github.com/stacklok/minder/internal/events"
 	"github.com/stacklok/minder/internal/providers"
+	ghprov "github.com/stacklok/minder/internal/providers/github"
 	providertelemetry "github.com/stacklok/minder/internal/providers/telemetry"
 	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
 )
@@ -44,6 +48,7 @@ type Executor struct {
 	querier  db.Store
 	crypteng *crypto.Engine
 	provMt   providertelemetry.ProviderMetrics
+	ghApp    *ghprov.App
 }
 
 // ExecutorOption is a function that modifies an executor
@@ -56,6 +61,14 @@ func WithProviderMetrics(mt providertelemetry.ProviderMetrics) ExecutorOption {
 	}
 }
 
+// WithGitHubApp sets the GitHub App used to authenticate GitHub providers
+// that have an installation
+func WithGitHubApp(app *ghprov.App) ExecutorOption {
+	return func(e *Executor) {
+		e.ghApp = app
+	}
+}
+
 // NewExecutor creates a new executor
 func NewExecutor(
 	querier db.Store,
@@ -114,6 +127,7 @@ func (e *Executor) HandleEntityEvent(msg *message.Message) error {
 
 	pbOpts := []providers.ProviderBuilderOption{
 		providers.WithProviderMetrics(e.provMt),
+		providers.WithGitHubApp(e.ghApp),
 	}
 	cli, err := providers.GetProviderBuilder(ctx, provider, *projectID, e.querier, e.crypteng, pbOpts...)
 	if err != nil {
@@ -134,6 +148,33 @@ func (e *Executor) HandleEntityEvent(msg *message.Message) error {
 	return e.evalEntityEvent(ctx, inf, ectx, cli)
 }
 
+// NewDigestNotifier creates the notifier a digest is delivered through, using
+// the provider the digest was enqueued with.
+func (e *Executor) NewDigestNotifier(
+	ctx context.Context,
+	d *db.AlertDigest,
+	alertCfg *pb.RuleType_Definition_Alert,
+) (digest.Notifier, error) {
+	provider, err := e.querier.GetProviderByName(ctx, db.GetProviderByNameParams{
+		Name:      d.Provider,
+		ProjectID: d.ProjectID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting provider: %w", err)
+	}
+
+	pbOpts := []providers.ProviderBuilderOption{
+		providers.WithProviderMetrics(e.provMt),
+		providers.WithGitHubApp(e.ghApp),
+	}
+	cli, err := providers.GetProviderBuilder(ctx, provider, d.ProjectID, e.querier, e.crypteng, pbOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error building client: %w", err)
+	}
+
+	return alert.NewDigestNotifier(alertCfg, cli)
+}
+
 func (e *Executor) evalEntityEvent(
 	ctx context.Context,
 	inf *EntityInfoWrapper,
@@ -151,6 +192,10 @@ func (e *Executor) evalEntityEvent(
 		return fmt.Errorf("error getting profiles: %w", err)
 	}
 
+	// the rules which couldn't get to their data are recorded, the others are
+	// still evaluated, and the event is retried once all of them are done
+	var retriableErr error
+
 	for _, profile := range MergeDatabaseListIntoProfiles(dbpols, ectx) {
 		// Get only these rules that are relevant for this entity type
 		relevant, err := GetRulesForEntity(profile, inf.Type)
@@ -176,7 +221,16 @@ func (e *Executor) evalEntityEvent(
 			logEval(ctx, inf, evalParams)
 
 			// Create or update the evaluation status
-			return e.createOrUpdateEvalStatus(ctx, evalParams)
+			if err := e.createOrUpdateEvalStatus(ctx, evalParams); err != nil {
+				return err
+			}
+
+			// the evaluation couldn't get to the data, e.g. because the
+			// provider's rate limit ran out. Have the event retried.
+			if evalErr := evalParams.GetEvalErr(); errors.Is(evalErr, events.ErrRetriable) {
+				retriableErr = errors.Join(retriableErr, fmt.Errorf("rule %s: %w", rule.Type, evalErr))
+			}
+			return nil
 		})
 
 		if err != nil {
@@ -187,7 +241,7 @@ func (e *Executor) evalEntityEvent(
 			return fmt.Errorf("error traversing rules for profile %s: %w", p, err)
 		}
 	}
-	return nil
+	return retriableErr
 }
 
 func (e *Executor) getEvaluator(
@@ -232,7 +286,7 @@ func (e *Executor) getEvaluator(
 	params.RuleType = rt
 
 	// Create the rule type engine
-	rte, err := NewRuleTypeEngine(profile, rt, cli)
+	rte, err := NewRuleTypeEngine(profile, rt, cli, e.querier)
 	if err != nil {
 		return nil, nil, fmt.Errorf("error creating rule type engine: %w", err)
 	}
@@ -262,15 +316,21 @@ func logEval(
 	// log evaluation
 	evalLog.Err(params.GetEvalErr()).Msg("result - evaluation")
 
-	// log remediation
-	logger.Debug().
-		Str("action", "remediate").
-		Str("action_status", string(evalerrors.ErrorAsRemediationStatus(params.GetActionsErr().RemediateErr))).
-		Msg("result - action")
-
-	// log alert
-	logger.Debug().
-		Str("action", "alert").
-		Str("action_status", string(evalerrors.ErrorAsAlertStatus(params.GetActionsErr().AlertErr))).
-		Msg("result - action")
+	// log remediations
+	for _, rem := range params.GetActionsErr().Remediations {
+		logger.Debug().
+			Str("action", "remediate").
+			Str("action_name", rem.Name).
+			Str("action_status", string(evalerrors.ErrorAsRemediationStatus(rem.Err))).
+			Msg("result - action")
+	}
+
+	// log alerts
+	for _, alert := range params.GetActionsErr().Alerts {
+		logger.Debug().
+			Str("action", "alert").
+			Str("action_name", alert.Name).
+			Str("action_status", string(evalerrors.ErrorAsAlertStatus(alert.Err))).
+			Msg("result - action")
+	}
 }
@@ -206,16 +206,17 @@ default allow = true`,
 			Details:    "",
 		}).Return(ruleEvalDetailsId, nil)
 
+	// Empty metadata
+	meta, _ := json.Marshal(map[string]any{})
 	// Mock upserting remediate status
 	ruleEvalRemediationId := uuid.New()
 	mockStore.EXPECT().
 		UpsertRuleDetailsRemediate(gomock.Any(), db.UpsertRuleDetailsRemediateParams{
 			RuleEvalID: ruleEvalId,
 			Status:     db.RemediationStatusTypesSkipped,
+			Metadata:   meta,
 			Details:    "",
 		}).Return(ruleEvalRemediationId, nil)
-	// Empty metadata
-	meta, _ := json.Marshal(map[string]any{})
 	// Mock upserting alert status
 	ruleEvalAlertId := uuid.New()
 	mockStore.EXPECT().
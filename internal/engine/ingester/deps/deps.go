@@ -0,0 +1,212 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package deps provides the repository dependency inventory rule data ingest engine
+package deps
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/util"
+	"github.com/mitchellh/mapstructure"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/stacklok/minder/internal/db"
+	"github.com/stacklok/minder/internal/engine/ingester/diff"
+	engif "github.com/stacklok/minder/internal/engine/interfaces"
+	"github.com/stacklok/minder/internal/providers"
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+const (
+	// DepsRuleDataIngestType is the type of the deps rule data ingest engine
+	DepsRuleDataIngestType = "deps"
+	defaultBranch          = "main"
+)
+
+// Deps is the engine for a rule type that uses the repository dependency inventory
+type Deps struct {
+	cfg     *pb.DepsType
+	gitprov provifv1.Git
+}
+
+// NewDepsIngester creates a new deps rule data ingest engine
+func NewDepsIngester(cfg *pb.DepsType, pbuild *providers.ProviderBuilder) (*Deps, error) {
+	if pbuild == nil {
+		return nil, fmt.Errorf("provider builder is nil")
+	}
+
+	if !pbuild.Implements(db.ProviderTypeGit) {
+		return nil, fmt.Errorf("provider builder does not implement git")
+	}
+
+	if cfg == nil {
+		cfg = &pb.DepsType{}
+	}
+
+	gitprov, err := pbuild.GetGit()
+	if err != nil {
+		return nil, fmt.Errorf("could not get git provider: %w", err)
+	}
+
+	return &Deps{
+		cfg:     cfg,
+		gitprov: gitprov,
+	}, nil
+}
+
+// GetType returns the type of the deps rule data ingest engine
+func (*Deps) GetType() string {
+	return DepsRuleDataIngestType
+}
+
+// GetConfig returns the config for the deps rule data ingest engine
+func (di *Deps) GetConfig() protoreflect.ProtoMessage {
+	return di.cfg
+}
+
+// Ingest clones the repository and returns the dependencies found in all the
+// dependency files of the configured ecosystems
+func (di *Deps) Ingest(ctx context.Context, ent protoreflect.ProtoMessage, params map[string]any) (*engif.Result, error) {
+	repo, ok := ent.(*pb.Repository)
+	if !ok {
+		return nil, fmt.Errorf("entity is not a repository")
+	}
+
+	userCfg := &IngesterConfig{}
+	if err := mapstructure.Decode(params, userCfg); err != nil {
+		return nil, fmt.Errorf("failed to read deps ingester configuration from params: %w", err)
+	}
+
+	if repo.GetCloneUrl() == "" {
+		return nil, fmt.Errorf("could not get clone url")
+	}
+
+	branch := di.getBranch(userCfg)
+
+	r, err := di.gitprov.Clone(ctx, repo.GetCloneUrl(), branch)
+	if err != nil {
+		return nil, fmt.Errorf("could not clone repo: %w", err)
+	}
+
+	wt, err := r.Worktree()
+	if err != nil {
+		return nil, fmt.Errorf("could not get worktree: %w", err)
+	}
+
+	deps, err := ScanFilesystem(ctx, wt.Filesystem, di.cfg.Ecosystems)
+	if err != nil {
+		return nil, fmt.Errorf("could not scan repository for dependencies: %w", err)
+	}
+
+	return &engif.Result{
+		Object: pb.RepoDependencies{
+			Repo:   repo,
+			Branch: branch,
+			Deps:   deps,
+		},
+		Fs: wt.Filesystem,
+	}, nil
+}
+
+func (di *Deps) getBranch(userCfg *IngesterConfig) string {
+	if userCfg.Branch != "" {
+		return userCfg.Branch
+	}
+
+	if di.cfg.Branch != "" {
+		return di.cfg.Branch
+	}
+
+	return defaultBranch
+}
+
+// ScanFilesystem walks the filesystem and parses every file that matches one
+// of the ecosystems. Files that can't be parsed are logged and skipped, a single
+// malformed lockfile shouldn't hide the rest of the inventory.
+func ScanFilesystem(
+	ctx context.Context,
+	fs billy.Filesystem,
+	ecosystems []*pb.DiffType_Ecosystem,
+) ([]*pb.PrDependencies_ContextualDependency, error) {
+	logger := zerolog.Ctx(ctx)
+	deps := make([]*pb.PrDependencies_ContextualDependency, 0)
+
+	err := util.Walk(fs, "/", func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			if info.Name() == "node_modules" || info.Name() == "vendor" || info.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		eco := diff.EcosystemForFile(ecosystems, path)
+		if eco == diff.DepEcosystemNone {
+			return nil
+		}
+
+		contents, err := readFile(fs, path)
+		if err != nil {
+			return err
+		}
+
+		fileDeps, err := diff.ParseDepfile(eco, contents)
+		if err != nil {
+			logger.Warn().Err(err).Str("path", path).Msg("could not parse dependency file, skipping")
+			return nil
+		}
+
+		for _, dep := range fileDeps {
+			deps = append(deps, &pb.PrDependencies_ContextualDependency{
+				Dep: dep,
+				File: &pb.PrDependencies_ContextualDependency_FilePatch{
+					Name: path,
+				},
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deps, nil
+}
+
+func readFile(fs billy.Filesystem, path string) (string, error) {
+	f, err := fs.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("could not open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	contents, err := io.ReadAll(f)
+	if err != nil {
+		return "", fmt.Errorf("could not read %s: %w", path, err)
+	}
+
+	return string(contents), nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package deps provides the repository dependency inventory rule data ingest engine
+package deps
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestScanFilesystem(t *testing.T) {
+	t.Parallel()
+
+	fs := memfs.New()
+	for path, content := range map[string]string{
+		"requirements.txt":                     "requests==2.31.0\n",
+		"web/package-lock.json":                `{"packages": {"node_modules/lodash": {"version": "4.17.21"}}}`,
+		"web/node_modules/x/package-lock.json": `{"packages": {"node_modules/ignored": {"version": "1.0.0"}}}`,
+		"broken/package-lock.json":             "{",
+		"README.md":                            "not a dependency file",
+	} {
+		require.NoError(t, util.WriteFile(fs, path, []byte(content), 0644))
+	}
+
+	ecosystems := []*pb.DiffType_Ecosystem{
+		{Name: "npm", Depfile: "package-lock.json"},
+		{Name: "pypi", Depfile: "requirements.txt"},
+	}
+
+	deps, err := ScanFilesystem(context.Background(), fs, ecosystems)
+	require.NoError(t, err)
+
+	found := make(map[string]string, len(deps))
+	for _, d := range deps {
+		found[d.Dep.Name] = d.File.Name
+	}
+	assert.Len(t, found, 2)
+	assert.Contains(t, found["requests"], "requirements.txt")
+	assert.Contains(t, found["lodash"], "web/package-lock.json")
+}
@@ -0,0 +1,117 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diff provides the diff rule data ingest engine
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// EcosystemForFile returns the ecosystem of a dependency file according to the
+// ecosystem mapping of the ingester or DepEcosystemNone if the file doesn't match
+func EcosystemForFile(ecosystems []*pb.DiffType_Ecosystem, filename string) DependencyEcosystem {
+	lastComponent := filepath.Base(filename)
+
+	for _, ecoMapping := range ecosystems {
+		if match, _ := filepath.Match(ecoMapping.Depfile, lastComponent); match {
+			return DependencyEcosystem(ecoMapping.Name)
+		}
+	}
+	return DepEcosystemNone
+}
+
+// ParseDepfile parses the complete contents of a dependency file, as opposed to
+// the added lines of a patch
+func ParseDepfile(eco DependencyEcosystem, contents string) ([]*pb.Dependency, error) {
+	switch strings.ToLower(string(eco)) {
+	case string(DepEcosystemNPM):
+		return npmLockfileParse(contents)
+	case string(DepEcosystemGo), string(DepEcosystemPyPI):
+		// both the go.sum and the requirements.txt parsers only look at
+		// added lines, so we present the whole file as being added
+		parser := newEcosystemParser(eco)
+		return parser(asAddedLines(contents))
+	default:
+		return nil, fmt.Errorf("unsupported ecosystem: %s", eco)
+	}
+}
+
+func asAddedLines(contents string) string {
+	lines := strings.Split(contents, "\n")
+	for i := range lines {
+		lines[i] = "+" + lines[i]
+	}
+	return strings.Join(lines, "\n")
+}
+
+type npmLockfile struct {
+	// lockfile v2 and v3, keyed by the path in node_modules
+	Packages map[string]*npmDependency `json:"packages"`
+	// lockfile v1, keyed by the package name
+	Dependencies map[string]*npmDependency `json:"dependencies"`
+}
+
+const nodeModulesPrefix = "node_modules/"
+
+func npmLockfileParse(contents string) ([]*pb.Dependency, error) {
+	var lockfile npmLockfile
+	if err := json.Unmarshal([]byte(contents), &lockfile); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal npm lockfile: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	deps := make([]*pb.Dependency, 0, len(lockfile.Packages)+len(lockfile.Dependencies))
+	addDep := func(name, version string) {
+		key := name + "@" + version
+		if name == "" || version == "" || seen[key] {
+			return
+		}
+		seen[key] = true
+		deps = append(deps, &pb.Dependency{
+			Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_NPM,
+			Name:      name,
+			Version:   version,
+		})
+	}
+
+	for path, dep := range lockfile.Packages {
+		// the empty key is the project itself
+		idx := strings.LastIndex(path, nodeModulesPrefix)
+		if idx == -1 {
+			continue
+		}
+		addDep(path[idx+len(nodeModulesPrefix):], dep.Version)
+	}
+
+	for name, dep := range lockfile.Dependencies {
+		addDep(name, dep.Version)
+	}
+
+	// map iteration order is random, keep the output stable
+	sort.Slice(deps, func(i, j int) bool {
+		if deps[i].Name == deps[j].Name {
+			return deps[i].Version < deps[j].Version
+		}
+		return deps[i].Name < deps[j].Name
+	})
+
+	return deps, nil
+}
@@ -0,0 +1,122 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diff provides the diff rule data ingest engine
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestParseDepfile(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description string
+		ecosystem   DependencyEcosystem
+		content     string
+		expected    []*pb.Dependency
+		wantErr     bool
+	}{
+		{
+			description: "npm lockfile v3",
+			ecosystem:   DepEcosystemNPM,
+			content: `{
+  "name": "app",
+  "lockfileVersion": 3,
+  "packages": {
+    "": {"name": "app", "version": "1.0.0"},
+    "node_modules/lodash": {"version": "4.17.21"},
+    "node_modules/@types/node": {"version": "20.8.0"},
+    "node_modules/a/node_modules/lodash": {"version": "4.17.20"},
+    "node_modules/b/node_modules/lodash": {"version": "4.17.20"}
+  }
+}`,
+			expected: []*pb.Dependency{
+				{Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_NPM, Name: "@types/node", Version: "20.8.0"},
+				{Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_NPM, Name: "lodash", Version: "4.17.20"},
+				{Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_NPM, Name: "lodash", Version: "4.17.21"},
+			},
+		},
+		{
+			description: "npm lockfile v1",
+			ecosystem:   DepEcosystemNPM,
+			content: `{
+  "name": "app",
+  "lockfileVersion": 1,
+  "dependencies": {
+    "express": {"version": "4.18.2"}
+  }
+}`,
+			expected: []*pb.Dependency{
+				{Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_NPM, Name: "express", Version: "4.18.2"},
+			},
+		},
+		{
+			description: "malformed npm lockfile",
+			ecosystem:   DepEcosystemNPM,
+			content:     "{",
+			wantErr:     true,
+		},
+		{
+			description: "go.sum",
+			ecosystem:   DepEcosystemGo,
+			content: `golang.org/x/mod v0.13.0 h1:I/DsJXRlw/8l/0c24sM9yb0T4z9liZTduXvdAWYiysY=
+golang.org/x/mod v0.13.0/go.mod h1:hTbmBsO62+eylJbnUtE2MGJUyE7QWk4xUqPFrRgJ+7c=
+`,
+			expected: []*pb.Dependency{
+				{Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_GO, Name: "golang.org/x/mod", Version: "v0.13.0"},
+			},
+		},
+		{
+			description: "requirements.txt",
+			ecosystem:   DepEcosystemPyPI,
+			content:     "requests==2.31.0\n",
+			expected: []*pb.Dependency{
+				{Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_PYPI, Name: "requests", Version: "2.31.0"},
+			},
+		},
+		{
+			description: "unsupported ecosystem",
+			ecosystem:   DependencyEcosystem("cargo"),
+			content:     "",
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			deps, err := ParseDepfile(tt.ecosystem, tt.content)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, deps, len(tt.expected))
+			for i := range tt.expected {
+				assert.Equal(t, tt.expected[i].Ecosystem, deps[i].Ecosystem)
+				assert.Equal(t, tt.expected[i].Name, deps[i].Name)
+				assert.Equal(t, tt.expected[i].Version, deps[i].Version)
+			}
+		})
+	}
+}
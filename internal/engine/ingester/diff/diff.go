@@ -18,7 +18,6 @@ package diff
 import (
 	"context"
 	"fmt"
-	"path/filepath"
 
 	"github.com/rs/zerolog"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -155,14 +154,7 @@ func (di *Diff) ingestFile(
 }
 
 func (di *Diff) getEcosystemForFile(filename string) DependencyEcosystem {
-	lastComponent := filepath.Base(filename)
-
-	for _, ecoMapping := range di.cfg.Ecosystems {
-		if match, _ := filepath.Match(ecoMapping.Depfile, lastComponent); match {
-			return DependencyEcosystem(ecoMapping.Name)
-		}
-	}
-	return DepEcosystemNone
+	return EcosystemForFile(di.cfg.Ecosystems, filename)
 }
 
 func (di *Diff) getParserForFile(filename string, logger zerolog.Logger) ecosystemParser {
@@ -179,5 +171,5 @@ func (di *Diff) getParserForFile(filename string, logger zerolog.Logger) ecosyst
 		Str("package-ecosystem", string(eco)).
 		Msg("matched ecosystem")
 
-	return newEcosystemParser(eco)
+	return newParserForFile(eco, filename)
 }
@@ -19,14 +19,36 @@ import (
 	"bufio"
 	"encoding/json"
 	"fmt"
+	"path/filepath"
+	"regexp"
 	"strings"
 
+	"golang.org/x/mod/semver"
+
 	"github.com/stacklok/minder/internal/util"
 	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
 )
 
 type ecosystemParser func(string) ([]*pb.Dependency, error)
 
+var (
+	goModCommentRegexp = regexp.MustCompile(`\s//`)
+
+	packageJsonDepBlockRegexp = regexp.MustCompile(
+		`^\s*"(dependencies|devDependencies|optionalDependencies|peerDependencies)"\s*:\s*\{`)
+	packageJsonEntryRegexp = regexp.MustCompile(`^\s*"([^"]+)"\s*:\s*"([^"]*)"`)
+	semverRegexp           = regexp.MustCompile(`^\d+\.\d+\.\d+(-[0-9A-Za-z.-]+)?$`)
+)
+
+// newParserForFile returns the parser for a dependency file. Manifests whose
+// format differs from the lockfile of their ecosystem have a parser of their own.
+func newParserForFile(eco DependencyEcosystem, filename string) ecosystemParser {
+	if strings.EqualFold(string(eco), string(DepEcosystemNPM)) && filepath.Base(filename) == "package.json" {
+		return packageJsonParse
+	}
+	return newEcosystemParser(eco)
+}
+
 func newEcosystemParser(eco DependencyEcosystem) ecosystemParser {
 	switch strings.ToLower(string(eco)) {
 	case string(DepEcosystemNPM):
@@ -133,15 +155,7 @@ func goParse(patch string) ([]*pb.Dependency, error) {
 		line := scanner.Text()
 
 		if strings.HasPrefix(line, "+") {
-			fields := strings.Split(line, " ")
-			if len(fields) > 2 && !strings.HasSuffix(fields[1], "/go.mod") {
-				name, version := fields[0], fields[1]
-				dep := &pb.Dependency{
-					Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_GO,
-					Name:      name[1:],
-					Version:   version,
-				}
-
+			if dep := goParseLine(line[1:]); dep != nil {
 				deps = append(deps, dep)
 			}
 		}
@@ -154,6 +168,37 @@ func goParse(patch string) ([]*pb.Dependency, error) {
 	return deps, nil
 }
 
+// goParseLine parses either a go.sum line or a go.mod requirement. Requirements
+// can be single-line require directives or lines of a require block, the
+// latter are recognized by their two fields being a module and a version.
+func goParseLine(line string) *pb.Dependency {
+	// go.sum hashes may contain slashes, but never whitespace
+	if idx := goModCommentRegexp.FindStringIndex(line); idx != nil {
+		line = line[:idx[0]]
+	}
+
+	fields := strings.Fields(line)
+	if len(fields) > 0 && fields[0] == "require" {
+		fields = fields[1:]
+	}
+
+	switch {
+	case len(fields) == 3 && strings.HasPrefix(fields[2], "h1:"):
+		if strings.HasSuffix(fields[1], "/go.mod") {
+			return nil
+		}
+	case len(fields) == 2 && semver.IsValid(fields[1]):
+	default:
+		return nil
+	}
+
+	return &pb.Dependency{
+		Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_GO,
+		Name:      fields[0],
+		Version:   fields[1],
+	}
+}
+
 type npmDependency struct {
 	Version      string                    `json:"version"`
 	Resolved     string                    `json:"resolved"`
@@ -213,3 +258,65 @@ func npmParse(patch string) ([]*pb.Dependency, error) {
 	}
 	return deps, nil
 }
+
+// packageJsonParse parses the dependency entries added to package.json. The
+// patch only contains the hunks that changed, so entries are only recognized
+// in the hunks that include the header of their dependency block.
+func packageJsonParse(patch string) ([]*pb.Dependency, error) {
+	var deps []*pb.Dependency
+	inDeps := false
+
+	for _, line := range strings.Split(patch, "\n") {
+		if strings.HasPrefix(line, "@@") {
+			// a new hunk, we don't know which block it starts in
+			inDeps = false
+			continue
+		}
+		if line == "" || strings.HasPrefix(line, "-") {
+			continue
+		}
+
+		content := line[1:]
+		if packageJsonDepBlockRegexp.MatchString(content) {
+			inDeps = true
+			continue
+		}
+		if !inDeps {
+			continue
+		}
+		if strings.HasPrefix(strings.TrimSpace(content), "}") {
+			inDeps = false
+			continue
+		}
+		if !strings.HasPrefix(line, "+") {
+			continue
+		}
+
+		matches := packageJsonEntryRegexp.FindStringSubmatch(content)
+		if matches == nil {
+			continue
+		}
+		version := npmRangeVersion(matches[2])
+		if version == "" {
+			continue
+		}
+		deps = append(deps, &pb.Dependency{
+			Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_NPM,
+			Name:      matches[1],
+			Version:   version,
+		})
+	}
+
+	return deps, nil
+}
+
+// npmRangeVersion returns the lowest version allowed by a simple range such
+// as ^1.2.3 or ~1.2.3, or an empty string for ranges we can't reason about,
+// e.g. unions, wildcards or git URLs
+func npmRangeVersion(versionRange string) string {
+	version := strings.TrimLeft(strings.TrimSpace(versionRange), "^~>=v ")
+	if !semverRegexp.MatchString(version) {
+		return ""
+	}
+	return version
+}
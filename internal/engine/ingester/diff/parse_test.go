@@ -323,3 +323,74 @@ func TestPyPiParse(t *testing.T) {
 		})
 	}
 }
+
+func TestGoModParse(t *testing.T) {
+	t.Parallel()
+
+	patch := `@@ -3,9 +3,11 @@ module github.com/example/app
+ go 1.21
+ 
++require golang.org/x/mod v0.13.0
++
+ require (
+ 	github.com/google/uuid v1.3.1
+-	github.com/stretchr/testify v1.8.3
++	github.com/stretchr/testify v1.8.4
++	golang.org/x/text v0.13.0 // indirect
+ )
+ 
++replace github.com/google/uuid => ../uuid
++toolchain go1.21.3`
+
+	got, err := goParse(patch)
+	assert.NoError(t, err)
+
+	expected := []*pb.Dependency{
+		{Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_GO, Name: "golang.org/x/mod", Version: "v0.13.0"},
+		{Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_GO, Name: "github.com/stretchr/testify", Version: "v1.8.4"},
+		{Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_GO, Name: "golang.org/x/text", Version: "v0.13.0"},
+	}
+	assert.Equal(t, len(expected), len(got), "mismatched dependency count")
+	for i := range expected {
+		if i < len(got) && !proto.Equal(expected[i], got[i]) {
+			t.Errorf("mismatch at index %d: expected %v, got %v", i, expected[i], got[i])
+		}
+	}
+}
+
+func TestPackageJsonParse(t *testing.T) {
+	t.Parallel()
+
+	patch := `@@ -2,12 +2,15 @@
+   "name": "app",
+   "scripts": {
+-    "build": "tsc"
++    "build": "tsc -p ."
+   },
+   "dependencies": {
+-    "lodash": "^4.17.20",
++    "lodash": "^4.17.21",
++    "left-pad": "git+https://github.com/left-pad/left-pad.git",
++    "express": "~4.18.2"
+   },
+   "devDependencies": {
++    "typescript": "5.2.2"
+   }
+@@ -30,3 +33,4 @@
++    "mongodb": "5.1.0"`
+
+	got, err := packageJsonParse(patch)
+	assert.NoError(t, err)
+
+	expected := []*pb.Dependency{
+		{Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_NPM, Name: "lodash", Version: "4.17.21"},
+		{Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_NPM, Name: "express", Version: "4.18.2"},
+		{Ecosystem: pb.DepEcosystem_DEP_ECOSYSTEM_NPM, Name: "typescript", Version: "5.2.2"},
+	}
+	assert.Equal(t, len(expected), len(got), "mismatched dependency count")
+	for i := range expected {
+		if i < len(got) && !proto.Equal(expected[i], got[i]) {
+			t.Errorf("mismatch at index %d: expected %v, got %v", i, expected[i], got[i])
+		}
+	}
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/stacklok/minder/internal/engine/ingester/artifact"
 	"github.com/stacklok/minder/internal/engine/ingester/builtin"
+	"github.com/stacklok/minder/internal/engine/ingester/deps"
 	"github.com/stacklok/minder/internal/engine/ingester/diff"
 	"github.com/stacklok/minder/internal/engine/ingester/git"
 	"github.com/stacklok/minder/internal/engine/ingester/rest"
@@ -64,6 +65,8 @@ func NewRuleDataIngest(rt *pb.RuleType, pbuild *providers.ProviderBuilder) (engi
 		return git.NewGitIngester(ing.GetGit(), pbuild)
 	case diff.DiffRuleDataIngestType:
 		return diff.NewDiffIngester(ing.GetDiff(), pbuild)
+	case deps.DepsRuleDataIngestType:
+		return deps.NewDepsIngester(ing.GetDeps(), pbuild)
 	default:
 		return nil, fmt.Errorf("unsupported rule type engine: %s", rt.Def.Ingest.Type)
 	}
@@ -67,6 +67,9 @@ const (
 	ActionOptOff
 	// ActionOptDryRun means perform a dry run of the remediation
 	ActionOptDryRun
+	// ActionOptApproval means render the remediation and wait for the approval
+	// of a user before performing it
+	ActionOptApproval
 	// ActionOptUnknown means the action is unknown. This is a sentinel value.
 	ActionOptUnknown
 )
@@ -74,9 +77,10 @@ const (
 // ActionOptFromString returns the ActionOpt from a string representation
 func ActionOptFromString(s *string, defAction ActionOpt) ActionOpt {
 	var actionOptMap = map[string]ActionOpt{
-		"on":      ActionOptOn,
-		"off":     ActionOptOff,
-		"dry_run": ActionOptDryRun,
+		"on":       ActionOptOn,
+		"off":      ActionOptOff,
+		"dry_run":  ActionOptDryRun,
+		"approval": ActionOptApproval,
 	}
 
 	if s == nil {
@@ -97,11 +101,18 @@ type ActionType string
 type Action interface {
 	Class() ActionType
 	Type() string
-	GetOnOffState(*pb.Profile) ActionOpt
+	GetOnOffState(*pb.Profile, *pb.Profile_Rule) ActionOpt
 	Do(ctx context.Context, cmd ActionCmd, setting ActionOpt, entity protoreflect.ProtoMessage,
 		params ActionsParams, metadata *json.RawMessage) (json.RawMessage, error)
 }
 
+// ActionRollbacker is implemented by the actions that record the state of the
+// entity before changing it, and are able to restore it
+type ActionRollbacker interface {
+	// Rollback restores the state recorded in the metadata returned by Do
+	Rollback(ctx context.Context, metadata json.RawMessage) error
+}
+
 // ActionCmd is the type that defines what effect an action should have
 type ActionCmd string
 
@@ -110,6 +121,9 @@ const (
 	ActionCmdOff ActionCmd = "turn_off"
 	// ActionCmdOn means turn on the action
 	ActionCmdOn ActionCmd = "turn_on"
+	// ActionCmdStillOn means the rule keeps failing while the action is on.
+	// Actions which don't track the evaluations of a failing rule do nothing.
+	ActionCmdStillOn ActionCmd = "still_on"
 	// ActionCmdDoNothing means the action should do nothing
 	ActionCmdDoNothing ActionCmd = "do_nothing"
 )
@@ -123,14 +137,21 @@ type EvalStatusParams struct {
 	Rule             *pb.Profile_Rule
 	RuleType         *pb.RuleType
 	ProfileID        uuid.UUID
+	ProjectID        uuid.UUID
 	RepoID           uuid.UUID
 	ArtifactID       uuid.NullUUID
 	PullRequestID    uuid.NullUUID
 	EntityType       db.Entities
 	RuleTypeID       uuid.UUID
 	EvalStatusFromDb *db.ListRuleEvaluationsByProfileIdRow
-	evalErr          error
-	actionsErr       evalerrors.ActionsError
+	// RemediationsFromDb are the remediation details from the database keyed by action name
+	RemediationsFromDb map[string]db.RuleDetailsRemediate
+	// AlertsFromDb are the alert details from the database keyed by action name
+	AlertsFromDb map[string]db.RuleDetailsAlert
+	// RemediationApprovalsFromDb are the latest approvals of the remediations keyed by action name
+	RemediationApprovalsFromDb map[string]db.RemediationApproval
+	evalErr                    error
+	actionsErr                 evalerrors.ActionsError
 }
 
 // Ensure EvalStatusParams implements the necessary interfaces
@@ -153,23 +174,19 @@ func (e *EvalStatusParams) SetActionsErr(ctx context.Context, actionErr evalerro
 	logger := zerolog.Ctx(ctx)
 
 	// Make sure we don't try to push a nil json.RawMessage accidentally
-	if actionErr.AlertMeta == nil {
-		// Default to an empty json struct if the action did not return anything
-		m, err := json.Marshal(&map[string]any{})
-		if err != nil {
-			// This should never happen since we are marshaling an empty struct
-			logger.Error().Err(err).Msg("error marshaling empty json.RawMessage")
-		}
-		actionErr.AlertMeta = m
-	}
-	if actionErr.RemediateMeta == nil {
-		// Default to an empty json struct if the action did not return anything
-		m, err := json.Marshal(&map[string]any{})
-		if err != nil {
-			// This should never happen since we are marshaling an empty struct
-			logger.Error().Err(err).Msg("error marshaling empty json.RawMessage")
+	for _, results := range [][]evalerrors.ActionResult{actionErr.Remediations, actionErr.Alerts} {
+		for i := range results {
+			if results[i].Meta != nil {
+				continue
+			}
+			// Default to an empty json struct if the action did not return anything
+			m, err := json.Marshal(&map[string]any{})
+			if err != nil {
+				// This should never happen since we are marshaling an empty struct
+				logger.Error().Err(err).Msg("error marshaling empty json.RawMessage")
+			}
+			results[i].Meta = m
 		}
-		actionErr.RemediateMeta = m
 	}
 	// All okay
 	e.actionsErr = actionErr
@@ -190,6 +207,33 @@ func (e *EvalStatusParams) GetEvalStatusFromDb() *db.ListRuleEvaluationsByProfil
 	return e.EvalStatusFromDb
 }
 
+// GetRemediationFromDb returns the details of the named remediation from the database,
+// nil if the remediation has no details yet
+func (e *EvalStatusParams) GetRemediationFromDb(name string) *db.RuleDetailsRemediate {
+	if rem, ok := e.RemediationsFromDb[name]; ok {
+		return &rem
+	}
+	return nil
+}
+
+// GetAlertFromDb returns the details of the named alert from the database,
+// nil if the alert has no details yet
+func (e *EvalStatusParams) GetAlertFromDb(name string) *db.RuleDetailsAlert {
+	if alert, ok := e.AlertsFromDb[name]; ok {
+		return &alert
+	}
+	return nil
+}
+
+// GetRemediationApprovalFromDb returns the latest approval of the named remediation
+// from the database, nil if the remediation never waited for an approval
+func (e *EvalStatusParams) GetRemediationApprovalFromDb(name string) *db.RemediationApproval {
+	if approval, ok := e.RemediationApprovalsFromDb[name]; ok {
+		return &approval
+	}
+	return nil
+}
+
 // GetRuleType returns the rule type
 func (e *EvalStatusParams) GetRuleType() *pb.RuleType {
 	return e.RuleType
@@ -209,6 +253,9 @@ type EvalParams interface {
 type ActionsParams interface {
 	GetEvalErr() error
 	GetEvalStatusFromDb() *db.ListRuleEvaluationsByProfileIdRow
+	GetRemediationFromDb(name string) *db.RuleDetailsRemediate
+	GetAlertFromDb(name string) *db.RuleDetailsAlert
+	GetRemediationApprovalFromDb(name string) *db.RemediationApproval
 	GetRuleType() *pb.RuleType
 	GetProfile() *pb.Profile
 	GetRule() *pb.Profile_Rule
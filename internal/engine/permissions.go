@@ -0,0 +1,147 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"strings"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/stacklok/minder/internal/engine/actions/alert/check_run"
+	"github.com/stacklok/minder/internal/engine/actions/alert/issue"
+	"github.com/stacklok/minder/internal/engine/actions/alert/security_advisory"
+	"github.com/stacklok/minder/internal/engine/actions/remediate/gh_branch_protect"
+	"github.com/stacklok/minder/internal/engine/actions/remediate/pull_request"
+	remrest "github.com/stacklok/minder/internal/engine/actions/remediate/rest"
+	"github.com/stacklok/minder/internal/engine/ingester/artifact"
+	engif "github.com/stacklok/minder/internal/engine/interfaces"
+	minderv1 "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+// workflowsPath is where GitHub reads the CI workflows of a repository from
+const workflowsPath = ".github/workflows/"
+
+// RequiredPermissions returns the permissions the provider of the profiles
+// needs for the rules they instantiate, along with the names of the rule types
+// needing each permission. Remediations and alerts only need permissions when
+// they're turned on, dry runs don't change anything.
+func RequiredPermissions(
+	profiles []*minderv1.Profile, ruleTypes []*minderv1.RuleType,
+) (map[provifv1.Permission][]string, error) {
+	required := map[provifv1.Permission][]string{}
+
+	for _, p := range profiles {
+		for _, rt := range ruleTypes {
+			rules, err := GetRulesFromProfileOfType(p, rt)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, rule := range rules {
+				for _, perm := range ruleTypePermissions(p, rule, rt) {
+					if !slices.Contains(required[perm], rt.Name) {
+						required[perm] = append(required[perm], rt.Name)
+					}
+				}
+			}
+		}
+	}
+
+	for perm := range required {
+		slices.Sort(required[perm])
+	}
+	return required, nil
+}
+
+// ruleTypePermissions returns the permissions needed to evaluate the rule and
+// run its actions
+func ruleTypePermissions(
+	p *minderv1.Profile, rule *minderv1.Profile_Rule, rt *minderv1.RuleType,
+) []provifv1.Permission {
+	var perms []provifv1.Permission
+
+	if rt.GetDef().GetIngest().GetType() == artifact.ArtifactRuleDataIngestType {
+		perms = append(perms, provifv1.PermissionReadPackages)
+	}
+
+	for _, rem := range rt.GetDef().GetRemediateActions() {
+		if !isActionEnabled(p.GetRemediateSetting(nil), rule.GetRemediate(), engif.ActionOptOff,
+			p.GetRemediateActions(), rem.GetActionName()) {
+			continue
+		}
+
+		switch rem.GetType() {
+		case remrest.RemediateType, gh_branch_protect.RemediateType:
+			perms = append(perms, provifv1.PermissionAdministerRepositories)
+		case pull_request.RemediateType:
+			perms = append(perms, provifv1.PermissionWriteContents)
+			if changesWorkflows(rem.GetPullRequest()) {
+				perms = append(perms, provifv1.PermissionWriteWorkflows)
+			}
+		}
+	}
+
+	for _, alert := range rt.GetDef().GetAlertActions() {
+		if !isActionEnabled(p.GetAlertSetting(nil), rule.GetAlert(), engif.ActionOptOn,
+			p.GetAlertActions(), alert.GetActionName()) {
+			continue
+		}
+
+		switch alert.GetType() {
+		case security_advisory.AlertType:
+			perms = append(perms, provifv1.PermissionWriteSecurityAdvisories)
+		case issue.AlertType:
+			perms = append(perms, provifv1.PermissionWriteIssues)
+		case check_run.AlertType:
+			// the alert either creates check runs or sets commit statuses
+			if alert.GetCheckRun().GetCommitStatus() {
+				perms = append(perms, provifv1.PermissionWriteStatuses)
+			} else {
+				perms = append(perms, provifv1.PermissionWriteChecks)
+			}
+		}
+	}
+
+	return perms
+}
+
+// isActionEnabled tells whether an action changes anything, following the
+// same precedence as the actions engine: the setting of the rule, then the
+// setting of the named action, then the setting of the profile, then the
+// default of the action.
+func isActionEnabled(
+	setting *string, ruleSetting string, def engif.ActionOpt, actionSettings map[string]string, name string,
+) bool {
+	onOff := engif.ActionOptFromString(setting, def)
+	if s, ok := actionSettings[name]; ok {
+		onOff = engif.ActionOptFromString(&s, onOff)
+	}
+	if ruleSetting != "" {
+		onOff = engif.ActionOptFromString(&ruleSetting, onOff)
+	}
+	return onOff == engif.ActionOptOn || onOff == engif.ActionOptApproval
+}
+
+// changesWorkflows returns true if the pull request remediation patches CI
+// workflows, which GitHub only allows with a dedicated permission
+func changesWorkflows(pr *minderv1.RuleType_Definition_Remediate_PullRequestRemediation) bool {
+	for _, cnt := range pr.GetContents() {
+		if strings.HasPrefix(strings.TrimPrefix(cnt.GetPath(), "/"), workflowsPath) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,185 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	minderv1 "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+func TestRequiredPermissions(t *testing.T) {
+	t.Parallel()
+
+	on := "on"
+	dryRun := "dry_run"
+	off := "off"
+
+	ruleTypes := []*minderv1.RuleType{
+		{
+			Name: "branch_protection",
+			Def: &minderv1.RuleType_Definition{
+				InEntity:  "repository",
+				Ingest:    &minderv1.RuleType_Definition_Ingest{Type: "rest"},
+				Remediate: &minderv1.RuleType_Definition_Remediate{Type: "gh_branch_protection"},
+			},
+		},
+		{
+			Name: "actions_pinned",
+			Def: &minderv1.RuleType_Definition{
+				InEntity: "repository",
+				Ingest:   &minderv1.RuleType_Definition_Ingest{Type: "git"},
+				Remediate: &minderv1.RuleType_Definition_Remediate{
+					Type: "pull_request",
+					PullRequest: &minderv1.RuleType_Definition_Remediate_PullRequestRemediation{
+						Contents: []*minderv1.RuleType_Definition_Remediate_PullRequestRemediation_Content{
+							{Path: ".github/workflows/ci.yml"},
+						},
+					},
+				},
+				Alert: &minderv1.RuleType_Definition_Alert{Type: "security_advisory"},
+			},
+		},
+		{
+			Name: "artifact_signature",
+			Def: &minderv1.RuleType_Definition{
+				InEntity: "artifact",
+				Ingest:   &minderv1.RuleType_Definition_Ingest{Type: "artifact"},
+			},
+		},
+		{
+			Name: "checks",
+			Def: &minderv1.RuleType_Definition{
+				InEntity: "pull_request",
+				Ingest:   &minderv1.RuleType_Definition_Ingest{Type: "git"},
+				Alert:    &minderv1.RuleType_Definition_Alert{Type: "check_run"},
+			},
+		},
+		{
+			Name: "statuses",
+			Def: &minderv1.RuleType_Definition{
+				InEntity: "pull_request",
+				Ingest:   &minderv1.RuleType_Definition_Ingest{Type: "git"},
+				Alert: &minderv1.RuleType_Definition_Alert{
+					Type:     "check_run",
+					CheckRun: &minderv1.RuleType_Definition_Alert_AlertTypeCheckRun{CommitStatus: true},
+				},
+			},
+		},
+		{
+			Name: "unused",
+			Def: &minderv1.RuleType_Definition{
+				InEntity: "repository",
+				Ingest:   &minderv1.RuleType_Definition_Ingest{Type: "artifact"},
+			},
+		},
+	}
+
+	repoRules := []*minderv1.Profile_Rule{
+		{Type: "branch_protection"},
+		{Type: "actions_pinned"},
+	}
+
+	tests := []struct {
+		name    string
+		profile *minderv1.Profile
+		want    map[provifv1.Permission][]string
+	}{
+		{
+			name: "alerts are on by default, remediations off",
+			profile: &minderv1.Profile{
+				Repository: repoRules,
+				Artifact:   []*minderv1.Profile_Rule{{Type: "artifact_signature"}},
+			},
+			want: map[provifv1.Permission][]string{
+				provifv1.PermissionReadPackages:            {"artifact_signature"},
+				provifv1.PermissionWriteSecurityAdvisories: {"actions_pinned"},
+			},
+		},
+		{
+			name: "remediations turned on",
+			profile: &minderv1.Profile{
+				Remediate:  &on,
+				Alert:      &off,
+				Repository: repoRules,
+			},
+			want: map[provifv1.Permission][]string{
+				provifv1.PermissionAdministerRepositories: {"branch_protection"},
+				provifv1.PermissionWriteContents:          {"actions_pinned"},
+				provifv1.PermissionWriteWorkflows:         {"actions_pinned"},
+			},
+		},
+		{
+			name: "check runs and commit statuses",
+			profile: &minderv1.Profile{
+				PullRequest: []*minderv1.Profile_Rule{{Type: "checks"}, {Type: "statuses"}},
+			},
+			want: map[provifv1.Permission][]string{
+				provifv1.PermissionWriteChecks:   {"checks"},
+				provifv1.PermissionWriteStatuses: {"statuses"},
+			},
+		},
+		{
+			name: "dry runs don't need permissions",
+			profile: &minderv1.Profile{
+				Remediate:  &dryRun,
+				Alert:      &dryRun,
+				Repository: repoRules,
+			},
+			want: map[provifv1.Permission][]string{},
+		},
+		{
+			name: "action settings take precedence",
+			profile: &minderv1.Profile{
+				Remediate:        &on,
+				Alert:            &off,
+				RemediateActions: map[string]string{"pull_request": "off"},
+				AlertActions:     map[string]string{"security_advisory": "on"},
+				Repository:       repoRules,
+			},
+			want: map[provifv1.Permission][]string{
+				provifv1.PermissionAdministerRepositories:  {"branch_protection"},
+				provifv1.PermissionWriteSecurityAdvisories: {"actions_pinned"},
+			},
+		},
+		{
+			name: "rule settings take precedence over action settings",
+			profile: &minderv1.Profile{
+				RemediateActions: map[string]string{"gh_branch_protection": "on", "pull_request": "on"},
+				AlertActions:     map[string]string{"security_advisory": "on"},
+				Repository: []*minderv1.Profile_Rule{
+					{Type: "branch_protection", Remediate: &off},
+					{Type: "actions_pinned", Remediate: &off, Alert: &off},
+				},
+			},
+			want: map[provifv1.Permission][]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := RequiredPermissions([]*minderv1.Profile{tt.profile}, ruleTypes)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
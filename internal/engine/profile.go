@@ -182,6 +182,9 @@ func MergeDatabaseListIntoProfiles(ppl []db.ListProfilesByProjectIDRow, ectx *En
 				sAlert := string(p.Alert.ActionType)
 				profiles[p.Name].Alert = &sAlert
 			}
+
+			profiles[p.Name].RemediateActions = actionSettingsFromDB(p.RemediateActions)
+			profiles[p.Name].AlertActions = actionSettingsFromDB(p.AlertActions)
 		}
 		if pm := rowInfoToProfileMap(profiles[p.Name], p.Entity, p.ContextualRules); pm != nil {
 			profiles[p.Name] = pm
@@ -220,6 +223,9 @@ func MergeDatabaseGetIntoProfiles(ppl []db.GetProfileByProjectAndIDRow, ectx *En
 				sRem := string(p.Remediate.ActionType)
 				profiles[p.Name].Remediate = &sRem
 			}
+
+			profiles[p.Name].RemediateActions = actionSettingsFromDB(p.RemediateActions)
+			profiles[p.Name].AlertActions = actionSettingsFromDB(p.AlertActions)
 		}
 		if pm := rowInfoToProfileMap(profiles[p.Name], p.Entity, p.ContextualRules); pm != nil {
 			profiles[p.Name] = pm
@@ -229,6 +235,16 @@ func MergeDatabaseGetIntoProfiles(ppl []db.GetProfileByProjectAndIDRow, ectx *En
 	return profiles
 }
 
+// actionSettingsFromDB returns the per action settings of a profile, nil if
+// there are none or they can't be parsed
+func actionSettingsFromDB(raw json.RawMessage) map[string]string {
+	var settings map[string]string
+	if err := json.Unmarshal(raw, &settings); err != nil || len(settings) == 0 {
+		return nil
+	}
+	return settings
+}
+
 // rowInfoToProfileMap adds the database row information to the given map of
 // profiles. This assumes that the profiles belong to the same group.
 // Note that this function is thought to be called from scpecific Merge functions
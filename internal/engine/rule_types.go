@@ -27,6 +27,7 @@ import (
 
 	"github.com/stacklok/minder/internal/db"
 	"github.com/stacklok/minder/internal/engine/actions"
+	"github.com/stacklok/minder/internal/engine/actions/alert/digest"
 	enginerr "github.com/stacklok/minder/internal/engine/errors"
 	"github.com/stacklok/minder/internal/engine/eval"
 	"github.com/stacklok/minder/internal/engine/ingestcache"
@@ -179,6 +180,7 @@ func NewRuleTypeEngine(
 	p *minderv1.Profile,
 	rt *minderv1.RuleType,
 	cli *providers.ProviderBuilder,
+	digestQueue digest.Queue,
 ) (*RuleTypeEngine, error) {
 	rval, err := NewRuleValidator(rt)
 	if err != nil {
@@ -195,7 +197,7 @@ func NewRuleTypeEngine(
 		return nil, fmt.Errorf("cannot create rule evaluator: %w", err)
 	}
 
-	ae, err := actions.NewRuleActions(p, rt, cli)
+	ae, err := actions.NewRuleActions(p, rt, cli, digestQueue)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create rule actions engine: %w", err)
 	}
@@ -313,6 +315,7 @@ func RuleTypePBFromDB(rt *db.RuleType, ectx *EntityContext) (*minderv1.RuleType,
 		Description: rt.Description,
 		Guidance:    rt.Guidance,
 		Def:         def,
+		Revision:    &rt.Revision,
 	}, nil
 }
 
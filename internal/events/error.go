@@ -18,8 +18,13 @@ package events
 import (
 	"errors"
 	"fmt"
+	"time"
 )
 
+// maxRetryDelay bounds how long a handler waits before a message is retried,
+// so a single message doesn't hold the topic back for long
+const maxRetryDelay = time.Minute
+
 var (
 	// ErrRetriable is an error that may be retried. All other errors encountered
 	// by watermill be simply logged and ignored.
@@ -31,3 +36,23 @@ func NewRetriableError(sfmt string, args ...any) error {
 	msg := fmt.Sprintf(sfmt, args...)
 	return fmt.Errorf("%w: %s", ErrRetriable, msg)
 }
+
+// retryAtError is implemented by retriable errors which know when retrying
+// may succeed, e.g. because a rate limit resets by then
+type retryAtError interface {
+	RetryAt() time.Time
+}
+
+// retryDelay returns how long to wait before retrying after the given error
+func retryDelay(err error) time.Duration {
+	var rerr retryAtError
+	if !errors.As(err, &rerr) {
+		return 0
+	}
+
+	d := time.Until(rerr.RetryAt())
+	if d > maxRetryDelay {
+		return maxRetryDelay
+	}
+	return d
+}
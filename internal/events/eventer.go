@@ -233,6 +233,14 @@ func (e *Eventer) Register(
 				})
 
 				if retriable {
+					// hold the retry back until it may succeed, e.g. after a
+					// rate limit reset
+					if d := retryDelay(err); d > 0 {
+						select {
+						case <-msg.Context().Done():
+						case <-time.After(d):
+						}
+					}
 					// if the error is retriable, return it so that the message is retried
 					return err
 				}
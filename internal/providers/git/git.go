@@ -30,17 +30,40 @@ import (
 
 // Git is the struct that contains the GitHub REST API client
 type Git struct {
-	token string
+	token    string
+	username string
+}
+
+// defaultUsername is the username sent along with the token. Most
+// providers accept anything here, but it can't be empty.
+const defaultUsername = "minder-user"
+
+// Option is a function which can be used to configure the Git client
+type Option func(*Git)
+
+// WithUsername sets the username sent along with the token when cloning.
+// GitLab, for instance, expects "oauth2" when authenticating with an OAuth token.
+func WithUsername(username string) Option {
+	return func(g *Git) {
+		g.username = username
+	}
 }
 
 // Ensure that the Git client implements the Git interface
 var _ provifv1.Git = (*Git)(nil)
 
 // NewGit creates a new GitHub client
-func NewGit(token string) *Git {
-	return &Git{
-		token: token,
+func NewGit(token string, opts ...Option) *Git {
+	g := &Git{
+		token:    token,
+		username: defaultUsername,
+	}
+
+	for _, opt := range opts {
+		opt(g)
 	}
+
+	return g
 }
 
 // GetToken returns the token for the provider
@@ -60,8 +83,7 @@ func (g *Git) Clone(ctx context.Context, url, branch string) (*git.Repository, e
 
 	if g.token != "" {
 		opts.Auth = &http.BasicAuth{
-			// the Username can be anything but it can't be empty
-			Username: "minder-user",
+			Username: g.username,
 			Password: g.token,
 		}
 	}
@@ -0,0 +1,272 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gitea provides a client for interacting with the Gitea API
+package gitea
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"golang.org/x/oauth2"
+
+	"github.com/stacklok/minder/internal/db"
+	gitclient "github.com/stacklok/minder/internal/providers/git"
+	"github.com/stacklok/minder/internal/providers/ratelimit"
+	"github.com/stacklok/minder/internal/providers/registry"
+	"github.com/stacklok/minder/internal/providers/telemetry"
+	minderv1 "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+// Gitea is the string that represents the Gitea provider
+const Gitea = "gitea"
+
+// Implements is the list of provider types that the Gitea provider implements
+var Implements = []db.ProviderType{
+	db.ProviderTypeGit,
+	db.ProviderTypeRest,
+	db.ProviderTypeRepoLister,
+}
+
+func init() {
+	registry.Register(&registry.Implementation{
+		Name:           Gitea,
+		Implements:     Implements,
+		ValidateConfig: registry.ValidateWith(ParseV1Config),
+		Endpoints: func(def json.RawMessage) ([]string, error) {
+			cfg, err := ParseV1Config(def)
+			if err != nil {
+				return nil, err
+			}
+			return []string{cfg.GetEndpoint()}, nil
+		},
+		New: func(ctx context.Context, p registry.Params) (provifv1.Provider, error) {
+			cfg, err := ParseV1Config(p.Definition)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing gitea config: %w", err)
+			}
+			return NewClient(ctx, cfg, p.Metrics, p.Token)
+		},
+	})
+}
+
+var (
+	// ErrNotFound Denotes if the call returned a 404
+	ErrNotFound = errors.New("not found")
+)
+
+// Client is the struct that contains the Gitea REST API client
+type Client struct {
+	baseURL *url.URL
+	cli     *http.Client
+	git     *gitclient.Git
+	token   string
+	limiter *ratelimit.Limiter
+}
+
+// Ensure that the Gitea client implements the provider interfaces
+var (
+	_ provifv1.REST           = (*Client)(nil)
+	_ provifv1.Git            = (*Client)(nil)
+	_ provifv1.RepoLister     = (*Client)(nil)
+	_ provifv1.WebhookDeleter = (*Client)(nil)
+)
+
+// ClientOption is a function which can be used to set options on the Gitea client.
+type ClientOption func(*Client)
+
+// WithLimiter sets the limiter keeping track of the rate limits of the API. It
+// defaults to the limiter shared by the process.
+func WithLimiter(l *ratelimit.Limiter) ClientOption {
+	return func(c *Client) {
+		c.limiter = l
+	}
+}
+
+// NewClient creates a new Gitea REST API client for the instance the
+// Endpoint field in the GiteaProviderConfig struct points to.
+func NewClient(
+	ctx context.Context,
+	config *minderv1.GiteaProviderConfig,
+	metrics telemetry.ProviderMetrics,
+	token string,
+	opts ...ClientOption,
+) (*Client, error) {
+	var err error
+
+	cli := &Client{
+		token:   token,
+		limiter: ratelimit.Default(),
+	}
+
+	for _, opt := range opts {
+		opt(cli)
+	}
+
+	ts := oauth2.StaticTokenSource(
+		&oauth2.Token{AccessToken: token},
+	)
+	tc := oauth2.NewClient(ctx, ts)
+
+	// requests are held back when the API reports its rate limit ran out
+	tc.Transport = cli.limiter.NewRoundTripper(tc.Transport, token, Gitea, metrics)
+
+	tc.Transport, err = metrics.NewDurationRoundTripper(tc.Transport, Gitea)
+	if err != nil {
+		return nil, fmt.Errorf("error creating duration round tripper: %w", err)
+	}
+
+	endpoint := config.GetEndpoint()
+	// make sure relative paths are resolved under the API prefix
+	if !strings.HasSuffix(endpoint, "/") {
+		endpoint += "/"
+	}
+
+	baseURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	cli.baseURL = baseURL
+	cli.cli = tc
+	cli.git = gitclient.NewGit(token)
+	return cli, nil
+}
+
+// ParseV1Config parses the raw config into a GiteaProviderConfig struct
+func ParseV1Config(rawCfg json.RawMessage) (*minderv1.GiteaProviderConfig, error) {
+	type wrapper struct {
+		Gitea *minderv1.GiteaProviderConfig `json:"gitea" yaml:"gitea" mapstructure:"gitea" validate:"required"`
+	}
+
+	var w wrapper
+	if err := provifv1.ParseAndValidate(rawCfg, &w); err != nil {
+		return nil, err
+	}
+
+	// Validate the config according to the protobuf validation rules.
+	if err := w.Gitea.Validate(); err != nil {
+		return nil, fmt.Errorf("error validating Gitea v1 provider config: %w", err)
+	}
+
+	return w.Gitea, nil
+}
+
+// GetToken returns the token for the provider
+func (c *Client) GetToken() string {
+	return c.token
+}
+
+// GetBaseURL returns the base URL for the REST API.
+func (c *Client) GetBaseURL() string {
+	return c.baseURL.String()
+}
+
+// NewRequest creates an HTTP request relative to the API endpoint.
+func (c *Client) NewRequest(method, endpoint string, body any) (*http.Request, error) {
+	rel, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing endpoint: %w", err)
+	}
+	targetURL := c.baseURL.ResolveReference(rel)
+
+	var reader io.Reader
+	switch b := body.(type) {
+	case nil:
+	case io.Reader:
+		reader = b
+	default:
+		buf, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling body: %w", err)
+		}
+		reader = bytes.NewReader(buf)
+	}
+
+	req, err := http.NewRequest(method, targetURL.String(), reader)
+	if err != nil {
+		return nil, err
+	}
+	if reader != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+	return req, nil
+}
+
+// Do executes an HTTP request.
+func (c *Client) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	req = req.WithContext(ctx)
+
+	return c.cli.Do(req)
+}
+
+// Clone clones a git repository
+func (c *Client) Clone(ctx context.Context, cloneURL, branch string) (*git.Repository, error) {
+	return c.git.Clone(ctx, cloneURL, branch)
+}
+
+// Validate checks that the token is accepted by the Gitea instance
+func (c *Client) Validate(ctx context.Context) error {
+	if _, err := c.do(ctx, http.MethodGet, "user", nil, nil); err != nil {
+		return fmt.Errorf("invalid token: %w", err)
+	}
+	return nil
+}
+
+// do sends a request to the API and decodes the JSON response into out,
+// if given.
+func (c *Client) do(ctx context.Context, method, endpoint string, body, out any) (*http.Response, error) {
+	req, err := c.NewRequest(method, endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return resp, fmt.Errorf("%s %s: %w", method, endpoint, ErrNotFound)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return resp, fmt.Errorf("%s %s: unexpected status %d: %s",
+			method, endpoint, resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	if out == nil {
+		return resp, nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return resp, fmt.Errorf("error decoding response: %w", err)
+	}
+	return resp, nil
+}
+
+// repoPath returns the API path of a repository
+func repoPath(owner, name string) string {
+	return "repos/" + url.PathEscape(owner) + "/" + url.PathEscape(name)
+}
@@ -0,0 +1,182 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitea
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	minderv1 "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	// HookTypeGitea is the type of the webhooks sending Gitea's own payloads
+	HookTypeGitea = "gitea"
+
+	// pageSize is the default, and maximum, page size of the Gitea API
+	pageSize = 50
+)
+
+// Repository is the subset of a Gitea repository minder cares about
+type Repository struct {
+	ID            int64  `json:"id"`
+	Name          string `json:"name"`
+	FullName      string `json:"full_name"`
+	Owner         User   `json:"owner"`
+	Private       bool   `json:"private"`
+	Fork          bool   `json:"fork"`
+	Archived      bool   `json:"archived"`
+	DefaultBranch string `json:"default_branch"`
+	CloneURL      string `json:"clone_url"`
+	HTMLURL       string `json:"html_url"`
+}
+
+// User is the user or organization a Gitea repository belongs to
+type User struct {
+	ID    int64  `json:"id"`
+	Login string `json:"login"`
+}
+
+// Hook is a Gitea repository webhook
+type Hook struct {
+	ID     int64             `json:"id,omitempty"`
+	Type   string            `json:"type"`
+	Config map[string]string `json:"config"`
+	Events []string          `json:"events"`
+	Active bool              `json:"active"`
+}
+
+// URL returns the URL the webhook sends its payloads to
+func (h *Hook) URL() string {
+	return h.Config["url"]
+}
+
+// ListUserRepositories returns a list of all repositories the authenticated
+// user has access to
+func (c *Client) ListUserRepositories(ctx context.Context, _ string) ([]*minderv1.Repository, error) {
+	repos, err := c.listRepositories(ctx, "user/repos")
+	if err != nil {
+		return nil, err
+	}
+
+	return convertRepositories(repos), nil
+}
+
+// ListOrganizationRepsitories returns a list of all repositories of the
+// organization
+func (c *Client) ListOrganizationRepsitories(ctx context.Context, owner string) ([]*minderv1.Repository, error) {
+	repos, err := c.listRepositories(ctx, "orgs/"+url.PathEscape(owner)+"/repos")
+	if err != nil {
+		return nil, err
+	}
+
+	return convertRepositories(repos), nil
+}
+
+// listRepositories goes through the pages until a short one, skipping
+// archived repositories
+func (c *Client) listRepositories(ctx context.Context, endpoint string) ([]*Repository, error) {
+	q := url.Values{}
+	q.Set("limit", strconv.Itoa(pageSize))
+
+	var all []*Repository
+	for page := 1; ; page++ {
+		q.Set("page", strconv.Itoa(page))
+
+		var repos []*Repository
+		if _, err := c.do(ctx, http.MethodGet, endpoint+"?"+q.Encode(), nil, &repos); err != nil {
+			return all, err
+		}
+		for _, r := range repos {
+			if !r.Archived {
+				all = append(all, r)
+			}
+		}
+		if len(repos) < pageSize {
+			return all, nil
+		}
+	}
+}
+
+func convertRepositories(repos []*Repository) []*minderv1.Repository {
+	var converted []*minderv1.Repository
+	for _, r := range repos {
+		converted = append(converted, ConvertRepository(r))
+	}
+	return converted
+}
+
+// ConvertRepository converts a Gitea repository to the minder repository
+// representation
+func ConvertRepository(r *Repository) *minderv1.Repository {
+	return &minderv1.Repository{
+		Name:      r.Name,
+		Owner:     r.Owner.Login,
+		RepoId:    int32(r.ID), // FIXME this is a 64 bit int
+		CloneUrl:  r.CloneURL,
+		IsPrivate: r.Private,
+		IsFork:    r.Fork,
+	}
+}
+
+// GetRepository returns a repository given its owner and name
+func (c *Client) GetRepository(ctx context.Context, owner, name string) (*Repository, error) {
+	var r Repository
+	if _, err := c.do(ctx, http.MethodGet, repoPath(owner, name), nil, &r); err != nil {
+		return nil, fmt.Errorf("error getting repository %s/%s: %w", owner, name, err)
+	}
+	return &r, nil
+}
+
+// ListHooks lists the webhooks of a repository
+func (c *Client) ListHooks(ctx context.Context, owner, name string) ([]*Hook, error) {
+	var hooks []*Hook
+	if _, err := c.do(ctx, http.MethodGet, repoPath(owner, name)+"/hooks", nil, &hooks); err != nil {
+		return nil, fmt.Errorf("error listing hooks: %w", err)
+	}
+	return hooks, nil
+}
+
+// CreateHook creates a webhook on a repository
+func (c *Client) CreateHook(ctx context.Context, owner, name string, hook *Hook) (*Hook, error) {
+	var created Hook
+	if _, err := c.do(ctx, http.MethodPost, repoPath(owner, name)+"/hooks", hook, &created); err != nil {
+		return nil, fmt.Errorf("error creating hook: %w", err)
+	}
+	return &created, nil
+}
+
+// DeleteHook deletes a webhook from a repository
+func (c *Client) DeleteHook(ctx context.Context, owner, name string, hookID int64) error {
+	endpoint := fmt.Sprintf("%s/hooks/%d", repoPath(owner, name), hookID)
+	if _, err := c.do(ctx, http.MethodDelete, endpoint, nil, nil); err != nil {
+		return fmt.Errorf("error deleting hook: %w", err)
+	}
+	return nil
+}
+
+// DeleteRepositoryWebhook deletes a webhook from a repository, ignoring
+// webhooks which no longer exist
+func (c *Client) DeleteRepositoryWebhook(ctx context.Context, owner, name string, _, hookID int64) error {
+	err := c.DeleteHook(ctx, owner, name, hookID)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	return err
+}
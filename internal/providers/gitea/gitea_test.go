@@ -0,0 +1,278 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stacklok/minder/internal/events"
+	"github.com/stacklok/minder/internal/providers/ratelimit"
+	provtelemetry "github.com/stacklok/minder/internal/providers/telemetry"
+	minderv1 "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// fakeGitea is a minimal stand-in for the Gitea v1 API
+type fakeGitea struct {
+	repos    []*Repository
+	hooks    map[string][]*Hook
+	nextHook int64
+}
+
+func newFakeGitea(t *testing.T) (*fakeGitea, *httptest.Server) {
+	t.Helper()
+
+	f := &fakeGitea{
+		hooks:    map[string][]*Hook{},
+		nextHook: 100,
+	}
+	// enough repositories to need a second page
+	for i := 1; i <= pageSize+2; i++ {
+		f.repos = append(f.repos, &Repository{
+			ID: int64(i), Name: fmt.Sprintf("repo-%d", i), Owner: User{ID: 1, Login: "acme"},
+			CloneURL: fmt.Sprintf("https://gitea.example.com/acme/repo-%d.git", i),
+		})
+	}
+	f.repos[1].Private = true
+	f.repos[1].Fork = true
+	f.repos[2].Archived = true
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		f.serve(t, w, r)
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return f, srv
+}
+
+func (f *fakeGitea) serve(t *testing.T, w http.ResponseWriter, r *http.Request) {
+	t.Helper()
+
+	path := strings.TrimPrefix(r.URL.EscapedPath(), "/api/v1/")
+	switch {
+	case path == "user" && r.Method == http.MethodGet:
+		writeJSON(t, w, User{ID: 1, Login: "jdoe"})
+	case (path == "user/repos" || path == "orgs/acme/repos") && r.Method == http.MethodGet:
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		start := min((page-1)*limit, len(f.repos))
+		end := min(start+limit, len(f.repos))
+		writeJSON(t, w, f.repos[start:end])
+	case path == "repos/acme/repo-2" && r.Method == http.MethodGet:
+		writeJSON(t, w, f.repos[1])
+	case path == "repos/acme/repo-2/hooks" && r.Method == http.MethodGet:
+		writeJSON(t, w, f.hooks["acme/repo-2"])
+	case path == "repos/acme/repo-2/hooks" && r.Method == http.MethodPost:
+		var h Hook
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&h))
+		h.ID = f.nextHook
+		f.nextHook++
+		f.hooks["acme/repo-2"] = append(f.hooks["acme/repo-2"], &h)
+		w.WriteHeader(http.StatusCreated)
+		writeJSON(t, w, h)
+	case strings.HasPrefix(path, "repos/acme/repo-2/hooks/") && r.Method == http.MethodDelete:
+		id, _ := strconv.ParseInt(strings.TrimPrefix(path, "repos/acme/repo-2/hooks/"), 10, 64)
+		hooks := f.hooks["acme/repo-2"]
+		for i, h := range hooks {
+			if h.ID == id {
+				f.hooks["acme/repo-2"] = append(hooks[:i], hooks[i+1:]...)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"The target couldn't be found."}`))
+	}
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, v any) {
+	t.Helper()
+	require.NoError(t, json.NewEncoder(w).Encode(v))
+}
+
+func newTestClient(t *testing.T, endpoint, token string) *Client {
+	t.Helper()
+
+	cli, err := NewClient(context.Background(), &minderv1.GiteaProviderConfig{
+		Endpoint: endpoint,
+	}, provtelemetry.NewNoopMetrics(), token, WithLimiter(ratelimit.NewLimiter()))
+	require.NoError(t, err)
+	return cli
+}
+
+func TestNewClient(t *testing.T) {
+	t.Parallel()
+
+	cli := newTestClient(t, "https://gitea.example.com/api/v1", "token")
+	assert.Equal(t, "https://gitea.example.com/api/v1/", cli.GetBaseURL())
+	assert.Equal(t, "token", cli.GetToken())
+}
+
+func TestParseV1Config(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := ParseV1Config(json.RawMessage(`{"gitea": {"endpoint": "https://gitea.example.com/api/v1/"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "https://gitea.example.com/api/v1/", cfg.GetEndpoint())
+
+	// there's no default instance
+	_, err = ParseV1Config(json.RawMessage(`{"gitea": {}}`))
+	assert.Error(t, err)
+
+	_, err = ParseV1Config(json.RawMessage(`{"gitea": {"endpoint": "gitea.example.com"}}`))
+	assert.Error(t, err)
+
+	_, err = ParseV1Config(json.RawMessage(`{"github": {}}`))
+	assert.Error(t, err)
+}
+
+func TestListRepositories(t *testing.T) {
+	t.Parallel()
+
+	_, srv := newFakeGitea(t)
+	cli := newTestClient(t, srv.URL+"/api/v1", "token")
+
+	repos, err := cli.ListUserRepositories(context.Background(), "")
+	require.NoError(t, err)
+	// all pages, without the archived repository
+	require.Len(t, repos, pageSize+1)
+
+	assert.Equal(t, "acme", repos[0].Owner)
+	assert.Equal(t, "repo-1", repos[0].Name)
+	assert.Equal(t, int32(1), repos[0].RepoId)
+	assert.Equal(t, "https://gitea.example.com/acme/repo-1.git", repos[0].CloneUrl)
+	assert.False(t, repos[0].IsPrivate)
+	assert.False(t, repos[0].IsFork)
+
+	assert.True(t, repos[1].IsPrivate)
+	assert.True(t, repos[1].IsFork)
+	assert.Equal(t, "repo-4", repos[2].Name)
+
+	repos, err = cli.ListOrganizationRepsitories(context.Background(), "acme")
+	require.NoError(t, err)
+	assert.Len(t, repos, pageSize+1)
+
+	_, err = cli.ListOrganizationRepsitories(context.Background(), "nope")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestHooks(t *testing.T) {
+	t.Parallel()
+
+	fake, srv := newFakeGitea(t)
+	cli := newTestClient(t, srv.URL+"/api/v1/", "token")
+	ctx := context.Background()
+
+	r, err := cli.GetRepository(ctx, "acme", "repo-2")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), r.ID)
+
+	_, err = cli.GetRepository(ctx, "acme", "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	created, err := cli.CreateHook(ctx, "acme", "repo-2", &Hook{
+		Type: HookTypeGitea,
+		Config: map[string]string{
+			"url":          "https://minder.example.com/api/v1/webhook/gitea/abc",
+			"content_type": "json",
+			"secret":       "secret",
+		},
+		Events: []string{"push"},
+		Active: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), created.ID)
+	assert.Equal(t, "secret", fake.hooks["acme/repo-2"][0].Config["secret"])
+
+	hooks, err := cli.ListHooks(ctx, "acme", "repo-2")
+	require.NoError(t, err)
+	require.Len(t, hooks, 1)
+	assert.Equal(t, "https://minder.example.com/api/v1/webhook/gitea/abc", hooks[0].URL())
+
+	require.NoError(t, cli.DeleteHook(ctx, "acme", "repo-2", created.ID))
+	assert.Empty(t, fake.hooks["acme/repo-2"])
+	assert.ErrorIs(t, cli.DeleteHook(ctx, "acme", "repo-2", created.ID), ErrNotFound)
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	_, srv := newFakeGitea(t)
+
+	assert.NoError(t, newTestClient(t, srv.URL+"/api/v1", "token").Validate(context.Background()))
+	assert.Error(t, newTestClient(t, srv.URL+"/api/v1", "wrong").Validate(context.Background()))
+}
+
+func TestREST(t *testing.T) {
+	t.Parallel()
+
+	_, srv := newFakeGitea(t)
+	cli := newTestClient(t, srv.URL+"/api/v1", "token")
+
+	req, err := cli.NewRequest(http.MethodGet, "repos/acme/repo-2", nil)
+	require.NoError(t, err)
+	assert.Equal(t, srv.URL+"/api/v1/repos/acme/repo-2", req.URL.String())
+
+	resp, err := cli.Do(context.Background(), req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var r Repository
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&r))
+	assert.Equal(t, "repo-2", r.Name)
+}
+
+func TestRateLimited(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Retry-After", strconv.Itoa(int(time.Hour.Seconds())))
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	t.Cleanup(srv.Close)
+	cli := newTestClient(t, srv.URL+"/api/v1", "token")
+
+	// running into the rate limit is retriable, and holds the next requests back
+	for i := 0; i < 2; i++ {
+		req, err := cli.NewRequest(http.MethodGet, "user", nil)
+		require.NoError(t, err)
+		_, err = cli.Do(context.Background(), req)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, events.ErrRetriable)
+	}
+	assert.Equal(t, int32(1), calls.Load())
+}
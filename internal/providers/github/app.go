@@ -0,0 +1,243 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v53/github"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// appJWTLifetime is how long the JWTs authenticating as the app are valid.
+	// GitHub rejects JWTs valid for more than 10 minutes.
+	appJWTLifetime = 9 * time.Minute
+	// appJWTClockSkew backdates the JWTs to allow for clock drift
+	appJWTClockSkew = time.Minute
+	// installationTokenTimeout bounds the call minting an installation token
+	installationTokenTimeout = 15 * time.Second
+
+	// InstallationGitUsername is the username GitHub expects when cloning
+	// over HTTPS with an installation token
+	InstallationGitUsername = "x-access-token"
+)
+
+// App authenticates to GitHub as a GitHub App. It mints short-lived
+// installation tokens, which are cached and refreshed once they expire.
+type App struct {
+	appID    int64
+	key      *rsa.PrivateKey
+	endpoint string
+
+	mu      sync.Mutex
+	sources map[int64]oauth2.TokenSource
+}
+
+// AppOption is a function which can be used to set options on the App.
+type AppOption func(*App)
+
+// WithAppEndpoint sets the GitHub API endpoint the app talks to. It
+// defaults to the public GitHub API.
+func WithAppEndpoint(endpoint string) AppOption {
+	return func(a *App) {
+		a.endpoint = endpoint
+	}
+}
+
+// NewApp creates a new GitHub App given its ID and its PEM encoded private key
+func NewApp(appID int64, privateKey []byte, opts ...AppOption) (*App, error) {
+	key, err := parsePrivateKey(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing GitHub App private key: %w", err)
+	}
+
+	a := &App{
+		appID:   appID,
+		key:     key,
+		sources: map[int64]oauth2.TokenSource{},
+	}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	return a, nil
+}
+
+func parsePrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+
+	// GitHub hands out PKCS#1 keys, but accept PKCS#8 ones as well
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("private key is not an RSA key")
+	}
+	return rsaKey, nil
+}
+
+// GetAppID returns the ID of the GitHub App
+func (a *App) GetAppID() int64 {
+	return a.appID
+}
+
+// JWT returns a JWT authenticating as the app itself
+// See https://docs.github.com/en/apps/creating-github-apps/authenticating-with-a-github-app/generating-a-json-web-token-jwt-for-a-github-app
+func (a *App) JWT() (string, error) {
+	now := time.Now()
+	tok, err := jwt.NewBuilder().
+		Issuer(strconv.FormatInt(a.appID, 10)).
+		IssuedAt(now.Add(-appJWTClockSkew)).
+		Expiration(now.Add(appJWTLifetime)).
+		Build()
+	if err != nil {
+		return "", fmt.Errorf("error building JWT: %w", err)
+	}
+
+	signed, err := jwt.Sign(tok, jwt.WithKey(jwa.RS256, a.key))
+	if err != nil {
+		return "", fmt.Errorf("error signing JWT: %w", err)
+	}
+	return string(signed), nil
+}
+
+// client returns a GitHub client authenticated as the app itself
+func (a *App) client(ctx context.Context) (*github.Client, error) {
+	appJWT, err := a.JWT()
+	if err != nil {
+		return nil, err
+	}
+
+	tc := oauth2.NewClient(ctx, oauth2.StaticTokenSource(&oauth2.Token{AccessToken: appJWT}))
+	ghClient := github.NewClient(tc)
+
+	if a.endpoint != "" {
+		parsedURL, err := url.Parse(a.endpoint)
+		if err != nil {
+			return nil, err
+		}
+		ghClient.BaseURL = parsedURL
+	}
+
+	return ghClient, nil
+}
+
+// FindInstallation returns the installation of the app on the given
+// organization or user account. It returns ErrNotFound if the app is not
+// installed there.
+func (a *App) FindInstallation(ctx context.Context, owner string, isOrg bool) (*github.Installation, error) {
+	cli, err := a.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var inst *github.Installation
+	var resp *github.Response
+	if isOrg {
+		inst, resp, err = cli.Apps.FindOrganizationInstallation(ctx, owner)
+	} else {
+		inst, resp, err = cli.Apps.FindUserInstallation(ctx, owner)
+	}
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("no installation for %s: %w", owner, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error finding installation for %s: %w", owner, err)
+	}
+	return inst, nil
+}
+
+// InstallationToken returns a token authenticating as the given installation
+// of the app. Tokens are cached until they are about to expire.
+func (a *App) InstallationToken(ctx context.Context, installationID int64) (string, error) {
+	a.mu.Lock()
+	ts, ok := a.sources[installationID]
+	if !ok {
+		ts = oauth2.ReuseTokenSource(nil, &installationTokenSource{app: a, installationID: installationID})
+		a.sources[installationID] = ts
+	}
+	a.mu.Unlock()
+
+	// oauth2.TokenSource doesn't take a context, so bail out early if the
+	// caller is gone already
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	tok, err := ts.Token()
+	if err != nil {
+		return "", err
+	}
+	return tok.AccessToken, nil
+}
+
+// ForgetInstallation drops the cached token of an installation, e.g. once the
+// app is uninstalled.
+func (a *App) ForgetInstallation(installationID int64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.sources, installationID)
+}
+
+// installationTokenSource mints a new installation token on every call.
+// It's meant to be wrapped by oauth2.ReuseTokenSource.
+type installationTokenSource struct {
+	app            *App
+	installationID int64
+}
+
+func (its *installationTokenSource) Token() (*oauth2.Token, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), installationTokenTimeout)
+	defer cancel()
+
+	cli, err := its.app.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tok, _, err := cli.Apps.CreateInstallationToken(ctx, its.installationID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating token for installation %d: %w", its.installationID, err)
+	}
+
+	return &oauth2.Token{
+		AccessToken: tok.GetToken(),
+		TokenType:   "token",
+		Expiry:      tok.GetExpiresAt().Time,
+	}, nil
+}
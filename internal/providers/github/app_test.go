@@ -0,0 +1,130 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestApp(t *testing.T, endpoint string) (*App, *rsa.PrivateKey) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	var opts []AppOption
+	if endpoint != "" {
+		opts = append(opts, WithAppEndpoint(endpoint))
+	}
+	app, err := NewApp(42, keyPEM, opts...)
+	require.NoError(t, err)
+	return app, key
+}
+
+func TestNewApp(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewApp(42, []byte("not a key"))
+	assert.Error(t, err)
+
+	app, _ := newTestApp(t, "")
+	assert.Equal(t, int64(42), app.GetAppID())
+}
+
+func TestAppJWT(t *testing.T) {
+	t.Parallel()
+
+	app, key := newTestApp(t, "")
+
+	signed, err := app.JWT()
+	require.NoError(t, err)
+
+	tok, err := jwt.Parse([]byte(signed), jwt.WithKey(jwa.RS256, &key.PublicKey))
+	require.NoError(t, err)
+	assert.Equal(t, "42", tok.Issuer())
+	assert.True(t, tok.IssuedAt().Before(time.Now()))
+	assert.LessOrEqual(t, tok.Expiration().Sub(tok.IssuedAt()), 10*time.Minute)
+}
+
+func TestAppInstallations(t *testing.T) {
+	t.Parallel()
+
+	var minted atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/orgs/acme/installation", func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "Bearer "))
+		_, _ = w.Write([]byte(`{"id": 7, "account": {"login": "acme", "type": "Organization"}}`))
+	})
+	mux.HandleFunc("/users/jdoe/installation", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+	})
+	mux.HandleFunc("/app/installations/7/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		n := minted.Add(1)
+		w.WriteHeader(http.StatusCreated)
+		assert.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+			"token":      fmt.Sprintf("ghs_%d", n),
+			"expires_at": time.Now().Add(time.Hour).Format(time.RFC3339),
+		}))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	app, _ := newTestApp(t, srv.URL+"/")
+	ctx := context.Background()
+
+	inst, err := app.FindInstallation(ctx, "acme", true)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), inst.GetID())
+
+	_, err = app.FindInstallation(ctx, "jdoe", false)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	tok, err := app.InstallationToken(ctx, 7)
+	require.NoError(t, err)
+	assert.Equal(t, "ghs_1", tok)
+
+	// the token is reused until it expires
+	tok, err = app.InstallationToken(ctx, 7)
+	require.NoError(t, err)
+	assert.Equal(t, "ghs_1", tok)
+
+	app.ForgetInstallation(7)
+	tok, err = app.InstallationToken(ctx, 7)
+	require.NoError(t, err)
+	assert.Equal(t, "ghs_2", tok)
+
+	_, err = app.InstallationToken(ctx, 8)
+	assert.Error(t, err)
+}
@@ -0,0 +1,128 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"net/url"
+	"strings"
+
+	minderv1 "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	// DefaultServerURL is the URL of github.com
+	DefaultServerURL = "https://github.com"
+	// DefaultRegistry is the container registry of github.com
+	DefaultRegistry = "ghcr.io"
+
+	defaultAPIHost = "api.github.com"
+
+	// the paths of the REST and uploads APIs of a GitHub Enterprise Server
+	enterpriseAPIPath     = "/api/v3/"
+	enterpriseUploadsPath = "/api/uploads/"
+)
+
+// IsEnterprise returns true if the config points to a GitHub Enterprise
+// Server instead of github.com
+func IsEnterprise(cfg *minderv1.GitHubProviderConfig) bool {
+	u := enterpriseEndpoint(cfg)
+	return u != nil
+}
+
+// ServerURL returns the URL of the GitHub instance the config points to,
+// without a trailing slash, e.g. https://github.example.com for the
+// https://github.example.com/api/v3/ endpoint.
+func ServerURL(cfg *minderv1.GitHubProviderConfig) string {
+	u := enterpriseEndpoint(cfg)
+	if u == nil {
+		return DefaultServerURL
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+// APIHost returns the host of the REST API the config points to
+func APIHost(cfg *minderv1.GitHubProviderConfig) string {
+	u := enterpriseEndpoint(cfg)
+	if u == nil {
+		return defaultAPIHost
+	}
+	return u.Host
+}
+
+// Registry returns the host of the container registry of the packages of the
+// GitHub instance the config points to. GitHub Enterprise Server serves it on
+// the containers subdomain.
+func Registry(cfg *minderv1.GitHubProviderConfig) string {
+	if cfg.GetRegistry() != "" {
+		return cfg.GetRegistry()
+	}
+	u := enterpriseEndpoint(cfg)
+	if u == nil {
+		return DefaultRegistry
+	}
+	return "containers." + u.Host
+}
+
+// enterpriseEndpoint returns the parsed endpoint of the config, or nil if it
+// points to github.com
+func enterpriseEndpoint(cfg *minderv1.GitHubProviderConfig) *url.URL {
+	if cfg.GetEndpoint() == "" {
+		return nil
+	}
+	u, err := url.Parse(cfg.GetEndpoint())
+	if err != nil || u.Host == "" || u.Host == defaultAPIHost {
+		return nil
+	}
+	return u
+}
+
+// apiURLs returns the REST and uploads API endpoints of the config, or nils
+// for the defaults of github.com. The uploads endpoint of a GitHub Enterprise
+// Server is derived from its REST endpoint unless it's configured.
+func apiURLs(cfg *minderv1.GitHubProviderConfig) (*url.URL, *url.URL, error) {
+	var base, upload *url.URL
+	if cfg.GetEndpoint() != "" {
+		var err error
+		base, err = url.Parse(withTrailingSlash(cfg.GetEndpoint()))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	switch {
+	case cfg.GetUploadEndpoint() != "":
+		var err error
+		upload, err = url.Parse(withTrailingSlash(cfg.GetUploadEndpoint()))
+		if err != nil {
+			return nil, nil, err
+		}
+	case IsEnterprise(cfg):
+		upload = &url.URL{Scheme: base.Scheme, Host: base.Host}
+		if strings.HasSuffix(base.Path, enterpriseAPIPath) {
+			upload.Path = strings.TrimSuffix(base.Path, enterpriseAPIPath) + enterpriseUploadsPath
+		} else {
+			upload.Path = base.Path + strings.TrimPrefix(enterpriseUploadsPath, "/")
+		}
+	}
+
+	return base, upload, nil
+}
+
+func withTrailingSlash(u string) string {
+	if strings.HasSuffix(u, "/") {
+		return u
+	}
+	return u + "/"
+}
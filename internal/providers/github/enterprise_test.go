@@ -0,0 +1,149 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stacklok/minder/internal/providers/httpcache"
+	"github.com/stacklok/minder/internal/providers/ratelimit"
+	provtelemetry "github.com/stacklok/minder/internal/providers/telemetry"
+	minderv1 "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestEnterpriseConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		cfg          *minderv1.GitHubProviderConfig
+		isEnterprise bool
+		serverURL    string
+		apiHost      string
+		registry     string
+		uploadURL    string
+	}{
+		{
+			name:      "github.com",
+			cfg:       &minderv1.GitHubProviderConfig{},
+			serverURL: "https://github.com",
+			apiHost:   "api.github.com",
+			registry:  "ghcr.io",
+		},
+		{
+			name:      "github.com api endpoint",
+			cfg:       &minderv1.GitHubProviderConfig{Endpoint: "https://api.github.com/"},
+			serverURL: "https://github.com",
+			apiHost:   "api.github.com",
+			registry:  "ghcr.io",
+		},
+		{
+			name:         "enterprise server",
+			cfg:          &minderv1.GitHubProviderConfig{Endpoint: "https://github.example.com/api/v3"},
+			isEnterprise: true,
+			serverURL:    "https://github.example.com",
+			apiHost:      "github.example.com",
+			registry:     "containers.github.example.com",
+			uploadURL:    "https://github.example.com/api/uploads/",
+		},
+		{
+			name: "enterprise server with explicit upload endpoint and registry",
+			cfg: &minderv1.GitHubProviderConfig{
+				Endpoint:       "https://github.example.com/api/v3/",
+				UploadEndpoint: "https://uploads.github.example.com",
+				Registry:       "registry.example.com",
+			},
+			isEnterprise: true,
+			serverURL:    "https://github.example.com",
+			apiHost:      "github.example.com",
+			registry:     "registry.example.com",
+			uploadURL:    "https://uploads.github.example.com/",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.isEnterprise, IsEnterprise(tt.cfg))
+			assert.Equal(t, tt.serverURL, ServerURL(tt.cfg))
+			assert.Equal(t, tt.apiHost, APIHost(tt.cfg))
+			assert.Equal(t, tt.registry, Registry(tt.cfg))
+
+			_, upload, err := apiURLs(tt.cfg)
+			require.NoError(t, err)
+			if tt.uploadURL == "" {
+				assert.Nil(t, upload)
+			} else {
+				assert.Equal(t, tt.uploadURL, upload.String())
+			}
+		})
+	}
+}
+
+func TestRestClientEnterpriseServer(t *testing.T) {
+	t.Parallel()
+
+	// a stand-in for a GitHub Enterprise Server, which serves its API
+	// under /api/v3/
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message": "Bad credentials"}`))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v3/user":
+			_, _ = w.Write([]byte(`{"login": "octocat"}`))
+		case "/api/v3/repos/acme/api":
+			_, _ = w.Write([]byte(`{"id": 42, "name": "api",
+				"clone_url": "https://github.example.com/acme/api.git"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	newClient := func(token string) *RestClient {
+		t.Helper()
+		cli, err := NewRestClient(context.Background(), &minderv1.GitHubProviderConfig{
+			Endpoint: srv.URL + "/api/v3",
+		}, provtelemetry.NewNoopMetrics(), token, "",
+			WithRateLimiter(ratelimit.NewLimiter()), WithCache(httpcache.NewCache()))
+		require.NoError(t, err)
+		return cli
+	}
+
+	cli := newClient("token")
+	assert.Equal(t, "containers."+strings.TrimPrefix(srv.URL, "http://"), cli.GetRegistry())
+	assert.NoError(t, cli.Validate(context.Background()))
+
+	repo, err := cli.GetRepository(context.Background(), "acme", "api")
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), repo.GetID())
+	assert.Equal(t, "https://github.example.com/acme/api.git", repo.GetCloneURL())
+
+	assert.Error(t, newClient("wrong").Validate(context.Background()))
+}
@@ -19,13 +19,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
 	"time"
 
 	"github.com/google/go-github/v53/github"
 	"golang.org/x/oauth2"
 
 	"github.com/stacklok/minder/internal/db"
+	gitclient "github.com/stacklok/minder/internal/providers/git"
+	"github.com/stacklok/minder/internal/providers/httpcache"
+	"github.com/stacklok/minder/internal/providers/ratelimit"
+	"github.com/stacklok/minder/internal/providers/registry"
 	"github.com/stacklok/minder/internal/providers/telemetry"
 	minderv1 "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
 	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
@@ -47,15 +50,83 @@ var Implements = []db.ProviderType{
 	db.ProviderTypeRepoLister,
 }
 
+func init() {
+	registry.Register(&registry.Implementation{
+		Name:           Github,
+		Implements:     Implements,
+		ValidateConfig: registry.ValidateWith(ParseV1Config),
+		Endpoints: func(def json.RawMessage) ([]string, error) {
+			cfg, err := ParseV1Config(def)
+			if err != nil {
+				return nil, err
+			}
+			return []string{cfg.GetEndpoint(), cfg.GetUploadEndpoint(), cfg.GetRegistry()}, nil
+		},
+		New: func(ctx context.Context, p registry.Params) (provifv1.Provider, error) {
+			cfg, err := ParseV1Config(p.Definition)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing github config: %w", err)
+			}
+
+			var opts []RestClientOption
+			if p.Installation {
+				opts = append(opts, WithInstallationToken())
+			}
+			return NewRestClient(ctx, cfg, p.Metrics, p.Token, p.OwnerFilter, opts...)
+		},
+		GitOptions: func(p registry.Params) []gitclient.Option {
+			if p.Installation {
+				return []gitclient.Option{gitclient.WithUsername(InstallationGitUsername)}
+			}
+			return nil
+		},
+	})
+}
+
 // RestClient is the struct that contains the GitHub REST API client
 type RestClient struct {
-	client *github.Client
-	token  string
-	owner  string
+	client       *github.Client
+	token        string
+	owner        string
+	registry     string
+	installation bool
+	limiter      *ratelimit.Limiter
+	cache        *httpcache.Cache
+}
+
+// RestClientOption is a function which can be used to set options on the RestClient.
+type RestClientOption func(*RestClient)
+
+// WithInstallationToken marks the token as a GitHub App installation token.
+// Installations can't use the endpoints scoped to the authenticated user, so
+// e.g. repositories are listed through the installation instead.
+func WithInstallationToken() RestClientOption {
+	return func(c *RestClient) {
+		c.installation = true
+	}
+}
+
+// WithRateLimiter sets the limiter keeping track of the rate limits of the
+// token. It defaults to the limiter shared by the process.
+func WithRateLimiter(l *ratelimit.Limiter) RestClientOption {
+	return func(c *RestClient) {
+		c.limiter = l
+	}
+}
+
+// WithCache sets the cache the responses are kept in. It defaults to the
+// cache shared by the process.
+func WithCache(c *httpcache.Cache) RestClientOption {
+	return func(cl *RestClient) {
+		cl.cache = c
+	}
 }
 
 // Ensure that the GitHub client implements the GitHub interface
-var _ provifv1.GitHub = (*RestClient)(nil)
+var (
+	_ provifv1.GitHub         = (*RestClient)(nil)
+	_ provifv1.WebhookDeleter = (*RestClient)(nil)
+)
 
 // NewRestClient creates a new GitHub REST API client
 // BaseURL defaults to the public GitHub API, if needing to use a customer domain
@@ -64,37 +135,55 @@ var _ provifv1.GitHub = (*RestClient)(nil)
 func NewRestClient(
 	ctx context.Context,
 	config *minderv1.GitHubProviderConfig,
-	metrics telemetry.HttpClientMetrics,
+	metrics telemetry.ProviderMetrics,
 	token string,
 	owner string,
+	opts ...RestClientOption,
 ) (*RestClient, error) {
-	var err error
+	cli := &RestClient{
+		token:    token,
+		owner:    owner,
+		registry: Registry(config),
+		limiter:  ratelimit.Default(),
+		cache:    httpcache.Default(),
+	}
+
+	for _, opt := range opts {
+		opt(cli)
+	}
 
 	ts := oauth2.StaticTokenSource(
 		&oauth2.Token{AccessToken: token},
 	)
 	tc := oauth2.NewClient(ctx, ts)
 
-	tc.Transport, err = metrics.NewDurationRoundTripper(tc.Transport, db.ProviderTypeGithub)
+	// requests are held back when the token runs out of quota, and rate
+	// limit errors are retriable
+	tc.Transport = cli.limiter.NewRoundTripper(tc.Transport, token, Github, metrics)
+	// responses are revalidated with conditional requests, which don't count
+	// against the rate limit
+	tc.Transport = cli.cache.NewRoundTripper(tc.Transport, token, Github, metrics)
+
+	var err error
+	tc.Transport, err = metrics.NewDurationRoundTripper(tc.Transport, Github)
 	if err != nil {
 		return nil, fmt.Errorf("error creating duration round tripper: %w", err)
 	}
 
-	ghClient := github.NewClient(tc)
+	cli.client = github.NewClient(tc)
 
-	if config.Endpoint != "" {
-		parsedURL, err := url.Parse(config.Endpoint)
-		if err != nil {
-			return nil, err
-		}
-		ghClient.BaseURL = parsedURL
+	baseURL, uploadURL, err := apiURLs(config)
+	if err != nil {
+		return nil, err
+	}
+	if baseURL != nil {
+		cli.client.BaseURL = baseURL
+	}
+	if uploadURL != nil {
+		cli.client.UploadURL = uploadURL
 	}
 
-	return &RestClient{
-		client: ghClient,
-		token:  token,
-		owner:  owner,
-	}, nil
+	return cli, nil
 }
 
 // ParseV1Config parses the raw config into a GitHubConfig struct
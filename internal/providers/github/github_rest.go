@@ -78,6 +78,10 @@ func convertRepository(repo *github.Repository) *minderv1.Repository {
 // ListAllRepositories returns a list of all repositories for the authenticated user
 // Two APIs are available, contigent on whether the token is for a user or an organization
 func (c *RestClient) ListAllRepositories(ctx context.Context, isOrg bool, owner string) ([]*github.Repository, error) {
+	if c.installation {
+		return c.listInstallationRepositories(ctx)
+	}
+
 	opt := &github.RepositoryListOptions{
 		ListOptions: github.ListOptions{
 			PerPage: 100,
@@ -122,6 +126,34 @@ func (c *RestClient) ListAllRepositories(ctx context.Context, isOrg bool, owner
 	return allRepos, nil
 }
 
+// listInstallationRepositories returns the repositories the GitHub App
+// installation has been granted access to
+func (c *RestClient) listInstallationRepositories(ctx context.Context) ([]*github.Repository, error) {
+	opt := &github.ListOptions{
+		PerPage: 100,
+	}
+
+	var allRepos []*github.Repository
+	for {
+		repos, resp, err := c.client.Apps.ListRepos(ctx, opt)
+		if err != nil {
+			return allRepos, err
+		}
+		allRepos = append(allRepos, repos.Repositories...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return allRepos, nil
+}
+
+// IsInstallation returns true if the client authenticates as a GitHub App installation
+func (c *RestClient) IsInstallation() bool {
+	return c.installation
+}
+
 // ListAllPackages returns a list of all packages for the authenticated user
 func (c *RestClient) ListAllPackages(ctx context.Context, isOrg bool, owner string, artifactType string,
 	pageNumber int, itemsPerPage int) ([]*github.Package, error) {
@@ -182,7 +214,7 @@ func (c *RestClient) ListPackagesByRepository(ctx context.Context, isOrg bool, o
 			artifacts, resp, err = c.client.Users.ListPackages(ctx, "", opt)
 		}
 		if err != nil {
-			if resp.StatusCode == http.StatusNotFound {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
 				return allContainers, fmt.Errorf("packages not found for repository %d: %w", repositoryId, ErrNotFound)
 			}
 
@@ -420,6 +452,12 @@ func (c *RestClient) UpdateBranchProtection(
 	return err
 }
 
+// RemoveBranchProtection removes the branch protection of a given branch
+func (c *RestClient) RemoveBranchProtection(ctx context.Context, owner, repo, branch string) error {
+	_, err := c.client.Repositories.RemoveBranchProtection(ctx, owner, repo, branch)
+	return err
+}
+
 // GetAuthenticatedUser returns the authenticated user
 func (c *RestClient) GetAuthenticatedUser(ctx context.Context) (*github.User, error) {
 	user, _, err := c.client.Users.Get(ctx, "")
@@ -429,6 +467,31 @@ func (c *RestClient) GetAuthenticatedUser(ctx context.Context) (*github.User, er
 	return user, nil
 }
 
+// AdministersAccount returns whether the authenticated user administers the
+// given account, that is whether the account is the user itself or an
+// organization the user is an active admin of. Accounts are matched by ID,
+// as logins can be renamed and taken over by other accounts.
+func (c *RestClient) AdministersAccount(ctx context.Context, account *github.User) (bool, error) {
+	if account.GetType() != "Organization" {
+		user, err := c.GetAuthenticatedUser(ctx)
+		if err != nil {
+			return false, err
+		}
+		return user.GetID() == account.GetID(), nil
+	}
+
+	membership, resp, err := c.client.Organizations.GetOrgMembership(ctx, "", account.GetLogin())
+	if resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusForbidden) {
+		// the user is not a member, or the organization restricts access
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return membership.GetState() == "active" && membership.GetRole() == "admin" &&
+		membership.GetOrganization().GetID() == account.GetID(), nil
+}
+
 // GetBaseURL returns the base URL for the REST API.
 func (c *RestClient) GetBaseURL() string {
 	return c.client.BaseURL.String()
@@ -474,10 +537,24 @@ func (c *RestClient) GetOwner() string {
 	return ""
 }
 
+// GetRegistry returns the host of the container registry of the packages
+func (c *RestClient) GetRegistry() string {
+	return c.registry
+}
+
+// Validate checks that the GitHub instance the client talks to accepts its
+// token
+func (c *RestClient) Validate(ctx context.Context) error {
+	if _, _, err := c.client.Users.Get(ctx, ""); err != nil {
+		return fmt.Errorf("invalid token: %w", err)
+	}
+	return nil
+}
+
 // ListHooks lists all Hooks for the specified repository.
 func (c *RestClient) ListHooks(ctx context.Context, owner, repo string) ([]*github.Hook, error) {
 	list, resp, err := c.client.Repositories.ListHooks(ctx, owner, repo, nil)
-	if err != nil && resp.StatusCode == http.StatusNotFound {
+	if err != nil && resp != nil && resp.StatusCode == http.StatusNotFound {
 		// return empty list so that the caller can ignore the error and iterate over the empty list
 		return []*github.Hook{}, fmt.Errorf("hooks not found for repository %s/%s: %w", owner, repo, ErrNotFound)
 	}
@@ -490,6 +567,16 @@ func (c *RestClient) DeleteHook(ctx context.Context, owner, repo string, id int6
 	return err
 }
 
+// DeleteRepositoryWebhook deletes a webhook from a repository, ignoring
+// webhooks which no longer exist
+func (c *RestClient) DeleteRepositoryWebhook(ctx context.Context, owner, name string, _, hookID int64) error {
+	resp, err := c.client.Repositories.DeleteHook(ctx, owner, name, hookID)
+	if err != nil && resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return err
+}
+
 // CreateHook creates a new Hook.
 func (c *RestClient) CreateHook(ctx context.Context, owner, repo string, hook *github.Hook) (*github.Hook, error) {
 	h, _, err := c.client.Repositories.CreateHook(ctx, owner, repo, hook)
@@ -685,6 +772,90 @@ func (c *RestClient) ListPullRequests(
 	return prs, nil
 }
 
+// UpdatePullRequest edits the title, body or state of a pull request
+func (c *RestClient) UpdatePullRequest(
+	ctx context.Context,
+	owner, repo string,
+	number int,
+	pr *github.PullRequest,
+) (*github.PullRequest, error) {
+	pr, _, err := c.client.PullRequests.Edit(ctx, owner, repo, number, pr)
+	if err != nil {
+		return nil, err
+	}
+
+	return pr, nil
+}
+
+// MergePullRequest merges a pull request with the given method
+func (c *RestClient) MergePullRequest(
+	ctx context.Context,
+	owner, repo string,
+	number int,
+	commitMessage, method string,
+) (*github.PullRequestMergeResult, error) {
+	res, _, err := c.client.PullRequests.Merge(ctx, owner, repo, number, commitMessage, &github.PullRequestOptions{
+		MergeMethod: method,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// RequestReviewers requests reviews of a pull request from the given users
+func (c *RestClient) RequestReviewers(ctx context.Context, owner, repo string, number int, reviewers []string) error {
+	_, _, err := c.client.PullRequests.RequestReviewers(ctx, owner, repo, number, github.ReviewersRequest{
+		Reviewers: reviewers,
+	})
+	return err
+}
+
+// ListCheckRunsForRef lists the check runs with the given name for a ref
+func (c *RestClient) ListCheckRunsForRef(
+	ctx context.Context,
+	owner, repo, ref, checkName string,
+) ([]*github.CheckRun, error) {
+	res, _, err := c.client.Checks.ListCheckRunsForRef(ctx, owner, repo, ref, &github.ListCheckRunsOptions{
+		CheckName: github.String(checkName),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.CheckRuns, nil
+}
+
+// CreateCheckRun creates a check run
+func (c *RestClient) CreateCheckRun(
+	ctx context.Context,
+	owner, repo string,
+	opts github.CreateCheckRunOptions,
+) (*github.CheckRun, error) {
+	run, _, err := c.client.Checks.CreateCheckRun(ctx, owner, repo, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
+
+// UpdateCheckRun updates a check run
+func (c *RestClient) UpdateCheckRun(
+	ctx context.Context,
+	owner, repo string,
+	checkRunID int64,
+	opts github.UpdateCheckRunOptions,
+) (*github.CheckRun, error) {
+	run, _, err := c.client.Checks.UpdateCheckRun(ctx, owner, repo, checkRunID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
+
 // CreateComment creates a comment on a pull request or an issue
 func (c *RestClient) CreateComment(ctx context.Context, owner, repo string, number int, comment string) error {
 	_, _, err := c.client.Issues.CreateComment(ctx, owner, repo, number, &github.IssueComment{
@@ -692,3 +863,67 @@ func (c *RestClient) CreateComment(ctx context.Context, owner, repo string, numb
 	})
 	return err
 }
+
+// CreateIssue creates an issue in a repository.
+func (c *RestClient) CreateIssue(
+	ctx context.Context,
+	owner, repo string,
+	issue *github.IssueRequest,
+) (*github.Issue, error) {
+	i, _, err := c.client.Issues.Create(ctx, owner, repo, issue)
+	if err != nil {
+		return nil, err
+	}
+
+	return i, nil
+}
+
+// GetIssue fetches a single issue in a repository.
+func (c *RestClient) GetIssue(ctx context.Context, owner, repo string, number int) (*github.Issue, error) {
+	i, resp, err := c.client.Issues.Get(ctx, owner, repo, number)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("issue %s/%s#%d: %w", owner, repo, number, engerrors.ErrNotFound)
+		}
+		return nil, err
+	}
+
+	return i, nil
+}
+
+// CloseIssue closes an issue in a repository.
+func (c *RestClient) CloseIssue(ctx context.Context, owner, repo string, number int) (*github.Issue, error) {
+	i, resp, err := c.client.Issues.Edit(ctx, owner, repo, number, &github.IssueRequest{
+		State:       github.String("closed"),
+		StateReason: github.String("completed"),
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("issue %s/%s#%d: %w", owner, repo, number, engerrors.ErrNotFound)
+		}
+		return nil, err
+	}
+
+	return i, nil
+}
+
+// GetFileContent fetches the content of a file of a repository at the given
+// ref, or at the default branch if the ref is empty.
+func (c *RestClient) GetFileContent(ctx context.Context, owner, repo, path, ref string) (string, error) {
+	var opts *github.RepositoryContentGetOptions
+	if ref != "" {
+		opts = &github.RepositoryContentGetOptions{Ref: ref}
+	}
+	file, _, resp, err := c.client.Repositories.GetContents(ctx, owner, repo, path, opts)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return "", fmt.Errorf("file %s in %s/%s: %w", path, owner, repo, engerrors.ErrNotFound)
+		}
+		return "", err
+	}
+	if file == nil {
+		return "", fmt.Errorf("%s in %s/%s is not a file", path, owner, repo)
+	}
+
+	return file.GetContent()
+}
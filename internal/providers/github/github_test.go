@@ -16,10 +16,21 @@ package github
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/google/go-github/v53/github"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/stacklok/minder/internal/events"
+	"github.com/stacklok/minder/internal/providers/httpcache"
+	"github.com/stacklok/minder/internal/providers/ratelimit"
 	provtelemetry "github.com/stacklok/minder/internal/providers/telemetry"
 	minderv1 "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
 )
@@ -36,3 +47,132 @@ func TestNewRestClient(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, client)
 }
+
+func TestRestClientRateLimited(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message": "API rate limit exceeded"}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewRestClient(context.Background(), &minderv1.GitHubProviderConfig{
+		Endpoint: srv.URL + "/",
+	},
+		provtelemetry.NewNoopMetrics(),
+		"token", "", WithRateLimiter(ratelimit.NewLimiter()))
+	require.NoError(t, err)
+
+	// rate limit errors are retried by the eventer
+	_, err = client.GetRepository(context.Background(), "acme", "api")
+	assert.True(t, errors.Is(err, events.ErrRetriable), "unexpected error: %v", err)
+}
+
+func TestRestClientCachesResponses(t *testing.T) {
+	t.Parallel()
+
+	var notModified atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(`{"id": 1234, "name": "api", "default_branch": "main"}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewRestClient(context.Background(), &minderv1.GitHubProviderConfig{
+		Endpoint: srv.URL + "/",
+	},
+		provtelemetry.NewNoopMetrics(),
+		"token", "", WithCache(httpcache.NewCache()))
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		repo, err := client.GetRepository(context.Background(), "acme", "api")
+		require.NoError(t, err)
+		assert.Equal(t, "main", repo.GetDefaultBranch())
+	}
+	assert.Equal(t, int32(1), notModified.Load())
+}
+
+func TestRestClientAdministersAccount(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/user", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"id": 1, "login": "jdoe"}`))
+	})
+	mux.HandleFunc("/user/memberships/orgs/acme", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"state": "active", "role": "admin", "organization": {"id": 10, "login": "acme"}}`))
+	})
+	mux.HandleFunc("/user/memberships/orgs/members", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"state": "active", "role": "member", "organization": {"id": 20, "login": "members"}}`))
+	})
+	mux.HandleFunc("/user/memberships/orgs/invited", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"state": "pending", "role": "admin", "organization": {"id": 30, "login": "invited"}}`))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	client, err := NewRestClient(context.Background(), &minderv1.GitHubProviderConfig{
+		Endpoint: srv.URL + "/",
+	},
+		provtelemetry.NewNoopMetrics(),
+		"token", "")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		account *github.User
+		want    bool
+	}{
+		{
+			name:    "own user account",
+			account: &github.User{ID: github.Int64(1), Login: github.String("jdoe"), Type: github.String("User")},
+			want:    true,
+		},
+		{
+			name:    "another user account with the same login",
+			account: &github.User{ID: github.Int64(2), Login: github.String("jdoe"), Type: github.String("User")},
+		},
+		{
+			name:    "organization the user administers",
+			account: &github.User{ID: github.Int64(10), Login: github.String("acme"), Type: github.String("Organization")},
+			want:    true,
+		},
+		{
+			name:    "another organization with the same login",
+			account: &github.User{ID: github.Int64(11), Login: github.String("acme"), Type: github.String("Organization")},
+		},
+		{
+			name:    "organization the user is a member of",
+			account: &github.User{ID: github.Int64(20), Login: github.String("members"), Type: github.String("Organization")},
+		},
+		{
+			name:    "organization the user was invited to administer",
+			account: &github.User{ID: github.Int64(30), Login: github.String("invited"), Type: github.String("Organization")},
+		},
+		{
+			name:    "organization the user is not a member of",
+			account: &github.User{ID: github.Int64(40), Login: github.String("other"), Type: github.String("Organization")},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := client.AdministersAccount(context.Background(), tt.account)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	git "github.com/go-git/go-git/v5"
 	gomock "github.com/golang/mock/gomock"
+	authn "github.com/google/go-containerregistry/pkg/authn"
 	github "github.com/google/go-github/v53/github"
 	v1 "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
 )
@@ -252,6 +253,43 @@ func (mr *MockRepoListerMockRecorder) ListUserRepositories(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserRepositories", reflect.TypeOf((*MockRepoLister)(nil).ListUserRepositories), arg0, arg1)
 }
 
+// MockWebhookDeleter is a mock of WebhookDeleter interface.
+type MockWebhookDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookDeleterMockRecorder
+}
+
+// MockWebhookDeleterMockRecorder is the mock recorder for MockWebhookDeleter.
+type MockWebhookDeleterMockRecorder struct {
+	mock *MockWebhookDeleter
+}
+
+// NewMockWebhookDeleter creates a new mock instance.
+func NewMockWebhookDeleter(ctrl *gomock.Controller) *MockWebhookDeleter {
+	mock := &MockWebhookDeleter{ctrl: ctrl}
+	mock.recorder = &MockWebhookDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookDeleter) EXPECT() *MockWebhookDeleterMockRecorder {
+	return m.recorder
+}
+
+// DeleteRepositoryWebhook mocks base method.
+func (m *MockWebhookDeleter) DeleteRepositoryWebhook(ctx context.Context, owner, name string, repoID, hookID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRepositoryWebhook", ctx, owner, name, repoID, hookID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRepositoryWebhook indicates an expected call of DeleteRepositoryWebhook.
+func (mr *MockWebhookDeleterMockRecorder) DeleteRepositoryWebhook(ctx, owner, name, repoID, hookID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRepositoryWebhook", reflect.TypeOf((*MockWebhookDeleter)(nil).DeleteRepositoryWebhook), ctx, owner, name, repoID, hookID)
+}
+
 // MockGitHub is a mock of GitHub interface.
 type MockGitHub struct {
 	ctrl     *gomock.Controller
@@ -275,6 +313,21 @@ func (m *MockGitHub) EXPECT() *MockGitHubMockRecorder {
 	return m.recorder
 }
 
+// CloseIssue mocks base method.
+func (m *MockGitHub) CloseIssue(ctx context.Context, owner, repo string, number int) (*github.Issue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseIssue", ctx, owner, repo, number)
+	ret0, _ := ret[0].(*github.Issue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseIssue indicates an expected call of CloseIssue.
+func (mr *MockGitHubMockRecorder) CloseIssue(ctx, owner, repo, number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseIssue", reflect.TypeOf((*MockGitHub)(nil).CloseIssue), ctx, owner, repo, number)
+}
+
 // CloseSecurityAdvisory mocks base method.
 func (m *MockGitHub) CloseSecurityAdvisory(ctx context.Context, owner, repo, id string) error {
 	m.ctrl.T.Helper()
@@ -304,6 +357,21 @@ func (mr *MockGitHubMockRecorder) CreateBlob(ctx, owner, repo, blob interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBlob", reflect.TypeOf((*MockGitHub)(nil).CreateBlob), ctx, owner, repo, blob)
 }
 
+// CreateCheckRun mocks base method.
+func (m *MockGitHub) CreateCheckRun(ctx context.Context, owner, repo string, opts github.CreateCheckRunOptions) (*github.CheckRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCheckRun", ctx, owner, repo, opts)
+	ret0, _ := ret[0].(*github.CheckRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCheckRun indicates an expected call of CreateCheckRun.
+func (mr *MockGitHubMockRecorder) CreateCheckRun(ctx, owner, repo, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCheckRun", reflect.TypeOf((*MockGitHub)(nil).CreateCheckRun), ctx, owner, repo, opts)
+}
+
 // CreateComment mocks base method.
 func (m *MockGitHub) CreateComment(ctx context.Context, owner, repo string, number int, comment string) error {
 	m.ctrl.T.Helper()
@@ -348,6 +416,21 @@ func (mr *MockGitHubMockRecorder) CreateHook(ctx, owner, repo, hook interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHook", reflect.TypeOf((*MockGitHub)(nil).CreateHook), ctx, owner, repo, hook)
 }
 
+// CreateIssue mocks base method.
+func (m *MockGitHub) CreateIssue(ctx context.Context, owner, repo string, issue *github.IssueRequest) (*github.Issue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIssue", ctx, owner, repo, issue)
+	ret0, _ := ret[0].(*github.Issue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIssue indicates an expected call of CreateIssue.
+func (mr *MockGitHubMockRecorder) CreateIssue(ctx, owner, repo, issue interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIssue", reflect.TypeOf((*MockGitHub)(nil).CreateIssue), ctx, owner, repo, issue)
+}
+
 // CreatePullRequest mocks base method.
 func (m *MockGitHub) CreatePullRequest(ctx context.Context, owner, repo, title, body, head, base string) (*github.PullRequest, error) {
 	m.ctrl.T.Helper()
@@ -526,6 +609,36 @@ func (mr *MockGitHubMockRecorder) GetCommit(ctx, owner, repo, commitSHA interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommit", reflect.TypeOf((*MockGitHub)(nil).GetCommit), ctx, owner, repo, commitSHA)
 }
 
+// GetFileContent mocks base method.
+func (m *MockGitHub) GetFileContent(ctx context.Context, owner, repo, path, ref string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFileContent", ctx, owner, repo, path, ref)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFileContent indicates an expected call of GetFileContent.
+func (mr *MockGitHubMockRecorder) GetFileContent(ctx, owner, repo, path, ref interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFileContent", reflect.TypeOf((*MockGitHub)(nil).GetFileContent), ctx, owner, repo, path, ref)
+}
+
+// GetIssue mocks base method.
+func (m *MockGitHub) GetIssue(ctx context.Context, owner, repo string, number int) (*github.Issue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIssue", ctx, owner, repo, number)
+	ret0, _ := ret[0].(*github.Issue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIssue indicates an expected call of GetIssue.
+func (mr *MockGitHubMockRecorder) GetIssue(ctx, owner, repo, number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIssue", reflect.TypeOf((*MockGitHub)(nil).GetIssue), ctx, owner, repo, number)
+}
+
 // GetOwner mocks base method.
 func (m *MockGitHub) GetOwner() string {
 	m.ctrl.T.Helper()
@@ -630,6 +743,20 @@ func (mr *MockGitHubMockRecorder) GetRef(ctx, owner, repo, refString interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRef", reflect.TypeOf((*MockGitHub)(nil).GetRef), ctx, owner, repo, refString)
 }
 
+// GetRegistry mocks base method.
+func (m *MockGitHub) GetRegistry() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegistry")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetRegistry indicates an expected call of GetRegistry.
+func (mr *MockGitHubMockRecorder) GetRegistry() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistry", reflect.TypeOf((*MockGitHub)(nil).GetRegistry))
+}
+
 // GetRepository mocks base method.
 func (m *MockGitHub) GetRepository(arg0 context.Context, arg1, arg2 string) (*github.Repository, error) {
 	m.ctrl.T.Helper()
@@ -689,6 +816,21 @@ func (mr *MockGitHubMockRecorder) ListAllRepositories(arg0, arg1, arg2 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllRepositories", reflect.TypeOf((*MockGitHub)(nil).ListAllRepositories), arg0, arg1, arg2)
 }
 
+// ListCheckRunsForRef mocks base method.
+func (m *MockGitHub) ListCheckRunsForRef(ctx context.Context, owner, repo, ref, checkName string) ([]*github.CheckRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCheckRunsForRef", ctx, owner, repo, ref, checkName)
+	ret0, _ := ret[0].([]*github.CheckRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCheckRunsForRef indicates an expected call of ListCheckRunsForRef.
+func (mr *MockGitHubMockRecorder) ListCheckRunsForRef(ctx, owner, repo, ref, checkName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCheckRunsForRef", reflect.TypeOf((*MockGitHub)(nil).ListCheckRunsForRef), ctx, owner, repo, ref, checkName)
+}
+
 // ListFiles mocks base method.
 func (m *MockGitHub) ListFiles(ctx context.Context, owner, repo string, prNumber, perPage, pageNumber int) ([]*github.CommitFile, *github.Response, error) {
 	m.ctrl.T.Helper()
@@ -795,6 +937,21 @@ func (mr *MockGitHubMockRecorder) ListUserRepositories(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserRepositories", reflect.TypeOf((*MockGitHub)(nil).ListUserRepositories), arg0, arg1)
 }
 
+// MergePullRequest mocks base method.
+func (m *MockGitHub) MergePullRequest(ctx context.Context, owner, repo string, number int, commitMessage, method string) (*github.PullRequestMergeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergePullRequest", ctx, owner, repo, number, commitMessage, method)
+	ret0, _ := ret[0].(*github.PullRequestMergeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergePullRequest indicates an expected call of MergePullRequest.
+func (mr *MockGitHubMockRecorder) MergePullRequest(ctx, owner, repo, number, commitMessage, method interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergePullRequest", reflect.TypeOf((*MockGitHub)(nil).MergePullRequest), ctx, owner, repo, number, commitMessage, method)
+}
+
 // NewRequest mocks base method.
 func (m *MockGitHub) NewRequest(method, url string, body any) (*http.Request, error) {
 	m.ctrl.T.Helper()
@@ -810,6 +967,34 @@ func (mr *MockGitHubMockRecorder) NewRequest(method, url, body interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRequest", reflect.TypeOf((*MockGitHub)(nil).NewRequest), method, url, body)
 }
 
+// RemoveBranchProtection mocks base method.
+func (m *MockGitHub) RemoveBranchProtection(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveBranchProtection", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveBranchProtection indicates an expected call of RemoveBranchProtection.
+func (mr *MockGitHubMockRecorder) RemoveBranchProtection(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBranchProtection", reflect.TypeOf((*MockGitHub)(nil).RemoveBranchProtection), arg0, arg1, arg2, arg3)
+}
+
+// RequestReviewers mocks base method.
+func (m *MockGitHub) RequestReviewers(ctx context.Context, owner, repo string, number int, reviewers []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestReviewers", ctx, owner, repo, number, reviewers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestReviewers indicates an expected call of RequestReviewers.
+func (mr *MockGitHubMockRecorder) RequestReviewers(ctx, owner, repo, number, reviewers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestReviewers", reflect.TypeOf((*MockGitHub)(nil).RequestReviewers), ctx, owner, repo, number, reviewers)
+}
+
 // SetCommitStatus mocks base method.
 func (m *MockGitHub) SetCommitStatus(arg0 context.Context, arg1, arg2, arg3 string, arg4 *github.RepoStatus) (*github.RepoStatus, error) {
 	m.ctrl.T.Helper()
@@ -839,6 +1024,36 @@ func (mr *MockGitHubMockRecorder) UpdateBranchProtection(arg0, arg1, arg2, arg3,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBranchProtection", reflect.TypeOf((*MockGitHub)(nil).UpdateBranchProtection), arg0, arg1, arg2, arg3, arg4)
 }
 
+// UpdateCheckRun mocks base method.
+func (m *MockGitHub) UpdateCheckRun(ctx context.Context, owner, repo string, checkRunID int64, opts github.UpdateCheckRunOptions) (*github.CheckRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCheckRun", ctx, owner, repo, checkRunID, opts)
+	ret0, _ := ret[0].(*github.CheckRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCheckRun indicates an expected call of UpdateCheckRun.
+func (mr *MockGitHubMockRecorder) UpdateCheckRun(ctx, owner, repo, checkRunID, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCheckRun", reflect.TypeOf((*MockGitHub)(nil).UpdateCheckRun), ctx, owner, repo, checkRunID, opts)
+}
+
+// UpdatePullRequest mocks base method.
+func (m *MockGitHub) UpdatePullRequest(ctx context.Context, owner, repo string, number int, pr *github.PullRequest) (*github.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePullRequest", ctx, owner, repo, number, pr)
+	ret0, _ := ret[0].(*github.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePullRequest indicates an expected call of UpdatePullRequest.
+func (mr *MockGitHubMockRecorder) UpdatePullRequest(ctx, owner, repo, number, pr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePullRequest", reflect.TypeOf((*MockGitHub)(nil).UpdatePullRequest), ctx, owner, repo, number, pr)
+}
+
 // UpdateRef mocks base method.
 func (m *MockGitHub) UpdateRef(ctx context.Context, owner, repo, ref, sha string, force bool) (*github.Reference, error) {
 	m.ctrl.T.Helper()
@@ -853,3 +1068,113 @@ func (mr *MockGitHubMockRecorder) UpdateRef(ctx, owner, repo, ref, sha, force in
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRef", reflect.TypeOf((*MockGitHub)(nil).UpdateRef), ctx, owner, repo, ref, sha, force)
 }
+
+// MockOCI is a mock of OCI interface.
+type MockOCI struct {
+	ctrl     *gomock.Controller
+	recorder *MockOCIMockRecorder
+}
+
+// MockOCIMockRecorder is the mock recorder for MockOCI.
+type MockOCIMockRecorder struct {
+	mock *MockOCI
+}
+
+// NewMockOCI creates a new mock instance.
+func NewMockOCI(ctrl *gomock.Controller) *MockOCI {
+	mock := &MockOCI{ctrl: ctrl}
+	mock.recorder = &MockOCIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOCI) EXPECT() *MockOCIMockRecorder {
+	return m.recorder
+}
+
+// GetAuthenticator mocks base method.
+func (m *MockOCI) GetAuthenticator() authn.Authenticator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthenticator")
+	ret0, _ := ret[0].(authn.Authenticator)
+	return ret0
+}
+
+// GetAuthenticator indicates an expected call of GetAuthenticator.
+func (mr *MockOCIMockRecorder) GetAuthenticator() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthenticator", reflect.TypeOf((*MockOCI)(nil).GetAuthenticator))
+}
+
+// GetDigest mocks base method.
+func (m *MockOCI) GetDigest(ctx context.Context, repository, tag string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDigest", ctx, repository, tag)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDigest indicates an expected call of GetDigest.
+func (mr *MockOCIMockRecorder) GetDigest(ctx, repository, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDigest", reflect.TypeOf((*MockOCI)(nil).GetDigest), ctx, repository, tag)
+}
+
+// GetRegistry mocks base method.
+func (m *MockOCI) GetRegistry() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegistry")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetRegistry indicates an expected call of GetRegistry.
+func (mr *MockOCIMockRecorder) GetRegistry() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistry", reflect.TypeOf((*MockOCI)(nil).GetRegistry))
+}
+
+// GetToken mocks base method.
+func (m *MockOCI) GetToken() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetToken")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetToken indicates an expected call of GetToken.
+func (mr *MockOCIMockRecorder) GetToken() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetToken", reflect.TypeOf((*MockOCI)(nil).GetToken))
+}
+
+// ListRepositories mocks base method.
+func (m *MockOCI) ListRepositories(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRepositories", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRepositories indicates an expected call of ListRepositories.
+func (mr *MockOCIMockRecorder) ListRepositories(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepositories", reflect.TypeOf((*MockOCI)(nil).ListRepositories), ctx)
+}
+
+// ListTags mocks base method.
+func (m *MockOCI) ListTags(ctx context.Context, repository string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTags", ctx, repository)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTags indicates an expected call of ListTags.
+func (mr *MockOCIMockRecorder) ListTags(ctx, repository interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockOCI)(nil).ListTags), ctx, repository)
+}
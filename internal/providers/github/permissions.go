@@ -0,0 +1,95 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"golang.org/x/exp/slices"
+
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+// oauthScopesHeader lists the scopes of the classic token a request was made
+// with. GitHub doesn't send it for fine-grained tokens and GitHub Apps.
+const oauthScopesHeader = "X-OAuth-Scopes"
+
+// permissionScopes are the classic token scopes granting each permission.
+// The first scope is the one to ask for, the others are the scopes which also
+// grant it, e.g. repo includes admin:repo_hook. No scope grants the
+// permission to write check runs, only GitHub Apps can create them.
+// See https://docs.github.com/en/apps/oauth-apps/building-oauth-apps/scopes-for-oauth-apps
+var permissionScopes = map[provifv1.Permission][]string{
+	provifv1.PermissionReadRepositories:        {"repo"},
+	provifv1.PermissionManageWebhooks:          {"admin:repo_hook", "write:repo_hook", "repo"},
+	provifv1.PermissionReadPackages:            {"read:packages", "write:packages"},
+	provifv1.PermissionWriteContents:           {"repo"},
+	provifv1.PermissionWriteWorkflows:          {"workflow"},
+	provifv1.PermissionAdministerRepositories:  {"repo"},
+	provifv1.PermissionWriteSecurityAdvisories: {"repo"},
+	provifv1.PermissionWriteIssues:             {"repo", "public_repo"},
+	provifv1.PermissionWriteChecks:             nil,
+	provifv1.PermissionWriteStatuses:           {"repo:status", "repo"},
+}
+
+// GrantedPermissions returns the permissions granted by the scopes of the
+// token of the client. The scopes of fine-grained tokens and of GitHub App
+// installation tokens aren't reported, so ErrPermissionsUnknown is returned
+// for them.
+func (c *RestClient) GrantedPermissions(ctx context.Context) ([]provifv1.Permission, error) {
+	_, resp, err := c.client.Users.Get(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("error getting the authenticated user: %w", err)
+	}
+
+	if _, ok := resp.Header[http.CanonicalHeaderKey(oauthScopesHeader)]; !ok {
+		return nil, provifv1.ErrPermissionsUnknown
+	}
+	return permissionsFromScopes(parseScopes(resp.Header.Get(oauthScopesHeader))), nil
+}
+
+// PermissionScopes returns the classic token scopes granting the permission
+func (_ *RestClient) PermissionScopes(p provifv1.Permission) []string {
+	return permissionScopes[p]
+}
+
+// parseScopes parses the comma separated scopes of the oauthScopesHeader
+func parseScopes(header string) []string {
+	var scopes []string
+	for _, s := range strings.Split(header, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			scopes = append(scopes, s)
+		}
+	}
+	return scopes
+}
+
+// permissionsFromScopes returns the permissions granted by any of the scopes
+func permissionsFromScopes(scopes []string) []provifv1.Permission {
+	var granted []provifv1.Permission
+	for perm, grantedBy := range permissionScopes {
+		for _, s := range grantedBy {
+			if slices.Contains(scopes, s) {
+				granted = append(granted, perm)
+				break
+			}
+		}
+	}
+	slices.Sort(granted)
+	return granted
+}
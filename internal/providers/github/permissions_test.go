@@ -0,0 +1,124 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	provtelemetry "github.com/stacklok/minder/internal/providers/telemetry"
+	minderv1 "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+func TestGrantedPermissions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		scopes  *string
+		want    []provifv1.Permission
+		wantErr error
+	}{
+		{
+			name:   "repo and workflow",
+			scopes: scopesOf("repo, workflow"),
+			want: []provifv1.Permission{
+				provifv1.PermissionAdministerRepositories,
+				provifv1.PermissionManageWebhooks,
+				provifv1.PermissionReadRepositories,
+				provifv1.PermissionWriteContents,
+				provifv1.PermissionWriteIssues,
+				provifv1.PermissionWriteSecurityAdvisories,
+				provifv1.PermissionWriteStatuses,
+				provifv1.PermissionWriteWorkflows,
+			},
+		},
+		{
+			name:   "statuses only",
+			scopes: scopesOf("repo:status"),
+			want:   []provifv1.Permission{provifv1.PermissionWriteStatuses},
+		},
+		{
+			name:   "hooks and packages only",
+			scopes: scopesOf("admin:repo_hook,write:packages"),
+			want: []provifv1.Permission{
+				provifv1.PermissionManageWebhooks,
+				provifv1.PermissionReadPackages,
+			},
+		},
+		{
+			name:   "no scopes",
+			scopes: scopesOf(""),
+		},
+		{
+			name:    "fine-grained token",
+			wantErr: provifv1.ErrPermissionsUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v3/user" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				if tt.scopes != nil {
+					w.Header().Set(oauthScopesHeader, *tt.scopes)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"login": "octocat"}`))
+			}))
+			defer srv.Close()
+
+			cli, err := NewRestClient(context.Background(), &minderv1.GitHubProviderConfig{
+				Endpoint: srv.URL + "/api/v3/",
+			}, provtelemetry.NewNoopMetrics(), "token", "")
+			require.NoError(t, err)
+
+			granted, err := cli.GrantedPermissions(context.Background())
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, granted)
+		})
+	}
+}
+
+func TestPermissionScopes(t *testing.T) {
+	t.Parallel()
+
+	cli := &RestClient{}
+	assert.Equal(t, []string{"workflow"}, cli.PermissionScopes(provifv1.PermissionWriteWorkflows))
+	assert.Equal(t, "admin:repo_hook", cli.PermissionScopes(provifv1.PermissionManageWebhooks)[0])
+	assert.Empty(t, cli.PermissionScopes("unknown"))
+	// only GitHub Apps can create check runs
+	assert.Empty(t, cli.PermissionScopes(provifv1.PermissionWriteChecks))
+}
+
+func scopesOf(scopes string) *string {
+	return &scopes
+}
@@ -0,0 +1,198 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	minderv1 "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	// VisibilityPublic is the visibility of projects anyone can see
+	VisibilityPublic = "public"
+
+	perPage = 100
+)
+
+// Project is the subset of a GitLab project minder cares about
+type Project struct {
+	ID                int64     `json:"id"`
+	Name              string    `json:"name"`
+	Path              string    `json:"path"`
+	PathWithNamespace string    `json:"path_with_namespace"`
+	Namespace         Namespace `json:"namespace"`
+	DefaultBranch     string    `json:"default_branch"`
+	Visibility        string    `json:"visibility"`
+	HTTPURLToRepo     string    `json:"http_url_to_repo"`
+	WebURL            string    `json:"web_url"`
+	ForkedFromProject *Project  `json:"forked_from_project,omitempty"`
+}
+
+// Namespace is the group or user a GitLab project belongs to
+type Namespace struct {
+	ID       int64  `json:"id"`
+	Path     string `json:"path"`
+	FullPath string `json:"full_path"`
+	Kind     string `json:"kind"`
+}
+
+// IsPrivate returns true if the project is not publicly visible. Internal
+// projects are visible to any signed-in user and are treated as private.
+func (p *Project) IsPrivate() bool {
+	return p.Visibility != VisibilityPublic
+}
+
+// IsFork returns true if the project was forked from another one
+func (p *Project) IsFork() bool {
+	return p.ForkedFromProject != nil
+}
+
+// Hook is a GitLab project webhook
+type Hook struct {
+	ID                    int64  `json:"id,omitempty"`
+	URL                   string `json:"url"`
+	Token                 string `json:"token,omitempty"`
+	PushEvents            bool   `json:"push_events"`
+	TagPushEvents         bool   `json:"tag_push_events"`
+	MergeRequestsEvents   bool   `json:"merge_requests_events"`
+	EnableSSLVerification bool   `json:"enable_ssl_verification"`
+}
+
+// ListUserRepositories returns a list of all projects the authenticated user
+// is a member of
+func (c *Client) ListUserRepositories(ctx context.Context, _ string) ([]*minderv1.Repository, error) {
+	q := url.Values{}
+	q.Set("membership", "true")
+	q.Set("archived", "false")
+
+	projects, err := c.listProjects(ctx, "projects", q)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertProjects(projects), nil
+}
+
+// ListOrganizationRepsitories returns a list of all projects in the group,
+// including its subgroups
+func (c *Client) ListOrganizationRepsitories(ctx context.Context, owner string) ([]*minderv1.Repository, error) {
+	q := url.Values{}
+	q.Set("include_subgroups", "true")
+	q.Set("archived", "false")
+
+	projects, err := c.listProjects(ctx, "groups/"+url.PathEscape(owner)+"/projects", q)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertProjects(projects), nil
+}
+
+// listProjects follows GitLab's page based pagination until the last page
+func (c *Client) listProjects(ctx context.Context, endpoint string, q url.Values) ([]*Project, error) {
+	q.Set("per_page", strconv.Itoa(perPage))
+
+	var all []*Project
+	page := "1"
+	for page != "" {
+		q.Set("page", page)
+
+		var projects []*Project
+		resp, err := c.do(ctx, http.MethodGet, endpoint+"?"+q.Encode(), nil, &projects)
+		if err != nil {
+			return all, err
+		}
+		all = append(all, projects...)
+		page = resp.Header.Get("X-Next-Page")
+	}
+
+	return all, nil
+}
+
+func convertProjects(projects []*Project) []*minderv1.Repository {
+	var converted []*minderv1.Repository
+	for _, p := range projects {
+		converted = append(converted, ConvertProject(p))
+	}
+	return converted
+}
+
+// ConvertProject converts a GitLab project to the minder repository
+// representation. The namespace plays the role of the owner.
+func ConvertProject(p *Project) *minderv1.Repository {
+	return &minderv1.Repository{
+		Name:      p.Path,
+		Owner:     p.Namespace.FullPath,
+		RepoId:    int32(p.ID), // FIXME this is a 64 bit int
+		CloneUrl:  p.HTTPURLToRepo,
+		IsPrivate: p.IsPrivate(),
+		IsFork:    p.IsFork(),
+	}
+}
+
+// GetProject returns a project given its namespace and name
+func (c *Client) GetProject(ctx context.Context, owner, name string) (*Project, error) {
+	var p Project
+	if _, err := c.do(ctx, http.MethodGet, projectPath(owner+"/"+name), nil, &p); err != nil {
+		return nil, fmt.Errorf("error getting project %s/%s: %w", owner, name, err)
+	}
+	return &p, nil
+}
+
+// ListHooks lists the webhooks of a project
+func (c *Client) ListHooks(ctx context.Context, projectID int64) ([]*Hook, error) {
+	var hooks []*Hook
+	endpoint := projectPath(strconv.FormatInt(projectID, 10)) + "/hooks"
+	if _, err := c.do(ctx, http.MethodGet, endpoint, nil, &hooks); err != nil {
+		return nil, fmt.Errorf("error listing hooks: %w", err)
+	}
+	return hooks, nil
+}
+
+// CreateHook creates a webhook on a project
+func (c *Client) CreateHook(ctx context.Context, projectID int64, hook *Hook) (*Hook, error) {
+	var created Hook
+	endpoint := projectPath(strconv.FormatInt(projectID, 10)) + "/hooks"
+	if _, err := c.do(ctx, http.MethodPost, endpoint, hook, &created); err != nil {
+		return nil, fmt.Errorf("error creating hook: %w", err)
+	}
+	return &created, nil
+}
+
+// DeleteHook deletes a webhook from a project
+func (c *Client) DeleteHook(ctx context.Context, projectID, hookID int64) error {
+	endpoint := fmt.Sprintf("%s/hooks/%d", projectPath(strconv.FormatInt(projectID, 10)), hookID)
+	if _, err := c.do(ctx, http.MethodDelete, endpoint, nil, nil); err != nil {
+		return fmt.Errorf("error deleting hook: %w", err)
+	}
+	return nil
+}
+
+// DeleteRepositoryWebhook deletes a webhook from a project, ignoring
+// webhooks which no longer exist. GitLab projects are registered with
+// their ID, which the owner and name aren't needed next to.
+func (c *Client) DeleteRepositoryWebhook(ctx context.Context, _, _ string, repoID, hookID int64) error {
+	err := c.DeleteHook(ctx, repoID, hookID)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,273 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stacklok/minder/internal/events"
+	"github.com/stacklok/minder/internal/providers/ratelimit"
+	provtelemetry "github.com/stacklok/minder/internal/providers/telemetry"
+	minderv1 "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// fakeGitLab is a minimal stand-in for the GitLab v4 API
+type fakeGitLab struct {
+	projects []*Project
+	hooks    map[int64][]*Hook
+	nextHook int64
+}
+
+func newFakeGitLab(t *testing.T) (*fakeGitLab, *httptest.Server) {
+	t.Helper()
+
+	f := &fakeGitLab{
+		projects: []*Project{
+			{ID: 1, Path: "api", Namespace: Namespace{FullPath: "acme/backend"},
+				Visibility: "public", HTTPURLToRepo: "https://gitlab.example.com/acme/backend/api.git"},
+			{ID: 2, Path: "web", Namespace: Namespace{FullPath: "acme"},
+				Visibility: "private", HTTPURLToRepo: "https://gitlab.example.com/acme/web.git",
+				ForkedFromProject: &Project{ID: 7}},
+			{ID: 3, Path: "dotfiles", Namespace: Namespace{FullPath: "jdoe"},
+				Visibility: "internal", HTTPURLToRepo: "https://gitlab.example.com/jdoe/dotfiles.git"},
+		},
+		hooks:    map[int64][]*Hook{},
+		nextHook: 100,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		f.serve(t, w, r)
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return f, srv
+}
+
+func (f *fakeGitLab) serve(t *testing.T, w http.ResponseWriter, r *http.Request) {
+	t.Helper()
+
+	path := strings.TrimPrefix(r.URL.EscapedPath(), "/api/v4/")
+	switch {
+	case path == "projects" && r.Method == http.MethodGet:
+		assert.Equal(t, "true", r.URL.Query().Get("membership"))
+		// serve one project per page to exercise pagination
+		page := r.URL.Query().Get("page")
+		var idx int
+		_, _ = fmt.Sscanf(page, "%d", &idx)
+		if idx < len(f.projects) {
+			w.Header().Set("X-Next-Page", fmt.Sprint(idx+1))
+		}
+		writeJSON(t, w, f.projects[idx-1:idx])
+	case path == "groups/acme/projects" && r.Method == http.MethodGet:
+		assert.Equal(t, "true", r.URL.Query().Get("include_subgroups"))
+		writeJSON(t, w, f.projects[:2])
+	case path == "projects/acme%2Fweb" && r.Method == http.MethodGet:
+		writeJSON(t, w, f.projects[1])
+	case path == "projects/2/hooks" && r.Method == http.MethodGet:
+		writeJSON(t, w, f.hooks[2])
+	case path == "projects/2/hooks" && r.Method == http.MethodPost:
+		var h Hook
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&h))
+		h.ID = f.nextHook
+		f.nextHook++
+		f.hooks[2] = append(f.hooks[2], &h)
+		w.WriteHeader(http.StatusCreated)
+		writeJSON(t, w, h)
+	case strings.HasPrefix(path, "projects/2/hooks/") && r.Method == http.MethodDelete:
+		var id int64
+		_, _ = fmt.Sscanf(strings.TrimPrefix(path, "projects/2/hooks/"), "%d", &id)
+		for i, h := range f.hooks[2] {
+			if h.ID == id {
+				f.hooks[2] = append(f.hooks[2][:i], f.hooks[2][i+1:]...)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"404 Not Found"}`))
+	}
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, v any) {
+	t.Helper()
+	require.NoError(t, json.NewEncoder(w).Encode(v))
+}
+
+func newTestClient(t *testing.T, endpoint string) *Client {
+	t.Helper()
+
+	cli, err := NewClient(context.Background(), &minderv1.GitLabProviderConfig{
+		Endpoint: endpoint,
+	}, provtelemetry.NewNoopMetrics(), "token", WithLimiter(ratelimit.NewLimiter()))
+	require.NoError(t, err)
+	return cli
+}
+
+func TestNewClient(t *testing.T) {
+	t.Parallel()
+
+	cli := newTestClient(t, "")
+	assert.Equal(t, DefaultEndpoint, cli.GetBaseURL())
+
+	cli = newTestClient(t, "https://gitlab.example.com/api/v4")
+	assert.Equal(t, "https://gitlab.example.com/api/v4/", cli.GetBaseURL())
+	assert.Equal(t, "token", cli.GetToken())
+}
+
+func TestParseV1Config(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := ParseV1Config(json.RawMessage(`{"gitlab": {"endpoint": "https://gitlab.example.com/api/v4/"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "https://gitlab.example.com/api/v4/", cfg.GetEndpoint())
+
+	cfg, err = ParseV1Config(json.RawMessage(`{"gitlab": {}}`))
+	require.NoError(t, err)
+	assert.Empty(t, cfg.GetEndpoint())
+
+	_, err = ParseV1Config(json.RawMessage(`{"gitlab": {"endpoint": "gitlab.example.com"}}`))
+	assert.Error(t, err)
+
+	_, err = ParseV1Config(json.RawMessage(`{"github": {}}`))
+	assert.Error(t, err)
+}
+
+func TestListRepositories(t *testing.T) {
+	t.Parallel()
+
+	_, srv := newFakeGitLab(t)
+	cli := newTestClient(t, srv.URL+"/api/v4")
+
+	repos, err := cli.ListUserRepositories(context.Background(), "")
+	require.NoError(t, err)
+	require.Len(t, repos, 3)
+
+	assert.Equal(t, "acme/backend", repos[0].Owner)
+	assert.Equal(t, "api", repos[0].Name)
+	assert.Equal(t, int32(1), repos[0].RepoId)
+	assert.Equal(t, "https://gitlab.example.com/acme/backend/api.git", repos[0].CloneUrl)
+	assert.False(t, repos[0].IsPrivate)
+	assert.False(t, repos[0].IsFork)
+
+	assert.True(t, repos[1].IsPrivate)
+	assert.True(t, repos[1].IsFork)
+	// internal projects aren't public
+	assert.True(t, repos[2].IsPrivate)
+
+	repos, err = cli.ListOrganizationRepsitories(context.Background(), "acme")
+	require.NoError(t, err)
+	assert.Len(t, repos, 2)
+
+	_, err = cli.ListOrganizationRepsitories(context.Background(), "nope")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestHooks(t *testing.T) {
+	t.Parallel()
+
+	fake, srv := newFakeGitLab(t)
+	cli := newTestClient(t, srv.URL+"/api/v4/")
+	ctx := context.Background()
+
+	p, err := cli.GetProject(ctx, "acme", "web")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), p.ID)
+
+	_, err = cli.GetProject(ctx, "acme", "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	created, err := cli.CreateHook(ctx, p.ID, &Hook{
+		URL:        "https://minder.example.com/api/v1/webhook/gitlab/abc",
+		Token:      "secret",
+		PushEvents: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), created.ID)
+	assert.Equal(t, "secret", fake.hooks[2][0].Token)
+
+	hooks, err := cli.ListHooks(ctx, p.ID)
+	require.NoError(t, err)
+	require.Len(t, hooks, 1)
+	assert.Equal(t, "https://minder.example.com/api/v1/webhook/gitlab/abc", hooks[0].URL)
+
+	require.NoError(t, cli.DeleteHook(ctx, p.ID, created.ID))
+	assert.Empty(t, fake.hooks[2])
+	assert.ErrorIs(t, cli.DeleteHook(ctx, p.ID, created.ID), ErrNotFound)
+}
+
+func TestREST(t *testing.T) {
+	t.Parallel()
+
+	_, srv := newFakeGitLab(t)
+	cli := newTestClient(t, srv.URL+"/api/v4")
+
+	req, err := cli.NewRequest(http.MethodGet, "projects/acme%2Fweb", nil)
+	require.NoError(t, err)
+	assert.Equal(t, srv.URL+"/api/v4/projects/acme%2Fweb", req.URL.String())
+
+	resp, err := cli.Do(context.Background(), req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var p Project
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&p))
+	assert.Equal(t, "web", p.Path)
+}
+
+func TestRateLimited(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.Header().Set("RateLimit-Limit", "2000")
+		w.Header().Set("RateLimit-Remaining", "0")
+		w.Header().Set("RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	t.Cleanup(srv.Close)
+	cli := newTestClient(t, srv.URL+"/api/v4")
+
+	// running into the rate limit is retriable, and holds the next requests back
+	for i := 0; i < 2; i++ {
+		req, err := cli.NewRequest(http.MethodGet, "user", nil)
+		require.NoError(t, err)
+		_, err = cli.Do(context.Background(), req)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, events.ErrRetriable)
+	}
+	assert.Equal(t, int32(1), calls.Load())
+}
@@ -26,6 +26,9 @@ import (
 	"golang.org/x/oauth2"
 
 	"github.com/stacklok/minder/internal/db"
+	"github.com/stacklok/minder/internal/providers/httpcache"
+	"github.com/stacklok/minder/internal/providers/ratelimit"
+	"github.com/stacklok/minder/internal/providers/registry"
 	"github.com/stacklok/minder/internal/providers/telemetry"
 	minderv1 "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
 	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
@@ -36,20 +39,77 @@ type REST struct {
 	baseURL *url.URL
 	cli     *http.Client
 	tok     string
+	cache   *httpcache.Cache
+	limiter *ratelimit.Limiter
 }
 
 // Ensure that REST implements the REST interface
 var _ provifv1.REST = (*REST)(nil)
 
+func init() {
+	// other implementations may carry a REST configuration along, so the
+	// generic REST provider is only used when nothing else is configured
+	registry.Register(&registry.Implementation{
+		Name:           string(db.ProviderTypeRest),
+		Implements:     []db.ProviderType{db.ProviderTypeRest, db.ProviderTypeGit},
+		ValidateConfig: registry.ValidateWith(ParseV1Config),
+		Endpoints: func(def json.RawMessage) ([]string, error) {
+			cfg, err := ParseV1Config(def)
+			if err != nil {
+				return nil, err
+			}
+			return []string{cfg.GetBaseUrl()}, nil
+		},
+		New: func(_ context.Context, p registry.Params) (provifv1.Provider, error) {
+			cfg, err := ParseV1Config(p.Definition)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing http config: %w", err)
+			}
+			return NewREST(cfg, p.Metrics, p.Token)
+		},
+		Generic: true,
+	})
+}
+
+// RESTOption is a function which can be used to set options on the REST client.
+type RESTOption func(*REST)
+
+// WithCache sets the cache the responses are kept in. It defaults to the
+// cache shared by the process.
+func WithCache(c *httpcache.Cache) RESTOption {
+	return func(h *REST) {
+		h.cache = c
+	}
+}
+
+// WithLimiter sets the limiter keeping track of the rate limits of the API. It
+// defaults to the limiter shared by the process.
+func WithLimiter(l *ratelimit.Limiter) RESTOption {
+	return func(h *REST) {
+		h.limiter = l
+	}
+}
+
 // NewREST creates a new RESTful client.
 func NewREST(
 	config *minderv1.RESTProviderConfig,
-	metrics telemetry.HttpClientMetrics,
+	metrics telemetry.ProviderMetrics,
 	tok string,
+	opts ...RESTOption,
 ) (*REST, error) {
 	var cli *http.Client
 	var err error
 
+	h := &REST{
+		tok:     tok,
+		cache:   httpcache.Default(),
+		limiter: ratelimit.Default(),
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
 	if tok != "" {
 		ts := oauth2.StaticTokenSource(
 			&oauth2.Token{AccessToken: tok},
@@ -59,7 +119,11 @@ func NewREST(
 		cli = &http.Client{}
 	}
 
-	cli.Transport, err = metrics.NewDurationRoundTripper(cli.Transport, db.ProviderTypeRest)
+	// requests are held back when the API reports its rate limit ran out
+	cli.Transport = h.limiter.NewRoundTripper(cli.Transport, tok, string(db.ProviderTypeRest), metrics)
+	cli.Transport = h.cache.NewRoundTripper(cli.Transport, tok, string(db.ProviderTypeRest), metrics)
+
+	cli.Transport, err = metrics.NewDurationRoundTripper(cli.Transport, string(db.ProviderTypeRest))
 	if err != nil {
 		return nil, fmt.Errorf("error creating duration round tripper: %w", err)
 	}
@@ -70,11 +134,10 @@ func NewREST(
 		return nil, err
 	}
 
-	return &REST{
-		cli:     cli,
-		baseURL: baseURL,
-		tok:     tok,
-	}, nil
+	h.cli = cli
+	h.baseURL = baseURL
+
+	return h, nil
 }
 
 // GetToken returns the token for the provider
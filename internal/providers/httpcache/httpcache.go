@@ -0,0 +1,326 @@
+//
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package httpcache provides an HTTP transport which caches the responses of
+// the providers' APIs and revalidates them with conditional requests. APIs
+// like GitHub's don't count the requests answered with 304 Not Modified
+// against the rate limit, so ingesting data which didn't change is free.
+package httpcache
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/stacklok/minder/internal/providers/telemetry"
+)
+
+const (
+	// DefaultMaxSize is the default size of the cache, in bytes
+	DefaultMaxSize = 64 << 20
+	// DefaultMaxEntrySize is the default size of the largest response which
+	// is cached, in bytes
+	DefaultMaxEntrySize = 1 << 20
+
+	// HeaderFromCache is set on the responses served from the cache
+	HeaderFromCache = "X-From-Cache"
+)
+
+// entry is a cached response
+type entry struct {
+	key    string
+	status int
+	header http.Header
+	body   []byte
+}
+
+func (e *entry) size() int64 {
+	size := len(e.key) + len(e.body)
+	for k, vs := range e.header {
+		size += len(k)
+		for _, v := range vs {
+			size += len(v)
+		}
+	}
+	return int64(size)
+}
+
+// response builds a response from the cached one, updated with the headers
+// of the 304 response which revalidated it
+func (e *entry) response(r *http.Request, fresh http.Header) *http.Response {
+	header := e.header.Clone()
+	for k, vs := range fresh {
+		switch k {
+		case "Content-Length", "Content-Encoding", "Transfer-Encoding":
+			continue
+		}
+		header[k] = vs
+	}
+	header.Set(HeaderFromCache, "1")
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.status, http.StatusText(e.status)),
+		StatusCode:    e.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       r,
+	}
+}
+
+// Cache is a bounded in-memory store of responses, evicting the least
+// recently used ones. It is safe for concurrent use, and is meant to be
+// shared by all the clients of a process.
+type Cache struct {
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+	size    int64
+
+	maxSize      int64
+	maxEntrySize int64
+}
+
+// Option is a function which can be used to set options on the Cache.
+type Option func(*Cache)
+
+// WithMaxSize sets the size of the cache, in bytes
+func WithMaxSize(size int64) Option {
+	return func(c *Cache) {
+		c.maxSize = size
+	}
+}
+
+// WithMaxEntrySize sets the size of the largest response which is cached,
+// in bytes
+func WithMaxEntrySize(size int64) Option {
+	return func(c *Cache) {
+		c.maxEntrySize = size
+	}
+}
+
+// NewCache creates a new Cache
+func NewCache(opts ...Option) *Cache {
+	c := &Cache{
+		entries:      make(map[string]*list.Element),
+		lru:          list.New(),
+		maxSize:      DefaultMaxSize,
+		maxEntrySize: DefaultMaxEntrySize,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+var defaultCache = NewCache()
+
+// Default returns the Cache shared by the clients of the process
+func Default() *Cache {
+	return defaultCache
+}
+
+func (c *Cache) get(key string) *entry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*entry)
+}
+
+func (c *Cache) put(e *entry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.removeLocked(e.key)
+
+	size := e.size()
+	if size > c.maxSize {
+		return
+	}
+
+	c.entries[e.key] = c.lru.PushFront(e)
+	c.size += size
+
+	for c.size > c.maxSize {
+		oldest := c.lru.Back()
+		if oldest == nil {
+			break
+		}
+		c.removeLocked(oldest.Value.(*entry).key)
+	}
+}
+
+func (c *Cache) remove(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.removeLocked(key)
+}
+
+func (c *Cache) removeLocked(key string) {
+	elem, ok := c.entries[key]
+	if !ok {
+		return
+	}
+	c.lru.Remove(elem)
+	delete(c.entries, key)
+	c.size -= elem.Value.(*entry).size()
+}
+
+// NewRoundTripper returns a transport which caches the responses to the GET
+// requests made with the given token.
+func (c *Cache) NewRoundTripper(
+	wrapped http.RoundTripper,
+	token string,
+	providerType string,
+	metrics telemetry.HttpCacheMetrics,
+) http.RoundTripper {
+	if wrapped == nil {
+		wrapped = http.DefaultTransport
+	}
+
+	sum := sha256.Sum256([]byte(token))
+	return &roundTripper{
+		wrapped:      wrapped,
+		cache:        c,
+		token:        hex.EncodeToString(sum[:]),
+		providerType: providerType,
+		metrics:      metrics,
+	}
+}
+
+type roundTripper struct {
+	wrapped http.RoundTripper
+	cache   *Cache
+	// token is a hash of the token, so the token itself isn't kept around.
+	// Responses are cached per token, since what a token can see differs.
+	token        string
+	providerType string
+	metrics      telemetry.HttpCacheMetrics
+}
+
+var _ http.RoundTripper = (*roundTripper)(nil)
+
+func (rt *roundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	if !cacheable(r) {
+		return rt.wrapped.RoundTrip(r)
+	}
+
+	ctx := r.Context()
+	// the Accept header selects the representation, e.g. the GitHub media type
+	key := strings.Join([]string{rt.token, r.URL.String(), r.Header.Get("Accept")}, " ")
+
+	req := r
+	cached := rt.cache.get(key)
+	if cached != nil {
+		// the caller's request must not be modified
+		req = r.Clone(ctx)
+		if etag := cached.header.Get("ETag"); etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if lastModified := cached.header.Get("Last-Modified"); lastModified != "" {
+			req.Header.Set("If-Modified-Since", lastModified)
+		}
+	}
+
+	resp, err := rt.wrapped.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if cached != nil && resp.StatusCode == http.StatusNotModified {
+		rt.metrics.RecordCacheLookup(ctx, rt.providerType, true)
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		return cached.response(r, resp.Header), nil
+	}
+
+	rt.metrics.RecordCacheLookup(ctx, rt.providerType, false)
+	if !storable(resp) {
+		rt.cache.remove(key)
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, rt.cache.maxEntrySize+1))
+	if err != nil {
+		_ = resp.Body.Close()
+		return nil, err
+	}
+
+	if int64(len(body)) > rt.cache.maxEntrySize {
+		// too large to be cached, hand the response over as is
+		rt.cache.remove(key)
+		rest := resp.Body
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), rest), rest}
+		return resp, nil
+	}
+	_ = resp.Body.Close()
+
+	rt.cache.put(&entry{
+		key:    key,
+		status: resp.StatusCode,
+		header: resp.Header.Clone(),
+		body:   body,
+	})
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// cacheable tells whether the response to a request may come from the cache.
+// Requests which are already conditional or ask for part of the resource are
+// left to the caller.
+func cacheable(r *http.Request) bool {
+	if r.Method != http.MethodGet {
+		return false
+	}
+	for _, h := range []string{"If-None-Match", "If-Modified-Since", "Range"} {
+		if r.Header.Get(h) != "" {
+			return false
+		}
+	}
+	return !strings.Contains(r.Header.Get("Cache-Control"), "no-store")
+}
+
+// storable tells whether a response may be cached. Only the responses which
+// can be revalidated are, since they are never served without asking.
+func storable(resp *http.Response) bool {
+	if resp.StatusCode != http.StatusOK {
+		return false
+	}
+	if strings.Contains(resp.Header.Get("Cache-Control"), "no-store") {
+		return false
+	}
+	return resp.Header.Get("ETag") != "" || resp.Header.Get("Last-Modified") != ""
+}
@@ -0,0 +1,241 @@
+//
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpcache
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeMetrics struct {
+	mu     sync.Mutex
+	hits   int
+	misses int
+}
+
+func (m *fakeMetrics) RecordCacheLookup(_ context.Context, _ string, hit bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if hit {
+		m.hits++
+	} else {
+		m.misses++
+	}
+}
+
+// fakeAPI serves a resource with an ETag, answering conditional requests
+// with 304 as long as the resource didn't change
+type fakeAPI struct {
+	*httptest.Server
+	mu          sync.Mutex
+	body        string
+	etag        string
+	full        atomic.Int32
+	notModified atomic.Int32
+}
+
+func newFakeAPI(t *testing.T, body, etag string) *fakeAPI {
+	t.Helper()
+
+	api := &fakeAPI{body: body, etag: etag}
+	api.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		api.mu.Lock()
+		defer api.mu.Unlock()
+
+		w.Header().Set("X-RateLimit-Remaining", "4999")
+		if api.etag != "" && r.Header.Get("If-None-Match") == api.etag {
+			api.notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		api.full.Add(1)
+		if api.etag != "" {
+			w.Header().Set("ETag", api.etag)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(api.body))
+	}))
+	t.Cleanup(api.Close)
+	return api
+}
+
+func (api *fakeAPI) update(body, etag string) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	api.body = body
+	api.etag = etag
+}
+
+func get(t *testing.T, rt http.RoundTripper, url string) (*http.Response, string) {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	resp, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, string(body)
+}
+
+func TestRevalidation(t *testing.T) {
+	t.Parallel()
+
+	api := newFakeAPI(t, `{"name": "main"}`, `"v1"`)
+	mt := &fakeMetrics{}
+	rt := NewCache().NewRoundTripper(http.DefaultTransport, "token", "github", mt)
+
+	resp, body := get(t, rt, api.URL)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, `{"name": "main"}`, body)
+	assert.Empty(t, resp.Header.Get(HeaderFromCache))
+
+	// unchanged, served from the cache
+	resp, body = get(t, rt, api.URL)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, `{"name": "main"}`, body)
+	assert.Equal(t, "1", resp.Header.Get(HeaderFromCache))
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	assert.Equal(t, "4999", resp.Header.Get("X-RateLimit-Remaining"))
+	assert.Equal(t, int32(1), api.full.Load())
+	assert.Equal(t, int32(1), api.notModified.Load())
+
+	// changed, the new version replaces the cached one
+	api.update(`{"name": "develop"}`, `"v2"`)
+	_, body = get(t, rt, api.URL)
+	assert.Equal(t, `{"name": "develop"}`, body)
+	_, body = get(t, rt, api.URL)
+	assert.Equal(t, `{"name": "develop"}`, body)
+	assert.Equal(t, int32(2), api.full.Load())
+	assert.Equal(t, int32(2), api.notModified.Load())
+
+	assert.Equal(t, 2, mt.hits)
+	assert.Equal(t, 2, mt.misses)
+}
+
+func TestLastModified(t *testing.T) {
+	t.Parallel()
+
+	const lastModified = "Mon, 02 Jan 2023 15:04:05 GMT"
+	var notModified atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-Modified-Since") == lastModified {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Last-Modified", lastModified)
+		_, _ = w.Write([]byte("content"))
+	}))
+	defer srv.Close()
+
+	rt := NewCache().NewRoundTripper(http.DefaultTransport, "token", "rest", &fakeMetrics{})
+	get(t, rt, srv.URL)
+	_, body := get(t, rt, srv.URL)
+	assert.Equal(t, "content", body)
+	assert.Equal(t, int32(1), notModified.Load())
+}
+
+func TestCachedPerToken(t *testing.T) {
+	t.Parallel()
+
+	api := newFakeAPI(t, `{}`, `"v1"`)
+	c := NewCache()
+
+	get(t, c.NewRoundTripper(http.DefaultTransport, "alice", "github", &fakeMetrics{}), api.URL)
+	// what a token can see is none of another token's business
+	get(t, c.NewRoundTripper(http.DefaultTransport, "bob", "github", &fakeMetrics{}), api.URL)
+	assert.Equal(t, int32(2), api.full.Load())
+	assert.Equal(t, int32(0), api.notModified.Load())
+}
+
+func TestNotCached(t *testing.T) {
+	t.Parallel()
+
+	t.Run("responses without validators", func(t *testing.T) {
+		t.Parallel()
+
+		api := newFakeAPI(t, `{}`, "")
+		rt := NewCache().NewRoundTripper(http.DefaultTransport, "token", "github", &fakeMetrics{})
+		get(t, rt, api.URL)
+		get(t, rt, api.URL)
+		assert.Equal(t, int32(2), api.full.Load())
+	})
+
+	t.Run("requests other than GET", func(t *testing.T) {
+		t.Parallel()
+
+		api := newFakeAPI(t, `{}`, `"v1"`)
+		c := NewCache()
+		rt := c.NewRoundTripper(http.DefaultTransport, "token", "github", &fakeMetrics{})
+		for i := 0; i < 2; i++ {
+			req, err := http.NewRequest(http.MethodPost, api.URL, strings.NewReader(`{}`))
+			require.NoError(t, err)
+			resp, err := rt.RoundTrip(req)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+		}
+		assert.Equal(t, int32(2), api.full.Load())
+		assert.Equal(t, 0, c.lru.Len())
+	})
+
+	t.Run("responses larger than the entry size", func(t *testing.T) {
+		t.Parallel()
+
+		large := strings.Repeat("a", 64)
+		api := newFakeAPI(t, large, `"v1"`)
+		c := NewCache(WithMaxEntrySize(16))
+		rt := c.NewRoundTripper(http.DefaultTransport, "token", "github", &fakeMetrics{})
+
+		_, body := get(t, rt, api.URL)
+		assert.Equal(t, large, body)
+		assert.Equal(t, 0, c.lru.Len())
+	})
+}
+
+func TestEviction(t *testing.T) {
+	t.Parallel()
+
+	api := newFakeAPI(t, strings.Repeat("a", 100), `"v1"`)
+	c := NewCache(WithMaxSize(1024))
+	rt := c.NewRoundTripper(http.DefaultTransport, "token", "github", &fakeMetrics{})
+
+	for i := 0; i < 20; i++ {
+		get(t, rt, api.URL+"/"+strings.Repeat("p", i))
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	assert.LessOrEqual(t, c.size, int64(1024))
+	assert.Less(t, c.lru.Len(), 20)
+	assert.Equal(t, len(c.entries), c.lru.Len())
+
+	// the most recently used entry is kept
+	_, ok := c.entries[c.lru.Front().Value.(*entry).key]
+	assert.True(t, ok)
+	assert.True(t, strings.HasSuffix(c.lru.Front().Value.(*entry).key, "/"+strings.Repeat("p", 19)+" "))
+}
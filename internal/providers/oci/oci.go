@@ -0,0 +1,382 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package oci provides a client for interacting with OCI registries
+// through the distribution API
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+
+	"github.com/stacklok/minder/internal/db"
+	"github.com/stacklok/minder/internal/providers/registry"
+	"github.com/stacklok/minder/internal/providers/telemetry"
+	minderv1 "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+// OCI is the string that represents the OCI provider
+const OCI = "oci"
+
+// Implements is the list of provider types that the OCI provider implements
+var Implements = []db.ProviderType{
+	db.ProviderTypeOci,
+}
+
+func init() {
+	registry.Register(&registry.Implementation{
+		Name:           OCI,
+		Implements:     Implements,
+		ValidateConfig: registry.ValidateWith(ParseV1Config),
+		Endpoints: func(def json.RawMessage) ([]string, error) {
+			cfg, err := ParseV1Config(def)
+			if err != nil {
+				return nil, err
+			}
+			// credentials sent to an insecure registry go over plain HTTP
+			scheme := "https://"
+			if cfg.GetInsecure() {
+				scheme = "http://"
+			}
+			return []string{scheme + cfg.GetRegistry()}, nil
+		},
+		New: func(_ context.Context, p registry.Params) (provifv1.Provider, error) {
+			cfg, err := ParseV1Config(p.Definition)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing oci config: %w", err)
+			}
+			return NewClient(cfg, p.Metrics, p.Token)
+		},
+		AllowsAnonymous: true,
+	})
+}
+
+var (
+	// ErrNotFound Denotes if the registry doesn't know the repository or tag
+	ErrNotFound = errors.New("not found")
+)
+
+// cosignTagSuffixes are the suffixes of the tags cosign attaches to images
+var cosignTagSuffixes = []string{".sig", ".att", ".sbom"}
+
+// Image is a manifest in a repository, along with the tags pointing to it
+type Image struct {
+	Digest string
+	Tags   []string
+	// Created is the creation time recorded in the image config. It is zero
+	// for image indexes and images that don't record it.
+	Created time.Time
+}
+
+// Client is the struct that contains the OCI registry client
+type Client struct {
+	registry  string
+	nameOpts  []name.Option
+	auth      authn.Authenticator
+	transport http.RoundTripper
+	token     string
+}
+
+// Ensure that the OCI client implements the OCI interface
+var _ provifv1.OCI = (*Client)(nil)
+
+// NewClient creates a new OCI registry client.
+// When the config sets a username, the token is used as its password.
+// Otherwise the token is sent as a bearer token, and the registry is
+// accessed anonymously if there's no token at all.
+func NewClient(
+	config *minderv1.OCIProviderConfig,
+	metrics telemetry.HttpClientMetrics,
+	token string,
+) (*Client, error) {
+	tr, err := metrics.NewDurationRoundTripper(remote.DefaultTransport, OCI)
+	if err != nil {
+		return nil, fmt.Errorf("error creating duration round tripper: %w", err)
+	}
+
+	var auth authn.Authenticator
+	switch {
+	case token == "":
+		auth = authn.Anonymous
+	case config.GetUsername() != "":
+		auth = &authn.Basic{Username: config.GetUsername(), Password: token}
+	default:
+		auth = &authn.Bearer{Token: token}
+	}
+
+	var nameOpts []name.Option
+	if config.GetInsecure() {
+		nameOpts = append(nameOpts, name.Insecure)
+	}
+
+	// fail early on registries that can't be parsed
+	if _, err := name.NewRegistry(config.GetRegistry(), nameOpts...); err != nil {
+		return nil, fmt.Errorf("invalid registry: %w", err)
+	}
+
+	return &Client{
+		registry:  config.GetRegistry(),
+		nameOpts:  nameOpts,
+		auth:      auth,
+		transport: tr,
+		token:     token,
+	}, nil
+}
+
+// ParseV1Config parses the raw config into an OCIProviderConfig struct
+func ParseV1Config(rawCfg json.RawMessage) (*minderv1.OCIProviderConfig, error) {
+	type wrapper struct {
+		OCI *minderv1.OCIProviderConfig `json:"oci" yaml:"oci" mapstructure:"oci" validate:"required"`
+	}
+
+	var w wrapper
+	if err := provifv1.ParseAndValidate(rawCfg, &w); err != nil {
+		return nil, err
+	}
+
+	// Validate the config according to the protobuf validation rules.
+	if err := w.OCI.Validate(); err != nil {
+		return nil, fmt.Errorf("error validating OCI v1 provider config: %w", err)
+	}
+
+	return w.OCI, nil
+}
+
+// GetToken returns the token for the provider
+func (c *Client) GetToken() string {
+	return c.token
+}
+
+// GetRegistry returns the host of the registry
+func (c *Client) GetRegistry() string {
+	return c.registry
+}
+
+// GetAuthenticator returns the credentials for the registry
+func (c *Client) GetAuthenticator() authn.Authenticator {
+	return c.auth
+}
+
+// Reference returns the reference of the manifest with the given digest in
+// a repository of the registry
+func (c *Client) Reference(repository, digest string) (name.Reference, error) {
+	return name.NewDigest(c.registry+"/"+repository+"@"+digest, c.nameOpts...)
+}
+
+// ListRepositories lists the repositories of the registry, using the
+// catalog endpoint. Registries may restrict the catalog to the
+// repositories the credentials have access to.
+func (c *Client) ListRepositories(ctx context.Context) ([]string, error) {
+	reg, err := name.NewRegistry(c.registry, c.nameOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid registry: %w", err)
+	}
+
+	repos, err := remote.Catalog(ctx, reg, c.remoteOptions(ctx)...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing repositories: %w", wrapError(err))
+	}
+	return repos, nil
+}
+
+// ListTags lists the tags of a repository
+func (c *Client) ListTags(ctx context.Context, repository string) ([]string, error) {
+	repo, err := name.NewRepository(c.registry+"/"+repository, c.nameOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid repository: %w", err)
+	}
+
+	tags, err := remote.List(repo, c.remoteOptions(ctx)...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing tags of %s: %w", repository, wrapError(err))
+	}
+	return tags, nil
+}
+
+// GetDigest returns the digest of the manifest a tag points to
+func (c *Client) GetDigest(ctx context.Context, repository, tag string) (string, error) {
+	ref, err := name.NewTag(c.registry+"/"+repository+":"+tag, c.nameOpts...)
+	if err != nil {
+		return "", fmt.Errorf("invalid tag: %w", err)
+	}
+
+	desc, err := remote.Head(ref, c.remoteOptions(ctx)...)
+	if err != nil {
+		return "", fmt.Errorf("error getting digest of %s: %w", ref, wrapError(err))
+	}
+	return desc.Digest.String(), nil
+}
+
+// ListImages lists the tagged manifests of a repository, sorted by digest.
+// The tags cosign attaches to images, e.g. signatures, are skipped.
+// Fetching the creation time of an image requires fetching its config, so
+// this makes a few requests per image.
+func (c *Client) ListImages(ctx context.Context, repository string) ([]Image, error) {
+	tags, err := c.ListTags(ctx, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	byDigest := map[string][]string{}
+	for _, tag := range tags {
+		if isCosignTag(tag) {
+			continue
+		}
+
+		digest, err := c.GetDigest(ctx, repository, tag)
+		if errors.Is(err, ErrNotFound) {
+			// the tag was removed in the meantime
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		byDigest[digest] = append(byDigest[digest], tag)
+	}
+
+	images := make([]Image, 0, len(byDigest))
+	for digest, tags := range byDigest {
+		created, err := c.GetCreated(ctx, repository, digest)
+		if errors.Is(err, ErrNotFound) {
+			// the manifest was removed in the meantime
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		sort.Strings(tags)
+		images = append(images, Image{Digest: digest, Tags: tags, Created: created})
+	}
+	sort.Slice(images, func(i, j int) bool {
+		return images[i].Digest < images[j].Digest
+	})
+	return images, nil
+}
+
+// GetCreated returns the creation time recorded in the config of the image
+// with the given digest. The time is zero for image indexes, which have no
+// config, and for images built reproducibly, which record the Unix epoch.
+func (c *Client) GetCreated(ctx context.Context, repository, digest string) (time.Time, error) {
+	ref, err := c.Reference(repository, digest)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid digest: %w", err)
+	}
+
+	desc, err := remote.Get(ref, c.remoteOptions(ctx)...)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error getting manifest of %s: %w", ref, wrapError(err))
+	}
+	if !desc.MediaType.IsImage() {
+		return time.Time{}, nil
+	}
+
+	img, err := desc.Image()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error parsing manifest of %s: %w", ref, err)
+	}
+	cfg, err := img.ConfigFile()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error getting config of %s: %w", ref, wrapError(err))
+	}
+
+	created := cfg.Created.Time
+	if !created.After(time.Unix(0, 0)) {
+		return time.Time{}, nil
+	}
+	return created, nil
+}
+
+// Validate checks that the registry is reachable and accepts the
+// credentials of the client
+func (c *Client) Validate(ctx context.Context) error {
+	reg, err := name.NewRegistry(c.registry, c.nameOpts...)
+	if err != nil {
+		return fmt.Errorf("invalid registry: %w", err)
+	}
+
+	// the transport takes care of the token exchange when the registry
+	// asks for one
+	tr, err := transport.NewWithContext(ctx, reg, c.auth, c.transport, []string{reg.Scope(transport.PullScope)})
+	if err != nil {
+		return fmt.Errorf("invalid credentials: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s://%s/v2/", reg.Scheme(), reg.RegistryStr()), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := (&http.Client{Transport: tr}).Do(req)
+	if err != nil {
+		return fmt.Errorf("error reaching registry: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("invalid credentials: unexpected status %d: %s",
+			resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+func isCosignTag(tag string) bool {
+	if !strings.HasPrefix(tag, "sha256-") {
+		return false
+	}
+	for _, suffix := range cosignTagSuffixes {
+		if strings.HasSuffix(tag, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Client) remoteOptions(ctx context.Context) []remote.Option {
+	return []remote.Option{
+		remote.WithContext(ctx),
+		remote.WithAuth(c.auth),
+		remote.WithTransport(c.transport),
+	}
+}
+
+// wrapError maps the errors the registry returns for unknown repositories
+// and manifests to ErrNotFound
+func wrapError(err error) error {
+	var terr *transport.Error
+	if !errors.As(err, &terr) {
+		return err
+	}
+
+	if terr.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %s", ErrNotFound, err)
+	}
+	for _, diag := range terr.Errors {
+		if diag.Code == transport.NameUnknownErrorCode || diag.Code == transport.ManifestUnknownErrorCode {
+			return fmt.Errorf("%w: %s", ErrNotFound, err)
+		}
+	}
+	return err
+}
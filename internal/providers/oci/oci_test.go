@@ -0,0 +1,301 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stacklok/minder/internal/providers/telemetry"
+	minderv1 "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	testUser     = "minder"
+	testPassword = "s3cr3t"
+)
+
+// newTestRegistry starts an in-process registry which requires basic auth
+// and returns its host
+func newTestRegistry(t *testing.T) string {
+	t.Helper()
+
+	reg := registry.New()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		if !ok || user != testUser || pass != testPassword {
+			w.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		reg.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	return strings.TrimPrefix(srv.URL, "http://")
+}
+
+// pushImage pushes a random image to the registry and returns its digest
+func pushImage(t *testing.T, host, repository, tag string) string {
+	t.Helper()
+
+	img, err := random.Image(64, 1)
+	require.NoError(t, err)
+
+	ref, err := name.NewTag(host+"/"+repository+":"+tag, name.Insecure)
+	require.NoError(t, err)
+
+	auth := &authn.Basic{Username: testUser, Password: testPassword}
+	require.NoError(t, remote.Write(ref, img, remote.WithAuth(auth)))
+
+	digest, err := img.Digest()
+	require.NoError(t, err)
+	return digest.String()
+}
+
+// pushTag tags an existing manifest of the registry
+func pushTag(t *testing.T, host, repository, digest, tag string) {
+	t.Helper()
+
+	auth := remote.WithAuth(&authn.Basic{Username: testUser, Password: testPassword})
+
+	src, err := name.NewDigest(host+"/"+repository+"@"+digest, name.Insecure)
+	require.NoError(t, err)
+	desc, err := remote.Get(src, auth)
+	require.NoError(t, err)
+
+	dst, err := name.NewTag(host+"/"+repository+":"+tag, name.Insecure)
+	require.NoError(t, err)
+	require.NoError(t, remote.Tag(dst, desc, auth))
+}
+
+func newTestClient(t *testing.T, host, username, token string) *Client {
+	t.Helper()
+
+	cli, err := NewClient(&minderv1.OCIProviderConfig{
+		Registry: host,
+		Username: username,
+		Insecure: true,
+	}, telemetry.NewNoopMetrics(), token)
+	require.NoError(t, err)
+	return cli
+}
+
+func TestParseV1Config(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		cfg     string
+		want    *minderv1.OCIProviderConfig
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			cfg:  `{"oci": {"registry": "registry.example.com:5000", "username": "bot"}}`,
+			want: &minderv1.OCIProviderConfig{Registry: "registry.example.com:5000", Username: "bot"},
+		},
+		{
+			name:    "missing oci key",
+			cfg:     `{"gitlab": {}}`,
+			wantErr: true,
+		},
+		{
+			name:    "missing registry",
+			cfg:     `{"oci": {"username": "bot"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "registry with scheme",
+			cfg:     `{"oci": {"registry": "https://registry.example.com"}}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseV1Config([]byte(tt.cfg))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.Registry, got.Registry)
+			assert.Equal(t, tt.want.Username, got.Username)
+		})
+	}
+}
+
+func TestNewClientAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	cfg := &minderv1.OCIProviderConfig{Registry: "registry.example.com"}
+
+	cli, err := NewClient(cfg, telemetry.NewNoopMetrics(), "")
+	require.NoError(t, err)
+	assert.Equal(t, authn.Anonymous, cli.GetAuthenticator())
+
+	cli, err = NewClient(cfg, telemetry.NewNoopMetrics(), "token")
+	require.NoError(t, err)
+	assert.Equal(t, &authn.Bearer{Token: "token"}, cli.GetAuthenticator())
+
+	cfg.Username = "bot"
+	cli, err = NewClient(cfg, telemetry.NewNoopMetrics(), "token")
+	require.NoError(t, err)
+	assert.Equal(t, &authn.Basic{Username: "bot", Password: "token"}, cli.GetAuthenticator())
+}
+
+func TestListRepositoriesAndTags(t *testing.T) {
+	t.Parallel()
+
+	host := newTestRegistry(t)
+	digest := pushImage(t, host, "acme/api", "v1")
+	pushImage(t, host, "acme/api", "v2")
+	pushImage(t, host, "acme/web", "latest")
+
+	cli := newTestClient(t, host, testUser, testPassword)
+	ctx := context.Background()
+
+	repos, err := cli.ListRepositories(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"acme/api", "acme/web"}, repos)
+
+	tags, err := cli.ListTags(ctx, "acme/api")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"v1", "v2"}, tags)
+
+	got, err := cli.GetDigest(ctx, "acme/api", "v1")
+	require.NoError(t, err)
+	assert.Equal(t, digest, got)
+
+	ref, err := cli.Reference("acme/api", got)
+	require.NoError(t, err)
+	assert.Equal(t, host+"/acme/api@"+digest, ref.String())
+	assert.Equal(t, "http", ref.Context().Scheme())
+}
+
+func TestListImages(t *testing.T) {
+	t.Parallel()
+
+	host := newTestRegistry(t)
+	v1 := pushImage(t, host, "acme/api", "v1")
+	v2 := pushImage(t, host, "acme/api", "v2")
+	// tag v1 again, as latest
+	pushTag(t, host, "acme/api", v1, "latest")
+	// a signature, as cosign would push it
+	pushImage(t, host, "acme/api", strings.Replace(v1, ":", "-", 1)+".sig")
+
+	cli := newTestClient(t, host, testUser, testPassword)
+
+	images, err := cli.ListImages(context.Background(), "acme/api")
+	require.NoError(t, err)
+
+	want := []Image{
+		{Digest: v1, Tags: []string{"latest", "v1"}},
+		{Digest: v2, Tags: []string{"v2"}},
+	}
+	if v2 < v1 {
+		want[0], want[1] = want[1], want[0]
+	}
+	assert.Equal(t, want, images)
+}
+
+func TestGetCreated(t *testing.T) {
+	t.Parallel()
+
+	host := newTestRegistry(t)
+	created := time.Date(2023, time.November, 2, 10, 0, 0, 0, time.UTC)
+
+	img, err := random.Image(64, 1)
+	require.NoError(t, err)
+	img, err = mutate.CreatedAt(img, v1.Time{Time: created})
+	require.NoError(t, err)
+
+	ref, err := name.NewTag(host+"/acme/api:v1", name.Insecure)
+	require.NoError(t, err)
+	auth := &authn.Basic{Username: testUser, Password: testPassword}
+	require.NoError(t, remote.Write(ref, img, remote.WithAuth(auth)))
+	digest, err := img.Digest()
+	require.NoError(t, err)
+
+	// random images record the Unix epoch, as reproducible builds do
+	undated := pushImage(t, host, "acme/api", "v2")
+
+	cli := newTestClient(t, host, testUser, testPassword)
+	ctx := context.Background()
+
+	got, err := cli.GetCreated(ctx, "acme/api", digest.String())
+	require.NoError(t, err)
+	assert.True(t, created.Equal(got), "got %s", got)
+
+	got, err = cli.GetCreated(ctx, "acme/api", undated)
+	require.NoError(t, err)
+	assert.True(t, got.IsZero(), "got %s", got)
+
+	images, err := cli.ListImages(ctx, "acme/api")
+	require.NoError(t, err)
+	require.Len(t, images, 2)
+	for _, image := range images {
+		if image.Digest == digest.String() {
+			assert.True(t, created.Equal(image.Created), "got %s", image.Created)
+		} else {
+			assert.True(t, image.Created.IsZero(), "got %s", image.Created)
+		}
+	}
+}
+
+func TestNotFound(t *testing.T) {
+	t.Parallel()
+
+	host := newTestRegistry(t)
+	pushImage(t, host, "acme/api", "v1")
+
+	cli := newTestClient(t, host, testUser, testPassword)
+	ctx := context.Background()
+
+	_, err := cli.ListTags(ctx, "acme/missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = cli.GetDigest(ctx, "acme/api", "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	host := newTestRegistry(t)
+	ctx := context.Background()
+
+	assert.NoError(t, newTestClient(t, host, testUser, testPassword).Validate(ctx))
+	assert.Error(t, newTestClient(t, host, testUser, "wrong").Validate(ctx))
+	assert.Error(t, newTestClient(t, host, "", "").Validate(ctx))
+}
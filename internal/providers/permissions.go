@@ -0,0 +1,93 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package providers
+
+import (
+	"strings"
+
+	"golang.org/x/exp/slices"
+
+	provinfv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+// RequiredForEnrollment is listed among the users of the enrollment
+// permissions, which Minder needs regardless of the rule types in use
+const RequiredForEnrollment = "enrollment"
+
+// PermissionCheck is whether the credentials of a provider grant a permission
+// needed by Minder
+type PermissionCheck struct {
+	Permission provinfv1.Permission
+	// Scopes are the token scopes granting the permission
+	Scopes []string
+	// RequiredBy are RequiredForEnrollment or the names of the rule types
+	// needing the permission
+	RequiredBy []string
+	Granted    bool
+}
+
+// IsRequiredForEnrollment returns true if the provider can't be enrolled
+// without the permission
+func (c *PermissionCheck) IsRequiredForEnrollment() bool {
+	return slices.Contains(c.RequiredBy, RequiredForEnrollment)
+}
+
+// CheckPermissions checks the permissions granted to the credentials of a
+// provider against the enrollment permissions and the given permissions,
+// which map to the rule types needing them. The introspector may be nil when
+// the provider doesn't map permissions to scopes.
+func CheckPermissions(
+	introspector provinfv1.PermissionIntrospector,
+	granted []provinfv1.Permission,
+	required map[provinfv1.Permission][]string,
+) []PermissionCheck {
+	all := map[provinfv1.Permission][]string{}
+	for _, perm := range provinfv1.EnrollmentPermissions {
+		all[perm] = []string{RequiredForEnrollment}
+	}
+	for perm, requiredBy := range required {
+		all[perm] = append(all[perm], requiredBy...)
+	}
+
+	checks := make([]PermissionCheck, 0, len(all))
+	for perm, requiredBy := range all {
+		var scopes []string
+		if introspector != nil {
+			scopes = introspector.PermissionScopes(perm)
+		}
+		checks = append(checks, PermissionCheck{
+			Permission: perm,
+			Scopes:     scopes,
+			RequiredBy: requiredBy,
+			Granted:    slices.Contains(granted, perm),
+		})
+	}
+	slices.SortFunc(checks, func(a, b PermissionCheck) int {
+		return strings.Compare(string(a.Permission), string(b.Permission))
+	})
+	return checks
+}
+
+// MissingPermissions returns the checks of the permissions which aren't
+// granted
+func MissingPermissions(checks []PermissionCheck) []PermissionCheck {
+	var missing []PermissionCheck
+	for _, c := range checks {
+		if !c.Granted {
+			missing = append(missing, c)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,92 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package providers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	provinfv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+// scopesIntrospector names the scope of each permission after it
+type scopesIntrospector struct{}
+
+func (_ scopesIntrospector) GrantedPermissions(context.Context) ([]provinfv1.Permission, error) {
+	return nil, nil
+}
+
+func (_ scopesIntrospector) PermissionScopes(p provinfv1.Permission) []string {
+	return []string{"scope:" + string(p)}
+}
+
+func TestCheckPermissions(t *testing.T) {
+	t.Parallel()
+
+	checks := CheckPermissions(scopesIntrospector{},
+		[]provinfv1.Permission{provinfv1.PermissionReadRepositories, provinfv1.PermissionWriteContents},
+		map[provinfv1.Permission][]string{
+			provinfv1.PermissionWriteContents:           {"actions_pinned"},
+			provinfv1.PermissionReadRepositories:        {"secret_scanning"},
+			provinfv1.PermissionWriteSecurityAdvisories: {"actions_pinned", "secret_scanning"},
+		})
+
+	assert.Equal(t, []PermissionCheck{
+		{
+			Permission: provinfv1.PermissionManageWebhooks,
+			Scopes:     []string{"scope:manage_webhooks"},
+			RequiredBy: []string{RequiredForEnrollment},
+		},
+		{
+			Permission: provinfv1.PermissionReadRepositories,
+			Scopes:     []string{"scope:read_repositories"},
+			RequiredBy: []string{RequiredForEnrollment, "secret_scanning"},
+			Granted:    true,
+		},
+		{
+			Permission: provinfv1.PermissionWriteContents,
+			Scopes:     []string{"scope:write_contents"},
+			RequiredBy: []string{"actions_pinned"},
+			Granted:    true,
+		},
+		{
+			Permission: provinfv1.PermissionWriteSecurityAdvisories,
+			Scopes:     []string{"scope:write_security_advisories"},
+			RequiredBy: []string{"actions_pinned", "secret_scanning"},
+		},
+	}, checks)
+
+	missing := MissingPermissions(checks)
+	assert.Len(t, missing, 2)
+	assert.True(t, missing[0].IsRequiredForEnrollment())
+	assert.False(t, missing[1].IsRequiredForEnrollment())
+}
+
+func TestCheckPermissionsWithoutIntrospector(t *testing.T) {
+	t.Parallel()
+
+	checks := CheckPermissions(nil, nil, map[provinfv1.Permission][]string{
+		provinfv1.PermissionWriteChecks: {"checks"},
+	})
+
+	assert.Len(t, checks, 3)
+	for _, c := range checks {
+		assert.Empty(t, c.Scopes)
+		assert.False(t, c.Granted)
+	}
+	assert.Equal(t, provinfv1.PermissionWriteChecks, checks[2].Permission)
+}
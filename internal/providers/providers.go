@@ -18,6 +18,8 @@ package providers
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -27,13 +29,17 @@ import (
 	"github.com/stacklok/minder/internal/db"
 	gitclient "github.com/stacklok/minder/internal/providers/git"
 	ghclient "github.com/stacklok/minder/internal/providers/github"
-	httpclient "github.com/stacklok/minder/internal/providers/http"
+	"github.com/stacklok/minder/internal/providers/registry"
 	"github.com/stacklok/minder/internal/providers/telemetry"
 	provinfv1 "github.com/stacklok/minder/pkg/providers/v1"
 )
 
 // GetProviderBuilder is a utility function which allows for the creation of
 // a provider factory.
+// When the provider is a GitHub provider with a GitHub App installation
+// enrolled in the project, and the builder is given the app, an
+// installation token is used. Otherwise this falls back to the OAuth token
+// stored for the provider, which is refreshed when it's about to expire.
 func GetProviderBuilder(
 	ctx context.Context,
 	prov db.Provider,
@@ -42,9 +48,36 @@ func GetProviderBuilder(
 	crypteng *crypto.Engine,
 	opts ...ProviderBuilderOption,
 ) (*ProviderBuilder, error) {
+	pb := NewProviderBuilder(&prov, db.ProviderAccessToken{}, "", opts...)
+
+	if pb.app != nil && pb.Implements(db.ProviderTypeGithub) {
+		inst, err := store.GetGitHubAppInstallationByProjectAndProvider(ctx,
+			db.GetGitHubAppInstallationByProjectAndProviderParams{Provider: prov.Name, ProjectID: projectID})
+		if err == nil {
+			tok, err := pb.app.InstallationToken(ctx, inst.AppInstallationID)
+			if err != nil {
+				return nil, fmt.Errorf("error getting installation token: %w", err)
+			}
+			pb.tok = tok
+			pb.installation = true
+			pb.tokenInf = db.ProviderAccessToken{
+				Provider:  prov.Name,
+				ProjectID: projectID,
+				// the owner filter tells organizations apart from users
+				OwnerFilter: sql.NullString{String: inst.AccountLogin, Valid: inst.IsOrg},
+			}
+			return pb, nil
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("error getting app installation: %w", err)
+		}
+	}
+
 	encToken, err := store.GetAccessTokenByProjectID(ctx,
 		db.GetAccessTokenByProjectIDParams{Provider: prov.Name, ProjectID: projectID})
-	if err != nil {
+	if errors.Is(err, sql.ErrNoRows) && pb.allowsAnonymous() {
+		// e.g. OCI registries may be accessed anonymously
+		return pb, nil
+	} else if err != nil {
 		return nil, fmt.Errorf("error getting access token: %w", err)
 	}
 
@@ -53,16 +86,36 @@ func GetProviderBuilder(
 		return nil, fmt.Errorf("error decrypting access token: %w", err)
 	}
 
-	return NewProviderBuilder(&prov, encToken, decryptedToken.AccessToken, opts...), nil
+	pb.tokenInf = encToken
+	pb.tok = decryptedToken.AccessToken
+
+	// expiring tokens are refreshed shortly before they expire
+	if decryptedToken.RefreshToken != "" && !decryptedToken.Expiry.IsZero() {
+		cfg, err := providerOAuthConfig(&prov)
+		if err != nil {
+			return nil, fmt.Errorf("error getting OAuth config: %w", err)
+		}
+
+		ts := newRefreshingTokenSource(ctx, cfg, &decryptedToken, encToken, store, crypteng)
+		tok, err := ts.Token()
+		if err != nil {
+			return nil, fmt.Errorf("error getting access token: %w", err)
+		}
+		pb.tok = tok.AccessToken
+	}
+
+	return pb, nil
 }
 
 // ProviderBuilder is a utility struct which allows for the creation of
 // provider clients.
 type ProviderBuilder struct {
-	p        *db.Provider
-	tokenInf db.ProviderAccessToken
-	tok      string
-	metrics  telemetry.ProviderMetrics
+	p            *db.Provider
+	tokenInf     db.ProviderAccessToken
+	tok          string
+	metrics      telemetry.ProviderMetrics
+	app          *ghclient.App
+	installation bool
 }
 
 // ProviderBuilderOption is a function which can be used to set options on the ProviderBuilder.
@@ -75,6 +128,14 @@ func WithProviderMetrics(metrics telemetry.ProviderMetrics) ProviderBuilderOptio
 	}
 }
 
+// WithGitHubApp sets the GitHub App used to mint installation tokens for
+// GitHub providers. A nil app disables GitHub App authentication.
+func WithGitHubApp(app *ghclient.App) ProviderBuilderOption {
+	return func(pb *ProviderBuilder) {
+		pb.app = app
+	}
+}
+
 // NewProviderBuilder creates a new provider builder.
 func NewProviderBuilder(
 	p *db.Provider,
@@ -107,44 +168,91 @@ func (pb *ProviderBuilder) GetName() string {
 	return pb.p.Name
 }
 
+// GetProjectID returns the ID of the project the provider instance belongs to.
+func (pb *ProviderBuilder) GetProjectID() uuid.UUID {
+	return pb.p.ProjectID
+}
+
+// IsGitHubAppInstallation returns true if the provider authenticates as a
+// GitHub App installation rather than as the user who enrolled.
+func (pb *ProviderBuilder) IsGitHubAppInstallation() bool {
+	return pb.installation
+}
+
 // GetToken returns the token for the provider.
 func (pb *ProviderBuilder) GetToken() string {
 	return pb.tok
 }
 
+// GetClient returns the client of the implementation the provider's
+// definition configures.
+func (pb *ProviderBuilder) GetClient(ctx context.Context) (provinfv1.Provider, error) {
+	if pb.p.Version != provinfv1.V1 {
+		return nil, fmt.Errorf("provider version not supported")
+	}
+
+	impl, err := registry.Get(pb.p.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkImplements(impl, pb.p.Implements); err != nil {
+		return nil, err
+	}
+
+	return impl.New(ctx, pb.params())
+}
+
+// ClientAs returns the client of the provider, when it's a T. T is either
+// the client type of an implementation, or a provider interface.
+func ClientAs[T any](ctx context.Context, pb *ProviderBuilder) (T, error) {
+	var zero T
+
+	cli, err := pb.GetClient(ctx)
+	if err != nil {
+		return zero, fmt.Errorf("error creating client: %w", err)
+	}
+
+	t, ok := cli.(T)
+	if !ok {
+		return zero, fmt.Errorf("provider %s doesn't provide a %T client", pb.GetName(), zero)
+	}
+	return t, nil
+}
+
 // GetGit returns a git client for the provider.
 func (pb *ProviderBuilder) GetGit() (*gitclient.Git, error) {
 	if !pb.Implements(db.ProviderTypeGit) {
 		return nil, fmt.Errorf("provider does not implement git")
 	}
 
-	return gitclient.NewGit(pb.tok), nil
+	var opts []gitclient.Option
+	// providers which only clone repositories need no definition
+	if impl, err := registry.Get(pb.p.Definition); err == nil && impl.GitOptions != nil {
+		opts = impl.GitOptions(pb.params())
+	}
+
+	return gitclient.NewGit(pb.tok, opts...), nil
 }
 
-// GetHTTP returns a github client for the provider.
+// GetHTTP returns a REST client for the provider.
 func (pb *ProviderBuilder) GetHTTP(ctx context.Context) (provinfv1.REST, error) {
 	if !pb.Implements(db.ProviderTypeRest) {
 		return nil, fmt.Errorf("provider does not implement rest")
 	}
 
-	// We can re-use the GitHub provider in case it also implements GitHub.
-	// The client gives us the ability to handle rate limiting and other
-	// things.
-	if pb.Implements(db.ProviderTypeGithub) {
-		return pb.GetGitHub(ctx)
-	}
-
-	if pb.p.Version != provinfv1.V1 {
-		return nil, fmt.Errorf("provider version not supported")
-	}
-
-	// TODO: Parsing will change based on version
-	cfg, err := httpclient.ParseV1Config(pb.p.Definition)
+	// We re-use the client of the provider's implementation, e.g. the GitHub
+	// client gives us the ability to handle rate limiting and other things.
+	cli, err := pb.GetClient(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("error parsing http config: %w", err)
+		return nil, fmt.Errorf("error creating rest client: %w", err)
 	}
 
-	return httpclient.NewREST(cfg, pb.metrics, pb.tok)
+	rest, ok := cli.(provinfv1.REST)
+	if !ok {
+		return nil, fmt.Errorf("provider does not implement rest")
+	}
+	return rest, nil
 }
 
 // GetGitHub returns a github client for the provider.
@@ -153,22 +261,7 @@ func (pb *ProviderBuilder) GetGitHub(ctx context.Context) (*ghclient.RestClient,
 		return nil, fmt.Errorf("provider does not implement github")
 	}
 
-	if pb.p.Version != provinfv1.V1 {
-		return nil, fmt.Errorf("provider version not supported")
-	}
-
-	// TODO: Parsing will change based on version
-	cfg, err := ghclient.ParseV1Config(pb.p.Definition)
-	if err != nil {
-		return nil, fmt.Errorf("error parsing github config: %w", err)
-	}
-
-	cli, err := ghclient.NewRestClient(ctx, cfg, pb.metrics, pb.GetToken(), pb.tokenInf.OwnerFilter.String)
-	if err != nil {
-		return nil, fmt.Errorf("error creating github client: %w", err)
-	}
-
-	return cli, nil
+	return ClientAs[*ghclient.RestClient](ctx, pb)
 }
 
 // GetRepoLister returns a repo lister for the provider.
@@ -177,14 +270,31 @@ func (pb *ProviderBuilder) GetRepoLister(ctx context.Context) (provinfv1.RepoLis
 		return nil, fmt.Errorf("provider does not implement repo lister")
 	}
 
-	if pb.p.Version != provinfv1.V1 {
-		return nil, fmt.Errorf("provider version not supported")
+	cli, err := pb.GetClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error creating repo lister: %w", err)
 	}
 
-	if pb.Implements(db.ProviderTypeGithub) {
-		return pb.GetGitHub(ctx)
+	lister, ok := cli.(provinfv1.RepoLister)
+	if !ok {
+		return nil, fmt.Errorf("provider does not implement repo lister")
 	}
+	return lister, nil
+}
 
-	// TODO: We'll need to add support for other providers here
-	return nil, fmt.Errorf("provider does not implement repo lister")
+// allowsAnonymous returns true if the provider can be used without a token.
+func (pb *ProviderBuilder) allowsAnonymous() bool {
+	impl, err := registry.Get(pb.p.Definition)
+	return err == nil && impl.AllowsAnonymous
+}
+
+// params returns what the client of the provider is built from
+func (pb *ProviderBuilder) params() registry.Params {
+	return registry.Params{
+		Definition:   pb.p.Definition,
+		Token:        pb.tok,
+		OwnerFilter:  pb.tokenInf.OwnerFilter.String,
+		Installation: pb.installation,
+		Metrics:      pb.metrics,
+	}
 }
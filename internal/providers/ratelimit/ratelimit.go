@@ -0,0 +1,432 @@
+//
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ratelimit provides an HTTP transport which keeps track of the rate
+// limits reported by the providers' APIs, and holds requests back rather than
+// running into them.
+package ratelimit
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/stacklok/minder/internal/events"
+	"github.com/stacklok/minder/internal/providers/telemetry"
+)
+
+const (
+	// DefaultMaxWait is how long a request is blocked waiting for a rate limit
+	// to reset. Requests which would need to wait longer are deferred instead.
+	DefaultMaxWait = 10 * time.Second
+
+	// secondaryLimitBackoff is how long requests are held back after hitting
+	// a secondary rate limit which didn't say when to retry. GitHub asks to
+	// wait at least a minute.
+	secondaryLimitBackoff = time.Minute
+
+	// maxBudgets bounds the number of tokens tracked. Once reached, the
+	// budgets which already reset are dropped.
+	maxBudgets = 10000
+
+	// the rate limit headers set by GitHub
+	headerLimit     = "X-RateLimit-Limit"
+	headerRemaining = "X-RateLimit-Remaining"
+	headerReset     = "X-RateLimit-Reset"
+	headerResource  = "X-RateLimit-Resource"
+	headerRetry     = "Retry-After"
+
+	// the rate limit headers set by GitLab, the reset is a Unix timestamp too
+	headerGitLabLimit     = "RateLimit-Limit"
+	headerGitLabRemaining = "RateLimit-Remaining"
+	headerGitLabReset     = "RateLimit-Reset"
+)
+
+// LimitedError is returned when a request is held back because of a rate
+// limit. It wraps events.ErrRetriable, so the message which caused the
+// request is retried.
+type LimitedError struct {
+	// Resource is the rate limited resource, e.g. core or search
+	Resource string
+	// Reset is when the rate limit resets
+	Reset time.Time
+}
+
+// Error implements the error interface
+func (e *LimitedError) Error() string {
+	return fmt.Sprintf("rate limit for %s exceeded, resets at %s", e.Resource, e.Reset.Format(time.RFC3339))
+}
+
+// Unwrap makes the error retriable
+func (*LimitedError) Unwrap() error {
+	return events.ErrRetriable
+}
+
+// RetryAt tells when the request may be retried
+func (e *LimitedError) RetryAt() time.Time {
+	return e.Reset
+}
+
+// budget is the rate limit of a resource, as seen from a token
+type budget struct {
+	limit     int64
+	remaining int64
+	reset     time.Time
+	// retryAt is set when the API asked to back off, e.g. on a secondary
+	// rate limit
+	retryAt time.Time
+}
+
+type budgetKey struct {
+	token    string
+	resource string
+}
+
+// Limiter keeps track of the rate limits of the tokens used against an API.
+// It is safe for concurrent use, and is meant to be shared by all the clients
+// of a process, since the limits are per token rather than per client.
+type Limiter struct {
+	mu      sync.Mutex
+	budgets map[budgetKey]*budget
+
+	maxWait time.Duration
+	reserve int64
+	now     func() time.Time
+}
+
+// Option is a function which can be used to set options on the Limiter.
+type Option func(*Limiter)
+
+// WithMaxWait sets how long a request may block waiting for a rate limit to
+// reset before it's deferred instead
+func WithMaxWait(d time.Duration) Option {
+	return func(l *Limiter) {
+		l.maxWait = d
+	}
+}
+
+// WithReserve sets the number of requests which are kept in reserve. Once the
+// remaining quota drops to it, requests are held back until the limit resets.
+func WithReserve(n int64) Option {
+	return func(l *Limiter) {
+		l.reserve = n
+	}
+}
+
+// NewLimiter creates a new Limiter
+func NewLimiter(opts ...Option) *Limiter {
+	l := &Limiter{
+		budgets: make(map[budgetKey]*budget),
+		maxWait: DefaultMaxWait,
+		now:     time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	return l
+}
+
+var defaultLimiter = NewLimiter()
+
+// Default returns the Limiter shared by the clients of the process
+func Default() *Limiter {
+	return defaultLimiter
+}
+
+// NewRoundTripper returns a transport which applies the rate limits of the
+// given token to the requests going through it.
+func (l *Limiter) NewRoundTripper(
+	wrapped http.RoundTripper,
+	token string,
+	providerType string,
+	metrics telemetry.RateLimitMetrics,
+) http.RoundTripper {
+	if wrapped == nil {
+		wrapped = http.DefaultTransport
+	}
+
+	sum := sha256.Sum256([]byte(token))
+	return &roundTripper{
+		wrapped:      wrapped,
+		limiter:      l,
+		token:        hex.EncodeToString(sum[:]),
+		providerType: providerType,
+		metrics:      metrics,
+	}
+}
+
+type roundTripper struct {
+	wrapped http.RoundTripper
+	limiter *Limiter
+	// token is a hash of the token, so the token itself isn't kept around
+	token        string
+	providerType string
+	metrics      telemetry.RateLimitMetrics
+}
+
+var _ http.RoundTripper = (*roundTripper)(nil)
+
+func (rt *roundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	ctx := r.Context()
+	key := budgetKey{token: rt.token, resource: resourceForRequest(r)}
+
+	wait, reset, taken := rt.limiter.take(key)
+	if wait > rt.limiter.maxWait {
+		rt.metrics.RecordThrottled(ctx, rt.providerType, "defer")
+		return nil, &LimitedError{Resource: key.resource, Reset: reset}
+	} else if wait > 0 {
+		rt.metrics.RecordThrottled(ctx, rt.providerType, "wait")
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := rt.wrapped.RoundTrip(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// conditional requests revalidating a cached response don't count
+	// against the rate limit when the resource didn't change
+	if taken && resp.StatusCode == http.StatusNotModified {
+		rt.limiter.giveBack(key)
+	}
+
+	if res := resp.Header.Get(headerResource); res != "" {
+		key.resource = res
+	}
+
+	b, limited := rt.limiter.update(key, resp)
+	if b != nil {
+		rt.metrics.RecordRateLimit(ctx, rt.providerType, key.resource, b.remaining)
+	}
+	if !limited {
+		return resp, nil
+	}
+
+	rt.metrics.RecordThrottled(ctx, rt.providerType, "limited")
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+	return nil, &LimitedError{Resource: key.resource, Reset: b.resetAt()}
+}
+
+// take takes a request from the budget of a resource, and returns how long
+// to wait before sending it, along with when the limit resets. It also tells
+// whether the request was counted against the budget.
+func (l *Limiter) take(key budgetKey) (time.Duration, time.Time, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.budgets[key]
+	if !ok {
+		return 0, time.Time{}, false
+	}
+
+	now := l.now()
+	if b.retryAt.After(now) {
+		return b.retryAt.Sub(now), b.retryAt, false
+	}
+
+	if !b.reset.After(now) {
+		// the limit reset, the next response tells how much is left
+		return 0, time.Time{}, false
+	}
+
+	if b.remaining <= l.reserve {
+		return b.reset.Sub(now), b.reset, false
+	}
+
+	// account for requests in flight, the response corrects the count
+	b.remaining--
+	return 0, time.Time{}, true
+}
+
+// giveBack returns a request taken from the budget of a resource which turned
+// out not to count against the rate limit. A response reporting the remaining
+// quota overrides it anyway.
+func (l *Limiter) giveBack(key budgetKey) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if b, ok := l.budgets[key]; ok && (b.limit == 0 || b.remaining < b.limit) {
+		b.remaining++
+	}
+}
+
+// update records the rate limit reported by a response, and tells whether the
+// response is a rate limit error.
+func (l *Limiter) update(key budgetKey, resp *http.Response) (*budget, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	b, ok := l.budgets[key]
+	if !ok {
+		b = &budget{}
+	}
+
+	remaining, hasRemaining := parseInt(firstHeader(resp.Header, headerRemaining, headerGitLabRemaining))
+	if hasRemaining {
+		b.remaining = remaining
+		if limit, ok := parseInt(firstHeader(resp.Header, headerLimit, headerGitLabLimit)); ok {
+			b.limit = limit
+		}
+		if reset, ok := parseInt(firstHeader(resp.Header, headerReset, headerGitLabReset)); ok {
+			b.reset = time.Unix(reset, 0)
+		}
+	}
+
+	retryAfter, hasRetryAfter := parseRetryAfter(resp.Header.Get(headerRetry), now)
+	if hasRetryAfter {
+		b.retryAt = now.Add(retryAfter)
+	}
+
+	limited := false
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		limited = true
+	case http.StatusForbidden:
+		limited = (hasRemaining && remaining == 0) || hasRetryAfter
+		if !limited && isSecondaryLimit(resp) {
+			limited = true
+			b.retryAt = now.Add(secondaryLimitBackoff)
+		}
+	}
+	if limited && !b.retryAt.After(now) && !b.reset.After(now) {
+		// nothing said when to retry
+		b.retryAt = now.Add(secondaryLimitBackoff)
+	}
+
+	if !hasRemaining && !limited && !ok {
+		// not rate limited, nothing to keep track of
+		return nil, false
+	}
+
+	if !ok {
+		if len(l.budgets) >= maxBudgets {
+			l.prune(now)
+		}
+		l.budgets[key] = b
+	}
+
+	return b, limited
+}
+
+// prune drops the budgets which already reset
+func (l *Limiter) prune(now time.Time) {
+	for k, b := range l.budgets {
+		if !b.reset.After(now) && !b.retryAt.After(now) {
+			delete(l.budgets, k)
+		}
+	}
+}
+
+func (b *budget) resetAt() time.Time {
+	if b.retryAt.After(b.reset) {
+		return b.retryAt
+	}
+	return b.reset
+}
+
+// firstHeader returns the value of the first of the headers the response has
+func firstHeader(h http.Header, names ...string) string {
+	for _, name := range names {
+		if v := h.Get(name); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// resourceForRequest guesses the rate limited resource a request counts
+// against, until a response tells.
+func resourceForRequest(r *http.Request) string {
+	switch {
+	case strings.Contains(r.URL.Path, "/search/"):
+		return "search"
+	case strings.HasSuffix(r.URL.Path, "/graphql"):
+		return "graphql"
+	default:
+		return "core"
+	}
+}
+
+// isSecondaryLimit tells whether a 403 response is a secondary rate limit,
+// which GitHub only tells in the body. The body is restored for the caller.
+func isSecondaryLimit(resp *http.Response) bool {
+	if resp.Body == nil {
+		return false
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	rest := resp.Body
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(data), rest), rest}
+	if err != nil {
+		return false
+	}
+
+	return bytes.Contains(bytes.ToLower(data), []byte("secondary rate limit"))
+}
+
+func parseInt(v string) (int64, bool) {
+	if v == "" {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// parseRetryAfter parses a Retry-After header, which is either a number of
+// seconds or an HTTP date
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, ok := parseInt(v); ok {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return t.Sub(now), true
+	}
+	return 0, false
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,302 @@
+//
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stacklok/minder/internal/events"
+)
+
+type fakeMetrics struct {
+	mu        sync.Mutex
+	remaining map[string]int64
+	throttled map[string]int
+}
+
+func newFakeMetrics() *fakeMetrics {
+	return &fakeMetrics{
+		remaining: make(map[string]int64),
+		throttled: make(map[string]int),
+	}
+}
+
+func (m *fakeMetrics) RecordRateLimit(_ context.Context, _ string, resource string, remaining int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.remaining[resource] = remaining
+}
+
+func (m *fakeMetrics) RecordThrottled(_ context.Context, _ string, action string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.throttled[action]++
+}
+
+// newFakeAPI serves the given status and rate limit headers, and counts the
+// requests it got
+func newFakeAPI(t *testing.T, status int, headers map[string]string, body string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		for k, v := range headers {
+			w.Header().Set(k, v)
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func doRequest(t *testing.T, rt http.RoundTripper, url string) (*http.Response, error) {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	resp, err := rt.RoundTrip(req)
+	if resp != nil {
+		t.Cleanup(func() { _ = resp.Body.Close() })
+	}
+	return resp, err
+}
+
+func TestRoundTripperTracksQuota(t *testing.T) {
+	t.Parallel()
+
+	reset := time.Now().Add(time.Hour).Unix()
+	srv, calls := newFakeAPI(t, http.StatusOK, map[string]string{
+		headerLimit:     "5000",
+		headerRemaining: "4321",
+		headerReset:     strconv.FormatInt(reset, 10),
+		headerResource:  "core",
+	}, `{}`)
+
+	mt := newFakeMetrics()
+	rt := NewLimiter().NewRoundTripper(http.DefaultTransport, "token", "github", mt)
+
+	resp, err := doRequest(t, rt, srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Equal(t, int64(4321), mt.remaining["core"])
+	assert.Empty(t, mt.throttled)
+}
+
+func TestRoundTripperGitLabHeaders(t *testing.T) {
+	t.Parallel()
+
+	reset := time.Now().Add(time.Hour).Unix()
+	srv, calls := newFakeAPI(t, http.StatusTooManyRequests, map[string]string{
+		headerGitLabLimit:     "2000",
+		headerGitLabRemaining: "0",
+		headerGitLabReset:     strconv.FormatInt(reset, 10),
+	}, `{"message": "Retry later"}`)
+
+	mt := newFakeMetrics()
+	rt := NewLimiter().NewRoundTripper(http.DefaultTransport, "token", "gitlab", mt)
+
+	_, err := doRequest(t, rt, srv.URL)
+	var lerr *LimitedError
+	require.True(t, errors.As(err, &lerr))
+	assert.Equal(t, time.Unix(reset, 0), lerr.RetryAt())
+	assert.Equal(t, int64(0), mt.remaining["core"])
+
+	// the following requests don't reach the API until the limit resets
+	_, err = doRequest(t, rt, srv.URL)
+	assert.True(t, errors.Is(err, events.ErrRetriable))
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestRoundTripperNotModifiedIsFree(t *testing.T) {
+	t.Parallel()
+
+	reset := time.Now().Add(time.Hour).Unix()
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set(headerLimit, "5000")
+			w.Header().Set(headerRemaining, "2")
+			w.Header().Set(headerReset, strconv.FormatInt(reset, 10))
+			return
+		}
+		// revalidations don't necessarily report the rate limit
+		w.WriteHeader(http.StatusNotModified)
+	}))
+	t.Cleanup(srv.Close)
+
+	mt := newFakeMetrics()
+	rt := NewLimiter(WithReserve(1)).NewRoundTripper(http.DefaultTransport, "token", "github", mt)
+
+	resp, err := doRequest(t, rt, srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// a single request is left above the reserve, which the revalidations
+	// don't use up
+	for i := 0; i < 3; i++ {
+		resp, err := doRequest(t, rt, srv.URL)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotModified, resp.StatusCode)
+	}
+	assert.Equal(t, int32(4), calls.Load())
+	assert.Empty(t, mt.throttled)
+}
+
+func TestRoundTripperDefersUntilReset(t *testing.T) {
+	t.Parallel()
+
+	reset := time.Now().Add(time.Hour).Unix()
+	srv, calls := newFakeAPI(t, http.StatusForbidden, map[string]string{
+		headerLimit:     "5000",
+		headerRemaining: "0",
+		headerReset:     strconv.FormatInt(reset, 10),
+	}, `{"message": "API rate limit exceeded"}`)
+
+	mt := newFakeMetrics()
+	l := NewLimiter()
+	rt := l.NewRoundTripper(http.DefaultTransport, "token", "github", mt)
+
+	// the response running into the limit is turned into a retriable error
+	_, err := doRequest(t, rt, srv.URL)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, events.ErrRetriable))
+	var lerr *LimitedError
+	require.True(t, errors.As(err, &lerr))
+	assert.Equal(t, time.Unix(reset, 0), lerr.RetryAt())
+	assert.Equal(t, int32(1), calls.Load())
+
+	// the following requests don't reach the API until the limit resets
+	_, err = doRequest(t, rt, srv.URL)
+	assert.True(t, errors.Is(err, events.ErrRetriable))
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Equal(t, 1, mt.throttled["limited"])
+	assert.Equal(t, 1, mt.throttled["defer"])
+
+	// other tokens have a budget of their own
+	other := l.NewRoundTripper(http.DefaultTransport, "other", "github", mt)
+	_, err = doRequest(t, other, srv.URL)
+	assert.True(t, errors.Is(err, events.ErrRetriable))
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestRoundTripperWaitsForShortReset(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	reset := time.Now().Add(time.Hour).Unix()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		remaining := 1 - calls.Add(1)
+		w.Header().Set(headerRemaining, strconv.Itoa(int(remaining)))
+		w.Header().Set(headerReset, strconv.FormatInt(reset, 10))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	mt := newFakeMetrics()
+	l := NewLimiter()
+	// pretend the limit resets shortly
+	l.now = func() time.Time { return time.Unix(reset, 0).Add(-100 * time.Millisecond) }
+	rt := l.NewRoundTripper(http.DefaultTransport, "token", "github", mt)
+
+	_, err := doRequest(t, rt, srv.URL)
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, err = doRequest(t, rt, srv.URL)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+	assert.Equal(t, int32(2), calls.Load())
+	assert.Equal(t, 1, mt.throttled["wait"])
+}
+
+func TestRoundTripperRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		status  int
+		headers map[string]string
+		body    string
+		backoff time.Duration
+	}{
+		{
+			name:    "too many requests",
+			status:  http.StatusTooManyRequests,
+			headers: map[string]string{headerRetry: "120"},
+			backoff: 2 * time.Minute,
+		},
+		{
+			name:    "secondary rate limit",
+			status:  http.StatusForbidden,
+			body:    `{"message": "You have exceeded a secondary rate limit."}`,
+			backoff: secondaryLimitBackoff,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv, calls := newFakeAPI(t, tc.status, tc.headers, tc.body)
+			rt := NewLimiter().NewRoundTripper(http.DefaultTransport, "token", "github", newFakeMetrics())
+
+			_, err := doRequest(t, rt, srv.URL)
+			var lerr *LimitedError
+			require.True(t, errors.As(err, &lerr))
+			assert.WithinDuration(t, time.Now().Add(tc.backoff), lerr.RetryAt(), 5*time.Second)
+
+			_, err = doRequest(t, rt, srv.URL)
+			assert.True(t, errors.Is(err, events.ErrRetriable))
+			assert.Equal(t, int32(1), calls.Load())
+		})
+	}
+}
+
+func TestRoundTripperPassesForbidden(t *testing.T) {
+	t.Parallel()
+
+	// a plain permission error isn't a rate limit
+	srv, _ := newFakeAPI(t, http.StatusForbidden, map[string]string{
+		headerRemaining: "4999",
+		headerReset:     strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10),
+	}, `{"message": "Resource not accessible by integration"}`)
+	rt := NewLimiter().NewRoundTripper(http.DefaultTransport, "token", "github", newFakeMetrics())
+
+	resp, err := doRequest(t, rt, srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	// the body which was looked into is still there
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "Resource not accessible")
+}
@@ -0,0 +1,115 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/stacklok/minder/internal/db"
+	"github.com/stacklok/minder/internal/providers/registry"
+
+	// the provider implementations register themselves when imported
+	_ "github.com/stacklok/minder/internal/providers/gitea"
+	_ "github.com/stacklok/minder/internal/providers/github"
+	_ "github.com/stacklok/minder/internal/providers/gitlab"
+	_ "github.com/stacklok/minder/internal/providers/http"
+	_ "github.com/stacklok/minder/internal/providers/oci"
+)
+
+// Validator is implemented by the clients which can check whether the
+// service they talk to accepts their credentials
+type Validator interface {
+	Validate(ctx context.Context) error
+}
+
+// ValidateDefinition checks that the definition of a provider is valid for
+// the interfaces the provider implements, using the implementation the
+// definition configures.
+func ValidateDefinition(implements []db.ProviderType, def json.RawMessage) error {
+	if len(implements) == 0 {
+		return errors.New("provider must implement at least one interface")
+	}
+
+	impl, err := registry.Get(def)
+	if errors.Is(err, registry.ErrNoImplementation) {
+		// cloning with a token needs no configuration
+		if len(implements) == 1 && implements[0] == db.ProviderTypeGit {
+			return nil
+		}
+		return fmt.Errorf("definition must configure one of: %v", registry.Names())
+	} else if err != nil {
+		return err
+	}
+
+	if err := checkImplements(impl, implements); err != nil {
+		return err
+	}
+
+	if err := impl.ValidateConfig(def); err != nil {
+		return fmt.Errorf("invalid %s definition: %w", impl.Name, err)
+	}
+	return nil
+}
+
+// EndpointsChanged returns true if changing the definition of a provider from
+// oldDef to newDef would send its credentials somewhere else, either because
+// it configures another implementation or other endpoints
+func EndpointsChanged(oldDef, newDef json.RawMessage) (bool, error) {
+	oldImpl, oldEndpoints, err := endpoints(oldDef)
+	if err != nil {
+		return false, err
+	}
+	newImpl, newEndpoints, err := endpoints(newDef)
+	if err != nil {
+		return false, err
+	}
+	return oldImpl != newImpl || !slices.Equal(oldEndpoints, newEndpoints), nil
+}
+
+// endpoints returns the name of the implementation a definition configures
+// and the endpoints it configures, if any
+func endpoints(def json.RawMessage) (string, []string, error) {
+	impl, err := registry.Get(def)
+	if errors.Is(err, registry.ErrNoImplementation) {
+		return "", nil, nil
+	} else if err != nil {
+		return "", nil, err
+	}
+	if impl.Endpoints == nil {
+		return impl.Name, nil, nil
+	}
+
+	eps, err := impl.Endpoints(def)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid %s definition: %w", impl.Name, err)
+	}
+	return impl.Name, eps, nil
+}
+
+// checkImplements checks that the implementation provides all the given
+// interfaces
+func checkImplements(impl *registry.Implementation, implements []db.ProviderType) error {
+	for _, t := range implements {
+		if !slices.Contains(impl.Implements, t) {
+			return fmt.Errorf("%s providers don't implement %s", impl.Name, t)
+		}
+	}
+	return nil
+}
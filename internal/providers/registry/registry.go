@@ -0,0 +1,170 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package registry keeps track of the provider implementations. Each
+// implementation registers itself from the package of its client, so
+// that adding one doesn't require changes anywhere else.
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/stacklok/minder/internal/db"
+	gitclient "github.com/stacklok/minder/internal/providers/git"
+	"github.com/stacklok/minder/internal/providers/telemetry"
+	provinfv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+// ErrNoImplementation is returned when the definition of a provider doesn't
+// configure any of the registered implementations
+var ErrNoImplementation = errors.New("no provider implementation configured")
+
+// Params are what the client of a provider is built from
+type Params struct {
+	// Definition is the definition of the provider, as stored in the database
+	Definition json.RawMessage
+	// Token is the access token of the provider, empty for anonymous access
+	Token string
+	// OwnerFilter restricts the repositories listed to those of an owner
+	OwnerFilter string
+	// Installation is true when the token is a GitHub App installation token
+	Installation bool
+	// Metrics records the requests of the client
+	Metrics telemetry.ProviderMetrics
+}
+
+// Factory builds the client of a provider. The client implements the
+// provider interfaces of the implementation it's built for.
+type Factory func(ctx context.Context, p Params) (provinfv1.Provider, error)
+
+// Implementation describes a kind of provider, such as GitHub or GitLab
+type Implementation struct {
+	// Name is the key of the implementation's configuration in the
+	// definition of a provider, e.g. "github" for {"github": {...}}
+	Name string
+	// Implements is the list of provider interfaces the clients of the
+	// implementation provide
+	Implements []db.ProviderType
+	// ValidateConfig validates the definition of a provider
+	ValidateConfig func(def json.RawMessage) error
+	// Endpoints returns the URLs the credentials of a provider are sent to,
+	// which can't change once the provider is enrolled
+	Endpoints func(def json.RawMessage) ([]string, error)
+	// New builds the client of a provider
+	New Factory
+	// GitOptions returns the options of the git client of a provider, if
+	// the implementation needs any
+	GitOptions func(p Params) []gitclient.Option
+	// AllowsAnonymous is true if providers can be used without a token
+	AllowsAnonymous bool
+	// Generic is true for implementations whose configuration may be
+	// carried along by the definitions of other implementations, e.g. REST.
+	// They are only picked when no other implementation is configured.
+	Generic bool
+}
+
+var (
+	implementationsMu sync.RWMutex
+	implementations   = map[string]*Implementation{}
+)
+
+// Register registers a provider implementation. It's meant to be called
+// from the init function of the implementation's package, and panics when
+// an implementation with the same name is already registered.
+func Register(impl *Implementation) {
+	implementationsMu.Lock()
+	defer implementationsMu.Unlock()
+
+	if _, ok := implementations[impl.Name]; ok {
+		panic(fmt.Sprintf("provider implementation %s is already registered", impl.Name))
+	}
+	implementations[impl.Name] = impl
+}
+
+// Get returns the implementation the definition of a provider configures.
+// Generic implementations are only returned when the definition configures
+// no other one, and configuring several other ones is an error.
+func Get(def json.RawMessage) (*Implementation, error) {
+	var keys map[string]json.RawMessage
+	if len(def) > 0 {
+		if err := json.Unmarshal(def, &keys); err != nil {
+			return nil, fmt.Errorf("error parsing provider definition: %w", err)
+		}
+	}
+
+	implementationsMu.RLock()
+	defer implementationsMu.RUnlock()
+
+	var specific, generic []*Implementation
+	for key := range keys {
+		impl, ok := implementations[key]
+		if !ok {
+			continue
+		}
+		if impl.Generic {
+			generic = append(generic, impl)
+		} else {
+			specific = append(specific, impl)
+		}
+	}
+
+	switch {
+	case len(specific) == 1:
+		return specific[0], nil
+	case len(specific) > 1:
+		return nil, fmt.Errorf("definition configures several providers: %s", joinNames(specific))
+	case len(generic) == 1:
+		return generic[0], nil
+	case len(generic) > 1:
+		return nil, fmt.Errorf("definition configures several providers: %s", joinNames(generic))
+	}
+	return nil, ErrNoImplementation
+}
+
+// Names returns the sorted names of the registered implementations
+func Names() []string {
+	implementationsMu.RLock()
+	defer implementationsMu.RUnlock()
+
+	names := make([]string, 0, len(implementations))
+	for name := range implementations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ValidateWith adapts the config parser of a provider package into the
+// validator of its implementation
+func ValidateWith[T any](parse func(json.RawMessage) (T, error)) func(json.RawMessage) error {
+	return func(def json.RawMessage) error {
+		_, err := parse(def)
+		return err
+	}
+}
+
+func joinNames(impls []*Implementation) string {
+	names := make([]string, 0, len(impls))
+	for _, impl := range impls {
+		names = append(names, impl.Name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
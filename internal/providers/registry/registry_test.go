@@ -0,0 +1,111 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	Register(&Implementation{Name: "fake-forge"})
+	Register(&Implementation{Name: "fake-registry"})
+	Register(&Implementation{Name: "fake-rest", Generic: true})
+}
+
+func TestRegisterDuplicate(t *testing.T) {
+	t.Parallel()
+
+	assert.Panics(t, func() {
+		Register(&Implementation{Name: "fake-forge"})
+	})
+}
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		def     string
+		want    string
+		wantErr bool
+		// noImpl is set when the definition configures no implementation
+		noImpl bool
+	}{
+		{
+			name: "specific",
+			def:  `{"fake-forge": {}}`,
+			want: "fake-forge",
+		},
+		{
+			name: "specific wins over generic",
+			def:  `{"fake-rest": {}, "fake-registry": {}}`,
+			want: "fake-registry",
+		},
+		{
+			name: "generic",
+			def:  `{"fake-rest": {}}`,
+			want: "fake-rest",
+		},
+		{
+			name: "unknown keys are ignored",
+			def:  `{"fake-forge": {}, "bitbucket": {}}`,
+			want: "fake-forge",
+		},
+		{
+			name:    "several specific",
+			def:     `{"fake-forge": {}, "fake-registry": {}}`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown",
+			def:     `{"bitbucket": {}}`,
+			wantErr: true,
+			noImpl:  true,
+		},
+		{
+			name:    "empty",
+			wantErr: true,
+			noImpl:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			impl, err := Get([]byte(tt.def))
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.noImpl, errors.Is(err, ErrNoImplementation))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, impl.Name)
+		})
+	}
+}
+
+func TestNames(t *testing.T) {
+	t.Parallel()
+
+	names := Names()
+	assert.Subset(t, names, []string{"fake-forge", "fake-registry", "fake-rest"})
+	assert.IsNonDecreasing(t, names)
+}
@@ -0,0 +1,249 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package providers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stacklok/minder/internal/db"
+	gtclient "github.com/stacklok/minder/internal/providers/gitea"
+	"github.com/stacklok/minder/internal/providers/registry"
+	provinfv1 "github.com/stacklok/minder/pkg/providers/v1"
+)
+
+func TestRegisteredImplementations(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		def     string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "gitea",
+			def:  `{"gitea": {"endpoint": "https://gitea.example.com/api/v1/"}}`,
+			want: gtclient.Gitea,
+		},
+		{
+			name: "github wins over rest",
+			def:  `{"rest": {"base_url": "https://api.github.com/"}, "github": {}}`,
+			want: "github",
+		},
+		{
+			name: "rest",
+			def:  `{"rest": {"base_url": "https://example.com/"}}`,
+			want: "rest",
+		},
+		{
+			name:    "several",
+			def:     `{"github": {}, "gitea": {"endpoint": "https://gitea.example.com/api/v1/"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown",
+			def:     `{"bitbucket": {}}`,
+			wantErr: true,
+		},
+		{
+			name:    "empty",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			impl, err := registry.Get([]byte(tt.def))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, impl.Name)
+		})
+	}
+}
+
+func TestValidateDefinition(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		implements []db.ProviderType
+		def        string
+		wantErr    bool
+	}{
+		{
+			name:       "gitea",
+			implements: gtclient.Implements,
+			def:        `{"gitea": {"endpoint": "https://gitea.example.com/api/v1/"}}`,
+		},
+		{
+			name:       "gitea without endpoint",
+			implements: gtclient.Implements,
+			def:        `{"gitea": {}}`,
+			wantErr:    true,
+		},
+		{
+			name:       "gitea doesn't implement github",
+			implements: []db.ProviderType{db.ProviderTypeGithub},
+			def:        `{"gitea": {"endpoint": "https://gitea.example.com/api/v1/"}}`,
+			wantErr:    true,
+		},
+		{
+			name:       "git without definition",
+			implements: []db.ProviderType{db.ProviderTypeGit},
+		},
+		{
+			name:       "rest without definition",
+			implements: []db.ProviderType{db.ProviderTypeRest},
+			wantErr:    true,
+		},
+		{
+			name:       "no interfaces",
+			implements: nil,
+			def:        `{"github": {}}`,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateDefinition(tt.implements, []byte(tt.def))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestEndpointsChanged(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		oldDef string
+		newDef string
+		want   bool
+	}{
+		{
+			name:   "unchanged endpoint",
+			oldDef: `{"github": {"endpoint": "https://github.example.com/api/v3/"}}`,
+			newDef: `{"github": {"endpoint": "https://github.example.com/api/v3/"}, "rest": {}}`,
+		},
+		{
+			name:   "changed endpoint",
+			oldDef: `{"github": {}}`,
+			newDef: `{"github": {"endpoint": "https://attacker.example.com/"}}`,
+			want:   true,
+		},
+		{
+			name:   "changed upload endpoint",
+			oldDef: `{"github": {}}`,
+			newDef: `{"github": {"upload_endpoint": "https://attacker.example.com/"}}`,
+			want:   true,
+		},
+		{
+			name:   "changed implementation",
+			oldDef: `{"gitea": {"endpoint": "https://example.com/api/v1/"}}`,
+			newDef: `{"gitlab": {"endpoint": "https://example.com/api/v1/"}}`,
+			want:   true,
+		},
+		{
+			name:   "registry made insecure",
+			oldDef: `{"oci": {"registry": "ghcr.io"}}`,
+			newDef: `{"oci": {"registry": "ghcr.io", "insecure": true}}`,
+			want:   true,
+		},
+		{
+			name:   "git without definition",
+			oldDef: `{}`,
+			newDef: `{}`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := EndpointsChanged([]byte(tt.oldDef), []byte(tt.newDef))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetClientGitea(t *testing.T) {
+	t.Parallel()
+
+	pb := NewProviderBuilder(&db.Provider{
+		Name:       gtclient.Gitea,
+		Version:    provinfv1.V1,
+		Implements: gtclient.Implements,
+		Definition: []byte(`{"gitea": {"endpoint": "https://gitea.example.com/api/v1/"}}`),
+	}, db.ProviderAccessToken{}, "token")
+
+	cli, err := pb.GetClient(context.Background())
+	require.NoError(t, err)
+	assert.IsType(t, &gtclient.Client{}, cli)
+	assert.Implements(t, (*Validator)(nil), cli)
+	assert.Implements(t, (*provinfv1.WebhookDeleter)(nil), cli)
+
+	_, err = ClientAs[*gtclient.Client](context.Background(), pb)
+	assert.NoError(t, err)
+	_, err = pb.GetGitHub(context.Background())
+	assert.Error(t, err)
+
+	lister, err := pb.GetRepoLister(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, cli, lister)
+
+	_, err = pb.GetHTTP(context.Background())
+	assert.NoError(t, err)
+
+	_, err = pb.GetGit()
+	assert.NoError(t, err)
+}
+
+func TestGetClientChecksImplements(t *testing.T) {
+	t.Parallel()
+
+	// Gitea doesn't implement the GitHub interface
+	pb := NewProviderBuilder(&db.Provider{
+		Name:       gtclient.Gitea,
+		Version:    provinfv1.V1,
+		Implements: []db.ProviderType{db.ProviderTypeGithub, db.ProviderTypeGit},
+		Definition: []byte(`{"gitea": {"endpoint": "https://gitea.example.com/api/v1/"}}`),
+	}, db.ProviderAccessToken{}, "token")
+
+	_, err := pb.GetClient(context.Background())
+	assert.Error(t, err)
+
+	_, err = ClientAs[*gtclient.Client](context.Background(), pb)
+	assert.Error(t, err)
+}
@@ -17,17 +17,33 @@
 package telemetry
 
 import (
+	"context"
 	"net/http"
-
-	"github.com/stacklok/minder/internal/db"
 )
 
 // HttpClientMetrics provides the httpClientMetrics for http clients
 type HttpClientMetrics interface {
-	NewDurationRoundTripper(wrapped http.RoundTripper, providerType db.ProviderType) (http.RoundTripper, error)
+	NewDurationRoundTripper(wrapped http.RoundTripper, providerType string) (http.RoundTripper, error)
+}
+
+// RateLimitMetrics records the rate limits reported by the providers' APIs
+type RateLimitMetrics interface {
+	// RecordRateLimit records the remaining quota of a rate limited resource
+	RecordRateLimit(ctx context.Context, providerType string, resource string, remaining int64)
+	// RecordThrottled records a request which was held back because of a rate
+	// limit. The action is one of "wait", "defer" or "limited".
+	RecordThrottled(ctx context.Context, providerType string, action string)
+}
+
+// HttpCacheMetrics records how well the providers' responses are cached
+type HttpCacheMetrics interface {
+	// RecordCacheLookup records whether a response was served from the cache
+	RecordCacheLookup(ctx context.Context, providerType string, hit bool)
 }
 
 // ProviderMetrics provides the httpClientMetrics for providers
 type ProviderMetrics interface {
 	HttpClientMetrics
+	RateLimitMetrics
+	HttpCacheMetrics
 }
@@ -16,9 +16,8 @@
 package telemetry
 
 import (
+	"context"
 	"net/http"
-
-	"github.com/stacklok/minder/internal/db"
 )
 
 type noop struct{}
@@ -30,6 +29,15 @@ func NewNoopMetrics() *noop {
 	return &noop{}
 }
 
-func (_ *noop) NewDurationRoundTripper(wrapped http.RoundTripper, _ db.ProviderType) (http.RoundTripper, error) {
+func (_ *noop) NewDurationRoundTripper(wrapped http.RoundTripper, _ string) (http.RoundTripper, error) {
 	return wrapped, nil
 }
+
+func (_ *noop) RecordRateLimit(_ context.Context, _ string, _ string, _ int64) {
+}
+
+func (_ *noop) RecordThrottled(_ context.Context, _ string, _ string) {
+}
+
+func (_ *noop) RecordCacheLookup(_ context.Context, _ string, _ bool) {
+}
@@ -16,6 +16,7 @@
 package telemetry
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -25,8 +26,6 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
-
-	"github.com/stacklok/minder/internal/db"
 )
 
 var _ http.RoundTripper = (*instrumentedRoundTripper)(nil)
@@ -60,12 +59,16 @@ var _ ProviderMetrics = (*providerMetrics)(nil)
 
 type providerMetrics struct {
 	httpClientMetrics
+	rateLimitMetrics
+	httpCacheMetrics
 }
 
 // NewProviderMetrics creates a new provider metrics instance.
 func NewProviderMetrics() *providerMetrics {
 	return &providerMetrics{
 		httpClientMetrics: *newHttpClientMetrics(),
+		rateLimitMetrics:  *newRateLimitMetrics(),
+		httpCacheMetrics:  *newHttpCacheMetrics(),
 	}
 }
 
@@ -74,12 +77,12 @@ var _ HttpClientMetrics = (*httpClientMetrics)(nil)
 type httpClientMetrics struct {
 	providersMeter metric.Meter
 
-	httpProviderHistograms *xsync.MapOf[db.ProviderType, metric.Int64Histogram]
+	httpProviderHistograms *xsync.MapOf[string, metric.Int64Histogram]
 }
 
-func newProviderMapOf[V any]() *xsync.MapOf[db.ProviderType, V] {
-	return xsync.NewTypedMapOf[db.ProviderType, V](func(k db.ProviderType) uint64 {
-		return xsync.StrHash64(string(k))
+func newProviderMapOf[V any]() *xsync.MapOf[string, V] {
+	return xsync.NewTypedMapOf[string, V](func(k string) uint64 {
+		return xsync.StrHash64(k)
 	})
 }
 
@@ -91,7 +94,7 @@ func newHttpClientMetrics() *httpClientMetrics {
 	}
 }
 
-func (m *httpClientMetrics) createProviderHistogram(providerType db.ProviderType) (metric.Int64Histogram, error) {
+func (m *httpClientMetrics) createProviderHistogram(providerType string) (metric.Int64Histogram, error) {
 	histogramName := fmt.Sprintf("%s.http.roundtrip.duration", providerType)
 	return m.providersMeter.Int64Histogram(histogramName,
 		metric.WithDescription("HTTP roundtrip duration for provider"),
@@ -99,7 +102,7 @@ func (m *httpClientMetrics) createProviderHistogram(providerType db.ProviderType
 	)
 }
 
-func (m *httpClientMetrics) getHistogramForProvider(providerType db.ProviderType) metric.Int64Histogram {
+func (m *httpClientMetrics) getHistogramForProvider(providerType string) metric.Int64Histogram {
 	histogram, _ := m.httpProviderHistograms.LoadOrCompute(providerType, func() metric.Int64Histogram {
 		newHistogram, err := m.createProviderHistogram(providerType)
 		if err != nil {
@@ -113,7 +116,7 @@ func (m *httpClientMetrics) getHistogramForProvider(providerType db.ProviderType
 
 func (m *httpClientMetrics) NewDurationRoundTripper(
 	wrapped http.RoundTripper,
-	providerType db.ProviderType,
+	providerType string,
 ) (http.RoundTripper, error) {
 	histogram := m.getHistogramForProvider(providerType)
 	if histogram == nil {
@@ -125,3 +128,103 @@ func (m *httpClientMetrics) NewDurationRoundTripper(
 		durationHistogram: histogram,
 	}, nil
 }
+
+var _ RateLimitMetrics = (*rateLimitMetrics)(nil)
+
+type rateLimitKey struct {
+	providerType string
+	resource     string
+}
+
+type rateLimitMetrics struct {
+	// the last remaining quota seen, by provider type and resource
+	remaining *xsync.MapOf[rateLimitKey, int64]
+
+	throttledCounter metric.Int64Counter
+}
+
+// newRateLimitMetrics creates a new rate limit metrics instance.
+func newRateLimitMetrics() *rateLimitMetrics {
+	m := &rateLimitMetrics{
+		remaining: xsync.NewTypedMapOf[rateLimitKey, int64](func(k rateLimitKey) uint64 {
+			return xsync.StrHash64(k.providerType + "/" + k.resource)
+		}),
+	}
+
+	meter := otel.Meter("providers")
+	_, err := meter.Int64ObservableGauge("ratelimit.remaining",
+		metric.WithDescription("Remaining rate limit quota reported by the provider, labeled by resource"),
+		metric.WithUnit("requests"),
+		metric.WithInt64Callback(func(_ context.Context, observer metric.Int64Observer) error {
+			m.remaining.Range(func(k rateLimitKey, v int64) bool {
+				observer.Observe(v, metric.WithAttributes(
+					attribute.String("provider_type", k.providerType),
+					attribute.String("resource", k.resource),
+				))
+				return true
+			})
+			return nil
+		}),
+	)
+	if err != nil {
+		log.Printf("failed to create rate limit gauge: %v", err)
+	}
+
+	m.throttledCounter, err = meter.Int64Counter("ratelimit.throttled",
+		metric.WithDescription("Number of requests held back because of a rate limit, labeled by action"),
+		metric.WithUnit("requests"))
+	if err != nil {
+		log.Printf("failed to create rate limit throttled counter: %v", err)
+	}
+
+	return m
+}
+
+func (m *rateLimitMetrics) RecordRateLimit(
+	_ context.Context,
+	providerType string,
+	resource string,
+	remaining int64,
+) {
+	m.remaining.Store(rateLimitKey{providerType: providerType, resource: resource}, remaining)
+}
+
+func (m *rateLimitMetrics) RecordThrottled(ctx context.Context, providerType string, action string) {
+	if m.throttledCounter == nil {
+		return
+	}
+	m.throttledCounter.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("provider_type", providerType),
+		attribute.String("action", action),
+	))
+}
+
+var _ HttpCacheMetrics = (*httpCacheMetrics)(nil)
+
+type httpCacheMetrics struct {
+	lookupCounter metric.Int64Counter
+}
+
+// newHttpCacheMetrics creates a new http cache metrics instance.
+func newHttpCacheMetrics() *httpCacheMetrics {
+	counter, err := otel.Meter("providers").Int64Counter("httpcache.lookups",
+		metric.WithDescription("Number of cacheable requests, labeled by whether the cache was hit"),
+		metric.WithUnit("requests"))
+	if err != nil {
+		log.Printf("failed to create http cache lookup counter: %v", err)
+	}
+
+	return &httpCacheMetrics{
+		lookupCounter: counter,
+	}
+}
+
+func (m *httpCacheMetrics) RecordCacheLookup(ctx context.Context, providerType string, hit bool) {
+	if m.lookupCounter == nil {
+		return
+	}
+	m.lookupCounter.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("provider_type", providerType),
+		attribute.Bool("hit", hit),
+	))
+}
@@ -0,0 +1,349 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package providers
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"golang.org/x/exp/slices"
+	"golang.org/x/oauth2"
+
+	"github.com/stacklok/minder/internal/auth"
+	"github.com/stacklok/minder/internal/crypto"
+	"github.com/stacklok/minder/internal/db"
+	ghclient "github.com/stacklok/minder/internal/providers/github"
+)
+
+const (
+	// tokenRefreshMargin is how long before their expiry tokens are refreshed
+	tokenRefreshMargin = 5 * time.Minute
+	// tokenRefreshLookahead is how far ahead the refresher looks for expiring
+	// tokens. It's longer than the margin and the interval combined, so
+	// tokens are refreshed in the background before they're needed.
+	tokenRefreshLookahead = 10 * time.Minute
+	// defaultTokenRefreshInterval is how often the refresher runs
+	defaultTokenRefreshInterval = time.Minute
+)
+
+// OAuthConfigFunc returns the OAuth2 configuration the tokens of a provider
+// were issued with, which is needed to refresh them
+type OAuthConfigFunc func(prov *db.Provider) (*oauth2.Config, error)
+
+// OAuthConfig returns the OAuth2 configuration the tokens of a provider are
+// issued with, for a CLI or web client. Providers pointing to a GitHub
+// Enterprise Server use the OAuth2 app configured for the instance.
+func OAuthConfig(prov *db.Provider, cli bool) (*oauth2.Config, error) {
+	if slices.Contains(prov.Implements, db.ProviderTypeGitlab) {
+		return auth.NewOAuthConfig(auth.Gitlab, cli)
+	}
+	if slices.Contains(prov.Implements, db.ProviderTypeGithub) {
+		srv, err := gitHubEnterpriseServer(prov)
+		if err != nil {
+			return nil, err
+		} else if srv != nil {
+			return srv.OAuthConfig(prov.Name, cli)
+		}
+		return auth.NewOAuthConfig(auth.Github, cli)
+	}
+	// the OAuth2 apps of the server are named after the providers they're for
+	return auth.NewOAuthConfig(prov.Name, cli)
+}
+
+// RevokeToken revokes an OAuth2 token of a provider with the service which
+// issued it
+func RevokeToken(ctx context.Context, prov *db.Provider, token string) error {
+	if slices.Contains(prov.Implements, db.ProviderTypeGithub) {
+		srv, err := gitHubEnterpriseServer(prov)
+		if err != nil {
+			return err
+		} else if srv != nil {
+			return srv.RevokeToken(ctx, token)
+		}
+	}
+	return auth.DeleteAccessToken(ctx, prov.Name, token)
+}
+
+// gitHubEnterpriseServer returns the OAuth2 app of the GitHub Enterprise
+// Server a GitHub provider points to, or nil for github.com
+func gitHubEnterpriseServer(prov *db.Provider) (*auth.GitHubEnterpriseServer, error) {
+	cfg, err := ghclient.ParseV1Config(prov.Definition)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing github config: %w", err)
+	}
+	if !ghclient.IsEnterprise(cfg) {
+		return nil, nil
+	}
+	return auth.GetGitHubEnterpriseServer(ghclient.ServerURL(cfg))
+}
+
+// providerOAuthConfig returns the OAuth2 configuration the tokens of a
+// provider are refreshed with
+func providerOAuthConfig(prov *db.Provider) (*oauth2.Config, error) {
+	return OAuthConfig(prov, true)
+}
+
+// newRefreshingTokenSource returns a token source which hands out the given
+// token until shortly before it expires, and then refreshes it, storing the
+// refreshed token.
+func newRefreshingTokenSource(
+	ctx context.Context,
+	cfg *oauth2.Config,
+	tok *oauth2.Token,
+	tokenInf db.ProviderAccessToken,
+	store db.Store,
+	crypteng *crypto.Engine,
+) oauth2.TokenSource {
+	return oauth2.ReuseTokenSourceWithExpiry(tok, &storingTokenSource{
+		ctx:      ctx,
+		cfg:      cfg,
+		tokenInf: tokenInf,
+		store:    store,
+		crypteng: crypteng,
+	}, tokenRefreshMargin)
+}
+
+// storingTokenSource refreshes the token on every call. The refreshed token
+// is encrypted and stored in place of the previous one, and the health of the
+// provider is updated according to the outcome.
+// Refreshes of the token of a provider, e.g. by the TokenRefresher and by an
+// evaluation, are serialized by locking the stored token. The stored token is
+// read again once locked, and used as is if it was refreshed concurrently, so
+// that a rotated refresh token is never used twice.
+// It's meant to be wrapped by oauth2.ReuseTokenSourceWithExpiry.
+type storingTokenSource struct {
+	ctx context.Context
+	cfg *oauth2.Config
+	// tokenInf is the stored token this source handed out last
+	tokenInf db.ProviderAccessToken
+	store    db.Store
+	crypteng *crypto.Engine
+}
+
+func (sts *storingTokenSource) Token() (*oauth2.Token, error) {
+	tx, err := sts.store.BeginTransaction()
+	if err != nil {
+		return nil, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer sts.store.Rollback(tx)
+
+	qtx := sts.store.GetQuerierWithTransaction(tx)
+
+	stored, err := qtx.GetAccessTokenForUpdate(sts.ctx, db.GetAccessTokenForUpdateParams{
+		Provider:  sts.tokenInf.Provider,
+		ProjectID: sts.tokenInf.ProjectID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting stored token: %w", err)
+	}
+	storedTok, err := sts.crypteng.DecryptOAuthToken(stored.EncryptedToken)
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting stored token: %w", err)
+	}
+
+	if stored.ExpirationTime.After(sts.tokenInf.ExpirationTime) {
+		// the token was refreshed concurrently
+		sts.tokenInf = stored
+		return &storedTok, sts.store.Commit(tx)
+	}
+
+	// without an access token, the config's token source refreshes right away
+	tok, err := sts.cfg.TokenSource(sts.ctx, &oauth2.Token{RefreshToken: storedTok.RefreshToken}).Token()
+	if err != nil {
+		setProviderHealth(sts.ctx, sts.store, sts.tokenInf.Provider, sts.tokenInf.ProjectID,
+			db.ProviderHealthUnhealthy, fmt.Sprintf("failed to refresh the access token: %v", err))
+		return nil, fmt.Errorf("error refreshing token: %w", err)
+	}
+
+	// some servers rotate refresh tokens, so losing the refreshed token would
+	// leave the provider without a usable refresh token
+	updated, err := storeToken(sts.ctx, qtx, sts.crypteng, stored, tok)
+	if err != nil {
+		return nil, fmt.Errorf("error storing refreshed token: %w", err)
+	}
+	if err := sts.store.Commit(tx); err != nil {
+		return nil, fmt.Errorf("error committing refreshed token: %w", err)
+	}
+	sts.tokenInf = updated
+
+	setProviderHealth(sts.ctx, sts.store, sts.tokenInf.Provider, sts.tokenInf.ProjectID,
+		db.ProviderHealthHealthy, "")
+	return tok, nil
+}
+
+func storeToken(
+	ctx context.Context,
+	qtx db.Querier,
+	crypteng *crypto.Engine,
+	tokenInf db.ProviderAccessToken,
+	tok *oauth2.Token,
+) (db.ProviderAccessToken, error) {
+	jsonData, err := json.Marshal(tok)
+	if err != nil {
+		return db.ProviderAccessToken{}, fmt.Errorf("error marshalling token: %w", err)
+	}
+
+	encryptedToken, err := crypteng.EncryptOAuthToken(jsonData)
+	if err != nil {
+		return db.ProviderAccessToken{}, fmt.Errorf("error encrypting token: %w", err)
+	}
+
+	return qtx.UpdateAccessToken(ctx, db.UpdateAccessTokenParams{
+		Provider:       tokenInf.Provider,
+		ProjectID:      tokenInf.ProjectID,
+		EncryptedToken: base64.StdEncoding.EncodeToString(encryptedToken),
+		ExpirationTime: tok.Expiry,
+		OwnerFilter:    tokenInf.OwnerFilter,
+	})
+}
+
+func setProviderHealth(
+	ctx context.Context,
+	store db.Store,
+	provider string,
+	projectID uuid.UUID,
+	health db.ProviderHealth,
+	detail string,
+) {
+	err := store.UpdateProviderHealth(ctx, db.UpdateProviderHealthParams{
+		Name:         provider,
+		ProjectID:    projectID,
+		Health:       health,
+		HealthDetail: detail,
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).
+			Str("provider", provider).
+			Str("project_id", projectID.String()).
+			Msg("error updating provider health")
+	}
+}
+
+// TokenRefresher periodically refreshes the OAuth tokens of the providers
+// before they expire, so evaluations don't have to.
+type TokenRefresher struct {
+	store       db.Store
+	crypteng    *crypto.Engine
+	oauthConfig OAuthConfigFunc
+	interval    time.Duration
+}
+
+// TokenRefresherOption is a function which can be used to set options on the TokenRefresher.
+type TokenRefresherOption func(*TokenRefresher)
+
+// WithOAuthConfig sets the function returning the OAuth2 configuration used
+// to refresh the tokens of a provider
+func WithOAuthConfig(oauthConfig OAuthConfigFunc) TokenRefresherOption {
+	return func(r *TokenRefresher) {
+		r.oauthConfig = oauthConfig
+	}
+}
+
+// NewTokenRefresher creates a new token refresher
+func NewTokenRefresher(store db.Store, crypteng *crypto.Engine, opts ...TokenRefresherOption) *TokenRefresher {
+	r := &TokenRefresher{
+		store:       store,
+		crypteng:    crypteng,
+		oauthConfig: providerOAuthConfig,
+		interval:    defaultTokenRefreshInterval,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Run refreshes the expiring tokens until the context is cancelled
+func (r *TokenRefresher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := r.RefreshExpiring(ctx); err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("error refreshing provider tokens")
+			}
+		}
+	}
+}
+
+// RefreshExpiring refreshes the tokens which are about to expire, or have
+// expired already. A token which fails to refresh marks its provider
+// unhealthy, and is retried on the next run.
+func (r *TokenRefresher) RefreshExpiring(ctx context.Context) error {
+	expiring, err := r.store.ListAccessTokensExpiringBefore(ctx, time.Now().Add(tokenRefreshLookahead))
+	if err != nil {
+		return fmt.Errorf("error listing expiring tokens: %w", err)
+	}
+
+	for i := range expiring {
+		t := &expiring[i]
+		if err := r.refresh(ctx, t); err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).
+				Str("provider", t.Provider).
+				Str("project_id", t.ProjectID.String()).
+				Msg("error refreshing provider token")
+		}
+	}
+	return nil
+}
+
+func (r *TokenRefresher) refresh(ctx context.Context, t *db.ProviderAccessToken) error {
+	tok, err := r.crypteng.DecryptOAuthToken(t.EncryptedToken)
+	if err != nil {
+		return fmt.Errorf("error decrypting token: %w", err)
+	}
+
+	if tok.RefreshToken == "" {
+		if t.ExpirationTime.Before(time.Now()) {
+			setProviderHealth(ctx, r.store, t.Provider, t.ProjectID, db.ProviderHealthUnhealthy,
+				"the access token expired and can't be refreshed, the provider needs to be enrolled again")
+		}
+		return nil
+	}
+
+	prov, err := r.store.GetProviderByName(ctx, db.GetProviderByNameParams{
+		Name:      t.Provider,
+		ProjectID: t.ProjectID,
+	})
+	if err != nil {
+		return fmt.Errorf("error getting provider: %w", err)
+	}
+
+	cfg, err := r.oauthConfig(&prov)
+	if err != nil {
+		return err
+	}
+
+	sts := &storingTokenSource{
+		ctx:      ctx,
+		cfg:      cfg,
+		tokenInf: *t,
+		store:    r.store,
+		crypteng: r.crypteng,
+	}
+	_, err = sts.Token()
+	return err
+}
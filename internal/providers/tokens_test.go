@@ -0,0 +1,281 @@
+// Copyright 2023 Stacklok, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package providers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	mockdb "github.com/stacklok/minder/database/mock"
+	"github.com/stacklok/minder/internal/crypto"
+	"github.com/stacklok/minder/internal/db"
+)
+
+// newFakeTokenEndpoint serves refresh token grants, accepting only the
+// refresh token "good"
+func newFakeTokenEndpoint(t *testing.T) *oauth2.Config {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "refresh_token", r.PostForm.Get("grant_type"))
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.PostForm.Get("refresh_token") != "good" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error": "invalid_grant"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"access_token": "refreshed", "token_type": "bearer",
+			"refresh_token": "rotated", "expires_in": 7200}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	return &oauth2.Config{
+		ClientID: "client",
+		Endpoint: oauth2.Endpoint{TokenURL: srv.URL, AuthStyle: oauth2.AuthStyleInParams},
+	}
+}
+
+func encryptToken(t *testing.T, crypteng *crypto.Engine, tok *oauth2.Token) string {
+	t.Helper()
+
+	data, err := json.Marshal(tok)
+	require.NoError(t, err)
+	enc, err := crypteng.EncryptOAuthToken(data)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(enc)
+}
+
+// expectLockedToken expects the stored token to be locked and read again
+// within a transaction, which is rolled back once done
+func expectLockedToken(mockStore *mockdb.MockStore, stored db.ProviderAccessToken) {
+	mockStore.EXPECT().BeginTransaction().Return(&sql.Tx{}, nil)
+	mockStore.EXPECT().GetQuerierWithTransaction(gomock.Any()).Return(mockStore)
+	mockStore.EXPECT().GetAccessTokenForUpdate(gomock.Any(), db.GetAccessTokenForUpdateParams{
+		Provider:  stored.Provider,
+		ProjectID: stored.ProjectID,
+	}).Return(stored, nil)
+	mockStore.EXPECT().Rollback(gomock.Any()).Return(nil)
+}
+
+func TestRefreshingTokenSource(t *testing.T) {
+	t.Parallel()
+
+	cfg := newFakeTokenEndpoint(t)
+	crypteng := crypto.NewEngine("test")
+	projectID := uuid.New()
+
+	// tokenInf returns the stored token, as read when building the provider
+	tokenInf := func(tok *oauth2.Token) db.ProviderAccessToken {
+		return db.ProviderAccessToken{
+			Provider:       "gitlab",
+			ProjectID:      projectID,
+			EncryptedToken: encryptToken(t, crypteng, tok),
+			ExpirationTime: tok.Expiry,
+		}
+	}
+
+	t.Run("valid token is reused", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockStore := mockdb.NewMockStore(ctrl)
+
+		tok := &oauth2.Token{AccessToken: "current", RefreshToken: "good", Expiry: time.Now().Add(time.Hour)}
+		ts := newRefreshingTokenSource(context.Background(), cfg, tok, tokenInf(tok), mockStore, crypteng)
+
+		got, err := ts.Token()
+		require.NoError(t, err)
+		assert.Equal(t, "current", got.AccessToken)
+	})
+
+	t.Run("token about to expire is refreshed and stored", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockStore := mockdb.NewMockStore(ctrl)
+
+		tok := &oauth2.Token{AccessToken: "current", RefreshToken: "good", Expiry: time.Now().Add(time.Minute)}
+		expectLockedToken(mockStore, tokenInf(tok))
+		mockStore.EXPECT().UpdateAccessToken(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, arg db.UpdateAccessTokenParams) (db.ProviderAccessToken, error) {
+				assert.Equal(t, "gitlab", arg.Provider)
+				assert.Equal(t, projectID, arg.ProjectID)
+				assert.WithinDuration(t, time.Now().Add(2*time.Hour), arg.ExpirationTime, time.Minute)
+
+				stored, err := crypteng.DecryptOAuthToken(arg.EncryptedToken)
+				require.NoError(t, err)
+				assert.Equal(t, "refreshed", stored.AccessToken)
+				assert.Equal(t, "rotated", stored.RefreshToken)
+				return db.ProviderAccessToken{
+					Provider:       arg.Provider,
+					ProjectID:      arg.ProjectID,
+					EncryptedToken: arg.EncryptedToken,
+					ExpirationTime: arg.ExpirationTime,
+				}, nil
+			})
+		mockStore.EXPECT().Commit(gomock.Any()).Return(nil)
+		mockStore.EXPECT().UpdateProviderHealth(gomock.Any(), db.UpdateProviderHealthParams{
+			Name:      "gitlab",
+			ProjectID: projectID,
+			Health:    db.ProviderHealthHealthy,
+		}).Return(nil)
+
+		ts := newRefreshingTokenSource(context.Background(), cfg, tok, tokenInf(tok), mockStore, crypteng)
+
+		got, err := ts.Token()
+		require.NoError(t, err)
+		assert.Equal(t, "refreshed", got.AccessToken)
+
+		// the refreshed token is reused
+		got, err = ts.Token()
+		require.NoError(t, err)
+		assert.Equal(t, "refreshed", got.AccessToken)
+	})
+
+	t.Run("token refreshed concurrently is read back", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockStore := mockdb.NewMockStore(ctrl)
+
+		// the refresh token was rotated by the concurrent refresh, using the
+		// previous one would fail
+		tok := &oauth2.Token{AccessToken: "current", RefreshToken: "revoked", Expiry: time.Now().Add(time.Minute)}
+		expectLockedToken(mockStore, tokenInf(&oauth2.Token{
+			AccessToken:  "refreshed",
+			RefreshToken: "rotated",
+			Expiry:       time.Now().Add(2 * time.Hour),
+		}))
+		mockStore.EXPECT().Commit(gomock.Any()).Return(nil)
+
+		ts := newRefreshingTokenSource(context.Background(), cfg, tok, tokenInf(tok), mockStore, crypteng)
+
+		got, err := ts.Token()
+		require.NoError(t, err)
+		assert.Equal(t, "refreshed", got.AccessToken)
+	})
+
+	t.Run("failing to store the refreshed token is an error", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockStore := mockdb.NewMockStore(ctrl)
+
+		tok := &oauth2.Token{AccessToken: "current", RefreshToken: "good", Expiry: time.Now().Add(time.Minute)}
+		expectLockedToken(mockStore, tokenInf(tok))
+		mockStore.EXPECT().UpdateAccessToken(gomock.Any(), gomock.Any()).
+			Return(db.ProviderAccessToken{}, errors.New("connection reset"))
+
+		ts := newRefreshingTokenSource(context.Background(), cfg, tok, tokenInf(tok), mockStore, crypteng)
+
+		_, err := ts.Token()
+		assert.ErrorContains(t, err, "connection reset")
+	})
+
+	t.Run("failed refresh marks the provider unhealthy", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockStore := mockdb.NewMockStore(ctrl)
+
+		tok := &oauth2.Token{AccessToken: "current", RefreshToken: "revoked", Expiry: time.Now().Add(-time.Minute)}
+		expectLockedToken(mockStore, tokenInf(tok))
+		mockStore.EXPECT().UpdateProviderHealth(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, arg db.UpdateProviderHealthParams) error {
+				assert.Equal(t, db.ProviderHealthUnhealthy, arg.Health)
+				assert.Contains(t, arg.HealthDetail, "invalid_grant")
+				return nil
+			})
+
+		ts := newRefreshingTokenSource(context.Background(), cfg, tok, tokenInf(tok), mockStore, crypteng)
+
+		_, err := ts.Token()
+		assert.Error(t, err)
+	})
+}
+
+func TestTokenRefresher(t *testing.T) {
+	t.Parallel()
+
+	cfg := newFakeTokenEndpoint(t)
+	crypteng := crypto.NewEngine("test")
+	refreshable := uuid.New()
+	unrefreshable := uuid.New()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStore := mockdb.NewMockStore(ctrl)
+
+	expiring := db.ProviderAccessToken{
+		Provider:       "gitlab",
+		ProjectID:      refreshable,
+		EncryptedToken: encryptToken(t, crypteng, &oauth2.Token{AccessToken: "current", RefreshToken: "good"}),
+		ExpirationTime: time.Now().Add(5 * time.Minute),
+	}
+	mockStore.EXPECT().ListAccessTokensExpiringBefore(gomock.Any(), gomock.Any()).Return([]db.ProviderAccessToken{
+		expiring,
+		{
+			Provider:       "gitlab",
+			ProjectID:      unrefreshable,
+			EncryptedToken: encryptToken(t, crypteng, &oauth2.Token{AccessToken: "current"}),
+			ExpirationTime: time.Now().Add(-time.Minute),
+		},
+	}, nil)
+
+	mockStore.EXPECT().GetProviderByName(gomock.Any(), db.GetProviderByNameParams{
+		Name:      "gitlab",
+		ProjectID: refreshable,
+	}).Return(db.Provider{Name: "gitlab", ProjectID: refreshable, Implements: []db.ProviderType{db.ProviderTypeGitlab}}, nil)
+	expectLockedToken(mockStore, expiring)
+	mockStore.EXPECT().UpdateAccessToken(gomock.Any(), gomock.Any()).Return(expiring, nil)
+	mockStore.EXPECT().Commit(gomock.Any()).Return(nil)
+	mockStore.EXPECT().UpdateProviderHealth(gomock.Any(), db.UpdateProviderHealthParams{
+		Name:      "gitlab",
+		ProjectID: refreshable,
+		Health:    db.ProviderHealthHealthy,
+	}).Return(nil)
+
+	mockStore.EXPECT().UpdateProviderHealth(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.UpdateProviderHealthParams) error {
+			assert.Equal(t, unrefreshable, arg.ProjectID)
+			assert.Equal(t, db.ProviderHealthUnhealthy, arg.Health)
+			return nil
+		})
+
+	refresher := NewTokenRefresher(mockStore, crypteng, WithOAuthConfig(func(_ *db.Provider) (*oauth2.Config, error) {
+		return cfg, nil
+	}))
+	require.NoError(t, refresher.RefreshExpiring(context.Background()))
+}
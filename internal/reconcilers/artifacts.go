@@ -21,21 +21,27 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"math"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
+	"golang.org/x/exp/slices"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/stacklok/minder/internal/container"
 	"github.com/stacklok/minder/internal/db"
 	"github.com/stacklok/minder/internal/engine"
+	"github.com/stacklok/minder/internal/events"
 	"github.com/stacklok/minder/internal/providers"
 	"github.com/stacklok/minder/internal/providers/github"
+	"github.com/stacklok/minder/internal/providers/oci"
+	"github.com/stacklok/minder/internal/util"
 	pb "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
 )
 
@@ -85,15 +91,24 @@ func (e *Reconciler) handleRepoReconcilerEvent(msg *message.Message) error {
 
 	ctx := msg.Context()
 	log.Printf("handling reconciler event for project %s and repository %d", evt.Project.String(), evt.Repository)
-	return e.handleArtifactsReconcilerEvent(ctx, &evt)
+	return e.handleArtifactsReconcilerEvent(ctx, msg.Metadata.Get("provider"), &evt)
 }
 
 // HandleArtifactsReconcilerEvent recreates the artifacts belonging to
 // an specific repository
 // nolint: gocyclo
-func (e *Reconciler) handleArtifactsReconcilerEvent(ctx context.Context, evt *RepoReconcilerEvent) error {
-	// first retrieve data for the repository
-	repository, err := e.store.GetRepositoryByRepoID(ctx, evt.Repository)
+func (e *Reconciler) handleArtifactsReconcilerEvent(
+	ctx context.Context,
+	provider string,
+	evt *RepoReconcilerEvent,
+) error {
+	// first retrieve data for the repository, the ID of which is only unique
+	// within the provider it was registered through
+	repository, err := e.store.GetRepositoryByIDAndProject(ctx, db.GetRepositoryByIDAndProjectParams{
+		Provider:  provider,
+		RepoID:    evt.Repository,
+		ProjectID: evt.Project,
+	})
 	if err != nil {
 		return fmt.Errorf("error retrieving repository: %w", err)
 	}
@@ -108,6 +123,7 @@ func (e *Reconciler) handleArtifactsReconcilerEvent(ctx context.Context, evt *Re
 
 	pbOpts := []providers.ProviderBuilderOption{
 		providers.WithProviderMetrics(e.provMt),
+		providers.WithGitHubApp(e.ghApp),
 	}
 	p, err := providers.GetProviderBuilder(ctx, prov, evt.Project, e.store, e.crypteng, pbOpts...)
 	if err != nil {
@@ -136,6 +152,12 @@ func (e *Reconciler) handleArtifactsReconcilerEvent(ctx context.Context, evt *Re
 		return fmt.Errorf("error publishing message: %w", err)
 	}
 
+	// images in the OCI registries enrolled in the project are linked to
+	// the repository by their path
+	if err := e.handleOCIArtifacts(ctx, prov.Name, evt.Project, repository); err != nil {
+		return err
+	}
+
 	if !p.Implements(db.ProviderTypeGithub) {
 		log.Printf("provider %s is not supported for artifacts reconciler", prov.Name)
 		return nil
@@ -181,7 +203,9 @@ func (e *Reconciler) handleArtifactsReconcilerEvent(ctx context.Context, evt *Re
 
 		// now query for versions, retrieve the ones from last month
 		versions, err := cli.GetPackageVersions(ctx, isOrg, repository.RepoOwner, artifact.GetPackageType(), artifact.GetName())
-		if err != nil {
+		if errors.Is(err, events.ErrRetriable) {
+			return err
+		} else if err != nil {
 			// just log error and continue
 			log.Printf("error retrieving artifact versions: %v", err)
 			continue
@@ -273,3 +297,216 @@ func (e *Reconciler) handleArtifactsReconcilerEvent(ctx context.Context, evt *Re
 	}
 	return nil
 }
+
+// handleOCIArtifacts stores the images found at the owner/name path of the
+// repository in the OCI registry providers of the project, and publishes
+// them as artifacts of the repository.
+func (e *Reconciler) handleOCIArtifacts(
+	ctx context.Context,
+	repoProvider string,
+	projectID uuid.UUID,
+	repository db.Repository,
+) error {
+	provs, err := e.store.ListProvidersByProjectID(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("error retrieving providers: %w", err)
+	}
+
+	for _, prov := range provs {
+		if !slices.Contains(prov.Implements, db.ProviderTypeOci) {
+			continue
+		}
+
+		p, err := providers.GetProviderBuilder(ctx, prov, projectID, e.store, e.crypteng,
+			providers.WithProviderMetrics(e.provMt))
+		if err != nil {
+			log.Printf("error building client for provider %s: %v", prov.Name, err)
+			continue
+		}
+
+		cli, err := providers.ClientAs[*oci.Client](ctx, p)
+		if err != nil {
+			log.Printf("error getting oci client for provider %s: %v", prov.Name, err)
+			continue
+		}
+
+		err = e.handleOCIRepository(ctx, cli, repoProvider, projectID, repository)
+		if errors.Is(err, events.ErrRetriable) {
+			return err
+		} else if err != nil {
+			// just log error and continue with the next registry
+			log.Printf("error reconciling images of %s: %v", cli.GetRegistry(), err)
+		}
+	}
+	return nil
+}
+
+func (e *Reconciler) handleOCIRepository(
+	ctx context.Context,
+	cli *oci.Client,
+	repoProvider string,
+	projectID uuid.UUID,
+	repository db.Repository,
+) error {
+	path := strings.ToLower(repository.RepoOwner + "/" + repository.RepoName)
+	images, err := cli.ListImages(ctx, path)
+	if errors.Is(err, oci.ErrNotFound) {
+		// it's a valid use case for a repository to not have images in the registry
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	// registries accessed anonymously only serve public images
+	visibility := "private"
+	if cli.GetToken() == "" {
+		visibility = "public"
+	}
+
+	// the images are named after their location in the registry, which
+	// keeps them apart from the GitHub packages of the repository
+	artifactName := cli.GetRegistry() + "/" + path
+	stored, err := e.storedVersionTimes(ctx, repository.ID, artifactName)
+	if err != nil {
+		return err
+	}
+
+	var artifactID uuid.UUID
+	for _, image := range images {
+		ref, err := cli.Reference(path, image.Digest)
+		if err != nil {
+			log.Printf("error parsing image reference: %v", err)
+			continue
+		}
+
+		sigInfo, workflowInfo, err := container.GetOCIArtifactSignatureAndWorkflowInfo(
+			ctx, cli.GetAuthenticator(), ref)
+		if errors.Is(err, container.ErrSigValidation) {
+			// just log error and continue
+			log.Printf("error validating signature: %v", err)
+			continue
+		} else if errors.Is(err, container.ErrProtoParse) {
+			// log error and just pass an empty json
+			log.Printf("error getting bytes from proto: %v", err)
+		} else if err != nil {
+			return fmt.Errorf("error getting signature and workflow info: %w", err)
+		}
+
+		sigVerification := &pb.SignatureVerification{}
+		if err := protojson.Unmarshal(sigInfo, sigVerification); err != nil {
+			log.Printf("error unmarshalling signature verification: %v", err)
+			continue
+		}
+		ghWorkflow := &pb.GithubWorkflow{}
+		if err := protojson.Unmarshal(workflowInfo, ghWorkflow); err != nil {
+			log.Printf("error unmarshalling github workflow: %v", err)
+			continue
+		}
+
+		versionID, err := versionIDFromDigest(image.Digest)
+		if err != nil {
+			log.Printf("error getting version ID: %v", err)
+			continue
+		}
+
+		// registries don't tell when a manifest was pushed, so images which
+		// don't record their creation keep the time they were first seen
+		createdAt := image.Created
+		if createdAt.IsZero() {
+			createdAt = stored[image.Digest]
+		}
+		if createdAt.IsZero() {
+			createdAt = time.Now()
+		}
+
+		dbArtifact, _, err := util.UpsertVersionedArtifact(ctx, repository, &pb.Artifact{
+			Owner:      repository.RepoOwner,
+			Name:       artifactName,
+			Type:       CONTAINER_TYPE,
+			Visibility: visibility,
+			Repository: repository.RepoName,
+			Versions: []*pb.ArtifactVersion{{
+				VersionId:             versionID,
+				Tags:                  image.Tags,
+				Sha:                   image.Digest,
+				SignatureVerification: sigVerification,
+				GithubWorkflow:        ghWorkflow,
+				CreatedAt:             timestamppb.New(createdAt),
+			}},
+		}, e.store)
+		if err != nil {
+			// just log error and continue
+			log.Printf("error storing artifact version: %v", err)
+			continue
+		}
+		artifactID = dbArtifact.ID
+	}
+
+	if artifactID == uuid.Nil {
+		return nil
+	}
+
+	pbArtifact, err := util.GetArtifactWithVersions(ctx, e.store, repository.ID, artifactID)
+	if err != nil {
+		return fmt.Errorf("error getting artifact with versions: %w", err)
+	}
+
+	// the artifact is evaluated with the rule types of the repository's provider
+	err = engine.NewEntityInfoWrapper().
+		WithProvider(repoProvider).
+		WithArtifact(pbArtifact).
+		WithProjectID(projectID).
+		WithArtifactID(artifactID).
+		WithRepositoryID(repository.ID).
+		Publish(e.evt)
+	if err != nil {
+		return fmt.Errorf("error publishing message: %w", err)
+	}
+	return nil
+}
+
+// storedVersionTimes returns the creation times of the stored versions of
+// an artifact of the repository, by digest
+func (e *Reconciler) storedVersionTimes(
+	ctx context.Context,
+	repoID uuid.UUID,
+	artifactName string,
+) (map[string]time.Time, error) {
+	artifacts, err := e.store.ListArtifactsByRepoID(ctx, repoID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing artifacts: %w", err)
+	}
+
+	times := map[string]time.Time{}
+	for _, a := range artifacts {
+		if !strings.EqualFold(a.ArtifactName, artifactName) {
+			continue
+		}
+		versions, err := e.store.ListArtifactVersionsByArtifactID(ctx,
+			db.ListArtifactVersionsByArtifactIDParams{ArtifactID: a.ID})
+		if err != nil {
+			return nil, fmt.Errorf("error listing artifact versions: %w", err)
+		}
+		for _, v := range versions {
+			times[v.Sha] = v.CreatedAt
+		}
+	}
+	return times, nil
+}
+
+// versionIDFromDigest derives the ID of an artifact version from the digest
+// of its manifest, since OCI registries have no notion of version IDs.
+// Versions are stored by digest, so the ID only labels them; it is never
+// used to look a version up in GitHub Packages.
+func versionIDFromDigest(digest string) (int64, error) {
+	_, hex, ok := strings.Cut(digest, ":")
+	if !ok || len(hex) < 16 {
+		return 0, fmt.Errorf("invalid digest: %s", digest)
+	}
+	id, err := strconv.ParseUint(hex[:16], 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid digest: %s", digest)
+	}
+	// keep 63 bits, so that the ID is positive
+	return int64(id & math.MaxInt64), nil
+}
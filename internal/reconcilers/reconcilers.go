@@ -21,6 +21,7 @@ import (
 	"github.com/stacklok/minder/internal/crypto"
 	"github.com/stacklok/minder/internal/db"
 	"github.com/stacklok/minder/internal/events"
+	ghprov "github.com/stacklok/minder/internal/providers/github"
 	providertelemetry "github.com/stacklok/minder/internal/providers/telemetry"
 )
 
@@ -37,6 +38,7 @@ type Reconciler struct {
 	evt      *events.Eventer
 	crypteng *crypto.Engine
 	provMt   providertelemetry.ProviderMetrics
+	ghApp    *ghprov.App
 }
 
 // ReconcilerOption is a function that modifies a reconciler
@@ -49,6 +51,14 @@ func WithProviderMetrics(mt providertelemetry.ProviderMetrics) ReconcilerOption
 	}
 }
 
+// WithGitHubApp sets the GitHub App used to authenticate GitHub providers
+// that have an installation
+func WithGitHubApp(app *ghprov.App) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.ghApp = app
+	}
+}
+
 // NewReconciler creates a new reconciler object
 func NewReconciler(
 	store db.Store,
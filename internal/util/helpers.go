@@ -46,6 +46,7 @@ import (
 	_ "github.com/signalfx/splunk-otel-go/instrumentation/github.com/lib/pq/splunkpq" // nolint
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"golang.org/x/exp/slices"
 	"golang.org/x/term"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -587,3 +588,116 @@ func GetArtifactWithVersions(ctx context.Context, store db.Store, repoID, artifa
 		CreatedAt:  timestamppb.New(artifact.CreatedAt),
 	}, nil
 }
+
+// UpsertVersionedArtifact stores an artifact and its first version, moving
+// the tags of the version away from any other version of the artifact, and
+// removes versions older than thirty days
+func UpsertVersionedArtifact(
+	ctx context.Context,
+	dbrepo db.Repository,
+	artifact *minderv1.Artifact,
+	store db.Store,
+) (*db.Artifact, *db.ArtifactVersion, error) {
+	// we expect to have only one version at this point, the one from this webhook update
+	newArtifactVersion := artifact.Versions[0]
+	sigInfo, err := protojson.Marshal(newArtifactVersion.SignatureVerification)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error marshalling signature verification: %w", err)
+	}
+
+	workflowInfo, err := protojson.Marshal(newArtifactVersion.GithubWorkflow)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error marshalling workflow info: %w", err)
+	}
+
+	tx, err := store.BeginTransaction()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	qtx := store.GetQuerierWithTransaction(tx)
+
+	dbArtifact, err := qtx.UpsertArtifact(ctx, db.UpsertArtifactParams{
+		RepositoryID:       dbrepo.ID,
+		ArtifactName:       artifact.GetName(),
+		ArtifactType:       artifact.GetType(),
+		ArtifactVisibility: artifact.Visibility,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error upserting artifact: %w", err)
+	}
+
+	thirtyDaysAgo := time.Now().AddDate(0, 0, -30)
+	err = qtx.DeleteOldArtifactVersions(ctx,
+		db.DeleteOldArtifactVersionsParams{ArtifactID: dbArtifact.ID, CreatedAt: thirtyDaysAgo})
+	if err != nil {
+		// just log error, we will not remove older for now
+		log.Printf("error removing older artifact versions: %v", err)
+	}
+
+	// To avoid conflicts, we search for all existing entries that have the incoming tag in their Tags field.
+	// If found, the existing artifact is updated by removing the incoming tag from its tags column.
+	// Loop through all incoming tags
+	for _, incomingTag := range newArtifactVersion.Tags {
+		// Search artifact versions having the incoming tag (there should be at most 1 or no matches at all)
+		existingArtifactVersions, err := qtx.ListArtifactVersionsByArtifactIDAndTag(ctx,
+			db.ListArtifactVersionsByArtifactIDAndTagParams{ArtifactID: dbArtifact.ID,
+				Tags:  sql.NullString{Valid: true, String: incomingTag},
+				Limit: sql.NullInt32{Valid: false, Int32: 0}})
+		if errors.Is(err, sql.ErrNoRows) {
+			// There are no tagged versions matching the incoming tag, all okay
+			continue
+		} else if err != nil {
+			// Unexpected failure
+			return nil, nil, fmt.Errorf("failed during repository synchronization: %w", err)
+		}
+		// Loop through all artifact versions that matched the incoming tag
+		for _, existing := range existingArtifactVersions {
+			if !existing.Tags.Valid {
+				continue
+			}
+			// Rebuild the list of tags removing anything that would conflict with the incoming tag
+			newTags := slices.DeleteFunc(strings.Split(existing.Tags.String, ","), func(in string) bool { return in == incomingTag })
+			newTagsSQL := sql.NullString{String: strings.Join(newTags, ",")}
+			newTagsSQL.Valid = len(newTagsSQL.String) > 0
+			// Update the versioned artifact row in the store (we shouldn't change anything else except the tags value)
+			_, err := qtx.UpsertArtifactVersion(ctx, db.UpsertArtifactVersionParams{
+				ArtifactID:            existing.ArtifactID,
+				Version:               existing.Version,
+				Tags:                  newTagsSQL,
+				Sha:                   existing.Sha,
+				CreatedAt:             existing.CreatedAt,
+				SignatureVerification: existing.SignatureVerification.RawMessage,
+				GithubWorkflow:        existing.GithubWorkflow.RawMessage,
+			})
+			if err != nil {
+				return nil, nil, fmt.Errorf("error upserting artifact %s with version %d: %w", existing.ArtifactID, existing.Version, err)
+			}
+		}
+	}
+
+	// Proceed storing the new versioned artifact
+	dbVersion, err := qtx.UpsertArtifactVersion(ctx, db.UpsertArtifactVersionParams{
+		ArtifactID: dbArtifact.ID,
+		Version:    newArtifactVersion.VersionId,
+		Tags: sql.NullString{
+			String: strings.Join(newArtifactVersion.Tags, ","),
+			Valid:  true,
+		},
+		Sha:                   newArtifactVersion.Sha,
+		CreatedAt:             newArtifactVersion.CreatedAt.AsTime(),
+		SignatureVerification: sigInfo,
+		GithubWorkflow:        workflowInfo,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error upserting artifact version: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return &dbArtifact, &dbVersion, nil
+}
@@ -252,13 +252,15 @@ var ArtifactService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	OAuthService_GetAuthorizationURL_FullMethodName     = "/minder.v1.OAuthService/GetAuthorizationURL"
-	OAuthService_ExchangeCodeForTokenCLI_FullMethodName = "/minder.v1.OAuthService/ExchangeCodeForTokenCLI"
-	OAuthService_ExchangeCodeForTokenWEB_FullMethodName = "/minder.v1.OAuthService/ExchangeCodeForTokenWEB"
-	OAuthService_StoreProviderToken_FullMethodName      = "/minder.v1.OAuthService/StoreProviderToken"
-	OAuthService_RevokeOauthTokens_FullMethodName       = "/minder.v1.OAuthService/RevokeOauthTokens"
-	OAuthService_RevokeOauthProjectToken_FullMethodName = "/minder.v1.OAuthService/RevokeOauthProjectToken"
-	OAuthService_VerifyProviderTokenFrom_FullMethodName = "/minder.v1.OAuthService/VerifyProviderTokenFrom"
+	OAuthService_GetAuthorizationURL_FullMethodName      = "/minder.v1.OAuthService/GetAuthorizationURL"
+	OAuthService_ExchangeCodeForTokenCLI_FullMethodName  = "/minder.v1.OAuthService/ExchangeCodeForTokenCLI"
+	OAuthService_ExchangeCodeForTokenWEB_FullMethodName  = "/minder.v1.OAuthService/ExchangeCodeForTokenWEB"
+	OAuthService_StoreProviderToken_FullMethodName       = "/minder.v1.OAuthService/StoreProviderToken"
+	OAuthService_RevokeOauthTokens_FullMethodName        = "/minder.v1.OAuthService/RevokeOauthTokens"
+	OAuthService_RevokeOauthProjectToken_FullMethodName  = "/minder.v1.OAuthService/RevokeOauthProjectToken"
+	OAuthService_VerifyProviderTokenFrom_FullMethodName  = "/minder.v1.OAuthService/VerifyProviderTokenFrom"
+	OAuthService_GetProviderHealth_FullMethodName        = "/minder.v1.OAuthService/GetProviderHealth"
+	OAuthService_CheckProviderPermissions_FullMethodName = "/minder.v1.OAuthService/CheckProviderPermissions"
 )
 
 // OAuthServiceClient is the client API for OAuthService service.
@@ -277,6 +279,11 @@ type OAuthServiceClient interface {
 	RevokeOauthProjectToken(ctx context.Context, in *RevokeOauthProjectTokenRequest, opts ...grpc.CallOption) (*RevokeOauthProjectTokenResponse, error)
 	// VerifyProviderTokenFrom verifies that a token has been created for a provider since given timestamp
 	VerifyProviderTokenFrom(ctx context.Context, in *VerifyProviderTokenFromRequest, opts ...grpc.CallOption) (*VerifyProviderTokenFromResponse, error)
+	// GetProviderHealth returns the health of a provider's credentials
+	GetProviderHealth(ctx context.Context, in *GetProviderHealthRequest, opts ...grpc.CallOption) (*GetProviderHealthResponse, error)
+	// CheckProviderPermissions checks the permissions granted to a provider's
+	// credentials against the permissions needed by the rule types in use
+	CheckProviderPermissions(ctx context.Context, in *CheckProviderPermissionsRequest, opts ...grpc.CallOption) (*CheckProviderPermissionsResponse, error)
 }
 
 type oAuthServiceClient struct {
@@ -350,6 +357,24 @@ func (c *oAuthServiceClient) VerifyProviderTokenFrom(ctx context.Context, in *Ve
 	return out, nil
 }
 
+func (c *oAuthServiceClient) GetProviderHealth(ctx context.Context, in *GetProviderHealthRequest, opts ...grpc.CallOption) (*GetProviderHealthResponse, error) {
+	out := new(GetProviderHealthResponse)
+	err := c.cc.Invoke(ctx, OAuthService_GetProviderHealth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuthServiceClient) CheckProviderPermissions(ctx context.Context, in *CheckProviderPermissionsRequest, opts ...grpc.CallOption) (*CheckProviderPermissionsResponse, error) {
+	out := new(CheckProviderPermissionsResponse)
+	err := c.cc.Invoke(ctx, OAuthService_CheckProviderPermissions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OAuthServiceServer is the server API for OAuthService service.
 // All implementations must embed UnimplementedOAuthServiceServer
 // for forward compatibility
@@ -366,6 +391,11 @@ type OAuthServiceServer interface {
 	RevokeOauthProjectToken(context.Context, *RevokeOauthProjectTokenRequest) (*RevokeOauthProjectTokenResponse, error)
 	// VerifyProviderTokenFrom verifies that a token has been created for a provider since given timestamp
 	VerifyProviderTokenFrom(context.Context, *VerifyProviderTokenFromRequest) (*VerifyProviderTokenFromResponse, error)
+	// GetProviderHealth returns the health of a provider's credentials
+	GetProviderHealth(context.Context, *GetProviderHealthRequest) (*GetProviderHealthResponse, error)
+	// CheckProviderPermissions checks the permissions granted to a provider's
+	// credentials against the permissions needed by the rule types in use
+	CheckProviderPermissions(context.Context, *CheckProviderPermissionsRequest) (*CheckProviderPermissionsResponse, error)
 	mustEmbedUnimplementedOAuthServiceServer()
 }
 
@@ -394,6 +424,12 @@ func (UnimplementedOAuthServiceServer) RevokeOauthProjectToken(context.Context,
 func (UnimplementedOAuthServiceServer) VerifyProviderTokenFrom(context.Context, *VerifyProviderTokenFromRequest) (*VerifyProviderTokenFromResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyProviderTokenFrom not implemented")
 }
+func (UnimplementedOAuthServiceServer) GetProviderHealth(context.Context, *GetProviderHealthRequest) (*GetProviderHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProviderHealth not implemented")
+}
+func (UnimplementedOAuthServiceServer) CheckProviderPermissions(context.Context, *CheckProviderPermissionsRequest) (*CheckProviderPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckProviderPermissions not implemented")
+}
 func (UnimplementedOAuthServiceServer) mustEmbedUnimplementedOAuthServiceServer() {}
 
 // UnsafeOAuthServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -533,6 +569,42 @@ func _OAuthService_VerifyProviderTokenFrom_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OAuthService_GetProviderHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProviderHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthServiceServer).GetProviderHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthService_GetProviderHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthServiceServer).GetProviderHealth(ctx, req.(*GetProviderHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuthService_CheckProviderPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckProviderPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuthServiceServer).CheckProviderPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuthService_CheckProviderPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuthServiceServer).CheckProviderPermissions(ctx, req.(*CheckProviderPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OAuthService_ServiceDesc is the grpc.ServiceDesc for OAuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -568,6 +640,14 @@ var OAuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyProviderTokenFrom",
 			Handler:    _OAuthService_VerifyProviderTokenFrom_Handler,
 		},
+		{
+			MethodName: "GetProviderHealth",
+			Handler:    _OAuthService_GetProviderHealth_Handler,
+		},
+		{
+			MethodName: "CheckProviderPermissions",
+			Handler:    _OAuthService_CheckProviderPermissions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "minder/v1/minder.proto",
@@ -1153,6 +1233,10 @@ const (
 	ProfileService_CreateRuleType_FullMethodName            = "/minder.v1.ProfileService/CreateRuleType"
 	ProfileService_UpdateRuleType_FullMethodName            = "/minder.v1.ProfileService/UpdateRuleType"
 	ProfileService_DeleteRuleType_FullMethodName            = "/minder.v1.ProfileService/DeleteRuleType"
+	ProfileService_ListPendingRemediations_FullMethodName   = "/minder.v1.ProfileService/ListPendingRemediations"
+	ProfileService_ApproveRemediation_FullMethodName        = "/minder.v1.ProfileService/ApproveRemediation"
+	ProfileService_RejectRemediation_FullMethodName         = "/minder.v1.ProfileService/RejectRemediation"
+	ProfileService_RollbackRemediation_FullMethodName       = "/minder.v1.ProfileService/RollbackRemediation"
 )
 
 // ProfileServiceClient is the client API for ProfileService service.
@@ -1171,6 +1255,10 @@ type ProfileServiceClient interface {
 	CreateRuleType(ctx context.Context, in *CreateRuleTypeRequest, opts ...grpc.CallOption) (*CreateRuleTypeResponse, error)
 	UpdateRuleType(ctx context.Context, in *UpdateRuleTypeRequest, opts ...grpc.CallOption) (*UpdateRuleTypeResponse, error)
 	DeleteRuleType(ctx context.Context, in *DeleteRuleTypeRequest, opts ...grpc.CallOption) (*DeleteRuleTypeResponse, error)
+	ListPendingRemediations(ctx context.Context, in *ListPendingRemediationsRequest, opts ...grpc.CallOption) (*ListPendingRemediationsResponse, error)
+	ApproveRemediation(ctx context.Context, in *ApproveRemediationRequest, opts ...grpc.CallOption) (*ApproveRemediationResponse, error)
+	RejectRemediation(ctx context.Context, in *RejectRemediationRequest, opts ...grpc.CallOption) (*RejectRemediationResponse, error)
+	RollbackRemediation(ctx context.Context, in *RollbackRemediationRequest, opts ...grpc.CallOption) (*RollbackRemediationResponse, error)
 }
 
 type profileServiceClient struct {
@@ -1289,6 +1377,42 @@ func (c *profileServiceClient) DeleteRuleType(ctx context.Context, in *DeleteRul
 	return out, nil
 }
 
+func (c *profileServiceClient) ListPendingRemediations(ctx context.Context, in *ListPendingRemediationsRequest, opts ...grpc.CallOption) (*ListPendingRemediationsResponse, error) {
+	out := new(ListPendingRemediationsResponse)
+	err := c.cc.Invoke(ctx, ProfileService_ListPendingRemediations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) ApproveRemediation(ctx context.Context, in *ApproveRemediationRequest, opts ...grpc.CallOption) (*ApproveRemediationResponse, error) {
+	out := new(ApproveRemediationResponse)
+	err := c.cc.Invoke(ctx, ProfileService_ApproveRemediation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) RejectRemediation(ctx context.Context, in *RejectRemediationRequest, opts ...grpc.CallOption) (*RejectRemediationResponse, error) {
+	out := new(RejectRemediationResponse)
+	err := c.cc.Invoke(ctx, ProfileService_RejectRemediation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) RollbackRemediation(ctx context.Context, in *RollbackRemediationRequest, opts ...grpc.CallOption) (*RollbackRemediationResponse, error) {
+	out := new(RollbackRemediationResponse)
+	err := c.cc.Invoke(ctx, ProfileService_RollbackRemediation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProfileServiceServer is the server API for ProfileService service.
 // All implementations must embed UnimplementedProfileServiceServer
 // for forward compatibility
@@ -1305,6 +1429,10 @@ type ProfileServiceServer interface {
 	CreateRuleType(context.Context, *CreateRuleTypeRequest) (*CreateRuleTypeResponse, error)
 	UpdateRuleType(context.Context, *UpdateRuleTypeRequest) (*UpdateRuleTypeResponse, error)
 	DeleteRuleType(context.Context, *DeleteRuleTypeRequest) (*DeleteRuleTypeResponse, error)
+	ListPendingRemediations(context.Context, *ListPendingRemediationsRequest) (*ListPendingRemediationsResponse, error)
+	ApproveRemediation(context.Context, *ApproveRemediationRequest) (*ApproveRemediationResponse, error)
+	RejectRemediation(context.Context, *RejectRemediationRequest) (*RejectRemediationResponse, error)
+	RollbackRemediation(context.Context, *RollbackRemediationRequest) (*RollbackRemediationResponse, error)
 	mustEmbedUnimplementedProfileServiceServer()
 }
 
@@ -1348,6 +1476,18 @@ func (UnimplementedProfileServiceServer) UpdateRuleType(context.Context, *Update
 func (UnimplementedProfileServiceServer) DeleteRuleType(context.Context, *DeleteRuleTypeRequest) (*DeleteRuleTypeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRuleType not implemented")
 }
+func (UnimplementedProfileServiceServer) ListPendingRemediations(context.Context, *ListPendingRemediationsRequest) (*ListPendingRemediationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingRemediations not implemented")
+}
+func (UnimplementedProfileServiceServer) ApproveRemediation(context.Context, *ApproveRemediationRequest) (*ApproveRemediationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRemediation not implemented")
+}
+func (UnimplementedProfileServiceServer) RejectRemediation(context.Context, *RejectRemediationRequest) (*RejectRemediationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectRemediation not implemented")
+}
+func (UnimplementedProfileServiceServer) RollbackRemediation(context.Context, *RollbackRemediationRequest) (*RollbackRemediationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackRemediation not implemented")
+}
 func (UnimplementedProfileServiceServer) mustEmbedUnimplementedProfileServiceServer() {}
 
 // UnsafeProfileServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1577,6 +1717,78 @@ func _ProfileService_DeleteRuleType_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProfileService_ListPendingRemediations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingRemediationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).ListPendingRemediations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_ListPendingRemediations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).ListPendingRemediations(ctx, req.(*ListPendingRemediationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_ApproveRemediation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveRemediationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).ApproveRemediation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_ApproveRemediation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).ApproveRemediation(ctx, req.(*ApproveRemediationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_RejectRemediation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectRemediationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).RejectRemediation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_RejectRemediation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).RejectRemediation(ctx, req.(*RejectRemediationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_RollbackRemediation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackRemediationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).RollbackRemediation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_RollbackRemediation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).RollbackRemediation(ctx, req.(*RollbackRemediationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProfileService_ServiceDesc is the grpc.ServiceDesc for ProfileService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1632,6 +1844,260 @@ var ProfileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRuleType",
 			Handler:    _ProfileService_DeleteRuleType_Handler,
 		},
+		{
+			MethodName: "ListPendingRemediations",
+			Handler:    _ProfileService_ListPendingRemediations_Handler,
+		},
+		{
+			MethodName: "ApproveRemediation",
+			Handler:    _ProfileService_ApproveRemediation_Handler,
+		},
+		{
+			MethodName: "RejectRemediation",
+			Handler:    _ProfileService_RejectRemediation_Handler,
+		},
+		{
+			MethodName: "RollbackRemediation",
+			Handler:    _ProfileService_RollbackRemediation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "minder/v1/minder.proto",
+}
+
+const (
+	ProvidersService_CreateProvider_FullMethodName = "/minder.v1.ProvidersService/CreateProvider"
+	ProvidersService_ListProviders_FullMethodName  = "/minder.v1.ProvidersService/ListProviders"
+	ProvidersService_GetProvider_FullMethodName    = "/minder.v1.ProvidersService/GetProvider"
+	ProvidersService_UpdateProvider_FullMethodName = "/minder.v1.ProvidersService/UpdateProvider"
+	ProvidersService_DeleteProvider_FullMethodName = "/minder.v1.ProvidersService/DeleteProvider"
+)
+
+// ProvidersServiceClient is the client API for ProvidersService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProvidersServiceClient interface {
+	CreateProvider(ctx context.Context, in *CreateProviderRequest, opts ...grpc.CallOption) (*CreateProviderResponse, error)
+	ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersResponse, error)
+	GetProvider(ctx context.Context, in *GetProviderRequest, opts ...grpc.CallOption) (*GetProviderResponse, error)
+	UpdateProvider(ctx context.Context, in *UpdateProviderRequest, opts ...grpc.CallOption) (*UpdateProviderResponse, error)
+	DeleteProvider(ctx context.Context, in *DeleteProviderRequest, opts ...grpc.CallOption) (*DeleteProviderResponse, error)
+}
+
+type providersServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProvidersServiceClient(cc grpc.ClientConnInterface) ProvidersServiceClient {
+	return &providersServiceClient{cc}
+}
+
+func (c *providersServiceClient) CreateProvider(ctx context.Context, in *CreateProviderRequest, opts ...grpc.CallOption) (*CreateProviderResponse, error) {
+	out := new(CreateProviderResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_CreateProvider_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersResponse, error) {
+	out := new(ListProvidersResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_ListProviders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) GetProvider(ctx context.Context, in *GetProviderRequest, opts ...grpc.CallOption) (*GetProviderResponse, error) {
+	out := new(GetProviderResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_GetProvider_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) UpdateProvider(ctx context.Context, in *UpdateProviderRequest, opts ...grpc.CallOption) (*UpdateProviderResponse, error) {
+	out := new(UpdateProviderResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_UpdateProvider_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) DeleteProvider(ctx context.Context, in *DeleteProviderRequest, opts ...grpc.CallOption) (*DeleteProviderResponse, error) {
+	out := new(DeleteProviderResponse)
+	err := c.cc.Invoke(ctx, ProvidersService_DeleteProvider_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProvidersServiceServer is the server API for ProvidersService service.
+// All implementations must embed UnimplementedProvidersServiceServer
+// for forward compatibility
+type ProvidersServiceServer interface {
+	CreateProvider(context.Context, *CreateProviderRequest) (*CreateProviderResponse, error)
+	ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersResponse, error)
+	GetProvider(context.Context, *GetProviderRequest) (*GetProviderResponse, error)
+	UpdateProvider(context.Context, *UpdateProviderRequest) (*UpdateProviderResponse, error)
+	DeleteProvider(context.Context, *DeleteProviderRequest) (*DeleteProviderResponse, error)
+	mustEmbedUnimplementedProvidersServiceServer()
+}
+
+// UnimplementedProvidersServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedProvidersServiceServer struct {
+}
+
+func (UnimplementedProvidersServiceServer) CreateProvider(context.Context, *CreateProviderRequest) (*CreateProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProvider not implemented")
+}
+func (UnimplementedProvidersServiceServer) ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProviders not implemented")
+}
+func (UnimplementedProvidersServiceServer) GetProvider(context.Context, *GetProviderRequest) (*GetProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProvider not implemented")
+}
+func (UnimplementedProvidersServiceServer) UpdateProvider(context.Context, *UpdateProviderRequest) (*UpdateProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProvider not implemented")
+}
+func (UnimplementedProvidersServiceServer) DeleteProvider(context.Context, *DeleteProviderRequest) (*DeleteProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProvider not implemented")
+}
+func (UnimplementedProvidersServiceServer) mustEmbedUnimplementedProvidersServiceServer() {}
+
+// UnsafeProvidersServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProvidersServiceServer will
+// result in compilation errors.
+type UnsafeProvidersServiceServer interface {
+	mustEmbedUnimplementedProvidersServiceServer()
+}
+
+func RegisterProvidersServiceServer(s grpc.ServiceRegistrar, srv ProvidersServiceServer) {
+	s.RegisterService(&ProvidersService_ServiceDesc, srv)
+}
+
+func _ProvidersService_CreateProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).CreateProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_CreateProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).CreateProvider(ctx, req.(*CreateProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_ListProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).ListProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_ListProviders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).ListProviders(ctx, req.(*ListProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_GetProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).GetProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_GetProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).GetProvider(ctx, req.(*GetProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_UpdateProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).UpdateProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_UpdateProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).UpdateProvider(ctx, req.(*UpdateProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_DeleteProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).DeleteProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProvidersService_DeleteProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).DeleteProvider(ctx, req.(*DeleteProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProvidersService_ServiceDesc is the grpc.ServiceDesc for ProvidersService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProvidersService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "minder.v1.ProvidersService",
+	HandlerType: (*ProvidersServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateProvider",
+			Handler:    _ProvidersService_CreateProvider_Handler,
+		},
+		{
+			MethodName: "ListProviders",
+			Handler:    _ProvidersService_ListProviders_Handler,
+		},
+		{
+			MethodName: "GetProvider",
+			Handler:    _ProvidersService_GetProvider_Handler,
+		},
+		{
+			MethodName: "UpdateProvider",
+			Handler:    _ProvidersService_UpdateProvider_Handler,
+		},
+		{
+			MethodName: "DeleteProvider",
+			Handler:    _ProvidersService_DeleteProvider_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "minder/v1/minder.proto",
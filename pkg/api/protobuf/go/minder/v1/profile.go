@@ -13,3 +13,29 @@
 // limitations under the License.
 
 package v1
+
+// GetRemediateSetting returns whether and how to remediate the given rule of
+// the profile: the setting of the rule if any, else the one of the profile.
+// It returns nil if neither is set.
+func (p *Profile) GetRemediateSetting(rule *Profile_Rule) *string {
+	if rule != nil && rule.Remediate != nil {
+		return rule.Remediate
+	}
+	if p == nil {
+		return nil
+	}
+	return p.Remediate
+}
+
+// GetAlertSetting returns whether and how to alert on the given rule of the
+// profile: the setting of the rule if any, else the one of the profile.
+// It returns nil if neither is set.
+func (p *Profile) GetAlertSetting(rule *Profile_Rule) *string {
+	if rule != nil && rule.Alert != nil {
+		return rule.Alert
+	}
+	if p == nil {
+		return nil
+	}
+	return p.Alert
+}
@@ -38,3 +38,97 @@ func ParseRuleType(r io.Reader) (*RuleType, error) {
 
 	return rt, nil
 }
+
+// GetRemediateActions returns the remediations of the rule type in the order
+// they run: remediate first, followed by the remediations list.
+func (def *RuleType_Definition) GetRemediateActions() []*RuleType_Definition_Remediate {
+	var actions []*RuleType_Definition_Remediate
+	if def.GetRemediate() != nil {
+		actions = append(actions, def.GetRemediate())
+	}
+	return append(actions, def.GetRemediations()...)
+}
+
+// GetAlertActions returns the alerts of the rule type: alert first, followed
+// by the alerts list.
+func (def *RuleType_Definition) GetAlertActions() []*RuleType_Definition_Alert {
+	var actions []*RuleType_Definition_Alert
+	if def.GetAlert() != nil {
+		actions = append(actions, def.GetAlert())
+	}
+	return append(actions, def.GetAlerts()...)
+}
+
+// GetActionName returns the name identifying the remediation within the rule
+// type, which defaults to its type
+func (rem *RuleType_Definition_Remediate) GetActionName() string {
+	if rem.GetName() != "" {
+		return rem.GetName()
+	}
+	return rem.GetType()
+}
+
+// GetActionName returns the name identifying the alert within the rule type,
+// which defaults to its type
+func (alert *RuleType_Definition_Alert) GetActionName() string {
+	if alert.GetName() != "" {
+		return alert.GetName()
+	}
+	return alert.GetType()
+}
+
+const (
+	// PrContentActionReplace writes the content to the file, replacing it
+	PrContentActionReplace = "replace"
+	// PrContentActionReplaceRegex replaces the matches of a pattern in the file
+	PrContentActionReplaceRegex = "replace_regex"
+	// PrContentActionAppendLine appends a line to the file unless it has it already
+	PrContentActionAppendLine = "append_line"
+	// PrContentActionMergeYAML merges a YAML document into the file
+	PrContentActionMergeYAML = "merge_yaml"
+)
+
+// GetContentAction returns how the content patches its file, which defaults
+// to replacing it
+func (cnt *RuleType_Definition_Remediate_PullRequestRemediation_Content) GetContentAction() string {
+	if cnt.GetAction() == "" {
+		return PrContentActionReplace
+	}
+	return cnt.GetAction()
+}
+
+const (
+	// PrOnResolvedClose closes the pull request once the rule passes
+	PrOnResolvedClose = "close"
+	// PrOnResolvedComment comments on the pull request once the rule passes
+	PrOnResolvedComment = "comment"
+	// PrOnResolvedKeep leaves the pull request as is once the rule passes
+	PrOnResolvedKeep = "keep"
+)
+
+// GetOnResolvedAction returns what to do with the pull request once the rule
+// passes, which defaults to closing it
+func (prRem *RuleType_Definition_Remediate_PullRequestRemediation) GetOnResolvedAction() string {
+	if prRem.GetOnResolved() == "" {
+		return PrOnResolvedClose
+	}
+	return prRem.GetOnResolved()
+}
+
+const (
+	// PrMergeMethodMerge merges the pull request with a merge commit
+	PrMergeMethodMerge = "merge"
+	// PrMergeMethodSquash squashes the commits of the pull request
+	PrMergeMethodSquash = "squash"
+	// PrMergeMethodRebase rebases the commits of the pull request
+	PrMergeMethodRebase = "rebase"
+)
+
+// GetMergeMethod returns the method to merge the pull request with, which
+// defaults to a merge commit
+func (am *RuleType_Definition_Remediate_PullRequestRemediation_AutoMerge) GetMergeMethod() string {
+	if am.GetMethod() == "" {
+		return PrMergeMethodMerge
+	}
+	return am.GetMethod()
+}
@@ -17,6 +17,9 @@ package v1
 import (
 	"errors"
 	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
 )
 
 var (
@@ -33,9 +36,89 @@ type Validator interface {
 var _ Validator = (*GitHubProviderConfig)(nil)
 
 // Validate is a utility function which allows for the validation of a struct.
-func (_ *GitHubProviderConfig) Validate() error {
+func (ghcfg *GitHubProviderConfig) Validate() error {
 	// Unfortunately, we don't currently have a way to add custom tags to
 	// protobuf-generated structs, so we have to do this manually.
+	endpoints := []struct{ field, value string }{
+		{"endpoint", ghcfg.GetEndpoint()},
+		{"upload_endpoint", ghcfg.GetUploadEndpoint()},
+	}
+	for _, e := range endpoints {
+		if e.value == "" {
+			continue
+		}
+		u, err := url.Parse(e.value)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", e.field, err)
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("%s must be an absolute URL", e.field)
+		}
+	}
+
+	// the registry is a host, images are always pulled over https
+	if reg := ghcfg.GetRegistry(); strings.Contains(reg, "://") || strings.Contains(reg, "/") {
+		return fmt.Errorf("registry must be a host, optionally with a port")
+	}
+
+	return nil
+}
+
+// ensure GitLabProviderConfig implements the Validator interface
+var _ Validator = (*GitLabProviderConfig)(nil)
+
+// Validate is a utility function which allows for the validation of a struct.
+func (glcfg *GitLabProviderConfig) Validate() error {
+	if glcfg.GetEndpoint() == "" {
+		return nil
+	}
+
+	u, err := url.Parse(glcfg.GetEndpoint())
+	if err != nil {
+		return fmt.Errorf("invalid endpoint: %w", err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("endpoint must be an absolute URL")
+	}
+
+	return nil
+}
+
+// ensure GiteaProviderConfig implements the Validator interface
+var _ Validator = (*GiteaProviderConfig)(nil)
+
+// Validate is a utility function which allows for the validation of a struct.
+func (gtcfg *GiteaProviderConfig) Validate() error {
+	// Gitea is self-hosted, so there's no default endpoint
+	if gtcfg.GetEndpoint() == "" {
+		return fmt.Errorf("endpoint is required")
+	}
+
+	u, err := url.Parse(gtcfg.GetEndpoint())
+	if err != nil {
+		return fmt.Errorf("invalid endpoint: %w", err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("endpoint must be an absolute URL")
+	}
+
+	return nil
+}
+
+// ensure OCIProviderConfig implements the Validator interface
+var _ Validator = (*OCIProviderConfig)(nil)
+
+// Validate is a utility function which allows for the validation of a struct.
+func (ocicfg *OCIProviderConfig) Validate() error {
+	reg := ocicfg.GetRegistry()
+	if reg == "" {
+		return fmt.Errorf("registry is required")
+	}
+	// the registry is a host, the scheme follows from the insecure setting
+	if strings.Contains(reg, "://") || strings.Contains(reg, "/") {
+		return fmt.Errorf("registry must be a host, optionally with a port")
+	}
+
 	return nil
 }
 
@@ -115,6 +198,33 @@ func (def *RuleType_Definition) Validate() error {
 		return fmt.Errorf("%w: data eval is nil", ErrInvalidRuleTypeDefinition)
 	}
 
+	remNames := make([]string, 0, len(def.GetRemediateActions()))
+	for _, rem := range def.GetRemediateActions() {
+		remNames = append(remNames, rem.GetActionName())
+	}
+	if err := validateActionNames("remediation", remNames); err != nil {
+		return err
+	}
+
+	alertNames := make([]string, 0, len(def.GetAlertActions()))
+	for _, alert := range def.GetAlertActions() {
+		alertNames = append(alertNames, alert.GetActionName())
+	}
+	return validateActionNames("alert", alertNames)
+}
+
+func validateActionNames(class string, names []string) error {
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if name == "" {
+			return fmt.Errorf("%w: %s type cannot be empty", ErrInvalidRuleTypeDefinition, class)
+		}
+		if seen[name] {
+			return fmt.Errorf("%w: duplicate %s name %q, set a unique name", ErrInvalidRuleTypeDefinition, class, name)
+		}
+		seen[name] = true
+	}
+
 	return nil
 }
 
@@ -124,26 +234,56 @@ func (p *Profile) Validate() error {
 		return fmt.Errorf("%w: profile name cannot be empty", ErrValidationFailed)
 	}
 
-	// If the profile is nil or empty, we don't need to validate it
-	if p.Repository != nil && len(p.Repository) > 0 {
-		return validateEntity(p.Repository)
+	if p.GetAlert() == "approval" {
+		return fmt.Errorf("%w: invalid alert setting %q for profile, only remediations can wait for an approval",
+			ErrValidationFailed, p.GetAlert())
 	}
 
-	if p.BuildEnvironment != nil && len(p.BuildEnvironment) > 0 {
-		return validateEntity(p.BuildEnvironment)
+	if err := validateActionSettings("remediate", p.GetRemediateActions()); err != nil {
+		return err
 	}
 
-	if p.Artifact != nil && len(p.Artifact) > 0 {
-		return validateEntity(p.Artifact)
+	if err := validateActionSettings("alert", p.GetAlertActions()); err != nil {
+		return err
 	}
 
-	if p.PullRequest != nil && len(p.PullRequest) > 0 {
-		return validateEntity(p.PullRequest)
+	// If the profile is nil or empty, we don't need to validate it
+	for _, rules := range [][]*Profile_Rule{p.Repository, p.BuildEnvironment, p.Artifact, p.PullRequest} {
+		if len(rules) == 0 {
+			continue
+		}
+		if err := validateEntity(rules); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+func validateActionSettings(class string, settings map[string]string) error {
+	for name, setting := range settings {
+		if err := validateActionSetting(class, setting, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateActionSetting(class string, setting string, target string) error {
+	switch setting {
+	case "on", "off", "dry_run":
+		return nil
+	case "approval":
+		// only remediations can wait for the approval of a user
+		if class == "remediate" {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: invalid %s setting %q for %s, expected on, off or dry_run, or approval for remediations",
+		ErrValidationFailed, class, setting, target)
+}
+
 func validateEntity(e []*Profile_Rule) error {
 	if len(e) == 0 {
 		return fmt.Errorf("%w: entity rules cannot be empty", ErrValidationFailed)
@@ -171,6 +311,18 @@ func validateRule(r *Profile_Rule) error {
 		return fmt.Errorf("%w: rule def cannot be nil", ErrValidationFailed)
 	}
 
+	if r.Remediate != nil {
+		if err := validateActionSetting("remediate", r.GetRemediate(), "rule "+r.Type); err != nil {
+			return err
+		}
+	}
+
+	if r.Alert != nil {
+		if err := validateActionSetting("alert", r.GetAlert(), "rule "+r.Type); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -204,6 +356,24 @@ func (prRem *RuleType_Definition_Remediate_PullRequestRemediation) Validate() er
 		}
 	}
 
+	switch prRem.GetOnResolvedAction() {
+	case PrOnResolvedClose, PrOnResolvedComment, PrOnResolvedKeep:
+	default:
+		return fmt.Errorf("unknown on_resolved action: %s", prRem.GetOnResolvedAction())
+	}
+
+	if prRem.GetReopenAfterDays() < 0 {
+		return errors.New("reopen_after_days cannot be negative")
+	}
+
+	if prRem.AutoMerge != nil {
+		switch prRem.AutoMerge.GetMergeMethod() {
+		case PrMergeMethodMerge, PrMergeMethodSquash, PrMergeMethodRebase:
+		default:
+			return fmt.Errorf("unknown merge method: %s", prRem.AutoMerge.GetMergeMethod())
+		}
+	}
+
 	return nil
 }
 
@@ -215,6 +385,24 @@ func (prContent *RuleType_Definition_Remediate_PullRequestRemediation_Content) V
 		return fmt.Errorf("content path is required")
 	}
 
+	switch prContent.GetContentAction() {
+	case PrContentActionReplace, PrContentActionAppendLine, PrContentActionMergeYAML:
+		if prContent.Pattern != nil {
+			return fmt.Errorf("pattern is only supported by the %s action", PrContentActionReplaceRegex)
+		}
+	case PrContentActionReplaceRegex:
+		if prContent.GetPattern() == "" {
+			return fmt.Errorf("pattern is required by the %s action", PrContentActionReplaceRegex)
+		}
+		if _, err := regexp.Compile(prContent.GetPattern()); err != nil {
+			return fmt.Errorf("invalid pattern: %w", err)
+		}
+		// an empty content removes the matches
+		return nil
+	default:
+		return fmt.Errorf("unknown content action: %s", prContent.GetContentAction())
+	}
+
 	if prContent.Content == "" {
 		return fmt.Errorf("content is required")
 	}
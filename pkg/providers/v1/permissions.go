@@ -0,0 +1,78 @@
+// Copyright 2023 Stacklok, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"errors"
+)
+
+// Permission is something the credentials of a provider allow Minder to do.
+// Permissions are independent of the provider, each provider maps them to the
+// scopes of its tokens.
+type Permission string
+
+const (
+	// PermissionReadRepositories allows reading the contents and settings of
+	// repositories, including private ones
+	PermissionReadRepositories Permission = "read_repositories"
+	// PermissionManageWebhooks allows creating and deleting the webhooks of
+	// repositories
+	PermissionManageWebhooks Permission = "manage_webhooks"
+	// PermissionReadPackages allows listing packages and their versions
+	PermissionReadPackages Permission = "read_packages"
+	// PermissionWriteContents allows pushing branches and opening pull
+	// requests
+	PermissionWriteContents Permission = "write_contents"
+	// PermissionWriteWorkflows allows changing the CI workflows of
+	// repositories
+	PermissionWriteWorkflows Permission = "write_workflows"
+	// PermissionAdministerRepositories allows changing the settings of
+	// repositories, such as branch protection
+	PermissionAdministerRepositories Permission = "administer_repositories"
+	// PermissionWriteSecurityAdvisories allows creating and closing the
+	// security advisories of repositories
+	PermissionWriteSecurityAdvisories Permission = "write_security_advisories"
+	// PermissionWriteIssues allows opening and closing issues
+	PermissionWriteIssues Permission = "write_issues"
+	// PermissionWriteChecks allows creating and updating the check runs of
+	// commits
+	PermissionWriteChecks Permission = "write_checks"
+	// PermissionWriteStatuses allows setting the statuses of commits
+	PermissionWriteStatuses Permission = "write_statuses"
+)
+
+// EnrollmentPermissions are the permissions Minder needs to register the
+// repositories of a provider, regardless of the rule types in use
+var EnrollmentPermissions = []Permission{
+	PermissionReadRepositories,
+	PermissionManageWebhooks,
+}
+
+// ErrPermissionsUnknown is returned when the permissions granted to the
+// credentials of a provider can't be introspected, e.g. for GitHub
+// fine-grained personal access tokens
+var ErrPermissionsUnknown = errors.New("the permissions of the token can't be introspected")
+
+// PermissionIntrospector is implemented by the clients of providers which
+// can tell the permissions granted to their credentials
+type PermissionIntrospector interface {
+	// GrantedPermissions returns the permissions granted to the credentials
+	// of the client, or ErrPermissionsUnknown
+	GrantedPermissions(ctx context.Context) ([]Permission, error)
+	// PermissionScopes returns the token scopes which grant the permission,
+	// any of them is enough
+	PermissionScopes(Permission) []string
+}
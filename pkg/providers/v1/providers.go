@@ -25,6 +25,7 @@ import (
 
 	"github.com/go-git/go-git/v5"
 	"github.com/go-playground/validator/v10"
+	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/google/go-github/v53/github"
 
 	minderv1 "github.com/stacklok/minder/pkg/api/protobuf/go/minder/v1"
@@ -71,6 +72,15 @@ type RepoLister interface {
 	ListOrganizationRepsitories(context.Context, string) ([]*minderv1.Repository, error)
 }
 
+// WebhookDeleter is implemented by the clients of providers which register
+// repositories with a webhook
+type WebhookDeleter interface {
+	// DeleteRepositoryWebhook removes a webhook from a repository, given by
+	// its owner and name, and by its ID. Webhooks which no longer exist are
+	// ignored.
+	DeleteRepositoryWebhook(ctx context.Context, owner, name string, repoID, hookID int64) error
+}
+
 // GitHub is the interface for interacting with the GitHub REST API
 // Add methods here for interacting with the GitHub Rest API
 type GitHub interface {
@@ -83,6 +93,7 @@ type GitHub interface {
 	ListAllRepositories(context.Context, bool, string) ([]*github.Repository, error)
 	GetBranchProtection(context.Context, string, string, string) (*github.Protection, error)
 	UpdateBranchProtection(context.Context, string, string, string, *github.ProtectionRequest) error
+	RemoveBranchProtection(context.Context, string, string, string) error
 	ListAllPackages(context.Context, bool, string, string, int, int) ([]*github.Package, error)
 	ListPackagesByRepository(context.Context, bool, string, string, int64, int, int) ([]*github.Package, error)
 	GetPackageByName(context.Context, bool, string, string, string) (*github.Package, error)
@@ -98,6 +109,7 @@ type GitHub interface {
 	ListFiles(ctx context.Context, owner string, repo string, prNumber int,
 		perPage int, pageNumber int) ([]*github.CommitFile, *github.Response, error)
 	GetOwner() string
+	GetRegistry() string
 	ListHooks(ctx context.Context, owner, repo string) ([]*github.Hook, error)
 	DeleteHook(ctx context.Context, owner, repo string, id int64) error
 	CreateHook(ctx context.Context, owner, repo string, hook *github.Hook) (*github.Hook, error)
@@ -113,7 +125,36 @@ type GitHub interface {
 	UpdateRef(ctx context.Context, owner, repo, ref, sha string, force bool) (*github.Reference, error)
 	CreatePullRequest(ctx context.Context, owner, repo, title, body, head, base string) (*github.PullRequest, error)
 	ListPullRequests(ctx context.Context, owner, repo string, opt *github.PullRequestListOptions) ([]*github.PullRequest, error)
+	UpdatePullRequest(ctx context.Context, owner, repo string, number int, pr *github.PullRequest) (*github.PullRequest, error)
+	MergePullRequest(ctx context.Context, owner, repo string, number int,
+		commitMessage, method string) (*github.PullRequestMergeResult, error)
+	RequestReviewers(ctx context.Context, owner, repo string, number int, reviewers []string) error
+	ListCheckRunsForRef(ctx context.Context, owner, repo, ref, checkName string) ([]*github.CheckRun, error)
+	CreateCheckRun(ctx context.Context, owner, repo string, opts github.CreateCheckRunOptions) (*github.CheckRun, error)
+	UpdateCheckRun(ctx context.Context, owner, repo string, checkRunID int64,
+		opts github.UpdateCheckRunOptions) (*github.CheckRun, error)
 	CreateComment(ctx context.Context, owner, repo string, number int, comment string) error
+	CreateIssue(ctx context.Context, owner, repo string, issue *github.IssueRequest) (*github.Issue, error)
+	GetIssue(ctx context.Context, owner, repo string, number int) (*github.Issue, error)
+	CloseIssue(ctx context.Context, owner, repo string, number int) (*github.Issue, error)
+	GetFileContent(ctx context.Context, owner, repo, path, ref string) (string, error)
+}
+
+// OCI is the interface for interacting with OCI registries, through the
+// distribution API
+type OCI interface {
+	Provider
+
+	// GetRegistry returns the host of the registry
+	GetRegistry() string
+	// GetAuthenticator returns the credentials for the registry
+	GetAuthenticator() authn.Authenticator
+	// ListRepositories lists the repositories of the registry
+	ListRepositories(ctx context.Context) ([]string, error)
+	// ListTags lists the tags of a repository
+	ListTags(ctx context.Context, repository string) ([]string, error)
+	// GetDigest returns the digest of the manifest a tag points to
+	GetDigest(ctx context.Context, repository, tag string) (string, error)
 }
 
 // ParseAndValidate parses the given provider configuration and validates it.